@@ -0,0 +1,285 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	gamev1 "example/gen/game/v1"
+	"example/internal/bot"
+	"example/internal/gamemode"
+)
+
+const (
+	// 2枚目のカードが配られてから回答できるようになるまでのカウントダウン（クライアントと同じ）
+	botCardCountdown = 3 * time.Second
+	// 結果を見てから次のカードを要求するまでの間
+	botReadyDelay = 1500 * time.Millisecond
+)
+
+// ボットへのイベント配信（game_id -> player_id -> イベントのチャネル）
+var botChannels = make(map[int]map[int]chan []byte)
+var botLock sync.Mutex
+
+func (s *GameServer) AddBot(
+	ctx context.Context,
+	req *connect.Request[gamev1.AddBotRequest],
+) (*connect.Response[gamev1.AddBotResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var profile bot.Profile
+	if p := req.Msg.Profile; p != nil {
+		profile = bot.Profile{
+			Accuracy:    float64(p.AccuracyPercent) / 100,
+			MinReaction: time.Duration(p.MinReactionMs) * time.Millisecond,
+			MaxReaction: time.Duration(p.MaxReactionMs) * time.Millisecond,
+		}
+		if err := profile.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	} else {
+		level := req.Msg.Level
+		if level == "" {
+			level = "NORMAL"
+		}
+		var ok bool
+		profile, ok = bot.Level(level)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("不明なボットの強さです: %s（%v）", req.Msg.Level, bot.Levels()))
+		}
+	}
+	name := req.Msg.Name
+	if name == "" {
+		name = "BOT"
+	}
+
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+
+	// 人間のプレイヤーと同じJoinGameで参加する。非公開のゲームにも入れるよう招待コードを使う
+	joined, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: name,
+		GameId:     req.Msg.GameId,
+		InviteCode: gameEnt.InviteCode,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	botPlayer := joined.Msg.Player
+	if _, err := client.Player.UpdateOneID(int(botPlayer.Id)).SetIsBot(true).Save(ctx); err != nil {
+		log.Printf("failed to mark player %d as bot: %v", botPlayer.Id, err)
+		return nil, err
+	}
+	botPlayer.IsBot = true
+
+	startBot(gameIdInt, int(botPlayer.Id), profile)
+	maybeAutoStart(gameIdInt)
+
+	log.Printf("Bot %s (player %d) joined game %d with profile %+v", name, botPlayer.Id, gameIdInt, profile)
+	return connect.NewResponse(&gamev1.AddBotResponse{Player: botPlayer}), nil
+}
+
+// ボットのgoroutineを起動する
+func startBot(gameId int, playerId int, strategy bot.Strategy) {
+	events := make(chan []byte, 64)
+	botLock.Lock()
+	if botChannels[gameId] == nil {
+		botChannels[gameId] = make(map[int]chan []byte)
+	}
+	botChannels[gameId][playerId] = events
+	botLock.Unlock()
+
+	go runBot(gameId, playerId, strategy, events)
+}
+
+// ゲームのイベントをボットに配信する。ボットが詰まっていても送信側は待たない
+func notifyBots(gameId int, playerId int, message []byte) {
+	botLock.Lock()
+	defer botLock.Unlock()
+	for id, events := range botChannels[gameId] {
+		if playerId != 0 && id != playerId {
+			continue
+		}
+		select {
+		case events <- message:
+		default:
+			log.Printf("bot %d of game %d is busy, dropping %s", id, gameId, message)
+		}
+	}
+}
+
+// ゲームが削除された時などにボットを止める
+func stopBots(gameId int) {
+	botLock.Lock()
+	defer botLock.Unlock()
+	for _, events := range botChannels[gameId] {
+		close(events)
+	}
+	delete(botChannels, gameId)
+}
+
+// ボットとして参加中のプレイヤー
+func botPlayerIDs(gameId int) []int {
+	botLock.Lock()
+	defer botLock.Unlock()
+	var ids []int
+	for id := range botChannels[gameId] {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// ボットの本体。人間のクライアントと同じようにイベントを受け取り、ReportReadyとSubmitAnswerを呼ぶ
+func runBot(gameId int, playerId int, strategy bot.Strategy, events <-chan []byte) {
+	defer func() {
+		botLock.Lock()
+		if botChannels[gameId][playerId] == events {
+			delete(botChannels[gameId], playerId)
+		}
+		botLock.Unlock()
+		log.Printf("bot %d of game %d stopped", playerId, gameId)
+	}()
+
+	s := &GameServer{}
+	ctx := context.Background()
+	r := rand.New(rand.NewPCG(uint64(time.Now().UnixNano()), uint64(playerId)))
+	playerIdStr := strconv.Itoa(playerId)
+
+	var table []gamemode.Card
+	var answerC, readyC <-chan time.Time
+	// 回答する予定の時刻と、一時停止したときの残り時間
+	var answerAt time.Time
+	var answerLeft time.Duration
+	answerAfter := func(d time.Duration) {
+		answerAt = time.Now().Add(d)
+		answerC = time.After(d)
+	}
+	for {
+		select {
+		case b, ok := <-events:
+			if !ok {
+				return
+			}
+			var ev struct {
+				Event     string          `json:"event"`
+				PlayerID  int             `json:"player_id"`
+				IsCorrect bool            `json:"is_correct"`
+				Card      *gamemode.Card  `json:"card"`
+				Cards     []gamemode.Card `json:"cards"`
+			}
+			if err := json.Unmarshal(b, &ev); err != nil {
+				continue
+			}
+			switch ev.Event {
+			case "STARTED":
+				table = nil
+				readyC = time.After(botReadyDelay)
+			case "SUDDEN_DEATH":
+				readyC = time.After(botReadyDelay)
+			case "PAUSED":
+				// 一時停止中の回答は受け付けられないので、考えている回答は再開後に出す
+				if answerC != nil {
+					answerLeft = time.Until(answerAt)
+					answerC = nil
+				}
+			case "RESUMED":
+				if answerLeft > 0 {
+					answerAfter(answerLeft)
+					answerLeft = 0
+				}
+				readyC = time.After(botReadyDelay)
+			case "card":
+				if ev.Card == nil {
+					continue
+				}
+				table = append(table, *ev.Card)
+				if len(table) > 2 {
+					table = table[len(table)-2:]
+				}
+				if len(table) < 2 {
+					readyC = time.After(botReadyDelay)
+				} else {
+					// 2枚目はカウントダウンの後に見えるようになる。練習の反応時間も見えてからの時間なので、
+					// ゴーストも同じくカウントダウンの後から記録の時間をかけて回答する
+					answerAfter(botCardCountdown + strategy.ReactionTime(r))
+				}
+			case "cards":
+				table = ev.Cards
+				answerAfter(strategy.ReactionTime(r))
+			case "ANSWERED":
+				// 誰かが正解するか場札が変わったらこのラウンドは終わり。
+				// 他のプレイヤーの不正解では場札は変わらないので、考えている回答はそのまま出す
+				if !ev.IsCorrect && (ev.Cards == nil || sameTable(table, ev.Cards)) {
+					continue
+				}
+				answerC = nil
+				answerLeft = 0
+				if ev.Cards != nil {
+					table = ev.Cards
+				}
+				readyC = time.After(botReadyDelay)
+			case "ELIMINATED", "KICKED":
+				if ev.PlayerID == playerId {
+					return
+				}
+			case "GAME_OVER":
+				return
+			}
+		case <-answerC:
+			answerC = nil
+			card1, card2, symbol, ok := strategy.Answer(table, r)
+			if !ok {
+				continue
+			}
+			_, err := s.SubmitAnswer(ctx, connect.NewRequest(&gamev1.SubmitAnswerRequest{
+				PlayerId: playerIdStr,
+				Card1:    &gamev1.Card{Id: int32(card1.ID), Text: card1.Text},
+				Card2:    &gamev1.Card{Id: int32(card2.ID), Text: card2.Text},
+				Answer:   symbol,
+			}))
+			if err != nil {
+				log.Printf("bot %d failed to answer: %v", playerId, err)
+			}
+		case <-readyC:
+			readyC = nil
+			if _, err := s.ReportReady(ctx, connect.NewRequest(&gamev1.ReportReadyRequest{PlayerId: playerIdStr})); err != nil {
+				log.Printf("bot %d failed to report ready: %v", playerId, err)
+			}
+		}
+	}
+}
+
+// 場札が同じカードの並びか
+func sameTable(a, b []gamemode.Card) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ID != b[i].ID {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestAddBotRequiresHostToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, &gamev1.GameSettings{Visibility: string(g.VisibilityPRIVATE)})
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+	defer stopBots(int(created.GameId))
+
+	_, err := s.AddBot(ctx, connect.NewRequest(&gamev1.AddBotRequest{
+		GameId: gameId,
+		UserId: hostId,
+	}))
+	if errorCode(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected AddBot without the token to be rejected, got %v", err)
+	}
+
+	// 非公開のゲームにもボットを追加できる
+	res, err := s.AddBot(ctx, connect.NewRequest(&gamev1.AddBotRequest{
+		GameId:      gameId,
+		UserId:      hostId,
+		ResumeToken: host.ResumeToken,
+	}))
+	if err != nil {
+		t.Fatalf("expected the host to add a bot, got %v", err)
+	}
+	if !res.Msg.Player.IsBot {
+		t.Errorf("expected the added player to be a bot: %+v", res.Msg.Player)
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// gorilla/websocketは1つの接続への同時書き込みを許さないため、送信は接続ごとのgoroutineにまとめる。
+// 送信キューが溢れるほど遅い接続は切断する
+const (
+	sendQueueSize = 256
+	writeTimeout  = 10 * time.Second
+)
+
+// 接続ごとの送信キュー
+type connWriter struct {
+	send   chan []byte
+	closed bool
+}
+
+// gameClients, lobbyClientsと送信キューはどのgoroutineからも触るため、clientLockで守る
+var (
+	clientLock sync.Mutex
+	writers    = make(map[*websocket.Conn]*connWriter)
+)
+
+// 接続の送信用goroutineを起動する。Upgradeの直後に呼ぶ
+func openConn(conn *websocket.Conn) {
+	w := &connWriter{send: make(chan []byte, sendQueueSize)}
+	clientLock.Lock()
+	writers[conn] = w
+	clientLock.Unlock()
+
+	go func() {
+		for message := range w.send {
+			conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+			if err := conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				log.Printf("send %s error: %v", message, err)
+				// 読み込み側のループが終わり、登録を外す
+				conn.Close()
+			}
+		}
+		conn.Close()
+	}()
+}
+
+// 送信キューに積んだメッセージを送ってから接続を閉じる。何度呼んでもよい
+func closeConn(conn *websocket.Conn) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	closeConnLocked(conn)
+}
+
+// clientLockを保持して呼ぶ
+func closeConnLocked(conn *websocket.Conn) {
+	w, ok := writers[conn]
+	if !ok {
+		conn.Close()
+		return
+	}
+	if !w.closed {
+		w.closed = true
+		close(w.send)
+	}
+	delete(writers, conn)
+}
+
+// 接続の送信キューに積む。送れなかったらfalseを返す
+func sendMessage(conn *websocket.Conn, message []byte) bool {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	return sendMessageLocked(conn, message)
+}
+
+// clientLockを保持して呼ぶ
+func sendMessageLocked(conn *websocket.Conn, message []byte) bool {
+	w, ok := writers[conn]
+	if !ok || w.closed {
+		return false
+	}
+	select {
+	case w.send <- message:
+		return true
+	default:
+		log.Printf("send queue of %v is full, closing", conn.RemoteAddr())
+		w.closed = true
+		close(w.send)
+		delete(writers, conn)
+		return false
+	}
+}
+
+// ゲームの接続を登録する
+func addGameClient(conn *websocket.Conn, info ClientInfo) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	if gameClients[info.GameID] == nil {
+		gameClients[info.GameID] = make(map[*websocket.Conn]ClientInfo)
+	}
+	gameClients[info.GameID][conn] = info
+}
+
+// ゲームの接続の登録を外し、外した時点の登録先を返す（再戦で別のゲームに移った接続もある）
+func removeGameClient(conn *websocket.Conn) (ClientInfo, bool) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for gameId, conns := range gameClients {
+		if info, ok := conns[conn]; ok {
+			delete(conns, conn)
+			if len(conns) == 0 {
+				delete(gameClients, gameId)
+			}
+			return info, true
+		}
+	}
+	return ClientInfo{}, false
+}
+
+// ゲームの接続の写し。ロックを持たずに参照できる
+func gameConns(gameId int) map[*websocket.Conn]ClientInfo {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	conns := make(map[*websocket.Conn]ClientInfo, len(gameClients[gameId]))
+	for conn, info := range gameClients[gameId] {
+		conns[conn] = info
+	}
+	return conns
+}
+
+// プレイヤーの接続をゲームから外して閉じる
+func closePlayerConns(gameId int, playerId int) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for conn, info := range gameClients[gameId] {
+		if !info.Spectator && info.PlayerID == playerId {
+			closeConnLocked(conn)
+		}
+	}
+}
+
+// 再戦に移るプレイヤーの接続を新しいゲームに付け替える（moved: 元のplayer_id -> 新しいplayer_id）
+func moveGameClients(oldGameId int, newGameId int, moved map[int]int) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for conn, info := range gameClients[oldGameId] {
+		newPlayerId, ok := moved[info.PlayerID]
+		if info.Spectator || !ok {
+			continue
+		}
+		delete(gameClients[oldGameId], conn)
+		if gameClients[newGameId] == nil {
+			gameClients[newGameId] = make(map[*websocket.Conn]ClientInfo)
+		}
+		gameClients[newGameId][conn] = ClientInfo{GameID: newGameId, PlayerID: newPlayerId}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// 複数のgoroutineから同じ接続に送っても、メッセージが欠けずに届く
+func TestBroadcastToGameFromManyGoroutines(t *testing.T) {
+	const gameId = -1
+	const senders, perSender = 8, 20
+	testUpgrader := websocket.Upgrader{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := testUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		openConn(conn)
+		addGameClient(conn, ClientInfo{GameID: gameId, PlayerID: 1})
+		defer func() {
+			removeGameClient(conn)
+			closeConn(conn)
+		}()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer srv.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer conn.Close()
+	deadline := time.Now().Add(time.Second)
+	for len(gameConns(gameId)) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("connection was not registered")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < senders; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perSender; j++ {
+				broadcastToGame(gameId, []byte(fmt.Sprintf("%d-%d", i, j)))
+			}
+		}()
+	}
+	wg.Wait()
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	received := make(map[string]bool)
+	for len(received) < senders*perSender {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("received %d of %d messages: %v", len(received), senders*perSender, err)
+		}
+		received[string(message)] = true
+	}
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	"example/ent/dailychallenge"
+	gamev1 "example/gen/game/v1"
+	"example/internal/cardgen"
+)
+
+// デイリーチャレンジの日付の区切り（日本時間の0時）
+var dailyLocation = time.FixedZone("JST", 9*60*60)
+
+// ランキングの取得件数の既定値
+const dailyLeaderboardLimit = 100
+
+// デッキのシードを決める秘密の値。日付だけからその日のデッキを計算できないようにする。
+// DAILY_SECRETが未設定なら起動ごとに作る
+var dailySecret = loadDailySecret()
+
+func loadDailySecret() []byte {
+	if secret := os.Getenv("DAILY_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("failed to generate daily secret: %v", err)
+	}
+	return b
+}
+
+// 今日のデイリーチャレンジの日付
+func dailyToday() time.Time {
+	return time.Now().In(dailyLocation)
+}
+
+func (s *GameServer) StartDailyChallenge(
+	ctx context.Context,
+	req *connect.Request[gamev1.StartDailyChallengeRequest],
+) (*connect.Response[gamev1.StartDailyChallengeResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	// ランキングの対象は登録ユーザーのみ。名前だけでも練習として遊べる
+	var u *ent.User
+	name := req.Msg.PlayerName
+	if req.Msg.UserToken != "" {
+		var err error
+		u, err = userByToken(ctx, client, req.Msg.UserToken)
+		if err != nil {
+			return nil, err
+		}
+		name = u.Name
+	}
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+	}
+
+	// 全員が同じ並びのデッキで挑戦する
+	today := dailyToday()
+	day := today.Format(time.DateOnly)
+	seed, err := dailySeed(ctx, client, today)
+	if err != nil {
+		return nil, err
+	}
+	deck := newSeededDeck(seed)
+
+	// 今日の最初の挑戦だけをランキング対象として記録する。2回目以降は練習として遊べる
+	session := &practiceSession{
+		playerName: name,
+		seed:       seed,
+		deck:       deck,
+		daily:      true,
+	}
+	if u != nil {
+		entry, err := client.DailyChallenge.Create().
+			SetDay(day).
+			SetPlayerName(name).
+			SetUserID(u.ID).
+			SetSeed(seed).
+			SetCardCount(len(deck)).
+			Save(ctx)
+		switch {
+		case ent.IsConstraintError(err):
+			log.Printf("%s has already played the daily challenge of %s", name, day)
+		case err != nil:
+			log.Printf("failed creating daily challenge: %v", err)
+			return nil, err
+		default:
+			session.dailyID = entry.ID
+		}
+	}
+	practiceId := registerPractice(session)
+
+	log.Printf("Daily challenge %s started by %s (practice %d, ranked=%v)", day, name, practiceId, session.dailyID != 0)
+	return connect.NewResponse(&gamev1.StartDailyChallengeResponse{
+		PracticeId:    strconv.Itoa(practiceId),
+		Cards:         firstCards(deck),
+		CardCount:     int32(len(deck)),
+		Day:           day,
+		Ranked:        session.dailyID != 0,
+		PracticeToken: session.token,
+	}), nil
+}
+
+func (s *GameServer) GetDailyLeaderboard(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetDailyLeaderboardRequest],
+) (*connect.Response[gamev1.GetDailyLeaderboardResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	day := req.Msg.Day
+	if day == "" {
+		day = dailyToday().Format(time.DateOnly)
+	} else if _, err := time.Parse(time.DateOnly, day); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("日付はYYYY-MM-DDで指定してください: %s", day))
+	}
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = dailyLeaderboardLimit
+	}
+
+	ranking, err := dailyRanking(ctx, client, day)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*gamev1.DailyLeaderboardEntry
+	for i, e := range ranking {
+		if i >= limit {
+			break
+		}
+		entries = append(entries, &gamev1.DailyLeaderboardEntry{
+			Rank:       int32(i + 1),
+			PlayerName: e.PlayerName,
+			TotalMs:    *e.TotalMs,
+			Mistakes:   int32(e.Mistakes),
+		})
+	}
+	return connect.NewResponse(&gamev1.GetDailyLeaderboardResponse{
+		Day:     day,
+		Entries: entries,
+	}), nil
+}
+
+// その日のデッキのシード。記録済みの挑戦があればそのシードを使い、再起動で秘密の値が変わっても同じデッキにする
+func dailySeed(ctx context.Context, client *ent.Client, today time.Time) (int64, error) {
+	first, err := client.DailyChallenge.Query().
+		Where(dailychallenge.DayEQ(today.Format(time.DateOnly))).
+		Order(dailychallenge.ByID()).
+		First(ctx)
+	if err == nil {
+		return first.Seed, nil
+	}
+	if !ent.IsNotFound(err) {
+		log.Printf("failed querying daily challenge: %v", err)
+		return 0, err
+	}
+	return cardgen.DailySeed(dailySecret, today), nil
+}
+
+// 最後までプレイした挑戦をタイム順（同タイムはミスの少ない順、先に挑戦した順）に返す
+func dailyRanking(ctx context.Context, client *ent.Client, day string) ([]*ent.DailyChallenge, error) {
+	ranking, err := client.DailyChallenge.Query().
+		Where(
+			dailychallenge.DayEQ(day),
+			dailychallenge.TotalMsNotNil(),
+		).
+		Order(dailychallenge.ByTotalMs(), dailychallenge.ByMistakes(), dailychallenge.ByStartedAt()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed querying daily ranking: %v", err)
+		return nil, err
+	}
+	return ranking, nil
+}
+
+// ランキング対象の挑戦の結果を記録し、順位を返す
+func finishDailyChallenge(ctx context.Context, client *ent.Client, session *practiceSession, totalMs int64) (int, error) {
+	entry, err := client.DailyChallenge.UpdateOneID(session.dailyID).
+		SetTotalMs(totalMs).
+		SetReactionMs(session.reactions).
+		SetMistakes(session.mistakes).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed saving daily challenge: %v", err)
+		return 0, err
+	}
+	ranking, err := dailyRanking(ctx, client, entry.Day)
+	if err != nil {
+		return 0, err
+	}
+	for i, e := range ranking {
+		if e.ID == entry.ID {
+			return i + 1, nil
+		}
+	}
+	return 0, nil
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gamev1 "example/gen/game/v1"
+)
+
+func TestStartDailyChallengeRanksRegisteredUsersOnce(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	registered, err := s.RegisterUser(ctx, connect.NewRequest(&gamev1.RegisterUserRequest{Name: "daily-user"}))
+	if err != nil {
+		t.Fatalf("failed registering user: %v", err)
+	}
+
+	start := func(req *gamev1.StartDailyChallengeRequest) *gamev1.StartDailyChallengeResponse {
+		t.Helper()
+		res, err := s.StartDailyChallenge(ctx, connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("failed starting daily challenge: %v", err)
+		}
+		return res.Msg
+	}
+	// 登録ユーザーの名前を名乗っても、トークンがなければランキングの対象にならない
+	if res := start(&gamev1.StartDailyChallengeRequest{PlayerName: "daily-user"}); res.Ranked {
+		t.Error("expected an attempt without a user token not to be ranked")
+	}
+	first := start(&gamev1.StartDailyChallengeRequest{UserToken: registered.Msg.Token})
+	if !first.Ranked {
+		t.Error("expected the first attempt of a registered user to be ranked")
+	}
+	second := start(&gamev1.StartDailyChallengeRequest{UserToken: registered.Msg.Token})
+	if second.Ranked {
+		t.Error("expected the second attempt not to be ranked")
+	}
+	if first.Cards[0].Id != second.Cards[0].Id || first.Cards[1].Id != second.Cards[1].Id {
+		t.Error("expected the same deck for every attempt of the day")
+	}
+
+	_, err = s.StartDailyChallenge(ctx, connect.NewRequest(&gamev1.StartDailyChallengeRequest{UserToken: "wrong"}))
+	if errorCode(err) != connect.CodeUnauthenticated {
+		t.Errorf("expected a wrong user token to be rejected, got %v", err)
+	}
+}
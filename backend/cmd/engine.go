@@ -0,0 +1,607 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	"example/internal/cardgen"
+	"example/internal/gamemode"
+)
+
+type Card = gamemode.Card
+
+// Dobbleカードの位数（1枚のシンボル数は位数+1、カード・シンボルの種類は位数^2+位数+1）
+const dobbleOrder = 5
+
+// カード一式の枚数
+const deckSize = dobbleOrder*dobbleOrder + dobbleOrder + 1
+
+// 進行中のゲームの状態（デッキ・場札・ラウンド数など）
+var gameStates = make(map[int]*gamemode.State)
+
+// ゲーム作成時のデッキ（サドンデスで引き直す際に使う）
+var gameDecks = make(map[int][]Card)
+
+// 不正解でロックアウトされたプレイヤーの解除時刻（game_id -> player_id -> 解除時刻）
+var lockouts = make(map[int]map[int]time.Time)
+
+// ゲームごとの状態を持つマップ（gameStates, gameDecks, lockouts, suddenDeathGames, handicaps, 一時停止・再戦の投票）は
+// 別々のゲームから同時に書き込まれるため、ゲームのmutexとは別にgameStateLockを保持して触る。保持したまま他のロックは取らない
+var gameStateLock sync.Mutex
+
+// 作成したゲームの状態とサドンデスで引き直すためのデッキを登録する
+func registerGameState(st *gamemode.State) {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	gameStates[st.GameID] = st
+	gameDecks[st.GameID] = append([]Card(nil), st.Deck...)
+}
+
+// 進行中のゲームの状態（なければfalse）
+func gameStateOf(gameId int) (*gamemode.State, bool) {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	st, ok := gameStates[gameId]
+	return st, ok
+}
+
+// サドンデス中のゲームか
+func inSuddenDeath(gameId int) bool {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	return suddenDeathGames[gameId]
+}
+
+// シャッフル済みのDobbleカード一式を生成する
+func newShuffledDeck() []Card {
+	generatedCards, _, err := cardgen.GenerateDobbleCards(dobbleOrder)
+	if err != nil {
+		log.Fatalf("failed to generate cards: %v", err)
+	}
+
+	rand.Shuffle(len(generatedCards), func(i, j int) {
+		generatedCards[i], generatedCards[j] = generatedCards[j], generatedCards[i]
+	})
+	return toDeck(generatedCards)
+}
+
+// 生成したカードをゲームで使うカードに変換する
+func toDeck(generatedCards []cardgen.Card) []Card {
+	var cs []Card
+	for _, c := range generatedCards {
+		cs = append(cs, Card{
+			ID:   c.ID,
+			Text: "symbols: " + fmt.Sprint(c.Symbols),
+		})
+	}
+	return cs
+}
+
+func clearGameState(gameId int) {
+	gameStateLock.Lock()
+	delete(gameStates, gameId)
+	delete(gameDecks, gameId)
+	delete(suddenDeathGames, gameId)
+	delete(lockouts, gameId)
+	delete(pauseVotes, gameId)
+	delete(pausedAt, gameId)
+	delete(pausedClocks, gameId)
+	delete(rematchVotes, gameId)
+	delete(handicaps, gameId)
+	if t, ok := rematchTimers[gameId]; ok {
+		t.Stop()
+		delete(rematchTimers, gameId)
+	}
+	gameStateLock.Unlock()
+	stopBots(gameId)
+}
+
+// プレイヤーをdの間ロックアウトする。ゲームのmutexを保持して呼ぶ
+func lockOut(gameId int, playerId int, d time.Duration) {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	if lockouts[gameId] == nil {
+		lockouts[gameId] = make(map[int]time.Time)
+	}
+	lockouts[gameId][playerId] = time.Now().Add(d)
+}
+
+// ロックアウトの残り時間を返す（ロックアウトされていなければ0）。ゲームのmutexを保持して呼ぶ
+func lockoutLeft(gameId int, playerId int) time.Duration {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	until, ok := lockouts[gameId][playerId]
+	if !ok {
+		return 0
+	}
+	left := time.Until(until)
+	if left <= 0 {
+		delete(lockouts[gameId], playerId)
+		return 0
+	}
+	return left
+}
+
+// ロックアウトの残り時間を本人に通知する
+func notifyLockout(gameId int, playerId int, left time.Duration) {
+	msg := map[string]interface{}{
+		"event":      "LOCKED_OUT",
+		"game_id":    gameId,
+		"player_id":  playerId,
+		"lockout_ms": left.Milliseconds(),
+	}
+	b, _ := json.Marshal(msg)
+	sendToPlayer(gameId, playerId, b)
+}
+
+// ゲームに登録されているモードを返す（不明なモードは通常モードとして扱う）
+func gameModeOf(gameEnt *ent.Game) gamemode.GameMode {
+	mode, ok := gamemode.Lookup(gameEnt.Mode)
+	if !ok {
+		log.Printf("unknown mode %s of game %d, falling back to NORMAL", gameEnt.Mode, gameEnt.ID)
+		return gamemode.Normal{}
+	}
+	return mode
+}
+
+// ゲームの状態を取得し、プレイヤーのスコアと状態をDBから読み直す
+func loadState(ctx context.Context, client *ent.Client, gameEnt *ent.Game) *gamemode.State {
+	gameStateLock.Lock()
+	st, ok := gameStates[gameEnt.ID]
+	if !ok {
+		st = &gamemode.State{
+			GameID: gameEnt.ID,
+			Options: gamemode.Options{
+				EliminationInterval: gameEnt.EliminationInterval,
+				CenterCount:         gameEnt.CenterCount,
+				Scoring:             gameEnt.Scoring,
+			},
+		}
+		gameStates[gameEnt.ID] = st
+	}
+	gameStateLock.Unlock()
+
+	players, err := client.Player.Query().
+		Where(player.HasParentWith(g.IDEQ(gameEnt.ID))).
+		Order(player.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameEnt.ID, err)
+		return st
+	}
+	st.Players = nil
+	for _, p := range players {
+		st.Players = append(st.Players, &gamemode.Player{
+			ID:         p.ID,
+			Score:      p.Score,
+			WrongCount: p.WrongCount,
+			Active:     p.Status != player.StatusELIMINATED && p.Status != player.StatusFINISHED,
+			Team:       p.TeamID,
+		})
+		setHandicap(gameEnt.ID, p.ID, p.Handicap)
+	}
+	return st
+}
+
+func DistributeCard(client *ent.Client, gameId int) {
+	ctx := context.Background()
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("failed to get game %d: %v", gameId, err)
+		return
+	}
+	if gameEnt.Status == g.StatusPAUSED {
+		// 一時停止中は配らない（再開時に改めて確認する）
+		log.Printf("game %d is paused, holding the next card", gameId)
+		return
+	}
+	mode := gameModeOf(gameEnt)
+	st := loadState(ctx, client, gameEnt)
+	log.Printf("%d cards remaining with game id %d", len(st.Deck), gameId)
+
+	// 終了条件を満たしたらゲーム終了（同点ならサドンデスを続ける）
+	if mode.IsOver(st) && checkGameEnd(client, gameId) {
+		return
+	}
+
+	round := mode.NextRound(st)
+	for _, id := range round.Eliminated {
+		eliminatePlayer(ctx, client, st, id)
+	}
+	if round.Card == nil && round.Table == nil {
+		checkGameEnd(client, gameId)
+		return
+	}
+
+	// カード送信前にPLAYINGに更新し、次のREADY要求に備える
+	_, err = client.Player.Update().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED, player.StatusDISCONNECTED),
+		).
+		SetStatus("PLAYING").
+		Save(ctx)
+	if err != nil {
+		log.Fatalf("failed updating player status")
+	}
+
+	// 1枚ずつ配るモードは新しいカードを、場札を複数持つモードは場札全体を通知
+	var cardMsg map[string]interface{}
+	if round.Card != nil {
+		cardMsg = map[string]interface{}{
+			"event":   "card",
+			"game_id": gameId,
+			"card":    round.Card,
+		}
+	} else {
+		cardMsg = map[string]interface{}{
+			"event":   "cards",
+			"game_id": gameId,
+			"cards":   round.Table,
+		}
+	}
+	st.DealtAt = time.Now()
+	if round.Card != nil {
+		broadcastCard(gameId, cardMsg, *round.Card, st.Round+len(st.Table)-1)
+		return
+	}
+	cb, _ := json.Marshal(cardMsg)
+	broadcastToGame(gameId, cb)
+}
+
+// プレイヤーを脱落させて通知する。脱落者は観戦者としてそのままイベントを受信し続ける
+func eliminatePlayer(ctx context.Context, client *ent.Client, st *gamemode.State, playerID int) {
+	loser, err := client.Player.UpdateOneID(playerID).SetStatus(player.StatusELIMINATED).Save(ctx)
+	if err != nil {
+		log.Printf("failed to eliminate player %d: %v", playerID, err)
+		return
+	}
+
+	msg := map[string]interface{}{
+		"event":             "ELIMINATED",
+		"game_id":           st.GameID,
+		"player_id":         loser.ID,
+		"name":              loser.Name,
+		"round":             st.Round,
+		"remaining_players": len(st.ActivePlayers()),
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(st.GameID, b)
+
+	log.Printf("Player %d is ELIMINATED from game %d at round %d", loser.ID, st.GameID, st.Round)
+}
+
+// ゲームをFINISHEDにしてGAME_OVERを通知する（既に終了済みなら何もしない）
+func finishGame(client *ent.Client, gameId int) {
+	ctx := context.Background()
+	n, err := client.Game.Update().
+		Where(g.IDEQ(gameId), g.StatusIn(g.StatusSTARTED, g.StatusPAUSED)).
+		SetStatus(g.StatusFINISHED).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed updating game %d to FINISHED: %v", gameId, err)
+		return
+	}
+	if n == 0 {
+		log.Printf("game %d is already finished", gameId)
+		return
+	}
+	left := stopSharedClock(gameId)
+	endMsg := map[string]interface{}{
+		"event": "GAME_OVER",
+	}
+	gameStateLock.Lock()
+	if paused, ok := pausedClocks[gameId]; ok {
+		// 一時停止中に終了した場合は止めていた残り時間を使う
+		left = paused
+		delete(pausedClocks, gameId)
+	}
+	if suddenDeathGames[gameId] {
+		endMsg["sudden_death"] = true
+		delete(suddenDeathGames, gameId)
+	}
+	gameStateLock.Unlock()
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("failed to get game %d: %v", gameId, err)
+	} else if _, ok := gameModeOf(gameEnt).(gamemode.TeamScored); ok {
+		for k, v := range finishTeamGame(ctx, client, gameEnt, left) {
+			endMsg[k] = v
+		}
+	} else if _, ok := gameModeOf(gameEnt).(gamemode.Teamed); ok {
+		for k, v := range finishTeamsGame(ctx, client, gameEnt) {
+			endMsg[k] = v
+		}
+	} else {
+		// 観戦中でないプレイヤーのうち最高得点のプレイヤーが勝者
+		endMsg["mode"] = gameEnt.Mode
+		winner, err := client.Player.Query().
+			Where(
+				player.HasParentWith(g.IDEQ(gameId)),
+				player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED),
+			).
+			Order(player.ByScore(sql.OrderDesc()), player.ByWrongCount(), player.ByID()).
+			First(ctx)
+		if err != nil {
+			log.Printf("failed to query winner of game %d: %v", gameId, err)
+		} else {
+			endMsg["winner_id"] = winner.ID
+			endMsg["winner_name"] = winner.Name
+			if _, err := client.Game.UpdateOneID(gameId).SetWinnerID(winner.ID).Save(ctx); err != nil {
+				log.Printf("failed to save winner of game %d: %v", gameId, err)
+			}
+			if _, err := winner.Update().AddSeriesWins(1).Save(ctx); err != nil {
+				log.Printf("failed to count series win of player %d: %v", winner.ID, err)
+			}
+		}
+	}
+	// 複数ゲームの対戦なら対戦の順位も示す
+	if m := finishMatchGame(ctx, client, gameId); m != nil {
+		endMsg["match"] = m
+	}
+	// ハンデがあれば結果と一緒に示す
+	if list := handicapList(gameId); len(list) > 0 {
+		endMsg["handicaps"] = list
+	}
+	// 登録ユーザーのレーティングの変動
+	if list := rateGame(ctx, client, gameId); len(list) > 0 {
+		endMsg["ratings"] = list
+	}
+	b, _ := json.Marshal(endMsg)
+	broadcastToGame(gameId, b)
+
+	log.Printf("Game %d is FINISHED", gameId)
+	tournamentGameFinished(client, gameId)
+}
+
+/* sudden death */
+// サドンデス中のゲーム
+var suddenDeathGames = make(map[int]bool)
+
+// 終了条件を満たした時やサドンデス中の回答後に呼ぶ。首位が同点ならサドンデスを続け、決着していればゲームを終了する
+// ゲームが終了した（または既に終了していた）場合はtrueを返す
+func checkGameEnd(client *ent.Client, gameId int) bool {
+	ctx := context.Background()
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("failed to get game %d: %v", gameId, err)
+		return true
+	}
+	if gameEnt.Status != g.StatusSTARTED {
+		return true
+	}
+
+	// チームで競うモードは個人の同点ではサドンデスにしない
+	_, teamScored := gameModeOf(gameEnt).(gamemode.TeamScored)
+	_, teamed := gameModeOf(gameEnt).(gamemode.Teamed)
+	if gameEnt.TieBreak != g.TieBreakNONE && !teamScored && !teamed {
+		tied, err := tiedLeaders(ctx, client, gameId)
+		if err != nil {
+			log.Printf("failed to query tied players: %v", err)
+		} else if len(tied) > 1 {
+			continueSuddenDeath(ctx, client, gameEnt, tied)
+			return false
+		}
+	}
+	finishGame(client, gameId)
+	return true
+}
+
+// 観戦中でないプレイヤーのうち最高得点のプレイヤーを返す
+func tiedLeaders(ctx context.Context, client *ent.Client, gameId int) ([]*ent.Player, error) {
+	players, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED),
+		).
+		Order(player.ByScore(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var leaders []*ent.Player
+	for _, p := range players {
+		if p.Score != players[0].Score {
+			break
+		}
+		leaders = append(leaders, p)
+	}
+	return leaders, nil
+}
+
+// 同点のプレイヤーだけでサドンデスを行う。他のプレイヤーはFINISHEDにして観戦させる
+func continueSuddenDeath(ctx context.Context, client *ent.Client, gameEnt *ent.Game, tied []*ent.Player) {
+	var tiedIDs []int
+	for _, p := range tied {
+		tiedIDs = append(tiedIDs, p.ID)
+	}
+	watching, err := client.Player.Update().
+		Where(
+			player.HasParentWith(g.IDEQ(gameEnt.ID)),
+			player.IDNotIn(tiedIDs...),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED),
+		).
+		SetStatus(player.StatusFINISHED).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed to update watching players: %v", err)
+	}
+
+	// デッキが尽きていれば引き直す
+	st := loadState(ctx, client, gameEnt)
+	refilled := false
+	if len(st.Deck) == 0 {
+		gameStateLock.Lock()
+		deck := append([]Card(nil), gameDecks[gameEnt.ID]...)
+		gameStateLock.Unlock()
+		if gameEnt.TieBreak == g.TieBreakFRESH_DECK || len(deck) == 0 {
+			deck = newShuffledDeck()
+		} else {
+			rand.Shuffle(len(deck), func(i, j int) {
+				deck[i], deck[j] = deck[j], deck[i]
+			})
+		}
+		// 場札と同じカードを配ると全シンボルが一致してしまうため、場札は引き直す山から除く
+		onTable := make(map[int]bool, len(st.Table))
+		for _, c := range st.Table {
+			onTable[c.ID] = true
+		}
+		st.Deck = deck[:0]
+		for _, c := range deck {
+			if !onTable[c.ID] {
+				st.Deck = append(st.Deck, c)
+			}
+		}
+		refilled = true
+	}
+
+	gameStateLock.Lock()
+	already := suddenDeathGames[gameEnt.ID]
+	suddenDeathGames[gameEnt.ID] = true
+	gameStateLock.Unlock()
+	if already && watching == 0 && !refilled {
+		return
+	}
+
+	msg := map[string]interface{}{
+		"event":      "SUDDEN_DEATH",
+		"game_id":    gameEnt.ID,
+		"player_ids": tiedIDs,
+		"score":      tied[0].Score,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameEnt.ID, b)
+
+	log.Printf("Game %d is in sudden death between players %v", gameEnt.ID, tiedIDs)
+}
+
+/* shared clock */
+// 共有の持ち時間で遊ぶモード（gamemode.Clocked）の時計
+type SharedClock struct {
+	Deadline time.Time
+	Timer    *time.Timer
+}
+
+var sharedClocks = make(map[int]*SharedClock)
+var sharedClockLock sync.Mutex
+
+func startSharedClock(gameId int, d time.Duration) time.Duration {
+	sharedClockLock.Lock()
+	defer sharedClockLock.Unlock()
+	sharedClocks[gameId] = &SharedClock{
+		Deadline: time.Now().Add(d),
+		Timer:    time.AfterFunc(d, func() { sharedTimeUp(gameId) }),
+	}
+	return d
+}
+
+// 持ち時間を増減し、残り時間を返す
+func addSharedTime(gameId int, d time.Duration) time.Duration {
+	sharedClockLock.Lock()
+	defer sharedClockLock.Unlock()
+	clock, ok := sharedClocks[gameId]
+	if !ok {
+		return 0
+	}
+	clock.Deadline = clock.Deadline.Add(d)
+	left := time.Until(clock.Deadline)
+	if left < 0 {
+		left = 0
+	}
+	clock.Timer.Reset(left)
+	return left
+}
+
+// 持ち時間を止め、残り時間を返す
+func stopSharedClock(gameId int) time.Duration {
+	sharedClockLock.Lock()
+	defer sharedClockLock.Unlock()
+	clock, ok := sharedClocks[gameId]
+	if !ok {
+		return 0
+	}
+	clock.Timer.Stop()
+	delete(sharedClocks, gameId)
+	left := time.Until(clock.Deadline)
+	if left < 0 {
+		left = 0
+	}
+	return left
+}
+
+func sharedTimeUp(gameId int) {
+	log.Printf("Shared clock of game %d expired", gameId)
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	if st, ok := gameStateOf(gameId); ok {
+		st.TimeUp = true
+	}
+	client := GetDbClient(context.Background())
+	defer client.Close()
+	finishGame(client, gameId)
+}
+
+/* team scored modes */
+// チーム戦の結果を確定し、GAME_OVERに載せる情報を返す
+func finishTeamGame(ctx context.Context, client *ent.Client, gameEnt *ent.Game, left time.Duration) map[string]interface{} {
+	st, _ := gameStateOf(gameEnt.ID)
+	cleared := st != nil && len(st.Deck) == 0 && !st.TimeUp
+
+	// デッキを制覇できたら残り秒数をボーナスとして加算
+	teamScore := gameEnt.TeamScore
+	if cleared && left > 0 {
+		teamScore += int(left.Seconds())
+		_, err := client.Game.UpdateOneID(gameEnt.ID).SetTeamScore(teamScore).Save(ctx)
+		if err != nil {
+			log.Printf("failed to save team score: %v", err)
+		}
+	}
+
+	// 同じモード・デッキサイズでのチームハイスコア
+	best, err := client.Game.Query().
+		Where(
+			g.ModeEQ(gameEnt.Mode),
+			g.StatusEQ(g.StatusFINISHED),
+			g.TotalRoundsEQ(gameEnt.TotalRounds),
+		).
+		Order(g.ByTeamScore(sql.OrderDesc())).
+		First(ctx)
+	highScore := teamScore
+	if err != nil {
+		log.Printf("failed to query team high score: %v", err)
+	} else if best.TeamScore > highScore {
+		highScore = best.TeamScore
+	}
+
+	return map[string]interface{}{
+		"mode":         gameEnt.Mode,
+		"cleared":      cleared,
+		"team_score":   teamScore,
+		"high_score":   highScore,
+		"time_left_ms": left.Milliseconds(),
+	}
+}
+
+// チーム戦のモード名一覧
+func teamScoredModes() []string {
+	var names []string
+	for _, name := range gamemode.Names() {
+		mode, _ := gamemode.Lookup(name)
+		if _, ok := mode.(gamemode.TeamScored); ok {
+			names = append(names, name)
+		}
+	}
+	return names
+}
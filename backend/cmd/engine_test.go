@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	g "example/ent/game"
+	"example/internal/gamemode"
+	"example/internal/handicap"
+)
+
+func TestSuddenDeathRedrawSkipsTableCard(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	joinTestGame(t, s, created, "alice", created.HostToken)
+	joinTestGame(t, s, created, "bob", "")
+	gameId := int(created.GameId)
+	defer clearGameState(gameId)
+
+	for _, tieBreak := range []g.TieBreak{g.TieBreakREDRAW, g.TieBreakFRESH_DECK} {
+		gameEnt, err := testClient.Game.UpdateOneID(gameId).
+			SetTieBreak(tieBreak).
+			SetStatus(g.StatusSTARTED).
+			Save(ctx)
+		if err != nil {
+			t.Fatalf("failed updating game: %v", err)
+		}
+		deck := newShuffledDeck()
+		table := deck[0]
+		gameDecks[gameId] = deck[:5]
+		st := loadState(ctx, testClient, gameEnt)
+		st.Table = []Card{table}
+		st.Deck = nil
+
+		tied, err := tiedLeaders(ctx, testClient, gameId)
+		if err != nil {
+			t.Fatalf("failed querying tied players: %v", err)
+		}
+		continueSuddenDeath(ctx, testClient, gameEnt, tied)
+		if len(st.Deck) == 0 {
+			t.Fatalf("%s: expected the deck to be refilled", tieBreak)
+		}
+		for _, c := range st.Deck {
+			if c.ID == table.ID {
+				t.Errorf("%s: expected the table card %d not to be dealt again", tieBreak, table.ID)
+			}
+		}
+	}
+}
+
+// 別々のゲームの状態を同時に書き換えても、共有のマップが壊れない（go test -raceで確認する）
+func TestGameStateMapsAcrossGames(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 1; i <= 8; i++ {
+		gameId := -100 - i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				registerGameState(&gamemode.State{GameID: gameId, Deck: newShuffledDeck()[:3]})
+				lockOut(gameId, 1, time.Second)
+				lockoutLeft(gameId, 1)
+				setHandicap(gameId, 1, handicap.Handicap{AnswerDelayMs: 100})
+				handicapList(gameId)
+				clearGameState(gameId)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestDeckSizeMatchesGeneratedDeck(t *testing.T) {
+	if n := len(newShuffledDeck()); n != deckSize {
+		t.Errorf("expected a deck of %d cards, got %d", deckSize, n)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+	"example/internal/bot"
+	"example/internal/gamemode"
+	"example/internal/invite"
+	"example/internal/scoring"
+)
+
+// 練習の記録を相手にした対戦。記録と同じシードのデッキで非公開のゲームを作り、
+// 記録の反応時間どおりに回答するボット（ゴースト）と対戦する
+func (s *GameServer) StartGhostRace(
+	ctx context.Context,
+	req *connect.Request[gamev1.StartGhostRaceRequest],
+) (*connect.Response[gamev1.StartGhostRaceResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	if req.Msg.PlayerName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+	}
+	run, err := client.PracticeRun.Get(ctx, int(req.Msg.RunId))
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("記録 %d は存在しません", req.Msg.RunId))
+	}
+	if err != nil {
+		log.Printf("failed querying practice run: %v", err)
+		return nil, err
+	}
+
+	// 記録と同じ並びのデッキを作る
+	deck := newSeededDeck(run.Seed)
+	if run.CardCount > len(deck) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("記録のデッキ（%d枚）を再現できません", run.CardCount))
+	}
+	deck = deck[:run.CardCount]
+	mode := gamemode.Normal{}
+	opts := gamemode.Options{Scoring: scoring.Default()}
+	totalRounds, err := mode.Setup(&opts, len(deck))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// 人間が接続したら自動で始まるようにする
+	game, err := client.Game.Create().
+		SetName(fmt.Sprintf("%s vs %sのゴースト", req.Msg.PlayerName, run.PlayerName)).
+		SetTotalRounds(totalRounds).
+		SetMode(mode.Name()).
+		SetScoring(opts.Scoring).
+		SetMinPlayers(2).
+		SetMaxPlayers(2).
+		SetVisibility(g.VisibilityPRIVATE).
+		SetInviteCode(invite.NewCode()).
+		SetHostToken(newResumeToken()).
+		SetAutoStart(true).
+		SetCardCount(len(deck)).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed creating ghost race game: %v", err)
+		return nil, err
+	}
+	registerGameState(&gamemode.State{
+		GameID:  game.ID,
+		Options: opts,
+		Deck:    deck,
+	})
+	gameIdStr := strconv.Itoa(game.ID)
+
+	// 非公開のゲームなので招待コードで参加する。人間のプレイヤーがホストになる
+	joined, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: req.Msg.PlayerName,
+		GameId:     gameIdStr,
+		InviteCode: game.InviteCode,
+		HostToken:  game.HostToken,
+	}))
+	if err != nil {
+		discardGame(ctx, client, game.ID)
+		return nil, err
+	}
+	ghostJoined, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: run.PlayerName + "のゴースト",
+		GameId:     gameIdStr,
+		InviteCode: game.InviteCode,
+	}))
+	if err != nil {
+		discardGame(ctx, client, game.ID)
+		return nil, err
+	}
+	ghost := ghostJoined.Msg.Player
+	if _, err := client.Player.UpdateOneID(int(ghost.Id)).SetIsBot(true).Save(ctx); err != nil {
+		log.Printf("failed to mark player %d as ghost: %v", ghost.Id, err)
+		return nil, err
+	}
+	ghost.IsBot = true
+	startBot(game.ID, int(ghost.Id), bot.NewGhost(run.ReactionMs))
+
+	log.Printf("Ghost race %d started: %s vs run %d of %s", game.ID, req.Msg.PlayerName, run.ID, run.PlayerName)
+	return connect.NewResponse(&gamev1.StartGhostRaceResponse{
+		GameId:       int32(game.ID),
+		Player:       joined.Msg.Player,
+		ResumeToken:  joined.Msg.ResumeToken,
+		Ghost:        ghost,
+		GhostTotalMs: run.TotalMs,
+	}), nil
+}
+
+// 対戦を用意できなかったゲームを片付ける
+func discardGame(ctx context.Context, client *ent.Client, gameId int) {
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	clearGameState(gameId)
+	if _, err := client.Player.Delete().Where(player.HasParentWith(g.IDEQ(gameId))).Exec(ctx); err != nil {
+		log.Printf("failed deleting players of game %d: %v", gameId, err)
+	}
+	if err := client.Game.DeleteOneID(gameId).Exec(ctx); err != nil {
+		log.Printf("failed deleting game %d: %v", gameId, err)
+	}
+}
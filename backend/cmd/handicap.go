@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+	"example/internal/handicap"
+)
+
+// おとりのシンボルはカードのシンボルより大きい番号を使う
+const decoyBase = dobbleOrder*dobbleOrder + dobbleOrder + 1
+
+// ゲームごとのハンデ（game_id -> player_id -> ハンデ）。カードを配るたびにDBを読まないよう保持する
+var handicaps = make(map[int]map[int]handicap.Handicap)
+
+func (s *GameServer) SetHandicap(
+	ctx context.Context,
+	req *connect.Request[gamev1.SetHandicapRequest],
+) (*connect.Response[gamev1.SetHandicapResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	var h handicap.Handicap
+	if p := req.Msg.Handicap; p != nil {
+		h = handicap.Handicap{
+			ExtraSymbols:      int(p.ExtraSymbols),
+			AnswerDelayMs:     int(p.AnswerDelayMs),
+			MultiplierPercent: int(p.MultiplierPercent),
+		}
+	}
+	if err := h.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if gameEnt.Status == g.StatusFINISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("終了したゲームのハンデは変更できません"))
+	}
+	// 場札を複数並べるモードでは、おとり同士が一致しないことを保証できない
+	if h.ExtraSymbols > 0 && gameEnt.Mode == (gamemode.Speed{}).Name() {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("おとりのシンボルは1枚ずつ配るモードでのみ使えます"))
+	}
+	target, err := client.Player.Query().
+		Where(
+			player.IDEQ(playerIdInt),
+			player.HasParentWith(g.IDEQ(gameIdInt)),
+		).Only(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("プレイヤー %d はこのゲームにいません", playerIdInt))
+	}
+
+	update := target.Update()
+	if h.IsZero() {
+		update.ClearHandicap()
+	} else {
+		update.SetHandicap(h)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("failed to set handicap of player %d: %v", playerIdInt, err)
+		return nil, err
+	}
+	setHandicap(gameIdInt, playerIdInt, h)
+
+	msg := map[string]interface{}{
+		"event":     "HANDICAP",
+		"game_id":   gameIdInt,
+		"player_id": playerIdInt,
+		"handicap":  h,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+	broadcastPlayers(ctx, client, gameIdInt)
+
+	log.Printf("handicap of player %d in game %d set to %+v", playerIdInt, gameIdInt, h)
+	return connect.NewResponse(&gamev1.SetHandicapResponse{}), nil
+}
+
+// ハンデを記録する（ハンデなしなら消す）。ゲームのmutexを保持して呼ぶ
+func setHandicap(gameId int, playerId int, h handicap.Handicap) {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	if h.IsZero() {
+		delete(handicaps[gameId], playerId)
+		return
+	}
+	if handicaps[gameId] == nil {
+		handicaps[gameId] = make(map[int]handicap.Handicap)
+	}
+	handicaps[gameId][playerId] = h
+}
+
+// プレイヤーのハンデ（なければゼロ値）。ゲームのmutexを保持して呼ぶ
+func handicapOf(gameId int, playerId int) handicap.Handicap {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	return handicaps[gameId][playerId]
+}
+
+// 結果に載せるハンデの一覧（プレイヤーID順）。ゲームのmutexを保持して呼ぶ
+func handicapList(gameId int) []map[string]interface{} {
+	gameStateLock.Lock()
+	defer gameStateLock.Unlock()
+	var ids []int
+	for id := range handicaps[gameId] {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	var list []map[string]interface{}
+	for _, id := range ids {
+		list = append(list, map[string]interface{}{
+			"player_id": id,
+			"handicap":  handicaps[gameId][id],
+		})
+	}
+	return list
+}
+
+// 場札をプレイヤーから見たカードにする。Table[i]はRound+i枚目に配られたカード
+func tableFor(gameId int, playerId int, st *gamemode.State) []Card {
+	h := handicapOf(gameId, playerId)
+	if h.ExtraSymbols == 0 {
+		return st.Table
+	}
+	cards := make([]Card, len(st.Table))
+	for i, c := range st.Table {
+		cards[i] = decorateCard(c, st.Round+i, h.ExtraSymbols)
+	}
+	return cards
+}
+
+// おとりのシンボルを加えたカード。dealIndexは何枚目に配られたカードか
+func decorateCard(c Card, dealIndex int, n int) Card {
+	return Card{
+		ID:   c.ID,
+		Text: "symbols: " + fmt.Sprint(handicap.Decorate(c.Symbols(), c.ID, dealIndex, n, decoyBase)),
+	}
+}
+
+// 配ったカードを通知する。おとりのシンボルのハンデがあるプレイヤーにはそのプレイヤー用のカードを送る
+// ゲームのmutexを保持して呼ぶ
+func broadcastCard(gameId int, msg map[string]interface{}, card Card, dealIndex int) {
+	b, _ := json.Marshal(msg)
+	decorated := false
+	gameStateLock.Lock()
+	for _, h := range handicaps[gameId] {
+		if h.ExtraSymbols > 0 {
+			decorated = true
+		}
+	}
+	gameStateLock.Unlock()
+	if !decorated {
+		broadcastToGame(gameId, b)
+		return
+	}
+
+	notifyBots(gameId, 0, b)
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for conn, info := range gameClients[gameId] {
+		out := b
+		if h := handicapOf(gameId, info.PlayerID); !info.Spectator && h.ExtraSymbols > 0 {
+			own := make(map[string]interface{}, len(msg))
+			for k, v := range msg {
+				own[k] = v
+			}
+			own["card"] = decorateCard(card, dealIndex, h.ExtraSymbols)
+			out, _ = json.Marshal(own)
+		}
+		sendMessageLocked(conn, out)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gamev1 "example/gen/game/v1"
+)
+
+func TestSetHandicapRequiresHostToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	guest := joinTestGame(t, s, created, "guest", "")
+	defer clearGameState(int(created.GameId))
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+	guestId := strconv.Itoa(int(guest.Player.Id))
+
+	tests := []struct {
+		name   string
+		userId string
+		token  string
+		want   connect.Code
+	}{
+		{"host id without token", hostId, "", connect.CodeUnauthenticated},
+		{"guest", guestId, guest.ResumeToken, connect.CodePermissionDenied},
+		{"host", hostId, host.ResumeToken, 0},
+	}
+	for _, tt := range tests {
+		_, err := s.SetHandicap(ctx, connect.NewRequest(&gamev1.SetHandicapRequest{
+			GameId:      gameId,
+			UserId:      tt.userId,
+			PlayerId:    hostId,
+			Handicap:    &gamev1.Handicap{AnswerDelayMs: 500},
+			ResumeToken: tt.token,
+		}))
+		if got := errorCode(err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
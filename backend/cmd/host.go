@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+)
+
+// リクエストしたプレイヤーを本人確認用トークンで確認する。
+// プレイヤーIDはイベントやゲーム一覧で公開されているので、IDだけでは本人とみなさない
+func authenticatePlayer(ctx context.Context, client *ent.Client, gameId int, playerId string, token string) (*ent.Player, error) {
+	id, err := strconv.Atoi(playerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid user_id: %s", playerId))
+	}
+	p, err := client.Player.Query().
+		Where(
+			player.IDEQ(id),
+			player.HasParentWith(g.IDEQ(gameId)),
+		).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		log.Printf("failed to query player %d: %v", id, err)
+		return nil, err
+	}
+	if p == nil || p.ResumeToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(p.ResumeToken)) != 1 {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("本人確認に失敗しました"))
+	}
+	return p, nil
+}
+
+// リクエストしたプレイヤーがゲームのホスト本人か確認する
+func requireHost(ctx context.Context, client *ent.Client, gameId int, userId string, token string) error {
+	p, err := authenticatePlayer(ctx, client, gameId, userId, token)
+	if err != nil {
+		return err
+	}
+	if !p.IsHost {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("ホストのみ実行できます"))
+	}
+	return nil
+}
+
+// 参加するプレイヤーがゲーム作成時のホスト用トークンを示しているか確認する。
+// 既にホストがいるゲームでは誰もホストにならない
+func claimsHost(ctx context.Context, client *ent.Client, gameEnt *ent.Game, token string) (bool, error) {
+	if token == "" || gameEnt.HostToken == "" {
+		return false, nil
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(gameEnt.HostToken)) != 1 {
+		return false, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("ホスト用トークンが正しくありません"))
+	}
+	hosted, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameEnt.ID)),
+			player.IsHost(true),
+		).Exist(ctx)
+	if err != nil {
+		log.Printf("failed checking host of game %d: %v", gameEnt.ID, err)
+		return false, err
+	}
+	return !hosted, nil
+}
+
+// ホストを参加順で次のプレイヤーに引き継ぎ、参加者に通知する。残っているプレイヤーがいなければnilを返す
+func migrateHost(ctx context.Context, client *ent.Client, gameId int) *ent.Player {
+	next, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED, player.StatusDISCONNECTED),
+			player.IsBot(false),
+		).
+		Order(player.ByID()).
+		First(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			log.Printf("failed to find next host of game %d: %v", gameId, err)
+		}
+		return nil
+	}
+	next, err = next.Update().SetIsHost(true).Save(ctx)
+	if err != nil {
+		log.Printf("failed to set host of game %d: %v", gameId, err)
+		return nil
+	}
+
+	msg := map[string]interface{}{
+		"event":   "HOST_CHANGED",
+		"game_id": gameId,
+		"host_id": next.ID,
+		"name":    next.Name,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	log.Printf("host of game %d passed to player %d", gameId, next.ID)
+	return next
+}
+
+// ゲームのホストのIDを返す（ホストがいなければ0）
+func hostIDOf(gameEnt *ent.Game) int {
+	for _, p := range gameEnt.Edges.Players {
+		if p.IsHost {
+			return p.ID
+		}
+	}
+	return 0
+}
+
+func (s *GameServer) UpdateGameSettings(
+	ctx context.Context,
+	req *connect.Request[gamev1.UpdateGameSettingsRequest],
+) (*connect.Response[gamev1.UpdateGameSettingsResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	settings, err := validateSettings(req.Msg.Settings)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusCREATED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのゲームの設定は変更できません"))
+	}
+	count, err := gameEnt.QueryPlayers().Count(ctx)
+	if err != nil {
+		log.Printf("failed counting players: %v", err)
+		return nil, err
+	}
+	if settings.MaxPlayers > 0 && int(settings.MaxPlayers) < count {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("参加人数の上限を現在の参加者数(%d人)より少なくはできません", count))
+	}
+
+	gameEnt, err = gameEnt.Update().
+		SetMaxPlayers(int(settings.MaxPlayers)).
+		SetMinPlayers(int(settings.MinPlayers)).
+		SetVisibility(g.Visibility(settings.Visibility)).
+		SetAutoStart(settings.AutoStart).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed updating settings of game %d: %v", gameIdInt, err)
+		return nil, err
+	}
+
+	msg := map[string]interface{}{
+		"event":    "SETTINGS_UPDATED",
+		"game_id":  gameIdInt,
+		"settings": settingsToProto(gameEnt),
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+	lobbyMsg := map[string]interface{}{
+		"event": "SETTINGS_UPDATED",
+	}
+	lb, _ := json.Marshal(lobbyMsg)
+	broadcastToLobby(lb)
+
+	log.Printf("settings of game %d updated: %v", gameIdInt, settings)
+	return connect.NewResponse(&gamev1.UpdateGameSettingsResponse{}), nil
+}
+
+func (s *GameServer) KickPlayer(
+	ctx context.Context,
+	req *connect.Request[gamev1.KickPlayerRequest],
+) (*connect.Response[gamev1.KickPlayerResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusCREATED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのゲームではキックできません"))
+	}
+	target, err := moderationTarget(ctx, client, gameIdInt, playerIdInt)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsHost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ホスト自身はキックできません"))
+	}
+	if err := removePlayer(ctx, client, gameIdInt, target, "KICKED"); err != nil {
+		return nil, err
+	}
+
+	log.Printf("player %d kicked from game %d", playerIdInt, gameIdInt)
+	return connect.NewResponse(&gamev1.KickPlayerResponse{}), nil
+}
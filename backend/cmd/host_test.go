@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gamev1 "example/gen/game/v1"
+)
+
+func TestJoinGameHostIsCreator(t *testing.T) {
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	if created.HostToken == "" {
+		t.Fatal("expected a host token for the creator")
+	}
+
+	// 先に参加してもトークンがなければホストにならない
+	first := joinTestGame(t, s, created, "first", "")
+	if first.Player.IsHost {
+		t.Error("expected the first joiner without the host token not to be host")
+	}
+	creator := joinTestGame(t, s, created, "creator", created.HostToken)
+	if !creator.Player.IsHost {
+		t.Error("expected the creator with the host token to be host")
+	}
+	// トークンを使い回してもホストは1人だけ
+	again := joinTestGame(t, s, created, "again", created.HostToken)
+	if again.Player.IsHost {
+		t.Error("expected only one host per game")
+	}
+
+	_, err := s.JoinGame(context.Background(), connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: "forger",
+		InviteCode: created.InviteCode,
+		HostToken:  "wrong",
+	}))
+	if errorCode(err) != connect.CodeUnauthenticated {
+		t.Errorf("expected a wrong host token to be rejected, got %v", err)
+	}
+}
+
+func TestKickPlayerRequiresHostToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	guest := joinTestGame(t, s, created, "guest", "")
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+	guestId := strconv.Itoa(int(guest.Player.Id))
+
+	tests := []struct {
+		name  string
+		token string
+		want  connect.Code
+	}{
+		{"no token", "", connect.CodeUnauthenticated},
+		{"another player's token", guest.ResumeToken, connect.CodeUnauthenticated},
+		{"host token", host.ResumeToken, 0},
+	}
+	for _, tt := range tests {
+		_, err := s.KickPlayer(ctx, connect.NewRequest(&gamev1.KickPlayerRequest{
+			GameId:      gameId,
+			UserId:      hostId,
+			PlayerId:    guestId,
+			ResumeToken: tt.token,
+		}))
+		if got := errorCode(err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestStartGameRejectsNonHost(t *testing.T) {
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	joinTestGame(t, s, created, "host", created.HostToken)
+	guest := joinTestGame(t, s, created, "guest", "")
+
+	_, err := s.StartGame(context.Background(), connect.NewRequest(&gamev1.StartGameRequest{
+		GameId:      strconv.Itoa(int(created.GameId)),
+		UserId:      strconv.Itoa(int(guest.Player.Id)),
+		ResumeToken: guest.ResumeToken,
+	}))
+	if errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected a guest not to start the game, got %v", err)
+	}
+}
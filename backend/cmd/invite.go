@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+	"example/internal/invite"
+)
+
+// 招待コードから参加者を募集中のゲームを探す
+func gameByInviteCode(ctx context.Context, client *ent.Client, code string) (*ent.Game, error) {
+	gameEnt, err := client.Game.Query().
+		Where(
+			g.InviteCodeEQ(invite.Normalize(code)),
+			g.StatusEQ(g.StatusCREATED),
+		).
+		Order(g.ByID()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("招待コードに一致するゲームがありません"))
+	}
+	if err != nil {
+		log.Printf("failed to query game by invite code: %v", err)
+		return nil, err
+	}
+	return gameEnt, nil
+}
+
+// PRIVATEやパスワード付きのゲームに参加するときに招待コードかパスワードを確認する。
+// トーナメントの試合は参加者の確認で代える
+func checkInvite(ctx context.Context, client *ent.Client, gameEnt *ent.Game, code string, password string) error {
+	if gameEnt.Visibility != g.VisibilityPRIVATE && gameEnt.PasswordHash == "" {
+		return nil
+	}
+	if invite.MatchCode(gameEnt.InviteCode, code) {
+		return nil
+	}
+	if gameEnt.PasswordHash != "" && password != "" && invite.CheckPassword(gameEnt.PasswordHash, password) {
+		return nil
+	}
+	if tournamentGame, err := gameEnt.QueryTournamentMatches().Exist(ctx); err != nil {
+		log.Printf("failed checking tournament of game %d: %v", gameEnt.ID, err)
+		return err
+	} else if tournamentGame {
+		return nil
+	}
+	if gameEnt.PasswordHash != "" {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("パスワードか招待コードが正しくありません"))
+	}
+	return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("このゲームに参加するには招待コードが必要です"))
+}
+
+func (s *GameServer) RotateInviteCode(
+	ctx context.Context,
+	req *connect.Request[gamev1.RotateInviteCodeRequest],
+) (*connect.Response[gamev1.RotateInviteCodeResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	if gameEnt.Status == g.StatusFINISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("終了したゲームの招待コードは変更できません"))
+	}
+	gameEnt, err = gameEnt.Update().SetInviteCode(invite.NewCode()).Save(ctx)
+	if err != nil {
+		log.Printf("failed to rotate invite code of game %d: %v", gameIdInt, err)
+		return nil, err
+	}
+
+	log.Printf("Invite code of game %d is rotated", gameIdInt)
+	return connect.NewResponse(&gamev1.RotateInviteCodeResponse{
+		InviteCode: gameEnt.InviteCode,
+	}), nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestJoinPrivateGameRequiresInvite(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, &gamev1.GameSettings{Visibility: string(g.VisibilityPRIVATE)})
+	gameId := strconv.Itoa(int(created.GameId))
+
+	_, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: "stranger",
+		GameId:     gameId,
+	}))
+	if errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected joining without the code to be denied, got %v", err)
+	}
+	_, err = s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: "guesser",
+		GameId:     gameId,
+		InviteCode: "AAAAAAAAAA",
+	}))
+	if errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected joining with a wrong code to be denied, got %v", err)
+	}
+	// game_idを省略してもコードからゲームを探す
+	joined := joinTestGame(t, s, created, "friend", "")
+	if joined.Player.GameId != created.GameId {
+		t.Errorf("expected to join game %d, got %d", created.GameId, joined.Player.GameId)
+	}
+}
+
+func TestJoinGameWithPassword(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	res, err := s.CreateGame(ctx, connect.NewRequest(&gamev1.CreateGameRequest{
+		GameName:  t.Name(),
+		CardCount: 5,
+		Password:  "secret",
+	}))
+	if err != nil {
+		t.Fatalf("failed creating game: %v", err)
+	}
+	gameId := strconv.Itoa(int(res.Msg.GameId))
+
+	tests := []struct {
+		name     string
+		password string
+		want     connect.Code
+	}{
+		{"no password", "", connect.CodePermissionDenied},
+		{"wrong password", "guess", connect.CodePermissionDenied},
+		{"right password", "secret", 0},
+	}
+	for _, tt := range tests {
+		_, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+			PlayerName: tt.name,
+			GameId:     gameId,
+			Password:   tt.password,
+		}))
+		if got := errorCode(err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestRotateInviteCode(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, &gamev1.GameSettings{Visibility: string(g.VisibilityPRIVATE)})
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+
+	_, err := s.RotateInviteCode(ctx, connect.NewRequest(&gamev1.RotateInviteCodeRequest{
+		GameId: gameId,
+		UserId: hostId,
+	}))
+	if errorCode(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected rotating without the token to be rejected, got %v", err)
+	}
+	rotated, err := s.RotateInviteCode(ctx, connect.NewRequest(&gamev1.RotateInviteCodeRequest{
+		GameId:      gameId,
+		UserId:      hostId,
+		ResumeToken: host.ResumeToken,
+	}))
+	if err != nil {
+		t.Fatalf("expected the host to rotate the code, got %v", err)
+	}
+
+	// 古いコードは使えなくなる
+	_, err = s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: "late",
+		GameId:     gameId,
+		InviteCode: created.InviteCode,
+	}))
+	if errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected the old code to be rejected, got %v", err)
+	}
+	_, err = s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: "friend",
+		GameId:     gameId,
+		InviteCode: rotated.Msg.InviteCode,
+	}))
+	if err != nil {
+		t.Errorf("expected the new code to be accepted, got %v", err)
+	}
+}
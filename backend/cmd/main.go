@@ -2,9 +2,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
-	"math/rand/v2"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/rs/cors"
@@ -20,14 +21,20 @@ import (
 
 	"example/ent/game"
 	g "example/ent/game"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/team"
 	gamev1 "example/gen/game/v1"
 	"example/gen/game/v1/gamev1connect"
-	"example/internal/cardgen"
+	"example/internal/gamemode"
+	"example/internal/invite"
+	"example/internal/scoring"
+	"example/internal/series"
 
 	"github.com/gorilla/websocket"
 
 	"example/ent"
+	"example/ent/ban"
 
 	"encoding/json"
 
@@ -39,16 +46,22 @@ import (
 type ClientInfo struct {
 	GameID   int
 	PlayerID int
+	// Spectatorは観戦者の接続（PlayerIDは0）
+	Spectator bool
 }
 
+// ゲームごとの接続（clientLockを保持して触る）
 var gameClients = make(map[int]map[*websocket.Conn]ClientInfo)
 
 const DB_FILE = "file:backend/.db/ent.db?_fk=1"
 
+// 接続するデータベース（テストではインメモリーのSQLiteに差し替える）
+var dbSource = DB_FILE
+
 func GetDbClient(
 	ctx context.Context,
 ) *ent.Client {
-	client, err := ent.Open(dialect.SQLite, DB_FILE)
+	client, err := ent.Open(dialect.SQLite, dbSource)
 	if err != nil {
 		log.Fatalf("failed opening connection to sqlite: %v", err)
 	}
@@ -90,6 +103,14 @@ func (s *GameServer) JoinGame(
 	// レコード追加
 	game_id := req.Msg.GameId
 	player_name := req.Msg.PlayerName
+	if game_id == "" && req.Msg.InviteCode != "" {
+		// ゲームIDを省略した場合は招待コードからゲームを探す
+		invited, err := gameByInviteCode(ctx, client, req.Msg.InviteCode)
+		if err != nil {
+			return nil, err
+		}
+		game_id = strconv.Itoa(invited.ID)
+	}
 	gameIDInt, err := strconv.Atoi(game_id) // str to int
 	if err != nil {
 		log.Printf("invalid game_id: %v", err)
@@ -106,8 +127,89 @@ func (s *GameServer) JoinGame(
 	if gameEnt.Status != g.StatusCREATED {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのゲームには参加できません"))
 	}
+	// 非公開・パスワード付きのゲームは招待コードかパスワードが必要
+	if err := checkInvite(ctx, client, gameEnt, req.Msg.InviteCode, req.Msg.Password); err != nil {
+		return nil, err
+	}
 
-	newPlayer, err := client.Player.Create().SetName(player_name).SetParentID(gameIDInt).Save(ctx)
+	// 人数確認から登録までの間に他の参加者が割り込まないようにする
+	mu := getGameMutex(gameIDInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	count, err := client.Player.Query().
+		Where(player.HasParentWith(g.IDEQ(gameIDInt))).
+		Count(ctx)
+	if err != nil {
+		log.Printf("failed counting players: %v", err)
+		return nil, err
+	}
+	if gameEnt.MaxPlayers > 0 && count >= gameEnt.MaxPlayers {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("ゲームは満員です（上限%d人）", gameEnt.MaxPlayers))
+	}
+
+	// 登録ユーザーとして参加する場合、名前を省略すればユーザー名を使う
+	var u *ent.User
+	if req.Msg.UserToken != "" {
+		u, err = userByToken(ctx, client, req.Msg.UserToken)
+		if err != nil {
+			return nil, err
+		}
+		if player_name == "" {
+			player_name = u.Name
+		}
+		joined, err := client.Player.Query().
+			Where(
+				player.HasParentWith(g.IDEQ(gameIDInt)),
+				player.UserIDEQ(u.ID),
+			).Exist(ctx)
+		if err != nil {
+			log.Printf("failed checking user: %v", err)
+			return nil, err
+		}
+		if joined {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s は既にこのゲームに参加しています", u.Name))
+		}
+	}
+
+	// トーナメントの試合には参加者のトークンで参加し、登録した名前を使う
+	entrant, err := checkTournamentJoin(ctx, client, gameIDInt, req.Msg.EntrantToken)
+	if err != nil {
+		return nil, err
+	}
+	if entrant != nil {
+		player_name = entrant.Name
+	}
+
+	if err := checkBan(ctx, client, gameIDInt, player_name, u); err != nil {
+		return nil, err
+	}
+
+	teamID, err := teamForJoin(ctx, client, gameEnt, int(req.Msg.TeamId))
+	if err != nil {
+		return nil, err
+	}
+
+	// 作成時に渡したホスト用トークンを示したプレイヤー（ゲームの作成者）がホストになる
+	isHost, err := claimsHost(ctx, client, gameEnt, req.Msg.HostToken)
+	if err != nil {
+		return nil, err
+	}
+	playerCreate := client.Player.Create().
+		SetName(player_name).
+		SetParentID(gameIDInt).
+		SetIsHost(isHost).
+		SetResumeToken(newResumeToken())
+	if teamID != 0 {
+		playerCreate.SetTeamID(teamID)
+	}
+	if u != nil {
+		playerCreate.SetUserID(u.ID)
+	}
+	if entrant != nil {
+		playerCreate.SetTournamentSeed(entrant.Seed)
+	}
+	newPlayer, err := playerCreate.Save(ctx)
 	if err != nil {
 		log.Printf("failed creating player: %v", err)
 		return nil, err
@@ -120,14 +222,14 @@ func (s *GameServer) JoinGame(
 			Id:     int32(newPlayer.ID),
 			Name:   player_name,
 			Score:  int32(newPlayer.Score),
+			IsHost: newPlayer.IsHost,
+			IsBot:  newPlayer.IsBot,
+			TeamId: int32(newPlayer.TeamID),
 		},
+		ResumeToken: newPlayer.ResumeToken,
 	})
 
-	totalCards := len(unsentCards[gameIDInt])
-	totalRounds := totalCards - 1
-	if totalRounds < 0 {
-		totalRounds = 0
-	}
+	totalRounds := gameEnt.TotalRounds
 	// ゲームの全プレイヤー一覧を取得
 	allPlayers, _ := client.Player.Query().
 		Where(player.HasParentWith(g.IDEQ(gameIDInt))).
@@ -138,6 +240,11 @@ func (s *GameServer) JoinGame(
 			"player_id": p.ID,
 			"name":      p.Name,
 			"score":     p.Score,
+			"is_host":   p.IsHost,
+			"is_bot":    p.IsBot,
+			"team_id":   p.TeamID,
+			"handicap":  p.Handicap,
+			"muted":     p.Muted,
 		})
 	}
 
@@ -168,12 +275,22 @@ func (s *GameServer) CreateGame(
 	client := GetDbClient(ctx)
 	defer client.Close()
 
+	// ゲームモード（未指定は通常モード）
+	modeName := req.Msg.Mode
+	if modeName == "" {
+		modeName = gamemode.Normal{}.Name()
+	}
+	mode, ok := gamemode.Lookup(modeName)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("不明なゲームモードです: %s", req.Msg.Mode))
+	}
+
 	// 同名のCREATED/STARTEDゲームが存在しないかチェック
 	game_name := req.Msg.GameName
 	exists, err := client.Game.Query().
 		Where(
 			g.NameEQ(game_name),
-			g.StatusIn(g.StatusCREATED, g.StatusSTARTED),
+			g.StatusIn(g.StatusCREATED, g.StatusSTARTED, g.StatusPAUSED),
 		).Exist(ctx)
 	if err != nil {
 		log.Printf("failed checking game name: %v", err)
@@ -183,15 +300,53 @@ func (s *GameServer) CreateGame(
 		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("同名のゲームが既に存在します: %s", game_name))
 	}
 
-	// Dobbleカード生成
-	generatedCards, _, err := cardgen.GenerateDobbleCards(5)
+	tieBreak := g.TieBreakNONE
+	if req.Msg.TieBreak != "" {
+		tieBreak = g.TieBreak(req.Msg.TieBreak)
+		if err := g.TieBreakValidator(tieBreak); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("不明なタイブレーク方式です: %s", req.Msg.TieBreak))
+		}
+	}
+
+	settings, err := validateSettings(req.Msg.Settings)
 	if err != nil {
-		log.Fatalf("failed to generate cards: %v", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
-	rand.Shuffle(len(generatedCards), func(i, j int) {
-		generatedCards[i], generatedCards[j] = generatedCards[j], generatedCards[i]
-	})
+	// パスワードはハッシュだけを保存する
+	var passwordHash string
+	if req.Msg.Password != "" {
+		passwordHash, err = invite.HashPassword(req.Msg.Password)
+		if err != nil {
+			log.Printf("failed hashing password: %v", err)
+			return nil, err
+		}
+	}
+
+	// 得点ルール（未指定は正解+1、不正解-1）
+	rules := scoring.Default()
+	if req.Msg.Scoring != nil {
+		rules = scoringFromProto(req.Msg.Scoring)
+		if err := rules.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	// 複数ゲームの対戦（未指定は1ゲームのみ）
+	var seriesRules *series.Rules
+	if req.Msg.MatchFormat != "" {
+		r := series.Rules{Format: series.Format(req.Msg.MatchFormat), Length: int(req.Msg.MatchLength)}
+		if err := r.Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if !singleWinnerMode(mode) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%sモードでは複数ゲームの対戦はできません", mode.Name()))
+		}
+		seriesRules = &r
+	}
+
+	// Dobbleカード生成
+	generatedCards := newShuffledDeck()
 
 	// カード枚数制限
 	cardCount := int(req.Msg.CardCount)
@@ -199,31 +354,75 @@ func (s *GameServer) CreateGame(
 	if cardCount > 0 && cardCount <= len(generatedCards) {
 		generatedCards = generatedCards[:cardCount]
 	}
-	totalRounds := len(generatedCards) - 1
-	if totalRounds < 0 {
-		totalRounds = 0
+	// モードごとの設定を検証し、ラウンド数を決める
+	opts := gamemode.Options{
+		EliminationInterval: int(req.Msg.EliminationInterval),
+		CenterCount:         int(req.Msg.CenterCount),
+		Scoring:             rules,
+		TeamCount:           int(req.Msg.TeamCount),
+	}
+	totalRounds, err := mode.Setup(&opts, len(generatedCards))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 	log.Printf("final card count: %d, total rounds: %d", len(generatedCards), totalRounds)
 
 	// レコード追加
-	game, err := client.Game.Create().SetName(game_name).SetTotalRounds(totalRounds).Save(ctx)
+	gameCreate := client.Game.Create().
+		SetName(game_name).
+		SetTotalRounds(totalRounds).
+		SetMode(mode.Name()).
+		SetTieBreak(tieBreak).
+		SetScoring(rules).
+		SetMaxPlayers(int(settings.MaxPlayers)).
+		SetMinPlayers(int(settings.MinPlayers)).
+		SetVisibility(g.Visibility(settings.Visibility)).
+		SetAutoStart(settings.AutoStart).
+		SetAutoBalance(req.Msg.AutoBalance).
+		SetCardCount(len(generatedCards)).
+		SetInviteCode(invite.NewCode()).
+		SetPasswordHash(passwordHash).
+		SetHostToken(newResumeToken())
+	if opts.EliminationInterval > 0 {
+		gameCreate.SetEliminationInterval(opts.EliminationInterval)
+	}
+	if opts.CenterCount > 0 {
+		gameCreate.SetCenterCount(opts.CenterCount)
+	}
+	if seriesRules != nil {
+		m, err := client.Match.Create().
+			SetFormat(match.Format(seriesRules.Format)).
+			SetLength(seriesRules.Length).
+			Save(ctx)
+		if err != nil {
+			log.Printf("failed creating match: %v", err)
+			return nil, err
+		}
+		gameCreate.SetMatchID(m.ID)
+	}
+	game, err := gameCreate.Save(ctx)
 	if err != nil {
 		log.Printf("failed creating game: %v", err)
 		return nil, err
 	}
 
-	var cs []Card
-	for _, c := range generatedCards {
-		cs = append(cs, Card{
-			ID:   c.ID,
-			Text: "symbols: " + fmt.Sprint(c.Symbols),
-		})
+	if _, ok := mode.(gamemode.Teamed); ok {
+		if _, err := createTeams(ctx, client, game.ID, opts.TeamCount, req.Msg.TeamNames); err != nil {
+			return nil, err
+		}
 	}
-	log.Printf("%d cards created", len(cs))
-	unsentCards[game.ID] = cs
+
+	log.Printf("%d cards created", len(generatedCards))
+	registerGameState(&gamemode.State{
+		GameID:  game.ID,
+		Options: opts,
+		Deck:    generatedCards,
+	})
 
 	res := connect.NewResponse(&gamev1.CreateGameResponse{
-		GameId: int32(game.ID),
+		GameId:     int32(game.ID),
+		InviteCode: game.InviteCode,
+		HostToken:  game.HostToken,
 	})
 
 	msg := map[string]interface{}{
@@ -233,7 +432,7 @@ func (s *GameServer) CreateGame(
 	broadcastToAll(b)
 	broadcastToLobby(b)
 
-	log.Printf("Game %s id of %d created.", game_name, game.ID)
+	log.Printf("Game %s id of %d created. (mode=%s)", game_name, game.ID, mode.Name())
 	return res, nil
 }
 
@@ -247,20 +446,37 @@ func (s *GameServer) GetGames(
 	client := GetDbClient(ctx)
 	defer client.Close()
 
-	// データ取得（最新10件、プレイヤー数も含めて）
-	items, err := client.Game.Query().WithPlayers().Order(game.ByID(sql.OrderDesc())).Limit(10).All(ctx)
+	// データ取得（公開ゲームの最新10件、プレイヤー数も含めて）
+	items, err := client.Game.Query().
+		Where(game.VisibilityEQ(game.VisibilityPUBLIC)).
+		WithPlayers().WithPrevious().WithTeams().Order(game.ByID(sql.OrderDesc())).Limit(10).All(ctx)
 	if err != nil {
 		log.Printf("failed querying games: %v", err)
 		return nil, err
 	}
 	var games []*gamev1.Game
 	for _, t := range items {
+		previousID := 0
+		if t.Edges.Previous != nil {
+			previousID = t.Edges.Previous.ID
+		}
 		games = append(games, &gamev1.Game{
-			Id:          int32(t.ID),
-			Status:      string(t.Status),
-			Name:        t.Name,
-			PlayerCount: int32(len(t.Edges.Players)),
-			TotalRounds: int32(t.TotalRounds),
+			Id:             int32(t.ID),
+			Status:         string(t.Status),
+			Name:           t.Name,
+			PlayerCount:    int32(len(t.Edges.Players)),
+			TotalRounds:    int32(t.TotalRounds),
+			Mode:           t.Mode,
+			TeamScore:      int32(t.TeamScore),
+			Scoring:        scoringToProto(t.Scoring),
+			Settings:       settingsToProto(t),
+			HostId:         int32(hostIDOf(t)),
+			PreviousGameId: int32(previousID),
+			SpectatorCount: int32(spectatorCount(t.ID)),
+			Teams:          teamsToProto(t.Edges.Teams),
+			AutoBalance:    t.AutoBalance,
+			MatchId:        int32(t.MatchID),
+			HasPassword:    t.PasswordHash != "",
 		})
 	}
 
@@ -270,6 +486,68 @@ func (s *GameServer) GetGames(
 	return res, nil
 }
 
+// ゲーム設定を検証し、未指定の項目を既定値で埋めて返す
+func validateSettings(s *gamev1.GameSettings) (*gamev1.GameSettings, error) {
+	settings := &gamev1.GameSettings{}
+	if s != nil {
+		*settings = gamev1.GameSettings{
+			MaxPlayers: s.MaxPlayers,
+			MinPlayers: s.MinPlayers,
+			Visibility: s.Visibility,
+			AutoStart:  s.AutoStart,
+		}
+	}
+	if settings.MinPlayers == 0 {
+		settings.MinPlayers = 1
+	}
+	if settings.Visibility == "" {
+		settings.Visibility = string(g.VisibilityPUBLIC)
+	}
+	if settings.MaxPlayers < 0 || settings.MinPlayers < 0 {
+		return nil, fmt.Errorf("人数に負の値は指定できません")
+	}
+	if settings.MaxPlayers > 0 && settings.MaxPlayers < settings.MinPlayers {
+		return nil, fmt.Errorf("参加人数の上限(%d)が開始に必要な人数(%d)より少なくなっています", settings.MaxPlayers, settings.MinPlayers)
+	}
+	if err := g.VisibilityValidator(g.Visibility(settings.Visibility)); err != nil {
+		return nil, fmt.Errorf("不明な公開範囲です: %s", settings.Visibility)
+	}
+	return settings, nil
+}
+
+func settingsToProto(gameEnt *ent.Game) *gamev1.GameSettings {
+	return &gamev1.GameSettings{
+		MaxPlayers: int32(gameEnt.MaxPlayers),
+		MinPlayers: int32(gameEnt.MinPlayers),
+		Visibility: string(gameEnt.Visibility),
+		AutoStart:  gameEnt.AutoStart,
+	}
+}
+
+func scoringFromProto(r *gamev1.ScoringRules) scoring.Rules {
+	return scoring.Rules{
+		CorrectPoints:      int(r.CorrectPoints),
+		WrongPenalty:       int(r.WrongPenalty),
+		LockoutSeconds:     int(r.LockoutSeconds),
+		SpeedBonusPoints:   int(r.SpeedBonusPoints),
+		SpeedBonusWindowMs: int(r.SpeedBonusWindowMs),
+		StreakBonusPercent: int(r.StreakBonusPercent),
+		MaxStreak:          int(r.MaxStreak),
+	}
+}
+
+func scoringToProto(r scoring.Rules) *gamev1.ScoringRules {
+	return &gamev1.ScoringRules{
+		CorrectPoints:      int32(r.CorrectPoints),
+		WrongPenalty:       int32(r.WrongPenalty),
+		LockoutSeconds:     int32(r.LockoutSeconds),
+		SpeedBonusPoints:   int32(r.SpeedBonusPoints),
+		SpeedBonusWindowMs: int32(r.SpeedBonusWindowMs),
+		StreakBonusPercent: int32(r.StreakBonusPercent),
+		MaxStreak:          int32(r.MaxStreak),
+	}
+}
+
 func (s *GameServer) StartGame(
 	ctx context.Context,
 	req *connect.Request[gamev1.StartGameRequest],
@@ -281,36 +559,80 @@ func (s *GameServer) StartGame(
 	defer client.Close()
 
 	gameId := req.Msg.GameId
-	gamaIdInt, err := strconv.Atoi(gameId)
+	gameIdInt, err := strconv.Atoi(gameId)
 	if err != nil {
 		log.Printf("Failed to conv gameId %s", gameId)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("Failed to get game: %v", err)
+		return nil, err
+	}
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusCREATED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("このゲームは既に開始されています"))
+	}
+	count, err := gameEnt.QueryPlayers().Count(ctx)
+	if err != nil {
+		log.Printf("Failed to count players: %v", err)
+		return nil, err
+	}
+	if count < gameEnt.MinPlayers {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始には%d人以上の参加が必要です（現在%d人）", gameEnt.MinPlayers, count))
+	}
+
+	if err := startGame(ctx, client, gameIdInt); err != nil {
+		return nil, err
+	}
+
+	res := connect.NewResponse(&gamev1.StartGameResponse{})
+
+	log.Printf("Game %s is STARTED", gameId)
+
+	return res, nil
+
+}
+
+// ゲームを開始し、参加者とロビーに通知する。ゲームのmutexを保持して呼ぶ
+func startGame(ctx context.Context, client *ent.Client, gameId int) error {
 	// ゲームのステータスをSTARTEDに更新
-	_, err = client.Game.UpdateOneID(gamaIdInt).SetStatus("STARTED").Save(ctx)
+	gameEnt, err := client.Game.UpdateOneID(gameId).SetStatus("STARTED").Save(ctx)
 	if err != nil {
 		log.Printf("Failed to update game status: %v", err)
-		return nil, err
+		return err
 	}
 
-	// 全プレイヤーのステータスをSTARTEDに更新
+	// 全プレイヤーのステータスをSTARTEDに更新（切断中のプレイヤーは再接続時に戻す）
 	_, err = client.Player.Update().
-		Where(player.HasParentWith(g.IDEQ(gamaIdInt))).
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNEQ(player.StatusDISCONNECTED),
+		).
 		SetStatus("STARTED").
 		Save(ctx)
 	if err != nil {
 		log.Printf("Failed to update players status: %v", err)
 	}
 
-	totalCards := len(unsentCards[gamaIdInt])
-	totalRounds := totalCards - 1
-	if totalRounds < 0 {
-		totalRounds = 0
+	totalRounds := gameEnt.TotalRounds
+
+	// チーム対抗では未所属のプレイヤーをチームに入れる
+	_, teamed := gameModeOf(gameEnt).(gamemode.Teamed)
+	if teamed {
+		arrangeTeams(ctx, client, gameEnt)
 	}
 
 	// プレイヤー一覧を取得
 	players, err := client.Player.Query().
-		Where(player.HasParentWith(g.IDEQ(gamaIdInt))).
+		Where(player.HasParentWith(g.IDEQ(gameId))).
 		All(ctx)
 	if err != nil {
 		log.Printf("Failed to query players: %v", err)
@@ -321,17 +643,28 @@ func (s *GameServer) StartGame(
 			"player_id": p.ID,
 			"name":      p.Name,
 			"score":     p.Score,
+			"team_id":   p.TeamID,
+			"handicap":  p.Handicap,
+			"muted":     p.Muted,
 		})
 	}
 
 	msg := map[string]interface{}{
 		"event":        "STARTED",
-		"game_id":      gamaIdInt,
+		"game_id":      gameId,
 		"total_rounds": totalRounds,
 		"players":      playerList,
+		"mode":         gameEnt.Mode,
+	}
+	if teamed {
+		msg["teams"] = teamList(teamsOf(ctx, client, gameId))
+	}
+	// 共有の持ち時間で遊ぶモードは時計を開始
+	if clocked, ok := gameModeOf(gameEnt).(gamemode.Clocked); ok {
+		msg["time_left_ms"] = startSharedClock(gameId, clocked.InitialTime()).Milliseconds()
 	}
 	b, _ := json.Marshal(msg)
-	broadcastToGame(gamaIdInt, b)
+	broadcastToGame(gameId, b)
 
 	// ロビーに通知
 	lobbyMsg := map[string]interface{}{
@@ -339,47 +672,46 @@ func (s *GameServer) StartGame(
 	}
 	lb, _ := json.Marshal(lobbyMsg)
 	broadcastToLobby(lb)
+	return nil
+}
 
-	res := connect.NewResponse(&gamev1.StartGameResponse{})
-
-	log.Printf("Game %s is STARTED", gameId)
-
-	return res, nil
+// 自動開始のゲームで、参加人数が揃い全員が接続済みなら開始する
+func maybeAutoStart(gameId int) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
 
-}
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
 
-func DistributeCard(client *ent.Client, gameId int) {
-	cards := unsentCards[gameId]
-	log.Printf("%d cards remaining with game id %d", len(cards), gameId)
-	if len(cards) == 0 {
-		// カードが無くなったらゲーム終了イベントを送信
-		endMsg := map[string]interface{}{
-			"event": "GAME_OVER",
-		}
-		b, _ := json.Marshal(endMsg)
-		broadcastToGame(gameId, b)
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil || !gameEnt.AutoStart || gameEnt.Status != g.StatusCREATED {
 		return
 	}
-
-	if len(cards) > 0 {
-		// カード送信前にPLAYINGに更新し、次のREADY要求に備える
-		_, err := client.Player.Update().
-			Where(player.HasParentWith(g.IDEQ(gameId))).
-			SetStatus("PLAYING").
-			Save(context.Background())
-		if err != nil {
-			log.Fatalf("failed updating player status")
-		}
-
-		card := cards[0]
-		unsentCards[gameId] = cards[1:]
-		cardMsg := map[string]interface{}{
-			"event":   "card",
-			"game_id": gameId,
-			"card":    card,
+	target := gameEnt.MaxPlayers
+	if target == 0 {
+		target = gameEnt.MinPlayers
+	}
+	count, err := gameEnt.QueryPlayers().Count(ctx)
+	if err != nil || count < target {
+		return
+	}
+	connected := make(map[int]bool)
+	for _, info := range gameConns(gameId) {
+		if !info.Spectator {
+			connected[info.PlayerID] = true
 		}
-		cb, _ := json.Marshal(cardMsg)
-		broadcastToGame(gameId, cb)
+	}
+	for _, id := range botPlayerIDs(gameId) {
+		connected[id] = true
+	}
+	if len(connected) < count {
+		return
+	}
+	log.Printf("Game %d auto-starts with %d players", gameId, count)
+	if err := startGame(ctx, client, gameId); err != nil {
+		log.Printf("failed to auto-start game %d: %v", gameId, err)
 	}
 }
 
@@ -430,6 +762,10 @@ func (s *GameServer) ReportReady(
 		log.Printf("Player %s is already READY, skipping", playerId)
 		return connect.NewResponse(&gamev1.ReportReadyResponse{}), nil
 	}
+	if currentPlayer.Status == player.StatusELIMINATED || currentPlayer.Status == player.StatusFINISHED {
+		log.Printf("Player %s is %s, skipping", playerId, currentPlayer.Status)
+		return connect.NewResponse(&gamev1.ReportReadyResponse{}), nil
+	}
 
 	_, err = client.Player.UpdateOneID(playerIdInt).SetStatus(player.StatusREADY).Save(ctx)
 	if err != nil {
@@ -437,66 +773,19 @@ func (s *GameServer) ReportReady(
 		return nil, err
 	}
 
-	notReadyCount, err := client.Player.Query().
-		Where(
-			player.HasParentWith(g.IDEQ(gameId)),
-			player.StatusNEQ(player.StatusREADY),
-		).Count(ctx)
+	// 切断中のプレイヤーは待たない
+	ready, err := allReady(ctx, client, gameId)
 	if err != nil {
-		log.Printf("failed to count not-ready players: %v", err)
 		return nil, err
 	}
-
-	if notReadyCount == 0 {
+	if ready {
 		DistributeCard(client, gameId)
 	}
 
-	log.Printf("Player %s is READY (notReadyCount=%d)", playerId, notReadyCount)
+	log.Printf("Player %s is READY (allReady=%v)", playerId, ready)
 	return connect.NewResponse(&gamev1.ReportReadyResponse{}), nil
 }
 
-func extractSymbols(text string) []string {
-	start := strings.Index(text, "[")
-	end := strings.Index(text, "]")
-	if start == -1 || end == -1 || start >= end {
-		return []string{}
-	}
-	symbolsStr := text[start+1 : end]
-	return strings.Fields(symbolsStr)
-}
-
-func findCommonSymbol(card1, card2 Card) string {
-	symbols1 := extractSymbols(card1.Text)
-	symbols2 := extractSymbols(card2.Text)
-
-	symbolSet := make(map[string]struct{})
-	for _, s := range symbols1 {
-		symbolSet[s] = struct{}{}
-	}
-
-	for _, s := range symbols2 {
-		if _, exists := symbolSet[s]; exists {
-			return s
-		}
-	}
-	return ""
-}
-
-func isAnswerCorrect(card1, card2 Card, answer string) bool {
-	log.Printf("card1 %v", card1)
-	log.Printf("card2 %v", card2)
-	log.Printf("answer %v", answer)
-
-	commonSymbol := findCommonSymbol(card1, card2)
-	if commonSymbol == answer {
-		log.Printf("answer is correct")
-		return true
-	}
-
-	log.Printf("answer is wrong")
-	return false
-}
-
 func (s *GameServer) SubmitAnswer(
 	ctx context.Context,
 	req *connect.Request[gamev1.SubmitAnswerRequest],
@@ -504,87 +793,166 @@ func (s *GameServer) SubmitAnswer(
 	endLog := funcCallLog(logFuncName())
 	defer endLog()
 
-	message := "correct!!!"
-	card1 := Card{
-		ID:   int(req.Msg.Card1.Id),
-		Text: req.Msg.Card1.Text,
-	}
-	card2 := Card{
-		ID:   int(req.Msg.Card2.Id),
-		Text: req.Msg.Card2.Text,
-	}
-	answer := req.Msg.Answer
-	isCorrect := isAnswerCorrect(card1, card2, answer)
-	if !isCorrect {
-		message = "wrong!!!"
-	}
-
 	// player_idからgame_idを特定し、そのゲームのクライアントにbroadcast
 	playerIDStr := req.Msg.PlayerId
 	playerID, err := strconv.Atoi(playerIDStr)
 	if err != nil {
 		log.Printf("invalid playerID: %v", err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	playerEnt, err := client.Player.Get(ctx, playerID)
+	if err != nil {
+		log.Printf("failed to query player: %v", err)
+		return nil, err
+	}
+	switch playerEnt.Status {
+	case player.StatusELIMINATED:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("脱落したプレイヤーは回答できません"))
+	case player.StatusFINISHED:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("サドンデス中は同点のプレイヤーのみ回答できます"))
+	}
+	gameEnt, err := playerEnt.QueryParent().Only(ctx)
+	if err != nil {
+		log.Printf("failed to query parent game: %v", err)
+		return nil, err
+	}
+
+	mu := getGameMutex(gameEnt.ID)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// 一時停止中（再開のカウントダウン中を含む）は回答を受け付けない
+	gameEnt, err = client.Game.Get(ctx, gameEnt.ID)
+	if err != nil {
+		log.Printf("failed to get game: %v", err)
+		return nil, err
+	}
+	switch gameEnt.Status {
+	case g.StatusSTARTED:
+	case g.StatusPAUSED:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("一時停止中は回答できません"))
+	default:
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("実施中のゲームでのみ回答できます"))
+	}
+
+	// 不正解後のロックアウト中は回答を受け付けない
+	if left := lockoutLeft(gameEnt.ID, playerID); left > 0 {
+		notifyLockout(gameEnt.ID, playerID, left)
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("ロックアウト中です（残り%.1f秒）", left.Seconds()))
+	}
+
+	// モードのルールで正誤判定と得点計算を行う
+	mode := gameModeOf(gameEnt)
+	st := loadState(ctx, client, gameEnt)
+	// 反応時間はクライアントの申告ではなくサーバーで計測する
+	answer := gamemode.Answer{
+		PlayerID:     playerID,
+		CardIDs:      [2]int{int(req.Msg.Card1.GetId()), int(req.Msg.Card2.GetId())},
+		Symbol:       req.Msg.Answer,
+		ReactionTime: time.Since(st.DealtAt),
+		Streak:       playerEnt.Streak,
+	}
+	// ハンデで回答の受付を遅らせている間は受け付けない
+	h := handicapOf(gameEnt.ID, playerID)
+	if wait := h.AnswerDelay() - answer.ReactionTime; wait > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("ハンデのため回答できません（残り%.1f秒）", wait.Seconds()))
+	}
+	verdict, err := mode.ValidateAnswer(st, answer)
+	if errors.Is(err, gamemode.ErrAnswered) {
+		// 同じラウンドで先に正解したプレイヤーがいる
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("このラウンドは既に回答済みです"))
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	log.Printf("answer %v verdict %+v", answer, verdict)
+	score := mode.Score(st, answer, verdict)
+	score.Player = h.ApplyPoints(score.Player)
+
+	// スコア加減算処理
+	playerUpdate := client.Player.UpdateOneID(playerID).AddScore(score.Player)
+	streak := 0
+	if verdict.Correct {
+		streak = playerEnt.Streak + 1
+		playerUpdate.SetStreak(streak)
 	} else {
-		client := GetDbClient(ctx)
-		defer client.Close()
-		// スコア加減算処理を追加
-		if isCorrect {
-			_, err := client.Player.UpdateOneID(playerID).AddScore(1).Save(ctx)
-			if err != nil {
-				log.Printf("failed to add score: %v", err)
-			}
-		} else {
-			_, err := client.Player.UpdateOneID(playerID).AddScore(-1).Save(ctx)
-			if err != nil {
-				log.Printf("failed to subtract score: %v", err)
-			}
-		}
-		playerEnt, err := client.Player.Query().Where(player.IDEQ(playerID)).Only(ctx)
+		playerUpdate.AddWrongCount(1).SetStreak(0)
+	}
+	if _, err := playerUpdate.Save(ctx); err != nil {
+		log.Printf("failed to update score: %v", err)
+	}
+	_, teamed := mode.(gamemode.Teamed)
+	if teamed {
+		addTeamScore(ctx, client, playerEnt, score.Player)
+	}
+	if score.Team != 0 {
+		gameEnt, err = client.Game.UpdateOneID(gameEnt.ID).AddTeamScore(score.Team).Save(ctx)
 		if err != nil {
-			log.Printf("failed to query player: %v", err)
-		} else {
-			gameEnt, err := playerEnt.QueryParent().Only(ctx)
-			if err != nil {
-				log.Printf("failed to query parent game: %v", err)
-			} else {
-				// 参加者全員のスコアを取得
-				players, err := gameEnt.QueryPlayers().All(ctx)
-				scores := []map[string]interface{}{}
-				if err == nil {
-					for _, p := range players {
-						scores = append(scores, map[string]interface{}{
-							"player_id": p.ID,
-							"score":     p.Score,
-							"name":      p.Name,
-						})
-					}
-				}
-				// card1.Text, card2.Text から ent.Card を検索し、DBのIDを取得
-				correctSymbol := findCommonSymbol(card1, card2)
-				msg := map[string]interface{}{
-					"event":          "ANSWERED",
-					"player_id":      playerID,
-					"is_correct":     isCorrect,
-					"correct_symbol": correctSymbol,
-					"answer":         answer,
-					"scores":         scores,
-				}
-				b, _ := json.Marshal(msg)
-				broadcastToGame(gameEnt.ID, b)
-
-				// 残りカードが0なら自動的にゲーム終了
-				if len(unsentCards[gameEnt.ID]) == 0 {
-					log.Printf("No cards remaining for game %d, sending GAME_OVER", gameEnt.ID)
-					endMsg := map[string]interface{}{
-						"event": "GAME_OVER",
-					}
-					eb, _ := json.Marshal(endMsg)
-					broadcastToGame(gameEnt.ID, eb)
-				}
-			}
+			log.Printf("failed to add team score: %v", err)
+		}
+	}
+
+	// 参加者全員のスコアを取得
+	players, err := gameEnt.QueryPlayers().All(ctx)
+	scores := []map[string]interface{}{}
+	if err == nil {
+		for _, p := range players {
+			scores = append(scores, map[string]interface{}{
+				"player_id": p.ID,
+				"score":     p.Score,
+				"name":      p.Name,
+			})
+		}
+	}
+	msg := map[string]interface{}{
+		"event":          "ANSWERED",
+		"player_id":      playerID,
+		"is_correct":     verdict.Correct,
+		"correct_symbol": verdict.CorrectSymbol,
+		"answer":         answer.Symbol,
+		"scores":         scores,
+		"card_ids":       verdict.CardIDs,
+		"cards":          st.Table,
+		"points":         score.Player,
+		"reaction_ms":    answer.ReactionTime.Milliseconds(),
+		"streak":         streak,
+	}
+	if _, ok := mode.(gamemode.TeamScored); ok {
+		msg["team_score"] = gameEnt.TeamScore
+	}
+	if teamed {
+		msg["teams"] = teamList(teamsOf(ctx, client, gameEnt.ID))
+	}
+	if _, ok := mode.(gamemode.Clocked); ok {
+		// 共有の持ち時間を増減し、尽きたら終了
+		left := addSharedTime(gameEnt.ID, score.Time)
+		if left == 0 {
+			st.TimeUp = true
 		}
+		msg["time_left_ms"] = left.Milliseconds()
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameEnt.ID, b)
+
+	if lockout := gameEnt.Scoring.Lockout(); !verdict.Correct && lockout > 0 {
+		lockOut(gameEnt.ID, playerID, lockout)
+		notifyLockout(gameEnt.ID, playerID, lockout)
 	}
 
+	// 終了条件を満たしたら自動的にゲーム終了。サドンデス中は決着したかを毎回確認
+	if mode.IsOver(st) || inSuddenDeath(gameEnt.ID) {
+		log.Printf("Game %d is over or in sudden death, checking game end", gameEnt.ID)
+		checkGameEnd(client, gameEnt.ID)
+	}
+
+	message := "correct!!!"
+	if !verdict.Correct {
+		message = "wrong!!!"
+	}
 	// 戻り値を定義
 	res := connect.NewResponse(&gamev1.SubmitAnswerResponse{
 		IsCorrect: message,
@@ -620,11 +988,19 @@ func (s *GameServer) DeleteGame(
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのゲームは削除できません"))
 	}
 
-	// 関連プレイヤーを削除
+	// 関連プレイヤー・チーム・参加禁止を削除
 	_, err = client.Player.Delete().Where(player.HasParentWith(g.IDEQ(gameIdInt))).Exec(ctx)
 	if err != nil {
 		log.Printf("failed deleting players: %v", err)
 	}
+	_, err = client.Team.Delete().Where(team.HasParentWith(g.IDEQ(gameIdInt))).Exec(ctx)
+	if err != nil {
+		log.Printf("failed deleting teams: %v", err)
+	}
+	_, err = client.Ban.Delete().Where(ban.HasGameWith(g.IDEQ(gameIdInt))).Exec(ctx)
+	if err != nil {
+		log.Printf("failed deleting bans: %v", err)
+	}
 
 	// ゲームを削除
 	err = client.Game.DeleteOneID(gameIdInt).Exec(ctx)
@@ -634,7 +1010,7 @@ func (s *GameServer) DeleteGame(
 	}
 
 	// カード情報も削除
-	delete(unsentCards, gameIdInt)
+	clearGameState(gameIdInt)
 
 	// ロビーに通知
 	msg := map[string]interface{}{
@@ -647,6 +1023,53 @@ func (s *GameServer) DeleteGame(
 	return connect.NewResponse(&gamev1.DeleteGameResponse{}), nil
 }
 
+func (s *GameServer) GetTeamHighScores(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetTeamHighScoresRequest],
+) (*connect.Response[gamev1.GetTeamHighScoresResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	// 終了したチーム戦のゲームをスコア順に取得し、デッキサイズごとの最高記録を抽出
+	items, err := client.Game.Query().
+		Where(g.ModeIn(teamScoredModes()...), g.StatusEQ(g.StatusFINISHED)).
+		Order(g.ByTotalRounds(), g.ByTeamScore(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed querying team high scores: %v", err)
+		return nil, err
+	}
+	var highScores []*gamev1.TeamHighScore
+	for _, t := range items {
+		cardCount := int32(t.TotalRounds + 1)
+		if len(highScores) > 0 && highScores[len(highScores)-1].CardCount == cardCount {
+			continue
+		}
+		highScores = append(highScores, &gamev1.TeamHighScore{
+			CardCount: cardCount,
+			TeamScore: int32(t.TeamScore),
+			GameId:    int32(t.ID),
+			GameName:  t.Name,
+		})
+	}
+
+	return connect.NewResponse(&gamev1.GetTeamHighScoresResponse{
+		HighScores: highScores,
+	}), nil
+}
+
+func (s *GameServer) GetGameModes(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetGameModesRequest],
+) (*connect.Response[gamev1.GetGameModesResponse], error) {
+	return connect.NewResponse(&gamev1.GetGameModesResponse{
+		Modes: gamemode.Names(),
+	}), nil
+}
+
 /* websocket */
 var upgrader = websocket.Upgrader{
 	CheckOrigin: func(r *http.Request) bool {
@@ -663,124 +1086,123 @@ func websocketHandler(w http.ResponseWriter, r *http.Request) {
 		log.Println("Upgrade error:", err)
 		return
 	}
+	openConn(conn)
 
-	// 初回通信でgame_id, player_idをJSONで受信
+	// 初回通信でgame_id, player_id（観戦者はspectator: trueと招待コードなど）をJSONで受信
 	type InitMsg struct {
-		GameID   int `json:"game_id"`
-		PlayerID int `json:"player_id"`
+		GameID      int    `json:"game_id"`
+		PlayerID    int    `json:"player_id"`
+		ResumeToken string `json:"resume_token"`
+		Spectator   bool   `json:"spectator"`
+		spectatorAuth
 	}
 	_, message, err := conn.ReadMessage()
 	if err != nil {
 		log.Println("Read error:", err)
+		closeConn(conn)
 		return
 	}
 	log.Printf("Received: %s", message)
 	var initMsg InitMsg
 	if err := json.Unmarshal(message, &initMsg); err != nil {
 		log.Println("Invalid init message:", err)
+		closeConn(conn)
 		return
 	}
-	log.Printf("initMsg %v", initMsg)
+	log.Printf("initMsg game_id=%d player_id=%d spectator=%v", initMsg.GameID, initMsg.PlayerID, initMsg.Spectator)
 
-	// gameClientsに登録
-	if gameClients[initMsg.GameID] == nil {
-		gameClients[initMsg.GameID] = make(map[*websocket.Conn]ClientInfo)
+	if initMsg.Spectator {
+		spectatorWebsocketHandler(conn, initMsg.GameID, initMsg.spectatorAuth)
+		endLog()
+		return
 	}
-	gameClients[initMsg.GameID][conn] = ClientInfo{
+
+	// 再開トークンで本人確認し、切断中のプレイヤーは復帰させる
+	resumed, err := func() (bool, error) {
+		client := GetDbClient(context.Background())
+		defer client.Close()
+		return resumePlayer(client, initMsg.GameID, initMsg.PlayerID, initMsg.ResumeToken)
+	}()
+	if err != nil {
+		log.Printf("failed to resume: %v", err)
+		errMsg, _ := json.Marshal(map[string]interface{}{
+			"event":   "RESUME_FAILED",
+			"game_id": initMsg.GameID,
+		})
+		sendMessage(conn, errMsg)
+		closeConn(conn)
+		return
+	}
+
+	// gameClientsに登録
+	addGameClient(conn, ClientInfo{
 		GameID:   initMsg.GameID,
 		PlayerID: initMsg.PlayerID,
-	}
+	})
 
 	// クライアント接続直後にプレイヤー一覧を全員に送信
 	{
 		ctx := context.Background()
 		client := GetDbClient(ctx)
-		gamePlayers, _ := client.Player.Query().
-			Where(player.HasParentWith(g.IDEQ(initMsg.GameID))).
-			All(ctx)
-		client.Close()
-		var pList []map[string]interface{}
-		for _, p := range gamePlayers {
-			pList = append(pList, map[string]interface{}{
-				"player_id": p.ID,
-				"name":      p.Name,
-				"score":     p.Score,
-			})
-		}
-		playersEvent := map[string]interface{}{
-			"event":   "PLAYERS",
-			"players": pList,
+		broadcastPlayers(ctx, client, initMsg.GameID)
+		if resumed {
+			sendSnapshot(client, initMsg.GameID, initMsg.PlayerID)
 		}
-		pJSON, _ := json.Marshal(playersEvent)
-		broadcastToGame(initMsg.GameID, pJSON)
+		client.Close()
 	}
-
-	log.Printf("gameClients %v", gameClients)
+	maybeAutoStart(initMsg.GameID)
 
 	defer func() {
 		log.Printf("websocket disconnected execute defer func")
-		defer conn.Close()
-		delete(gameClients[initMsg.GameID], conn)
+		defer closeConn(conn)
+		// 再戦で別のゲームに移った接続もあるため、現在の登録先を使う
+		info, ok := removeGameClient(conn)
+		if !ok {
+			info = ClientInfo{GameID: initMsg.GameID, PlayerID: initMsg.PlayerID}
+		}
 
 		ctx := context.Background()
 		client := GetDbClient(ctx)
 		defer client.Close()
 
 		// ゲームの状態を確認
-		gameEnt, err := client.Game.Get(ctx, initMsg.GameID)
+		gameEnt, err := client.Game.Get(ctx, info.GameID)
 		if err != nil {
-			log.Printf("game %d not found: %v", initMsg.GameID, err)
+			log.Printf("game %d not found: %v", info.GameID, err)
 			endLog()
 			return
 		}
 
-		if gameEnt.Status == g.StatusSTARTED {
-			// 実施中のゲーム: FINISHEDにして他プレイヤーに切断通知
-			_, err = client.Game.UpdateOneID(initMsg.GameID).SetStatus("FINISHED").Save(ctx)
-			if err != nil {
-				log.Printf("failed updating game %d to FINISHED: %v", initMsg.GameID, err)
-			}
-			delete(unsentCards, initMsg.GameID)
+		// 同じプレイヤーの新しい接続が残っていれば再接続済み
+		if playerConnected(info.GameID, info.PlayerID) {
+			log.Printf("player %d is still connected to game %d", info.PlayerID, info.GameID)
+			endLog()
+			return
+		}
 
-			disconnectMsg := map[string]interface{}{
-				"event":     "disconnect",
-				"game_id":   initMsg.GameID,
-				"player_id": initMsg.PlayerID,
-			}
-			db, _ := json.Marshal(disconnectMsg)
-			broadcastToGame(initMsg.GameID, db)
+		// 脱落済みやサドンデスを観戦中のプレイヤーの切断はゲームに影響しない
+		p, err := client.Player.Get(ctx, info.PlayerID)
+		if err != nil {
+			// キックされたプレイヤーなど
+			log.Printf("player %d not found: %v", info.PlayerID, err)
+			endLog()
+			return
+		}
+		inProgress := gameEnt.Status == g.StatusSTARTED || gameEnt.Status == g.StatusPAUSED
+		if (p.Status == player.StatusELIMINATED || p.Status == player.StatusFINISHED) && inProgress {
+			log.Printf("eliminated player %d disconnected from game %d", info.PlayerID, info.GameID)
+			endLog()
+			return
+		}
 
-			log.Printf("Game %d finished due to disconnect.", initMsg.GameID)
-		} else {
-			// 未開始のゲーム: プレイヤーとゲームを削除
-			_, err = client.Player.Delete().
-				Where(player.HasParentWith(g.IDEQ(initMsg.GameID))).
-				Exec(ctx)
-			if err != nil {
-				log.Printf("failed deleting players for game %d: %v", initMsg.GameID, err)
-			}
-			err = client.Game.DeleteOneID(initMsg.GameID).Exec(ctx)
-			if err != nil {
-				log.Printf("failed deleting game %d: %v", initMsg.GameID, err)
-			}
-			delete(unsentCards, initMsg.GameID)
-			log.Printf("Game %d deleted due to disconnect.", initMsg.GameID)
-		}
-
-		// ロビーに通知
-		lobbyMsg := map[string]interface{}{
-			"event": "DELETED",
-		}
-		lb, _ := json.Marshal(lobbyMsg)
-		broadcastToLobby(lb)
+		// 未開始・実施中のゲームでは、すぐに外さず再接続を待つ
+		if gameEnt.Status == g.StatusCREATED || inProgress {
+			markDisconnected(client, info.GameID, info.PlayerID)
+		}
 		endLog()
 	}()
 
-	err = conn.WriteMessage(websocket.TextMessage, []byte("Hello from service!!"))
-	if err != nil {
-		log.Println("Write error:", err)
-	}
-	log.Print("Write message")
+	sendMessage(conn, []byte("Hello from service!!"))
 
 	// 常時通信
 	for {
@@ -793,42 +1215,67 @@ func websocketHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ゲームのプレイヤー一覧を全員に送信する
+func broadcastPlayers(ctx context.Context, client *ent.Client, gameID int) {
+	gamePlayers, _ := client.Player.Query().
+		Where(player.HasParentWith(g.IDEQ(gameID))).
+		All(ctx)
+	var pList []map[string]interface{}
+	for _, p := range gamePlayers {
+		pList = append(pList, map[string]interface{}{
+			"player_id": p.ID,
+			"name":      p.Name,
+			"score":     p.Score,
+			"is_host":   p.IsHost,
+			"is_bot":    p.IsBot,
+			"team_id":   p.TeamID,
+			"handicap":  p.Handicap,
+			"muted":     p.Muted,
+		})
+	}
+	playersEvent := map[string]interface{}{
+		"event":   "PLAYERS",
+		"players": pList,
+	}
+	pJSON, _ := json.Marshal(playersEvent)
+	broadcastToGame(gameID, pJSON)
+}
+
 func broadcastToGame(gameID int, message []byte) {
 	log.Printf("broadcast to game")
-	conns, ok := gameClients[gameID]
-	if !ok {
-		log.Printf("gameClients err: %v", ok)
-		return
+	notifyBots(gameID, 0, message)
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for conn := range gameClients[gameID] {
+		sendMessageLocked(conn, message)
 	}
-	for conn := range conns {
-		err := conn.WriteMessage(websocket.TextMessage, message)
-		if err != nil {
-			log.Printf("broadcast %s error: %v", message, err)
-			conn.Close()
-			delete(conns, conn)
-		} else {
-			log.Printf("broadcast %s success: %+v", message, conns[conn])
+}
+
+// ゲーム内の特定のプレイヤーの接続にだけ送信する
+func sendToPlayer(gameID int, playerID int, message []byte) {
+	notifyBots(gameID, playerID, message)
+	clientLock.Lock()
+	defer clientLock.Unlock()
+	for conn, info := range gameClients[gameID] {
+		if info.Spectator || info.PlayerID != playerID {
+			continue
 		}
+		sendMessageLocked(conn, message)
 	}
 }
 
 func broadcastToAll(message []byte) {
-	log.Printf("broadcast to all %v", gameClients)
+	clientLock.Lock()
+	defer clientLock.Unlock()
 	for _, conns := range gameClients {
 		for conn := range conns {
-			err := conn.WriteMessage(websocket.TextMessage, message)
-			if err != nil {
-				log.Printf("broadcast error: %v", err)
-				conn.Close()
-				delete(conns, conn)
-			} else {
-				log.Printf("broadcast success: %s", message)
-			}
+			sendMessageLocked(conn, message)
 		}
 	}
 }
 
 /* lobby websocket */
+// ロビーの接続（clientLockを保持して触る）
 var lobbyClients = make(map[*websocket.Conn]bool)
 
 func lobbyWebsocketHandler(w http.ResponseWriter, r *http.Request) {
@@ -837,32 +1284,41 @@ func lobbyWebsocketHandler(w http.ResponseWriter, r *http.Request) {
 		log.Println("Lobby WS upgrade error:", err)
 		return
 	}
+	openConn(conn)
+	clientLock.Lock()
 	lobbyClients[conn] = true
 	log.Printf("Lobby client connected. Total: %d", len(lobbyClients))
+	clientLock.Unlock()
 
 	defer func() {
+		clientLock.Lock()
 		delete(lobbyClients, conn)
-		conn.Close()
 		log.Printf("Lobby client disconnected. Total: %d", len(lobbyClients))
+		clientLock.Unlock()
+		unwatchTickets(conn)
+		closeConn(conn)
 	}()
 
-	// 接続維持（クライアントからの切断を検知）
+	// 接続維持（クライアントからの切断を検知）。キューに並んだプレイヤーは{"ticket": ...}を送って通知を待つ
 	for {
-		_, _, err := conn.ReadMessage()
+		_, message, err := conn.ReadMessage()
 		if err != nil {
 			break
 		}
+		var msg struct {
+			Ticket string `json:"ticket"`
+		}
+		if json.Unmarshal(message, &msg) == nil && msg.Ticket != "" {
+			watchTicket(conn, msg.Ticket)
+		}
 	}
 }
 
 func broadcastToLobby(message []byte) {
+	clientLock.Lock()
+	defer clientLock.Unlock()
 	for conn := range lobbyClients {
-		err := conn.WriteMessage(websocket.TextMessage, message)
-		if err != nil {
-			log.Printf("lobby broadcast error: %v", err)
-			conn.Close()
-			delete(lobbyClients, conn)
-		}
+		sendMessageLocked(conn, message)
 	}
 }
 
@@ -875,13 +1331,6 @@ func withCORS(h http.Handler) http.Handler {
 	}).Handler(h)
 }
 
-type Card struct {
-	ID   int    `json:"id"`
-	Text string `json:"text"`
-}
-
-var unsentCards = make(map[int][]Card)
-
 // ゲームごとのミューテックス（ReportReady/DistributeCardのレースコンディション防止）
 var gameMutexes = make(map[int]*sync.Mutex)
 var gameMutexLock sync.Mutex
@@ -908,7 +1357,7 @@ func main() {
 	}
 
 	// サーバー起動時に一度だけスキーマ作成
-	client, err := ent.Open(dialect.SQLite, DB_FILE)
+	client, err := ent.Open(dialect.SQLite, dbSource)
 	if err != nil {
 		log.Fatalf("failed opening connection to sqlite: %v", err)
 	}
@@ -937,10 +1386,43 @@ func main() {
 	mux.Handle(gamev1connect.NewStartGameServiceHandler(game))
 	mux.Handle(gamev1connect.NewReportReadyServiceHandler(game))
 	mux.Handle(gamev1connect.NewDeleteGameServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetTeamHighScoresServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetGameModesServiceHandler(game))
+	mux.Handle(gamev1connect.NewUpdateGameSettingsServiceHandler(game))
+	mux.Handle(gamev1connect.NewKickPlayerServiceHandler(game))
+	mux.Handle(gamev1connect.NewBanPlayerServiceHandler(game))
+	mux.Handle(gamev1connect.NewMutePlayerServiceHandler(game))
+	mux.Handle(gamev1connect.NewSendChatServiceHandler(game))
+	mux.Handle(gamev1connect.NewChangeTeamServiceHandler(game))
+	mux.Handle(gamev1connect.NewSetHandicapServiceHandler(game))
+	mux.Handle(gamev1connect.NewAddBotServiceHandler(game))
+	mux.Handle(gamev1connect.NewStartPracticeServiceHandler(game))
+	mux.Handle(gamev1connect.NewSubmitPracticeAnswerServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetPersonalBestsServiceHandler(game))
+	mux.Handle(gamev1connect.NewStartGhostRaceServiceHandler(game))
+	mux.Handle(gamev1connect.NewStartDailyChallengeServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetDailyLeaderboardServiceHandler(game))
+	mux.Handle(gamev1connect.NewPauseGameServiceHandler(game))
+	mux.Handle(gamev1connect.NewResumeGameServiceHandler(game))
+	mux.Handle(gamev1connect.NewRequestRematchServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetMatchServiceHandler(game))
+	mux.Handle(gamev1connect.NewJoinQueueServiceHandler(game))
+	mux.Handle(gamev1connect.NewLeaveQueueServiceHandler(game))
+	mux.Handle(gamev1connect.NewRotateInviteCodeServiceHandler(game))
+	mux.Handle(gamev1connect.NewRegisterUserServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetRatingsServiceHandler(game))
+	mux.Handle(gamev1connect.NewCreateTournamentServiceHandler(game))
+	mux.Handle(gamev1connect.NewRegisterTournamentPlayerServiceHandler(game))
+	mux.Handle(gamev1connect.NewStartTournamentServiceHandler(game))
+	mux.Handle(gamev1connect.NewGetTournamentServiceHandler(game))
+
+	// キューに並んだプレイヤーをゲームにまとめる
+	go runMatchmaker(game)
 
 	// WebSocketハンドラの登録
 	mux.HandleFunc("/ws", websocketHandler)
 	mux.HandleFunc("/ws/lobby", lobbyWebsocketHandler)
+	mux.HandleFunc("/ws/tournament", tournamentWebsocketHandler)
 
 	// httpサーバーを起動
 	http.ListenAndServe(
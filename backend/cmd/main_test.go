@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+)
+
+// テスト中はインメモリーのSQLiteを使う。共有キャッシュのデータベースは接続が1つでも
+// 残っている間だけ保たれるので、testClientを最後まで開いておく
+var testClient *ent.Client
+
+func TestMain(m *testing.M) {
+	dbSource = "file:cmdtest?mode=memory&cache=shared&_fk=1"
+	testClient = GetDbClient(context.Background())
+	if err := testClient.Schema.Create(context.Background()); err != nil {
+		log.Fatalf("failed creating schema resources: %v", err)
+	}
+	code := m.Run()
+	testClient.Close()
+	os.Exit(code)
+}
+
+// errorCode returns the connect code of err, or 0 if err is nil.
+func errorCode(err error) connect.Code {
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return cerr.Code()
+	}
+	if err != nil {
+		return connect.CodeUnknown
+	}
+	return 0
+}
+
+func TestStartGhostRaceJoinsPrivateGame(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	run, err := testClient.PracticeRun.Create().
+		SetPlayerName("alice").
+		SetCardCount(5).
+		SetSeed(1).
+		SetTotalMs(5000).
+		SetReactionMs([]int64{1000, 1000, 1000, 1000}).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("failed creating practice run: %v", err)
+	}
+
+	res, err := s.StartGhostRace(ctx, connect.NewRequest(&gamev1.StartGhostRaceRequest{
+		PlayerName: "bob",
+		RunId:      int32(run.ID),
+	}))
+	if err != nil {
+		t.Fatalf("expected the ghost race to start, got %v", err)
+	}
+	defer stopBots(int(res.Msg.GameId))
+
+	gameEnt, err := testClient.Game.Get(ctx, int(res.Msg.GameId))
+	if err != nil {
+		t.Fatalf("failed getting game: %v", err)
+	}
+	if gameEnt.Visibility != g.VisibilityPRIVATE {
+		t.Errorf("expected the ghost race to be private, got %s", gameEnt.Visibility)
+	}
+	joined, err := gameEnt.QueryPlayers().All(ctx)
+	if err != nil {
+		t.Fatalf("failed querying players: %v", err)
+	}
+	if len(joined) != 2 {
+		t.Fatalf("expected the player and the ghost to join, got %d players", len(joined))
+	}
+	if !res.Msg.Ghost.IsBot || res.Msg.ResumeToken == "" {
+		t.Errorf("unexpected response: %+v", res.Msg)
+	}
+}
+
+// createTestGame creates a game named after the test and returns the response.
+func createTestGame(t *testing.T, s *GameServer, settings *gamev1.GameSettings) *gamev1.CreateGameResponse {
+	t.Helper()
+	res, err := s.CreateGame(context.Background(), connect.NewRequest(&gamev1.CreateGameRequest{
+		GameName:  t.Name(),
+		CardCount: 5,
+		Settings:  settings,
+	}))
+	if err != nil {
+		t.Fatalf("failed creating game: %v", err)
+	}
+	return res.Msg
+}
+
+// joinTestGame joins the game by its invite code, claiming the host with hostToken if given.
+func joinTestGame(t *testing.T, s *GameServer, created *gamev1.CreateGameResponse, name string, hostToken string) *gamev1.JoinGameResponse {
+	t.Helper()
+	res, err := s.JoinGame(context.Background(), connect.NewRequest(&gamev1.JoinGameRequest{
+		PlayerName: name,
+		InviteCode: created.InviteCode,
+		HostToken:  hostToken,
+	}))
+	if err != nil {
+		t.Fatalf("failed joining %s: %v", name, err)
+	}
+	return res.Msg
+}
+
+func TestSubmitAnswerOncePerRound(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	alice := joinTestGame(t, s, created, "alice", created.HostToken)
+	bob := joinTestGame(t, s, created, "bob", "")
+	gameId := int(created.GameId)
+	defer clearGameState(gameId)
+
+	answer := func(p *gamev1.JoinGameResponse, symbol string) error {
+		_, err := s.SubmitAnswer(ctx, connect.NewRequest(&gamev1.SubmitAnswerRequest{
+			PlayerId: strconv.Itoa(int(p.Player.Id)),
+			Answer:   symbol,
+		}))
+		return err
+	}
+	// 開始前は回答できない
+	if err := answer(alice, "1"); errorCode(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected an answer before the start to be rejected, got %v", err)
+	}
+
+	if _, err := testClient.Game.UpdateOneID(gameId).SetStatus(g.StatusSTARTED).Save(ctx); err != nil {
+		t.Fatalf("failed starting game: %v", err)
+	}
+	st := gameStates[gameId]
+	gamemode.Normal{}.NextRound(st)
+	gamemode.Normal{}.NextRound(st)
+	symbol := gamemode.CommonSymbol(st.Table[0], st.Table[1])
+
+	if err := answer(alice, symbol); err != nil {
+		t.Fatalf("expected the first correct answer to be accepted, got %v", err)
+	}
+	if err := answer(bob, symbol); errorCode(err) != connect.CodeFailedPrecondition {
+		t.Errorf("expected a second answer in the same round to be rejected, got %v", err)
+	}
+}
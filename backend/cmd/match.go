@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/match"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+	"example/internal/series"
+)
+
+// 対戦の途中で、順位を確認してから次のゲームを用意するまでの間
+const matchNextGameDelay = 5 * time.Second
+
+// プレイヤー1人の勝者が決まるモードか（協力・チーム対抗モードは勝者がプレイヤーではない）
+func singleWinnerMode(mode gamemode.GameMode) bool {
+	_, teamScored := mode.(gamemode.TeamScored)
+	_, teamed := mode.(gamemode.Teamed)
+	return !teamScored && !teamed
+}
+
+func matchRules(m *ent.Match) series.Rules {
+	return series.Rules{
+		Format: series.Format(m.Format),
+		Length: m.Length,
+	}
+}
+
+// 対戦のゲームを行った順に返す
+func matchGames(ctx context.Context, client *ent.Client, matchId int) ([]*ent.Game, error) {
+	games, err := client.Game.Query().
+		Where(g.MatchIDEQ(matchId)).
+		WithPlayers(func(q *ent.PlayerQuery) { q.Order(player.ByID()) }).
+		Order(g.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query games of match %d: %v", matchId, err)
+	}
+	return games, err
+}
+
+// ゲームの勝者の名前（勝者がいなければ空）
+func gameWinnerName(gameEnt *ent.Game) string {
+	if gameEnt.WinnerID == nil {
+		return ""
+	}
+	for _, p := range gameEnt.Edges.Players {
+		if p.ID == *gameEnt.WinnerID {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// 対戦の順位を求める。プレイヤーは再戦で引き継ぐ識別子で数え、勝利数は最後に参加したゲームのseries_winsを使う
+func matchStandings(games []*ent.Game) []series.Standing {
+	var players []series.Standing
+	index := make(map[int]int)
+	for _, game := range games {
+		for _, p := range game.Edges.Players {
+			// ボットは次のゲームに引き継がないので、勝った場合だけ数える
+			if p.IsBot && p.SeriesWins == 0 {
+				continue
+			}
+			s := series.Standing{ID: seriesIDOf(p), Name: p.Name, Wins: p.SeriesWins}
+			if i, ok := index[s.ID]; ok {
+				players[i] = s
+				continue
+			}
+			index[s.ID] = len(players)
+			players = append(players, s)
+		}
+	}
+	return series.Standings(players)
+}
+
+func standingList(standings []series.Standing) []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, s := range standings {
+		list = append(list, map[string]interface{}{
+			"name": s.Name,
+			"wins": s.Wins,
+		})
+	}
+	return list
+}
+
+// 終了したゲームごとの勝者と得点
+func matchGameList(games []*ent.Game) []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, game := range games {
+		if game.Status != g.StatusFINISHED {
+			continue
+		}
+		var scores []map[string]interface{}
+		for _, p := range game.Edges.Players {
+			scores = append(scores, map[string]interface{}{
+				"name":  p.Name,
+				"score": p.Score,
+			})
+		}
+		list = append(list, map[string]interface{}{
+			"game_id":     game.ID,
+			"winner_name": gameWinnerName(game),
+			"scores":      scores,
+		})
+	}
+	return list
+}
+
+// 対戦の一部のゲームが終わったら、GAME_OVERに載せる対戦の順位を返す。
+// 決着していれば対戦を終了し、そうでなければ少し待って同じ設定の次のゲームを用意する。ゲームのmutexを保持して呼ぶ
+func finishMatchGame(ctx context.Context, client *ent.Client, gameId int) map[string]interface{} {
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil || gameEnt.MatchID == 0 {
+		return nil
+	}
+	m, err := client.Match.Get(ctx, gameEnt.MatchID)
+	if err != nil {
+		log.Printf("failed to get match %d: %v", gameEnt.MatchID, err)
+		return nil
+	}
+	if m.Status != match.StatusPLAYING {
+		return nil
+	}
+	games, err := matchGames(ctx, client, m.ID)
+	if err != nil {
+		return nil
+	}
+	rules := matchRules(m)
+	standings := matchStandings(games)
+	played := 0
+	for _, game := range games {
+		if game.Status == g.StatusFINISHED {
+			played++
+		}
+	}
+	result := map[string]interface{}{
+		"match_id":  m.ID,
+		"format":    m.Format,
+		"length":    m.Length,
+		"played":    played,
+		"standings": standingList(standings),
+	}
+
+	winner, decided := rules.Winner(standings)
+	// BEST_OFで規定のゲーム数を終えたら、勝者なしのゲームがあっても首位で決着する（首位が並べば勝者なし）
+	if !decided && rules.Format == series.BestOf && played >= rules.Length {
+		decided = true
+		if len(standings) > 1 && standings[0].Wins > standings[1].Wins {
+			winner = standings[0].Name
+		}
+	}
+	if !decided {
+		result["next_game_in_ms"] = matchNextGameDelay.Milliseconds()
+		time.AfterFunc(matchNextGameDelay, func() { continueMatch(gameId) })
+		return result
+	}
+
+	update := m.Update().SetStatus(match.StatusFINISHED)
+	if winner != "" {
+		update.SetWinnerName(winner)
+		result["winner_name"] = winner
+	}
+	if _, err := update.Save(ctx); err != nil {
+		log.Printf("failed to finish match %d: %v", m.ID, err)
+	}
+	result["finished"] = true
+	result["games"] = matchGameList(games)
+	log.Printf("Match %d is FINISHED after %d games (winner %q)", m.ID, played, winner)
+	return result
+}
+
+// 対戦の次のゲームを作り、接続中のプレイヤーを移す
+func continueMatch(gameId int) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("game %d not found: %v", gameId, err)
+		return
+	}
+	// 待っている間に再戦の投票で作られていれば何もしない
+	if exists, _ := gameEnt.QueryRematch().Exist(ctx); exists {
+		return
+	}
+	players, err := gameEnt.QueryPlayers().
+		Where(
+			player.StatusNEQ(player.StatusDISCONNECTED),
+			player.IsBot(false),
+		).All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameId, err)
+		return
+	}
+	continuing := make(map[int]bool)
+	for _, p := range players {
+		continuing[p.ID] = true
+	}
+	if len(continuing) == 0 {
+		log.Printf("no players left to continue match %d", gameEnt.MatchID)
+		return
+	}
+	if _, err := createRematch(ctx, client, gameEnt, continuing); err != nil {
+		log.Printf("failed to continue match %d: %v", gameEnt.MatchID, err)
+	}
+}
+
+func (s *GameServer) GetMatch(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetMatchRequest],
+) (*connect.Response[gamev1.GetMatchResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	m, err := client.Match.Get(ctx, int(req.Msg.MatchId))
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("対戦 %d は存在しません", req.Msg.MatchId))
+	}
+	if err != nil {
+		log.Printf("failed to get match: %v", err)
+		return nil, err
+	}
+	games, err := matchGames(ctx, client, m.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &gamev1.Match{
+		Id:     int32(m.ID),
+		Format: string(m.Format),
+		Length: int32(m.Length),
+		Status: string(m.Status),
+	}
+	if m.WinnerName != nil {
+		res.WinnerName = *m.WinnerName
+	}
+	for _, s := range matchStandings(games) {
+		res.Standings = append(res.Standings, &gamev1.MatchStanding{
+			PlayerName: s.Name,
+			Wins:       int32(s.Wins),
+		})
+	}
+	for _, game := range games {
+		mg := &gamev1.MatchGame{
+			GameId:     int32(game.ID),
+			Status:     string(game.Status),
+			WinnerName: gameWinnerName(game),
+		}
+		for _, p := range game.Edges.Players {
+			mg.Scores = append(mg.Scores, &gamev1.MatchGameScore{
+				PlayerName: p.Name,
+				Score:      int32(p.Score),
+			})
+		}
+		res.Games = append(res.Games, mg)
+	}
+	return connect.NewResponse(&gamev1.GetMatchResponse{Match: res}), nil
+}
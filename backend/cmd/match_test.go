@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"example/ent"
+	g "example/ent/game"
+)
+
+func TestMatchStandingsKeyedBySeriesID(t *testing.T) {
+	// 同じ名前の2人は別々に数え、再戦後の行は最初のゲームのplayer_idで同じプレイヤーとして数える
+	first := &ent.Game{Status: g.StatusFINISHED, Edges: ent.GameEdges{Players: []*ent.Player{
+		{ID: 1, Name: "alice", SeriesWins: 1},
+		{ID: 2, Name: "alice"},
+	}}}
+	second := &ent.Game{Status: g.StatusFINISHED, Edges: ent.GameEdges{Players: []*ent.Player{
+		{ID: 3, Name: "alice", SeriesID: 1, SeriesWins: 1},
+		{ID: 4, Name: "alice2", SeriesID: 2, SeriesWins: 1},
+	}}}
+	standings := matchStandings([]*ent.Game{first, second})
+	if len(standings) != 2 {
+		t.Fatalf("expected 2 standings, got %+v", standings)
+	}
+	if standings[0].ID != 1 || standings[0].Wins != 1 {
+		t.Errorf("unexpected standing of the first player: %+v", standings[0])
+	}
+	if standings[1].ID != 2 || standings[1].Name != "alice2" || standings[1].Wins != 1 {
+		t.Errorf("expected the renamed player to keep the win, got %+v", standings[1])
+	}
+}
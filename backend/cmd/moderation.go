@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	"example/ent/ban"
+	g "example/ent/game"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+)
+
+// チャット1件の最大文字数
+const maxChatLength = 200
+
+// ホストが操作する対象のプレイヤーを探す
+func moderationTarget(ctx context.Context, client *ent.Client, gameId int, playerId int) (*ent.Player, error) {
+	target, err := client.Player.Query().
+		Where(
+			player.IDEQ(playerId),
+			player.HasParentWith(g.IDEQ(gameId)),
+		).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("プレイヤー%dはこのゲームに参加していません", playerId))
+	}
+	if err != nil {
+		log.Printf("failed to query player %d: %v", playerId, err)
+		return nil, err
+	}
+	return target, nil
+}
+
+// プレイヤーをゲームから外し、本人を含む参加者に通知してから接続を切る。ゲームのmutexを保持して呼ぶ
+func removePlayer(ctx context.Context, client *ent.Client, gameId int, target *ent.Player, event string) error {
+	if err := client.Player.DeleteOne(target).Exec(ctx); err != nil {
+		log.Printf("failed deleting player %d: %v", target.ID, err)
+		return err
+	}
+
+	msg := map[string]interface{}{
+		"event":     event,
+		"game_id":   gameId,
+		"player_id": target.ID,
+		"name":      target.Name,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	closePlayerConns(gameId, target.ID)
+	broadcastPlayers(ctx, client, gameId)
+
+	lobbyMsg := map[string]interface{}{
+		"event": event,
+	}
+	lb, _ := json.Marshal(lobbyMsg)
+	broadcastToLobby(lb)
+	return nil
+}
+
+// 禁止されたプレイヤーの再参加を拒否する（登録ユーザーなら名前を変えても拒否する）
+func checkBan(ctx context.Context, client *ent.Client, gameId int, name string, u *ent.User) error {
+	banned := ban.NameEQ(name)
+	if u != nil {
+		banned = ban.Or(banned, ban.UserIDEQ(u.ID))
+	}
+	exists, err := client.Ban.Query().
+		Where(
+			ban.HasGameWith(g.IDEQ(gameId)),
+			banned,
+		).Exist(ctx)
+	if err != nil {
+		log.Printf("failed checking bans of game %d: %v", gameId, err)
+		return err
+	}
+	if exists {
+		return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("このゲームへの参加は禁止されています"))
+	}
+	return nil
+}
+
+// 再戦のゲームにも参加禁止を引き継ぐ
+func copyBans(ctx context.Context, client *ent.Client, oldGameId int, newGameId int) {
+	bans, err := client.Ban.Query().
+		Where(ban.HasGameWith(g.IDEQ(oldGameId))).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query bans of game %d: %v", oldGameId, err)
+		return
+	}
+	for _, b := range bans {
+		create := client.Ban.Create().
+			SetName(b.Name).
+			SetGameID(newGameId)
+		if b.UserID != 0 {
+			create.SetUserID(b.UserID)
+		}
+		if _, err := create.Save(ctx); err != nil {
+			log.Printf("failed to copy ban of %s to game %d: %v", b.Name, newGameId, err)
+		}
+	}
+}
+
+func (s *GameServer) BanPlayer(
+	ctx context.Context,
+	req *connect.Request[gamev1.BanPlayerRequest],
+) (*connect.Response[gamev1.BanPlayerResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusCREATED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのゲームでは参加を禁止できません"))
+	}
+	target, err := moderationTarget(ctx, client, gameIdInt, playerIdInt)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsHost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ホスト自身の参加は禁止できません"))
+	}
+
+	create := client.Ban.Create().
+		SetName(target.Name).
+		SetGameID(gameIdInt)
+	if target.UserID != 0 {
+		create.SetUserID(target.UserID)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		log.Printf("failed creating ban: %v", err)
+		return nil, err
+	}
+	if err := removePlayer(ctx, client, gameIdInt, target, "BANNED"); err != nil {
+		return nil, err
+	}
+
+	log.Printf("player %d (%s) banned from game %d", playerIdInt, target.Name, gameIdInt)
+	return connect.NewResponse(&gamev1.BanPlayerResponse{}), nil
+}
+
+func (s *GameServer) MutePlayer(
+	ctx context.Context,
+	req *connect.Request[gamev1.MutePlayerRequest],
+) (*connect.Response[gamev1.MutePlayerResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	target, err := moderationTarget(ctx, client, gameIdInt, playerIdInt)
+	if err != nil {
+		return nil, err
+	}
+	if target.IsHost {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ホスト自身はミュートできません"))
+	}
+	if _, err := target.Update().SetMuted(req.Msg.Muted).Save(ctx); err != nil {
+		log.Printf("failed to update player %d: %v", playerIdInt, err)
+		return nil, err
+	}
+
+	msg := map[string]interface{}{
+		"event":     "MUTED",
+		"game_id":   gameIdInt,
+		"player_id": playerIdInt,
+		"name":      target.Name,
+		"muted":     req.Msg.Muted,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+
+	log.Printf("player %d in game %d muted=%v", playerIdInt, gameIdInt, req.Msg.Muted)
+	return connect.NewResponse(&gamev1.MutePlayerResponse{}), nil
+}
+
+func (s *GameServer) SendChat(
+	ctx context.Context,
+	req *connect.Request[gamev1.SendChatRequest],
+) (*connect.Response[gamev1.SendChatResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	text := strings.TrimSpace(req.Msg.Text)
+	if text == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("メッセージを入力してください"))
+	}
+	if utf8.RuneCountInString(text) > maxChatLength {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("メッセージは%d文字までです", maxChatLength))
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// 他のプレイヤーになりすまして発言できないよう本人確認する
+	sender, err := authenticatePlayer(ctx, client, gameIdInt, req.Msg.PlayerId, req.Msg.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	if sender.Muted {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("ホストによりチャットが禁止されています"))
+	}
+
+	msg := map[string]interface{}{
+		"event":     "CHAT",
+		"game_id":   gameIdInt,
+		"player_id": playerIdInt,
+		"name":      sender.Name,
+		"text":      text,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+
+	return connect.NewResponse(&gamev1.SendChatResponse{}), nil
+}
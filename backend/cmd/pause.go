@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+)
+
+// 再開が決まってから実際に再開するまでのカウントダウン
+const resumeCountdown = 3 * time.Second
+
+// 一時停止・再開の賛成票（game_id -> player_id）。状態が切り替わるたびにリセットする
+var pauseVotes = make(map[int]map[int]bool)
+
+// 一時停止した時刻（ロックアウトや反応時間の計測を停止時間分ずらすのに使う）
+var pausedAt = make(map[int]time.Time)
+
+// 一時停止中の共有の持ち時間の残り
+var pausedClocks = make(map[int]time.Duration)
+
+// 再開のカウントダウン中のゲーム
+var resumingGames = make(map[int]bool)
+
+// 一時停止・再開の投票を記録する。ホストの要求か過半数の賛成で決定とする。ゲームのmutexを保持して呼ぶ
+func castPauseVote(ctx context.Context, client *ent.Client, gameId int, userId string, token string) (decided bool, votes int, needed int, err error) {
+	// 他のプレイヤーになりすまして投票できないよう本人確認する
+	voter, err := authenticatePlayer(ctx, client, gameId, userId, token)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	switch voter.Status {
+	case player.StatusELIMINATED, player.StatusFINISHED, player.StatusDISCONNECTED:
+		return false, 0, 0, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("対戦中のプレイヤーのみ投票できます"))
+	}
+
+	active, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED, player.StatusDISCONNECTED),
+		).Count(ctx)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	needed = active/2 + 1
+
+	gameStateLock.Lock()
+	if pauseVotes[gameId] == nil {
+		pauseVotes[gameId] = make(map[int]bool)
+	}
+	pauseVotes[gameId][voter.ID] = true
+	votes = len(pauseVotes[gameId])
+	gameStateLock.Unlock()
+	return voter.IsHost || votes >= needed, votes, needed, nil
+}
+
+func (s *GameServer) PauseGame(
+	ctx context.Context,
+	req *connect.Request[gamev1.PauseGameRequest],
+) (*connect.Response[gamev1.PauseGameResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusSTARTED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("実施中のゲームのみ一時停止できます"))
+	}
+	decided, votes, needed, err := castPauseVote(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(&gamev1.PauseGameResponse{
+		Accepted:    decided,
+		Votes:       int32(votes),
+		VotesNeeded: int32(needed),
+	})
+	if !decided {
+		msg := map[string]interface{}{
+			"event":        "PAUSE_VOTE",
+			"game_id":      gameIdInt,
+			"votes":        votes,
+			"votes_needed": needed,
+		}
+		b, _ := json.Marshal(msg)
+		broadcastToGame(gameIdInt, b)
+		return res, nil
+	}
+
+	if _, err := gameEnt.Update().SetStatus(g.StatusPAUSED).Save(ctx); err != nil {
+		log.Printf("failed to pause game %d: %v", gameIdInt, err)
+		return nil, err
+	}
+	gameStateLock.Lock()
+	delete(pauseVotes, gameIdInt)
+	pausedAt[gameIdInt] = time.Now()
+	gameStateLock.Unlock()
+
+	msg := map[string]interface{}{
+		"event":   "PAUSED",
+		"game_id": gameIdInt,
+	}
+	// 共有の持ち時間を止めておく
+	if _, ok := gameModeOf(gameEnt).(gamemode.Clocked); ok {
+		left := stopSharedClock(gameIdInt)
+		gameStateLock.Lock()
+		pausedClocks[gameIdInt] = left
+		gameStateLock.Unlock()
+		msg["time_left_ms"] = left.Milliseconds()
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+
+	log.Printf("Game %d is PAUSED", gameIdInt)
+	return res, nil
+}
+
+func (s *GameServer) ResumeGame(
+	ctx context.Context,
+	req *connect.Request[gamev1.ResumeGameRequest],
+) (*connect.Response[gamev1.ResumeGameResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusPAUSED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("一時停止中のゲームではありません"))
+	}
+	gameStateLock.Lock()
+	resuming := resumingGames[gameIdInt]
+	gameStateLock.Unlock()
+	if resuming {
+		return connect.NewResponse(&gamev1.ResumeGameResponse{Accepted: true}), nil
+	}
+	decided, votes, needed, err := castPauseVote(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	res := connect.NewResponse(&gamev1.ResumeGameResponse{
+		Accepted:    decided,
+		Votes:       int32(votes),
+		VotesNeeded: int32(needed),
+	})
+	if !decided {
+		msg := map[string]interface{}{
+			"event":        "RESUME_VOTE",
+			"game_id":      gameIdInt,
+			"votes":        votes,
+			"votes_needed": needed,
+		}
+		b, _ := json.Marshal(msg)
+		broadcastToGame(gameIdInt, b)
+		return res, nil
+	}
+
+	// カウントダウンの間も回答は受け付けない
+	gameStateLock.Lock()
+	delete(pauseVotes, gameIdInt)
+	resumingGames[gameIdInt] = true
+	gameStateLock.Unlock()
+	msg := map[string]interface{}{
+		"event":        "RESUMING",
+		"game_id":      gameIdInt,
+		"countdown_ms": resumeCountdown.Milliseconds(),
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+	time.AfterFunc(resumeCountdown, func() { finishResume(gameIdInt) })
+
+	return res, nil
+}
+
+// カウントダウン後にゲームを再開し、止めていた時計を動かす
+func finishResume(gameId int) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameStateLock.Lock()
+	delete(resumingGames, gameId)
+	gameStateLock.Unlock()
+	n, err := client.Game.Update().
+		Where(g.IDEQ(gameId), g.StatusEQ(g.StatusPAUSED)).
+		SetStatus(g.StatusSTARTED).
+		Save(ctx)
+	if err != nil || n == 0 {
+		log.Printf("game %d is no longer paused: %v", gameId, err)
+		return
+	}
+
+	// 停止していた時間分、ロックアウトの解除と反応時間の基準をずらす
+	gameStateLock.Lock()
+	paused := time.Since(pausedAt[gameId])
+	delete(pausedAt, gameId)
+	for id, until := range lockouts[gameId] {
+		lockouts[gameId][id] = until.Add(paused)
+	}
+	if st, ok := gameStates[gameId]; ok && !st.DealtAt.IsZero() {
+		st.DealtAt = st.DealtAt.Add(paused)
+	}
+	left, clocked := pausedClocks[gameId]
+	delete(pausedClocks, gameId)
+	gameStateLock.Unlock()
+
+	msg := map[string]interface{}{
+		"event":   "RESUMED",
+		"game_id": gameId,
+	}
+	if clocked {
+		msg["time_left_ms"] = startSharedClock(gameId, left).Milliseconds()
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	log.Printf("Game %d is RESUMED after %v", gameId, paused)
+
+	// 停止中に全員がREADYになっていれば次のカードを配る
+	if ready, _ := allReady(ctx, client, gameId); ready {
+		DistributeCard(client, gameId)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestPauseGameRequiresResumeToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	joinTestGame(t, s, created, "guest", "")
+	if _, err := testClient.Game.UpdateOneID(int(created.GameId)).SetStatus(g.StatusSTARTED).Save(ctx); err != nil {
+		t.Fatalf("failed starting game: %v", err)
+	}
+	defer clearGameState(int(created.GameId))
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+
+	// ホストのIDを知っているだけでは一時停止できない
+	_, err := s.PauseGame(ctx, connect.NewRequest(&gamev1.PauseGameRequest{
+		GameId: gameId,
+		UserId: hostId,
+	}))
+	if errorCode(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected a vote without the token to be rejected, got %v", err)
+	}
+
+	res, err := s.PauseGame(ctx, connect.NewRequest(&gamev1.PauseGameRequest{
+		GameId:      gameId,
+		UserId:      hostId,
+		ResumeToken: host.ResumeToken,
+	}))
+	if err != nil {
+		t.Fatalf("expected the host to pause the game, got %v", err)
+	}
+	if !res.Msg.Accepted {
+		t.Error("expected the host's request to pause the game at once")
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"log"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	"example/ent/practicerun"
+	gamev1 "example/gen/game/v1"
+	"example/internal/cardgen"
+	"example/internal/gamemode"
+)
+
+// 一人用のタイムアタック。ロビーやWebSocketを使わず、RPCの応答で次のカードを返す
+type practiceSession struct {
+	playerName string
+	token      string // 回答するときの本人確認用トークン。practice_idは連番なので推測できる
+	seed       int64
+	deck       []Card
+	next       int     // 次に配るカードの位置
+	table      [2]Card // 場の2枚
+	startedAt  time.Time
+	dealtAt    time.Time
+	reactions  []int64
+	mistakes   int
+	daily      bool // デイリーチャレンジの挑戦
+	dailyID    int  // ランキング対象のデイリーチャレンジの記録（ランキング対象外なら0）
+}
+
+// 進行中の練習（practice_id -> セッション）
+var practiceSessions = make(map[int]*practiceSession)
+var practiceLock sync.Mutex
+var practiceSeq int
+
+// 放置された練習を破棄するまでの時間
+const practiceTimeout = 30 * time.Minute
+
+// シードから決まった順番のDobbleカード一式を生成する
+func newSeededDeck(seed int64) []Card {
+	generatedCards, err := cardgen.GenerateSeededDeck(dobbleOrder, seed)
+	if err != nil {
+		log.Fatalf("failed to generate cards: %v", err)
+	}
+	return toDeck(generatedCards)
+}
+
+func (s *GameServer) StartPractice(
+	ctx context.Context,
+	req *connect.Request[gamev1.StartPracticeRequest],
+) (*connect.Response[gamev1.StartPracticeResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	if req.Msg.PlayerName == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+	}
+	seed := rand.Int64()
+	deck := newSeededDeck(seed)
+	cardCount := int(req.Msg.CardCount)
+	if cardCount < 0 || cardCount == 1 || cardCount > len(deck) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("カード枚数は2〜%d枚で指定してください", len(deck)))
+	}
+	if cardCount > 0 {
+		deck = deck[:cardCount]
+	}
+
+	best, err := personalBest(ctx, client, req.Msg.PlayerName, len(deck))
+	if err != nil {
+		return nil, err
+	}
+
+	session := &practiceSession{
+		playerName: req.Msg.PlayerName,
+		seed:       seed,
+		deck:       deck,
+	}
+	practiceId := registerPractice(session)
+
+	log.Printf("Practice %d started by %s with %d cards", practiceId, req.Msg.PlayerName, len(deck))
+	res := &gamev1.StartPracticeResponse{
+		PracticeId:    strconv.Itoa(practiceId),
+		Cards:         firstCards(deck),
+		CardCount:     int32(len(deck)),
+		PracticeToken: session.token,
+	}
+	if best != nil {
+		res.PersonalBestMs = best.TotalMs
+	}
+	return connect.NewResponse(res), nil
+}
+
+// 最初の2枚を場に出して練習を登録し、practice_idを返す。本人確認用トークンはsession.tokenに入る
+func registerPractice(session *practiceSession) int {
+	now := time.Now()
+	session.token = newResumeToken()
+	session.next = 2
+	session.table = [2]Card{session.deck[0], session.deck[1]}
+	session.startedAt = now
+	session.dealtAt = now
+
+	practiceLock.Lock()
+	defer practiceLock.Unlock()
+	for id, p := range practiceSessions {
+		if now.Sub(p.startedAt) > practiceTimeout {
+			delete(practiceSessions, id)
+		}
+	}
+	practiceSeq++
+	practiceSessions[practiceSeq] = session
+	return practiceSeq
+}
+
+// 最初に場に出る2枚
+func firstCards(deck []Card) []*gamev1.Card {
+	return []*gamev1.Card{
+		{Id: int32(deck[0].ID), Text: deck[0].Text},
+		{Id: int32(deck[1].ID), Text: deck[1].Text},
+	}
+}
+
+func (s *GameServer) SubmitPracticeAnswer(
+	ctx context.Context,
+	req *connect.Request[gamev1.SubmitPracticeAnswerRequest],
+) (*connect.Response[gamev1.SubmitPracticeAnswerResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	practiceId, err := strconv.Atoi(req.Msg.PracticeId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	practiceLock.Lock()
+	session, ok := practiceSessions[practiceId]
+	if !ok {
+		practiceLock.Unlock()
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("練習 %d は存在しないか終了しています", practiceId))
+	}
+	if subtle.ConstantTimeCompare([]byte(req.Msg.PracticeToken), []byte(session.token)) != 1 {
+		practiceLock.Unlock()
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("本人確認に失敗しました"))
+	}
+	res := &gamev1.SubmitPracticeAnswerResponse{}
+	if req.Msg.Answer != gamemode.CommonSymbol(session.table[0], session.table[1]) {
+		// 不正解はミスとして数え、同じ2枚のまま続ける
+		session.mistakes++
+		res.Remaining = int32(len(session.deck) - session.next)
+		practiceLock.Unlock()
+		return connect.NewResponse(res), nil
+	}
+
+	now := time.Now()
+	reaction := now.Sub(session.dealtAt).Milliseconds()
+	session.reactions = append(session.reactions, reaction)
+	res.Correct = true
+	res.ReactionMs = reaction
+	if session.next < len(session.deck) {
+		c := session.deck[session.next]
+		session.next++
+		session.table = [2]Card{session.table[1], c}
+		session.dealtAt = now
+		res.NextCard = &gamev1.Card{Id: int32(c.ID), Text: c.Text}
+		res.Remaining = int32(len(session.deck) - session.next)
+		practiceLock.Unlock()
+		return connect.NewResponse(res), nil
+	}
+	delete(practiceSessions, practiceId)
+	practiceLock.Unlock()
+
+	// デッキを最後まで回したら記録する
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	totalMs := now.Sub(session.startedAt).Milliseconds()
+	res.Finished = true
+	res.TotalMs = totalMs
+	res.ReactionTimesMs = session.reactions
+	res.Mistakes = int32(session.mistakes)
+	if session.daily {
+		// デイリーチャレンジは自己ベストとは別に記録する
+		if session.dailyID != 0 {
+			rank, err := finishDailyChallenge(ctx, client, session, totalMs)
+			if err != nil {
+				return nil, err
+			}
+			res.DailyRank = int32(rank)
+		}
+		log.Printf("Daily challenge %d finished by %s in %dms", practiceId, session.playerName, totalMs)
+		return connect.NewResponse(res), nil
+	}
+
+	prev, err := personalBest(ctx, client, session.playerName, len(session.deck))
+	if err != nil {
+		return nil, err
+	}
+	run, err := client.PracticeRun.Create().
+		SetPlayerName(session.playerName).
+		SetCardCount(len(session.deck)).
+		SetSeed(session.seed).
+		SetTotalMs(totalMs).
+		SetReactionMs(session.reactions).
+		SetMistakes(session.mistakes).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed saving practice run: %v", err)
+		return nil, err
+	}
+	log.Printf("Practice %d finished by %s in %dms (run %d)", practiceId, session.playerName, totalMs, run.ID)
+
+	res.PersonalBest = prev == nil || totalMs < prev.TotalMs
+	res.PersonalBestMs = totalMs
+	if !res.PersonalBest {
+		res.PersonalBestMs = prev.TotalMs
+	}
+	return connect.NewResponse(res), nil
+}
+
+func (s *GameServer) GetPersonalBests(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetPersonalBestsRequest],
+) (*connect.Response[gamev1.GetPersonalBestsResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	// デッキサイズ順・タイム順に取得し、デッキサイズごとの最速記録を抽出
+	runs, err := client.PracticeRun.Query().
+		Where(practicerun.PlayerNameEQ(req.Msg.PlayerName)).
+		Order(practicerun.ByCardCount(), practicerun.ByTotalMs(), practicerun.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed querying practice runs: %v", err)
+		return nil, err
+	}
+	var bests []*gamev1.PersonalBest
+	for _, r := range runs {
+		cardCount := int32(r.CardCount)
+		if len(bests) > 0 && bests[len(bests)-1].CardCount == cardCount {
+			continue
+		}
+		bests = append(bests, &gamev1.PersonalBest{
+			CardCount:       cardCount,
+			TotalMs:         r.TotalMs,
+			RunId:           int32(r.ID),
+			ReactionTimesMs: r.ReactionMs,
+		})
+	}
+	return connect.NewResponse(&gamev1.GetPersonalBestsResponse{
+		Bests: bests,
+	}), nil
+}
+
+// プレイヤーのデッキサイズごとの最速記録（記録がなければnil）
+func personalBest(ctx context.Context, client *ent.Client, playerName string, cardCount int) (*ent.PracticeRun, error) {
+	run, err := client.PracticeRun.Query().
+		Where(
+			practicerun.PlayerNameEQ(playerName),
+			practicerun.CardCountEQ(cardCount),
+		).
+		Order(practicerun.ByTotalMs(), practicerun.ByID()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("failed querying personal best: %v", err)
+		return nil, err
+	}
+	return run, nil
+}
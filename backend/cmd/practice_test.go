@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	gamev1 "example/gen/game/v1"
+)
+
+func TestSubmitPracticeAnswerRequiresPracticeToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	started, err := s.StartPractice(ctx, connect.NewRequest(&gamev1.StartPracticeRequest{
+		PlayerName: t.Name(),
+		CardCount:  5,
+	}))
+	if err != nil {
+		t.Fatalf("failed starting practice: %v", err)
+	}
+	if started.Msg.PracticeToken == "" {
+		t.Fatal("expected a practice token")
+	}
+
+	answer := func(token string) (*connect.Response[gamev1.SubmitPracticeAnswerResponse], error) {
+		return s.SubmitPracticeAnswer(ctx, connect.NewRequest(&gamev1.SubmitPracticeAnswerRequest{
+			PracticeId:    started.Msg.PracticeId,
+			Answer:        "none",
+			PracticeToken: token,
+		}))
+	}
+	// 連番のpractice_idだけでは他人の練習に回答できない
+	if _, err := answer(""); errorCode(err) != connect.CodeUnauthenticated {
+		t.Errorf("expected an answer without the token to be rejected, got %v", err)
+	}
+	res, err := answer(started.Msg.PracticeToken)
+	if err != nil {
+		t.Fatalf("expected the owner's answer to be accepted, got %v", err)
+	}
+	if res.Msg.Correct {
+		t.Errorf("expected the wrong answer to be counted as a mistake")
+	}
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+	"example/internal/matchmaking"
+	"example/internal/rating"
+)
+
+// マッチングの設定（4人揃えばすぐに、最初の1人が20秒待てば2人以上で開始する）。
+// レーティングの差が200以内のプレイヤーで組み、待つほど離れた相手とも組む
+var matchmakingConfig = matchmaking.Config{
+	GameSize:    4,
+	MinPlayers:  2,
+	MaxWait:     20 * time.Second,
+	RatingRange: 200,
+}
+
+// マッチメイカーがキューを確認する間隔
+const matchmakingInterval = time.Second
+
+// ロビーで通知を待たないままこれだけ経ったプレイヤーはキューから外す
+const unwatchedTicketTimeout = time.Minute
+
+// 待っているプレイヤー（並んだ順）と、通知先のロビーの接続（ticket -> 接続）
+var (
+	queue      []matchmaking.Ticket
+	queueConns = make(map[string]*websocket.Conn)
+	queueLock  sync.Mutex
+)
+
+func (s *GameServer) JoinQueue(
+	ctx context.Context,
+	req *connect.Request[gamev1.JoinQueueRequest],
+) (*connect.Response[gamev1.JoinQueueResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	// 登録ユーザーはレーティングで相手を選ぶ。登録していなければ初期値として扱う
+	name := req.Msg.PlayerName
+	r := rating.Initial
+	if req.Msg.UserToken != "" {
+		client := GetDbClient(ctx)
+		defer client.Close()
+		u, err := userByToken(ctx, client, req.Msg.UserToken)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			name = u.Name
+		}
+		r = u.Rating
+	}
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+	}
+	if req.Msg.Mode != "" {
+		if _, ok := gamemode.Lookup(req.Msg.Mode); !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("不明なゲームモードです: %s", req.Msg.Mode))
+		}
+	}
+	if req.Msg.CardCount < 0 || req.Msg.CardCount > deckSize {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("デッキの枚数が不正です: %d", req.Msg.CardCount))
+	}
+
+	ticket := matchmaking.Ticket{
+		ID:        newResumeToken(),
+		Name:      name,
+		Mode:      req.Msg.Mode,
+		CardCount: int(req.Msg.CardCount),
+		Rating:    r,
+		QueuedAt:  time.Now(),
+		UserToken: req.Msg.UserToken,
+	}
+	queueLock.Lock()
+	for _, t := range queue {
+		if ticket.UserToken != "" && t.UserToken == ticket.UserToken {
+			queueLock.Unlock()
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s は既にキューに並んでいます", name))
+		}
+	}
+	queue = append(queue, ticket)
+	waiting := len(queue)
+	queueLock.Unlock()
+	broadcastQueueSize(waiting)
+
+	log.Printf("%s joined the queue (mode=%q, card_count=%d, rating=%.0f)", ticket.Name, ticket.Mode, ticket.CardCount, ticket.Rating)
+	return connect.NewResponse(&gamev1.JoinQueueResponse{
+		Ticket:  ticket.ID,
+		Waiting: int32(waiting),
+	}), nil
+}
+
+func (s *GameServer) LeaveQueue(
+	ctx context.Context,
+	req *connect.Request[gamev1.LeaveQueueRequest],
+) (*connect.Response[gamev1.LeaveQueueResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	queueLock.Lock()
+	left := removeTicket(req.Msg.Ticket)
+	waiting := len(queue)
+	queueLock.Unlock()
+	if !left {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("キューに並んでいません"))
+	}
+	broadcastQueueSize(waiting)
+	return connect.NewResponse(&gamev1.LeaveQueueResponse{}), nil
+}
+
+// キューから外す。queueLockを保持して呼ぶ
+func removeTicket(id string) bool {
+	delete(queueConns, id)
+	for i, t := range queue {
+		if t.ID == id {
+			queue = append(queue[:i], queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ロビーの接続を並んでいるプレイヤーの通知先にする
+func watchTicket(conn *websocket.Conn, id string) {
+	queueLock.Lock()
+	defer queueLock.Unlock()
+	for _, t := range queue {
+		if t.ID == id {
+			queueConns[id] = conn
+			return
+		}
+	}
+}
+
+// ロビーの接続が切れたら、その接続で通知を待っていたプレイヤーをキューから外す
+func unwatchTickets(conn *websocket.Conn) {
+	queueLock.Lock()
+	removed := false
+	for id, c := range queueConns {
+		if c == conn {
+			removeTicket(id)
+			removed = true
+		}
+	}
+	waiting := len(queue)
+	queueLock.Unlock()
+	if removed {
+		broadcastQueueSize(waiting)
+	}
+}
+
+func broadcastQueueSize(waiting int) {
+	msg := map[string]interface{}{
+		"event":   "QUEUE",
+		"waiting": waiting,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToLobby(b)
+}
+
+// 一定間隔でキューを確認し、揃ったプレイヤーのゲームを作る
+func runMatchmaker(s *GameServer) {
+	for range time.Tick(matchmakingInterval) {
+		now := time.Now()
+		queueLock.Lock()
+		// 通知を受け取れるプレイヤーだけをまとめる
+		var watched, unwatched []matchmaking.Ticket
+		for _, t := range queue {
+			if queueConns[t.ID] != nil {
+				watched = append(watched, t)
+			} else if now.Sub(t.QueuedAt) < unwatchedTicketTimeout {
+				unwatched = append(unwatched, t)
+			}
+		}
+		groups, waiting := matchmakingConfig.Form(watched, now)
+		queue = append(waiting, unwatched...)
+		sort.SliceStable(queue, func(i, j int) bool { return queue[i].QueuedAt.Before(queue[j].QueuedAt) })
+		conns := make(map[string]*websocket.Conn)
+		for _, group := range groups {
+			for _, t := range group.Tickets {
+				conns[t.ID] = queueConns[t.ID]
+				delete(queueConns, t.ID)
+			}
+		}
+		queueLock.Unlock()
+
+		for _, group := range groups {
+			createQueuedGame(s, group, conns)
+		}
+		if len(groups) > 0 {
+			broadcastQueueSize(len(queue))
+		}
+	}
+}
+
+// マッチングしたプレイヤーのゲームを作って参加させ、各プレイヤーに知らせる。
+// 全員がゲームに接続すると自動で始まる
+func createQueuedGame(s *GameServer, group matchmaking.Group, conns map[string]*websocket.Conn) {
+	ctx := context.Background()
+	n := int32(len(group.Tickets))
+	created, err := s.CreateGame(ctx, connect.NewRequest(&gamev1.CreateGameRequest{
+		GameName:  "クイック対戦 " + group.Tickets[0].ID[:8],
+		Mode:      group.Mode,
+		CardCount: int32(group.CardCount),
+		Settings: &gamev1.GameSettings{
+			MaxPlayers: n,
+			MinPlayers: n,
+			Visibility: string(g.VisibilityPRIVATE),
+			AutoStart:  true,
+		},
+	}))
+	if err != nil {
+		// 希望の組み合わせでゲームを作れなかった（デッキが少なすぎるなど）
+		log.Printf("failed to create a game for the queue: %v", err)
+		for _, t := range group.Tickets {
+			notifyTicket(conns[t.ID], map[string]interface{}{
+				"event":  "MATCH_FAILED",
+				"ticket": t.ID,
+				"error":  err.Error(),
+			})
+		}
+		return
+	}
+	gameId := strconv.Itoa(int(created.Msg.GameId))
+
+	var names []string
+	for _, t := range group.Tickets {
+		names = append(names, t.Name)
+	}
+	for i, t := range group.Tickets {
+		// 最初のプレイヤーをホストにする
+		hostToken := ""
+		if i == 0 {
+			hostToken = created.Msg.HostToken
+		}
+		joined, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+			PlayerName: t.Name,
+			GameId:     gameId,
+			UserToken:  t.UserToken,
+			InviteCode: created.Msg.InviteCode,
+			HostToken:  hostToken,
+		}))
+		if err != nil {
+			log.Printf("failed to join %s to game %s: %v", t.Name, gameId, err)
+			continue
+		}
+		notifyTicket(conns[t.ID], map[string]interface{}{
+			"event":        "MATCHED",
+			"ticket":       t.ID,
+			"game_id":      created.Msg.GameId,
+			"player_id":    joined.Msg.Player.Id,
+			"resume_token": joined.Msg.ResumeToken,
+			"players":      names,
+		})
+	}
+	log.Printf("Game %s formed from the queue with %d players (mode=%q, card_count=%d)", gameId, n, group.Mode, group.CardCount)
+}
+
+// キューに並んだプレイヤーのロビーの接続に送る（接続がなければ何もしない）
+func notifyTicket(conn *websocket.Conn, msg map[string]interface{}) {
+	if conn == nil {
+		log.Printf("ticket %v is not watched in the lobby", msg["ticket"])
+		return
+	}
+	b, _ := json.Marshal(msg)
+	if !sendMessage(conn, b) {
+		log.Printf("failed to send %s", b)
+	}
+}
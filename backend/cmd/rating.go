@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	"example/ent/user"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+	"example/internal/rating"
+)
+
+// GetRatingsで返す人数の既定値と上限
+const (
+	defaultRatingsLimit = 10
+	maxRatingsLimit     = 100
+)
+
+func (s *GameServer) RegisterUser(
+	ctx context.Context,
+	req *connect.Request[gamev1.RegisterUserRequest],
+) (*connect.Response[gamev1.RegisterUserResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("ユーザー名を指定してください"))
+	}
+	u, err := client.User.Create().
+		SetName(req.Msg.Name).
+		SetToken(newResumeToken()).
+		Save(ctx)
+	switch {
+	case ent.IsConstraintError(err):
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("ユーザー名 %s は既に使われています", req.Msg.Name))
+	case err != nil:
+		log.Printf("failed creating user: %v", err)
+		return nil, err
+	}
+	log.Printf("User %d (%s) is registered", u.ID, u.Name)
+	return connect.NewResponse(&gamev1.RegisterUserResponse{
+		User:  userToProto(u),
+		Token: u.Token,
+	}), nil
+}
+
+func (s *GameServer) GetRatings(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetRatingsRequest],
+) (*connect.Response[gamev1.GetRatingsResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = defaultRatingsLimit
+	}
+	if limit > maxRatingsLimit {
+		limit = maxRatingsLimit
+	}
+	users, err := client.User.Query().
+		Order(user.ByRating(sql.OrderDesc()), user.ByID()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed querying users: %v", err)
+		return nil, err
+	}
+	var list []*gamev1.User
+	for _, u := range users {
+		list = append(list, userToProto(u))
+	}
+	return connect.NewResponse(&gamev1.GetRatingsResponse{Users: list}), nil
+}
+
+func userToProto(u *ent.User) *gamev1.User {
+	return &gamev1.User{
+		Id:         int32(u.ID),
+		Name:       u.Name,
+		Rating:     u.Rating,
+		RatedGames: int32(u.RatedGames),
+	}
+}
+
+// トークンから登録ユーザーを探す
+func userByToken(ctx context.Context, client *ent.Client, token string) (*ent.User, error) {
+	u, err := client.User.Query().Where(user.TokenEQ(token)).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("ユーザーのトークンが正しくありません"))
+	}
+	if err != nil {
+		log.Printf("failed to query user: %v", err)
+		return nil, err
+	}
+	return u, nil
+}
+
+// 終了したゲームの順位から登録ユーザーのレーティングを更新し、GAME_OVERに載せる変動を返す。
+// 登録ユーザーが2人以上いる対戦だけが対象で、協力モードとハンデのあるゲームは対象外。ゲームのmutexを保持して呼ぶ
+func rateGame(ctx context.Context, client *ent.Client, gameId int) []map[string]interface{} {
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		return nil
+	}
+	mode := gameModeOf(gameEnt)
+	if _, ok := mode.(gamemode.TeamScored); ok {
+		return nil
+	}
+	if len(handicapList(gameId)) > 0 {
+		return nil
+	}
+	players, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.IsBot(false),
+		).
+		WithUser().
+		WithTeam().
+		Order(player.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameId, err)
+		return nil
+	}
+	var rated []*ent.Player
+	for _, p := range players {
+		if p.Edges.User != nil {
+			rated = append(rated, p)
+		}
+	}
+	if len(rated) < 2 {
+		return nil
+	}
+
+	// チーム対抗ではチームの得点、それ以外は個人の得点で順位を決める。
+	// 脱落したプレイヤーは残ったプレイヤーより下の順位にする
+	_, teamed := mode.(gamemode.Teamed)
+	scores := make([]int, len(rated))
+	lowest, highest := 0, 0
+	for i, p := range rated {
+		scores[i] = p.Score
+		if teamed && p.Edges.Team != nil {
+			scores[i] = p.Edges.Team.Score
+		}
+		if i == 0 || scores[i] < lowest {
+			lowest = scores[i]
+		}
+		if i == 0 || scores[i] > highest {
+			highest = scores[i]
+		}
+	}
+	for i, p := range rated {
+		if !teamed && p.Status == player.StatusELIMINATED {
+			scores[i] -= highest - lowest + 1
+		}
+	}
+	places := rating.Places(scores)
+	standings := make([]rating.Standing, len(rated))
+	for i, p := range rated {
+		standings[i] = rating.Standing{Rating: p.Edges.User.Rating, Place: places[i]}
+	}
+	ratings := rating.Update(standings)
+
+	var list []map[string]interface{}
+	for i, p := range rated {
+		u, err := p.Edges.User.Update().
+			AddRating(ratings[i] - standings[i].Rating).
+			AddRatedGames(1).
+			Save(ctx)
+		if err != nil {
+			log.Printf("failed to update rating of user %d: %v", p.Edges.User.ID, err)
+			continue
+		}
+		list = append(list, map[string]interface{}{
+			"player_id": p.ID,
+			"user_id":   u.ID,
+			"name":      u.Name,
+			"place":     places[i],
+			"rating":    u.Rating,
+			"change":    u.Rating - standings[i].Rating,
+		})
+	}
+	log.Printf("Ratings of %d users are updated by game %d", len(list), gameId)
+	return list
+}
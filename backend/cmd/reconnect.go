@@ -0,0 +1,350 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	"example/ent/team"
+	"example/internal/gamemode"
+)
+
+// 切断したプレイヤーの再接続を待つ時間
+const reconnectGracePeriod = 30 * time.Second
+
+// 再接続を待っているプレイヤーの猶予タイマー（player_id -> タイマー）
+var reconnectTimers = make(map[int]*time.Timer)
+var reconnectTimerLock sync.Mutex
+
+func newResumeToken() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		log.Fatalf("failed to generate resume token: %v", err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// プレイヤーの接続がまだ残っているか（再接続が切断処理より先に届いた場合など）
+func playerConnected(gameId int, playerId int) bool {
+	for _, id := range botPlayerIDs(gameId) {
+		if id == playerId {
+			return true
+		}
+	}
+	for _, info := range gameConns(gameId) {
+		if !info.Spectator && info.PlayerID == playerId {
+			return true
+		}
+	}
+	return false
+}
+
+// 切断したプレイヤーをDISCONNECTEDにし、猶予時間内に再接続しなければゲームから外す
+func markDisconnected(client *ent.Client, gameId int, playerId int) {
+	ctx := context.Background()
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	p, err := client.Player.UpdateOneID(playerId).SetStatus(player.StatusDISCONNECTED).Save(ctx)
+	if err != nil {
+		log.Printf("failed to mark player %d as disconnected: %v", playerId, err)
+		return
+	}
+
+	reconnectTimerLock.Lock()
+	if t, ok := reconnectTimers[playerId]; ok {
+		t.Stop()
+	}
+	reconnectTimers[playerId] = time.AfterFunc(reconnectGracePeriod, func() { removeDisconnected(gameId, playerId) })
+	reconnectTimerLock.Unlock()
+
+	msg := map[string]interface{}{
+		"event":     "PLAYER_DISCONNECTED",
+		"game_id":   gameId,
+		"player_id": p.ID,
+		"name":      p.Name,
+		"grace_ms":  reconnectGracePeriod.Milliseconds(),
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	log.Printf("player %d of game %d disconnected, waiting %v for reconnect", playerId, gameId, reconnectGracePeriod)
+
+	// 切断したプレイヤーを待たずに、他の全員がREADYなら次のカードを配る
+	if ready, _ := allReady(ctx, client, gameId); ready {
+		DistributeCard(client, gameId)
+	}
+}
+
+// 猶予時間内に再接続しなかったプレイヤーをゲームから外す
+func removeDisconnected(gameId int, playerId int) {
+	reconnectTimerLock.Lock()
+	delete(reconnectTimers, playerId)
+	reconnectTimerLock.Unlock()
+
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	p, err := client.Player.Get(ctx, playerId)
+	if err != nil || p.Status != player.StatusDISCONNECTED {
+		// 再接続済み、または既にゲームから外れている
+		return
+	}
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("game %d not found: %v", gameId, err)
+		return
+	}
+	// 確認後に再接続やキックで状態が変わっていたら何もしない
+	n, err := client.Player.Delete().
+		Where(player.IDEQ(playerId), player.StatusEQ(player.StatusDISCONNECTED)).
+		Exec(ctx)
+	if err != nil {
+		log.Printf("failed deleting player %d: %v", playerId, err)
+		return
+	}
+	if n == 0 {
+		return
+	}
+
+	msg := map[string]interface{}{
+		"event":     "PLAYER_LEFT",
+		"game_id":   gameId,
+		"player_id": p.ID,
+		"name":      p.Name,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	log.Printf("player %d removed from game %d after the reconnect grace period", playerId, gameId)
+
+	remaining, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED),
+		).Count(ctx)
+	if err != nil {
+		log.Printf("failed counting players for game %d: %v", gameId, err)
+		return
+	}
+	// ボットだけが残ってもゲームは続けない
+	humans, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusELIMINATED, player.StatusFINISHED),
+			player.IsBot(false),
+		).Count(ctx)
+	if err != nil {
+		log.Printf("failed counting players for game %d: %v", gameId, err)
+		return
+	}
+
+	switch gameEnt.Status {
+	case g.StatusCREATED:
+		if humans == 0 {
+			// 誰も残っていなければボットごとゲームを削除
+			if _, err := client.Player.Delete().Where(player.HasParentWith(g.IDEQ(gameId))).Exec(ctx); err != nil {
+				log.Printf("failed deleting bots of game %d: %v", gameId, err)
+			}
+			if _, err := client.Team.Delete().Where(team.HasParentWith(g.IDEQ(gameId))).Exec(ctx); err != nil {
+				log.Printf("failed deleting teams of game %d: %v", gameId, err)
+			}
+			if err := client.Game.DeleteOneID(gameId).Exec(ctx); err != nil {
+				log.Printf("failed deleting game %d: %v", gameId, err)
+			}
+			clearGameState(gameId)
+			log.Printf("Game %d deleted due to disconnect.", gameId)
+		} else {
+			if p.IsHost {
+				migrateHost(ctx, client, gameId)
+			}
+			broadcastPlayers(ctx, client, gameId)
+		}
+		lobbyMsg := map[string]interface{}{
+			"event": "DELETED",
+		}
+		lb, _ := json.Marshal(lobbyMsg)
+		broadcastToLobby(lb)
+	case g.StatusSTARTED, g.StatusPAUSED:
+		if p.IsHost {
+			migrateHost(ctx, client, gameId)
+		}
+		if remaining <= 1 || humans == 0 {
+			// 対戦相手がいなくなったら終了
+			finishGame(client, gameId)
+			return
+		}
+		if ready, _ := allReady(ctx, client, gameId); ready {
+			DistributeCard(client, gameId)
+		}
+	}
+}
+
+// プレイヤーの接続を再開トークンで本人確認し、切断中なら復帰させる。切断中でなければfalseを返す
+func resumePlayer(client *ent.Client, gameId int, playerId int, token string) (bool, error) {
+	ctx := context.Background()
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	p, err := client.Player.Query().
+		Where(
+			player.IDEQ(playerId),
+			player.HasParentWith(g.IDEQ(gameId)),
+		).Only(ctx)
+	if err != nil {
+		return false, fmt.Errorf("player %d is not in game %d: %w", playerId, gameId, err)
+	}
+	// 接続中のプレイヤーにも確認する。他人の接続として個別の通知（再戦のトークンなど）を受け取れないようにする
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.ResumeToken)) != 1 {
+		return false, fmt.Errorf("invalid resume token for player %d", playerId)
+	}
+	if p.Status != player.StatusDISCONNECTED {
+		return false, nil
+	}
+
+	reconnectTimerLock.Lock()
+	if t, ok := reconnectTimers[playerId]; ok {
+		t.Stop()
+		delete(reconnectTimers, playerId)
+	}
+	reconnectTimerLock.Unlock()
+
+	// 開始済みのゲームでは次のカードに備えてPLAYINGに戻す
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		return false, err
+	}
+	status := player.StatusJOINING
+	if gameEnt.Status == g.StatusSTARTED || gameEnt.Status == g.StatusPAUSED {
+		status = player.StatusPLAYING
+	}
+	if _, err := p.Update().SetStatus(status).Save(ctx); err != nil {
+		return false, err
+	}
+
+	msg := map[string]interface{}{
+		"event":     "PLAYER_RECONNECTED",
+		"game_id":   gameId,
+		"player_id": p.ID,
+		"name":      p.Name,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	log.Printf("player %d of game %d reconnected", playerId, gameId)
+	return true, nil
+}
+
+// 再接続したプレイヤーにゲームの現在の状態をまとめて送る
+func sendSnapshot(client *ent.Client, gameId int, playerId int) {
+	if b := snapshotOf(client, gameId, playerId); b != nil {
+		sendToPlayer(gameId, playerId, b)
+	}
+}
+
+// ゲームの現在の状態をまとめたSNAPSHOTイベントを作る。playerIdが0なら観戦者向け
+func snapshotOf(client *ent.Client, gameId int, playerId int) []byte {
+	ctx := context.Background()
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("game %d not found: %v", gameId, err)
+		return nil
+	}
+	players, err := gameEnt.QueryPlayers().Order(player.ByID()).All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameId, err)
+		return nil
+	}
+	var pList []map[string]interface{}
+	for _, p := range players {
+		pList = append(pList, map[string]interface{}{
+			"player_id": p.ID,
+			"name":      p.Name,
+			"score":     p.Score,
+			"status":    p.Status,
+			"is_host":   p.IsHost,
+			"is_bot":    p.IsBot,
+			"team_id":   p.TeamID,
+			"handicap":  p.Handicap,
+			"muted":     p.Muted,
+		})
+	}
+
+	mode := gameModeOf(gameEnt)
+	msg := map[string]interface{}{
+		"event":           "SNAPSHOT",
+		"game_id":         gameId,
+		"status":          gameEnt.Status,
+		"mode":            gameEnt.Mode,
+		"total_rounds":    gameEnt.TotalRounds,
+		"players":         pList,
+		"sudden_death":    inSuddenDeath(gameId),
+		"spectator_count": spectatorCount(gameId),
+	}
+	if st, ok := gameStateOf(gameId); ok {
+		msg["round"] = st.Round
+		msg["cards"] = tableFor(gameId, playerId, st)
+		msg["cards_left"] = len(st.Deck)
+	}
+	if _, ok := mode.(gamemode.TeamScored); ok {
+		msg["team_score"] = gameEnt.TeamScore
+	}
+	if _, ok := mode.(gamemode.Teamed); ok {
+		msg["teams"] = teamList(teamsOf(ctx, client, gameId))
+	}
+	if _, ok := mode.(gamemode.Clocked); ok {
+		switch gameEnt.Status {
+		case g.StatusSTARTED:
+			msg["time_left_ms"] = addSharedTime(gameId, 0).Milliseconds()
+		case g.StatusPAUSED:
+			gameStateLock.Lock()
+			msg["time_left_ms"] = pausedClocks[gameId].Milliseconds()
+			gameStateLock.Unlock()
+		}
+	}
+	if left := lockoutLeft(gameId, playerId); left > 0 {
+		msg["lockout_ms"] = left.Milliseconds()
+	}
+	b, _ := json.Marshal(msg)
+	return b
+}
+
+// 切断中・観戦中以外の全員がREADYか確認する
+func allReady(ctx context.Context, client *ent.Client, gameId int) (bool, error) {
+	notReadyCount, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusNotIn(player.StatusREADY, player.StatusELIMINATED, player.StatusFINISHED, player.StatusDISCONNECTED),
+		).Count(ctx)
+	if err != nil {
+		log.Printf("failed to count not-ready players: %v", err)
+		return false, err
+	}
+	readyCount, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameId)),
+			player.StatusEQ(player.StatusREADY),
+		).Count(ctx)
+	if err != nil {
+		log.Printf("failed to count ready players: %v", err)
+		return false, err
+	}
+	return notReadyCount == 0 && readyCount > 0, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"example/ent/player"
+)
+
+func TestRemoveDisconnectedOnlyRemovesDisconnectedPlayers(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	guest := joinTestGame(t, s, created, "guest", "")
+	gameId := int(created.GameId)
+
+	// 再接続済みのプレイヤーは外さない
+	removeDisconnected(gameId, int(guest.Player.Id))
+	if _, err := testClient.Player.Get(ctx, int(guest.Player.Id)); err != nil {
+		t.Errorf("expected a connected player to stay, got %v", err)
+	}
+
+	if _, err := testClient.Player.UpdateOneID(int(guest.Player.Id)).SetStatus(player.StatusDISCONNECTED).Save(ctx); err != nil {
+		t.Fatalf("failed marking player disconnected: %v", err)
+	}
+	removeDisconnected(gameId, int(guest.Player.Id))
+	if _, err := testClient.Player.Get(ctx, int(guest.Player.Id)); err == nil {
+		t.Errorf("expected the disconnected player to be removed")
+	}
+	// 二重に呼ばれても残りのプレイヤーには影響しない
+	removeDisconnected(gameId, int(guest.Player.Id))
+	if _, err := testClient.Player.Get(ctx, int(host.Player.Id)); err != nil {
+		t.Errorf("expected the host to stay, got %v", err)
+	}
+}
+
+func TestResumePlayerChecksTokenOfConnectedPlayer(t *testing.T) {
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	gameId := int(created.GameId)
+
+	// 接続中のプレイヤーとしても、トークンなしでは接続できない
+	if _, err := resumePlayer(testClient, gameId, int(host.Player.Id), ""); err == nil {
+		t.Errorf("expected a socket without the resume token to be rejected")
+	}
+	resumed, err := resumePlayer(testClient, gameId, int(host.Player.Id), host.ResumeToken)
+	if err != nil {
+		t.Fatalf("expected the resume token to be accepted, got %v", err)
+	}
+	if resumed {
+		t.Errorf("expected a connected player not to be resumed")
+	}
+}
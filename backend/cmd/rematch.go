@@ -0,0 +1,329 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/match"
+	"example/ent/player"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+)
+
+// 最初の賛成票から再戦を締め切るまでの時間
+const rematchVoteWindow = 15 * time.Second
+
+// 再戦の賛成票（終了したgame_id -> player_id）
+var rematchVotes = make(map[int]map[int]bool)
+
+// 再戦の締め切りタイマー
+var rematchTimers = make(map[int]*time.Timer)
+
+func (s *GameServer) RequestRematch(
+	ctx context.Context,
+	req *connect.Request[gamev1.RequestRematchRequest],
+) (*connect.Response[gamev1.RequestRematchResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusFINISHED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("終了したゲームのみ再戦できます"))
+	}
+	if exists, err := gameEnt.QueryRematch().Exist(ctx); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("このゲームの再戦は既に作成されています"))
+	}
+	// 他のプレイヤーになりすまして投票し、そのプレイヤーを再戦に移せないようにする
+	p, err := authenticatePlayer(ctx, client, gameIdInt, req.Msg.PlayerId, req.Msg.ResumeToken)
+	if err != nil {
+		return nil, err
+	}
+	playerIdInt := p.ID
+
+	gameStateLock.Lock()
+	if rematchVotes[gameIdInt] == nil {
+		rematchVotes[gameIdInt] = make(map[int]bool)
+		// 締め切りまでに揃わなければ、賛成したプレイヤーだけで再戦する
+		rematchTimers[gameIdInt] = time.AfterFunc(rematchVoteWindow, func() { closeRematchVote(gameIdInt) })
+	}
+	rematchVotes[gameIdInt][playerIdInt] = true
+	consenting := rematchVotes[gameIdInt]
+	gameStateLock.Unlock()
+	votes := len(consenting)
+	voters := rematchVoters(gameIdInt)
+
+	res := &gamev1.RequestRematchResponse{
+		Votes:  int32(votes),
+		Voters: int32(voters),
+	}
+	if votes < voters {
+		msg := map[string]interface{}{
+			"event":       "REMATCH_VOTE",
+			"game_id":     gameIdInt,
+			"player_id":   playerIdInt,
+			"votes":       votes,
+			"voters":      voters,
+			"deadline_ms": rematchVoteWindow.Milliseconds(),
+		}
+		b, _ := json.Marshal(msg)
+		broadcastToGame(gameIdInt, b)
+		return connect.NewResponse(res), nil
+	}
+
+	// 接続中の全員が賛成したらすぐに再戦を作る
+	newGame, err := createRematch(ctx, client, gameEnt, consenting)
+	if err != nil {
+		return nil, err
+	}
+	res.Created = true
+	res.NewGameId = int32(newGame.ID)
+	return connect.NewResponse(res), nil
+}
+
+// 投票できるプレイヤー数（終了したゲームにまだ接続しているプレイヤー）
+func rematchVoters(gameId int) int {
+	connected := make(map[int]bool)
+	for _, info := range gameConns(gameId) {
+		if !info.Spectator {
+			connected[info.PlayerID] = true
+		}
+	}
+	return len(connected)
+}
+
+// 締め切り時点で賛成したプレイヤーがいれば再戦を作る
+func closeRematchVote(gameId int) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	gameStateLock.Lock()
+	consenting := rematchVotes[gameId]
+	gameStateLock.Unlock()
+	if len(consenting) == 0 {
+		return
+	}
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		log.Printf("game %d not found: %v", gameId, err)
+		return
+	}
+	if exists, _ := gameEnt.QueryRematch().Exist(ctx); exists {
+		return
+	}
+	if _, err := createRematch(ctx, client, gameEnt, consenting); err != nil {
+		log.Printf("failed to create rematch of game %d: %v", gameId, err)
+	}
+}
+
+// シリーズを通したプレイヤーの識別子。名前が同じでも別のプレイヤーとして数える
+func seriesIDOf(p *ent.Player) int {
+	if p.SeriesID != 0 {
+		return p.SeriesID
+	}
+	return p.ID
+}
+
+// 同じ設定・新しいシャッフルで再戦のゲームを作り、賛成したプレイヤーと接続を移す。元のゲームのmutexを保持して呼ぶ
+func createRematch(ctx context.Context, client *ent.Client, old *ent.Game, consenting map[int]bool) (*ent.Game, error) {
+	gameStateLock.Lock()
+	delete(rematchVotes, old.ID)
+	if t, ok := rematchTimers[old.ID]; ok {
+		t.Stop()
+		delete(rematchTimers, old.ID)
+	}
+	gameStateLock.Unlock()
+
+	deck := newShuffledDeck()
+	if old.CardCount > 0 && old.CardCount <= len(deck) {
+		deck = deck[:old.CardCount]
+	}
+	mode := gameModeOf(old)
+	oldTeams := teamsOf(ctx, client, old.ID)
+	opts := gamemode.Options{
+		EliminationInterval: old.EliminationInterval,
+		CenterCount:         old.CenterCount,
+		Scoring:             old.Scoring,
+		TeamCount:           len(oldTeams),
+	}
+	totalRounds, err := mode.Setup(&opts, len(deck))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	gameCreate := client.Game.Create().
+		SetName(old.Name).
+		SetTotalRounds(totalRounds).
+		SetMode(old.Mode).
+		SetTieBreak(old.TieBreak).
+		SetScoring(old.Scoring).
+		SetEliminationInterval(old.EliminationInterval).
+		SetCenterCount(old.CenterCount).
+		SetMaxPlayers(old.MaxPlayers).
+		SetMinPlayers(old.MinPlayers).
+		SetVisibility(old.Visibility).
+		SetAutoStart(old.AutoStart).
+		SetAutoBalance(old.AutoBalance).
+		SetCardCount(len(deck)).
+		SetInviteCode(old.InviteCode).
+		SetPasswordHash(old.PasswordHash).
+		SetPrevious(old)
+	// 決着していない対戦の途中なら、次のゲームも同じ対戦に含める
+	if old.MatchID != 0 {
+		if m, err := client.Match.Get(ctx, old.MatchID); err == nil && m.Status == match.StatusPLAYING {
+			gameCreate.SetMatchID(m.ID)
+		}
+	}
+	newGame, err := gameCreate.Save(ctx)
+	if err != nil {
+		log.Printf("failed creating rematch game: %v", err)
+		return nil, err
+	}
+	copyBans(ctx, client, old.ID, newGame.ID)
+	// チームは同じ名前・同じメンバーで作り直す
+	teamOf := make(map[int]int)
+	if len(oldTeams) > 0 {
+		var names []string
+		for _, t := range oldTeams {
+			names = append(names, t.Name)
+		}
+		newTeams, err := createTeams(ctx, client, newGame.ID, len(oldTeams), names)
+		if err != nil {
+			return nil, err
+		}
+		for i, t := range oldTeams {
+			teamOf[t.ID] = newTeams[i].ID
+		}
+	}
+	registerGameState(&gamemode.State{
+		GameID:  newGame.ID,
+		Options: opts,
+		Deck:    deck,
+	})
+
+	oldPlayers, err := old.QueryPlayers().Order(player.ByID()).All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", old.ID, err)
+		return nil, err
+	}
+	// 元のホストが賛成していればホストを引き継ぎ、いなければ参加順で最初のプレイヤーをホストにする
+	hostID := 0
+	for _, p := range oldPlayers {
+		if consenting[p.ID] && (hostID == 0 || p.IsHost) {
+			hostID = p.ID
+		}
+	}
+
+	moved := make(map[int]*ent.Player)
+	var pList []map[string]interface{}
+	for _, p := range oldPlayers {
+		if !consenting[p.ID] {
+			continue
+		}
+		playerCreate := client.Player.Create().
+			SetName(p.Name).
+			SetParentID(newGame.ID).
+			SetIsHost(p.ID == hostID).
+			SetSeriesWins(p.SeriesWins).
+			SetSeriesID(seriesIDOf(p)).
+			SetResumeToken(newResumeToken())
+		if t, ok := teamOf[p.TeamID]; ok {
+			playerCreate.SetTeamID(t)
+		}
+		if !p.Handicap.IsZero() {
+			playerCreate.SetHandicap(p.Handicap)
+		}
+		if p.UserID != 0 {
+			playerCreate.SetUserID(p.UserID)
+		}
+		if p.Muted {
+			playerCreate.SetMuted(true)
+		}
+		np, err := playerCreate.Save(ctx)
+		if err != nil {
+			log.Printf("failed to move player %d to game %d: %v", p.ID, newGame.ID, err)
+			continue
+		}
+		setHandicap(newGame.ID, np.ID, np.Handicap)
+		moved[p.ID] = np
+		pList = append(pList, map[string]interface{}{
+			"player_id":   np.ID,
+			"name":        np.Name,
+			"score":       np.Score,
+			"is_host":     np.IsHost,
+			"series_wins": np.SeriesWins,
+			"team_id":     np.TeamID,
+		})
+	}
+
+	// WebSocketの接続を新しいゲームに付け替え、各プレイヤーに新しいIDと再開トークンを知らせる
+	movedIDs := make(map[int]int, len(moved))
+	for oldId, np := range moved {
+		movedIDs[oldId] = np.ID
+	}
+	moveGameClients(old.ID, newGame.ID, movedIDs)
+	for _, np := range moved {
+		msg := map[string]interface{}{
+			"event":            "REMATCH",
+			"game_id":          newGame.ID,
+			"match_id":         newGame.MatchID,
+			"previous_game_id": old.ID,
+			"total_rounds":     newGame.TotalRounds,
+			"player_id":        np.ID,
+			"is_host":          np.IsHost,
+			"resume_token":     np.ResumeToken,
+			"players":          pList,
+		}
+		b, _ := json.Marshal(msg)
+		sendToPlayer(newGame.ID, np.ID, b)
+	}
+
+	// 再戦に加わらなかったプレイヤーにも知らせる
+	notice := map[string]interface{}{
+		"event":       "REMATCH_CREATED",
+		"game_id":     old.ID,
+		"new_game_id": newGame.ID,
+	}
+	nb, _ := json.Marshal(notice)
+	broadcastToGame(old.ID, nb)
+
+	lobbyMsg := map[string]interface{}{
+		"event": "CREATED",
+	}
+	lb, _ := json.Marshal(lobbyMsg)
+	broadcastToLobby(lb)
+
+	log.Printf("Rematch game %d of game %d created with %d players", newGame.ID, old.ID, len(moved))
+	maybeAutoStart(newGame.ID)
+	return newGame, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestRequestRematchRequiresResumeToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, nil)
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	joinTestGame(t, s, created, "guest", "")
+	gameId := int(created.GameId)
+	defer clearGameState(gameId)
+	if _, err := testClient.Game.UpdateOneID(gameId).SetStatus(g.StatusFINISHED).Save(ctx); err != nil {
+		t.Fatalf("failed finishing game: %v", err)
+	}
+
+	vote := func(token string) (*connect.Response[gamev1.RequestRematchResponse], error) {
+		return s.RequestRematch(ctx, connect.NewRequest(&gamev1.RequestRematchRequest{
+			GameId:      strconv.Itoa(gameId),
+			PlayerId:    strconv.Itoa(int(host.Player.Id)),
+			ResumeToken: token,
+		}))
+	}
+	// IDだけでは他のプレイヤーとして投票できない
+	if _, err := vote(""); errorCode(err) != connect.CodeUnauthenticated {
+		t.Errorf("expected a vote without the resume token to be rejected, got %v", err)
+	}
+	if _, err := vote(host.ResumeToken); err != nil {
+		t.Errorf("expected the player's own vote to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+
+	"example/ent"
+)
+
+// 観戦者が初回メッセージで示す招待コードや名前
+type spectatorAuth struct {
+	Name       string `json:"name"`
+	UserToken  string `json:"user_token"`
+	InviteCode string `json:"invite_code"`
+	Password   string `json:"password"`
+}
+
+// 観戦者にも参加者と同じ招待コード・パスワードと参加禁止の確認を行う（チャットも観戦者に届くため）
+func checkSpectator(ctx context.Context, client *ent.Client, gameId int, auth spectatorAuth) error {
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err != nil {
+		return connect.NewError(connect.CodeNotFound, fmt.Errorf("ゲーム %d は存在しません", gameId))
+	}
+	if err := checkInvite(ctx, client, gameEnt, auth.InviteCode, auth.Password); err != nil {
+		return err
+	}
+	var u *ent.User
+	if auth.UserToken != "" {
+		u, err = userByToken(ctx, client, auth.UserToken)
+		if err != nil {
+			return err
+		}
+	}
+	return checkBan(ctx, client, gameId, auth.Name, u)
+}
+
+// 観戦者の接続を処理する。観戦者はゲームのイベントをすべて受信するが、切断してもゲームには影響しない
+func spectatorWebsocketHandler(conn *websocket.Conn, gameId int, auth spectatorAuth) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	if err := checkSpectator(ctx, client, gameId, auth); err != nil {
+		log.Printf("spectator rejected from game %d: %v", gameId, err)
+		errMsg, _ := json.Marshal(map[string]interface{}{
+			"event":   "SPECTATE_FAILED",
+			"game_id": gameId,
+		})
+		sendMessage(conn, errMsg)
+		client.Close()
+		closeConn(conn)
+		return
+	}
+
+	addGameClient(conn, ClientInfo{
+		GameID:    gameId,
+		Spectator: true,
+	})
+	log.Printf("spectator connected to game %d", gameId)
+
+	// 途中から観戦しても分かるように現在の状態を送る
+	if b := snapshotOf(client, gameId, 0); b != nil {
+		sendMessage(conn, b)
+	}
+	client.Close()
+	broadcastSpectatorCount(gameId)
+
+	defer func() {
+		removeGameClient(conn)
+		closeConn(conn)
+		log.Printf("spectator disconnected from game %d", gameId)
+		broadcastSpectatorCount(gameId)
+	}()
+
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			log.Println("Spectator read error:", err)
+			break
+		}
+	}
+}
+
+// ゲームを観戦している接続数
+func spectatorCount(gameId int) int {
+	count := 0
+	for _, info := range gameConns(gameId) {
+		if info.Spectator {
+			count++
+		}
+	}
+	return count
+}
+
+// 観戦者数の変化をゲームとロビーに通知する
+func broadcastSpectatorCount(gameId int) {
+	msg := map[string]interface{}{
+		"event":           "SPECTATORS",
+		"game_id":         gameId,
+		"spectator_count": spectatorCount(gameId),
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameId, b)
+	broadcastToLobby(b)
+}
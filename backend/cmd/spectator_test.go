@@ -0,0 +1,33 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestSpectatePrivateGameRequiresInvite(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	created := createTestGame(t, s, &gamev1.GameSettings{Visibility: string(g.VisibilityPRIVATE)})
+	gameId := int(created.GameId)
+
+	if err := checkSpectator(ctx, testClient, gameId, spectatorAuth{}); errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected spectating without the code to be denied, got %v", err)
+	}
+	if err := checkSpectator(ctx, testClient, gameId, spectatorAuth{InviteCode: created.InviteCode}); err != nil {
+		t.Errorf("expected spectating with the code to be allowed, got %v", err)
+	}
+	// 参加禁止のプレイヤーは観戦もできない
+	if _, err := testClient.Ban.Create().SetName("troll").SetGameID(gameId).Save(ctx); err != nil {
+		t.Fatalf("failed creating ban: %v", err)
+	}
+	err := checkSpectator(ctx, testClient, gameId, spectatorAuth{Name: "troll", InviteCode: created.InviteCode})
+	if errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected a banned player to be denied, got %v", err)
+	}
+}
@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"entgo.io/ent/dialect/sql"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	"example/ent/team"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+)
+
+// チーム対抗モードのチームを作る。名前が足りなければ「チームN」とする
+func createTeams(ctx context.Context, client *ent.Client, gameId int, count int, names []string) ([]*ent.Team, error) {
+	var teams []*ent.Team
+	for i := 0; i < count; i++ {
+		name := fmt.Sprintf("チーム%d", i+1)
+		if i < len(names) && names[i] != "" {
+			name = names[i]
+		}
+		t, err := client.Team.Create().
+			SetName(name).
+			SetParentID(gameId).
+			Save(ctx)
+		if err != nil {
+			log.Printf("failed creating team %s: %v", name, err)
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	return teams, nil
+}
+
+// ゲームのチームを作成順に返す
+func teamsOf(ctx context.Context, client *ent.Client, gameId int) []*ent.Team {
+	teams, err := client.Team.Query().
+		Where(team.HasParentWith(g.IDEQ(gameId))).
+		Order(team.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query teams of game %d: %v", gameId, err)
+	}
+	return teams
+}
+
+// イベントに載せるチームと合計得点
+func teamList(teams []*ent.Team) []map[string]interface{} {
+	var list []map[string]interface{}
+	for _, t := range teams {
+		list = append(list, map[string]interface{}{
+			"team_id": t.ID,
+			"name":    t.Name,
+			"score":   t.Score,
+		})
+	}
+	return list
+}
+
+func teamsToProto(teams []*ent.Team) []*gamev1.Team {
+	var list []*gamev1.Team
+	for _, t := range teams {
+		list = append(list, &gamev1.Team{
+			Id:    int32(t.ID),
+			Name:  t.Name,
+			Score: int32(t.Score),
+		})
+	}
+	return list
+}
+
+// 参加するプレイヤーのチームを決める。指定がなく自動振り分けなら人数の少ないチーム、そうでなければ未所属（0）
+// ゲームのmutexを保持して呼ぶ
+func teamForJoin(ctx context.Context, client *ent.Client, gameEnt *ent.Game, requested int) (int, error) {
+	teams := teamsOf(ctx, client, gameEnt.ID)
+	if len(teams) == 0 {
+		return 0, nil
+	}
+	var teamIDs []int
+	for _, t := range teams {
+		teamIDs = append(teamIDs, t.ID)
+		if t.ID == requested {
+			return requested, nil
+		}
+	}
+	if requested != 0 {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("チーム %d はこのゲームにありません", requested))
+	}
+	if !gameEnt.AutoBalance {
+		return 0, nil
+	}
+	players, err := gameEnt.QueryPlayers().All(ctx)
+	if err != nil {
+		return 0, err
+	}
+	// 参加するプレイヤーは既存のどのプレイヤーよりも後に参加したものとして振り分ける
+	var mPlayers []*gamemode.Player
+	newID := 1
+	for _, p := range players {
+		mPlayers = append(mPlayers, &gamemode.Player{ID: p.ID, Team: p.TeamID})
+		if p.ID >= newID {
+			newID = p.ID + 1
+		}
+	}
+	mPlayers = append(mPlayers, &gamemode.Player{ID: newID})
+	return gamemode.AssignTeams(mPlayers, teamIDs)[newID], nil
+}
+
+// 開始時に未所属のプレイヤーをチームに入れる。自動振り分けなら人数も揃える。ゲームのmutexを保持して呼ぶ
+func arrangeTeams(ctx context.Context, client *ent.Client, gameEnt *ent.Game) {
+	teams := teamsOf(ctx, client, gameEnt.ID)
+	if len(teams) == 0 {
+		return
+	}
+	var teamIDs []int
+	for _, t := range teams {
+		teamIDs = append(teamIDs, t.ID)
+	}
+	players, err := client.Player.Query().
+		Where(
+			player.HasParentWith(g.IDEQ(gameEnt.ID)),
+			player.StatusNEQ(player.StatusDISCONNECTED),
+		).All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameEnt.ID, err)
+		return
+	}
+	var mPlayers []*gamemode.Player
+	for _, p := range players {
+		mPlayers = append(mPlayers, &gamemode.Player{ID: p.ID, Team: p.TeamID})
+	}
+	moves := gamemode.AssignTeams(mPlayers, teamIDs)
+	if gameEnt.AutoBalance {
+		moves = gamemode.BalanceTeams(mPlayers, teamIDs)
+	}
+	for playerID, teamID := range moves {
+		if _, err := client.Player.UpdateOneID(playerID).SetTeamID(teamID).Save(ctx); err != nil {
+			log.Printf("failed to move player %d to team %d: %v", playerID, teamID, err)
+		}
+	}
+	log.Printf("teams of game %d arranged: %v", gameEnt.ID, moves)
+}
+
+// 得点をプレイヤーのチームにも加算する
+func addTeamScore(ctx context.Context, client *ent.Client, playerEnt *ent.Player, points int) {
+	if playerEnt.TeamID == 0 || points == 0 {
+		return
+	}
+	if _, err := client.Team.UpdateOneID(playerEnt.TeamID).AddScore(points).Save(ctx); err != nil {
+		log.Printf("failed to add score to team %d: %v", playerEnt.TeamID, err)
+	}
+}
+
+// チーム対抗の結果を確定し、GAME_OVERに載せる情報を返す。合計得点が最も高いチームが勝ち（同点は引き分け）
+func finishTeamsGame(ctx context.Context, client *ent.Client, gameEnt *ent.Game) map[string]interface{} {
+	result := map[string]interface{}{
+		"mode": gameEnt.Mode,
+	}
+	teams, err := client.Team.Query().
+		Where(team.HasParentWith(g.IDEQ(gameEnt.ID))).
+		Order(team.ByScore(sql.OrderDesc()), team.ByID()).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query teams of game %d: %v", gameEnt.ID, err)
+		return result
+	}
+	result["teams"] = teamList(teams)
+	if len(teams) == 0 {
+		return result
+	}
+	if len(teams) > 1 && teams[1].Score == teams[0].Score {
+		result["draw"] = true
+		return result
+	}
+	result["winner_team_id"] = teams[0].ID
+	result["winner_team_name"] = teams[0].Name
+	return result
+}
+
+func (s *GameServer) ChangeTeam(
+	ctx context.Context,
+	req *connect.Request[gamev1.ChangeTeamRequest],
+) (*connect.Response[gamev1.ChangeTeamResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	gameIdInt, err := strconv.Atoi(req.Msg.GameId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	playerIdInt, err := strconv.Atoi(req.Msg.PlayerId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	mu := getGameMutex(gameIdInt)
+	mu.Lock()
+	defer mu.Unlock()
+
+	// 本人以外のチームを変えられるのはホストのみ
+	if req.Msg.UserId != req.Msg.PlayerId {
+		if err := requireHost(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+			return nil, err
+		}
+	} else if _, err := authenticatePlayer(ctx, client, gameIdInt, req.Msg.UserId, req.Msg.ResumeToken); err != nil {
+		return nil, err
+	}
+	gameEnt, err := client.Game.Get(ctx, gameIdInt)
+	if err != nil {
+		log.Printf("game not found: %v", err)
+		return nil, err
+	}
+	if gameEnt.Status != g.StatusCREATED {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("チームは開始前のみ変更できます"))
+	}
+	target, err := client.Player.Query().
+		Where(
+			player.IDEQ(playerIdInt),
+			player.HasParentWith(g.IDEQ(gameIdInt)),
+		).Only(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("プレイヤー %d はこのゲームにいません", playerIdInt))
+	}
+	exists, err := client.Team.Query().
+		Where(
+			team.IDEQ(int(req.Msg.TeamId)),
+			team.HasParentWith(g.IDEQ(gameIdInt)),
+		).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("チーム %d はこのゲームにありません", req.Msg.TeamId))
+	}
+
+	if _, err := target.Update().SetTeamID(int(req.Msg.TeamId)).Save(ctx); err != nil {
+		log.Printf("failed to change team of player %d: %v", playerIdInt, err)
+		return nil, err
+	}
+	msg := map[string]interface{}{
+		"event":     "TEAM_CHANGED",
+		"game_id":   gameIdInt,
+		"player_id": playerIdInt,
+		"team_id":   req.Msg.TeamId,
+	}
+	b, _ := json.Marshal(msg)
+	broadcastToGame(gameIdInt, b)
+	broadcastPlayers(ctx, client, gameIdInt)
+
+	log.Printf("player %d of game %d moved to team %d", playerIdInt, gameIdInt, req.Msg.TeamId)
+	return connect.NewResponse(&gamev1.ChangeTeamResponse{}), nil
+}
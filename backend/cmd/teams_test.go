@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	"example/ent/team"
+	gamev1 "example/gen/game/v1"
+)
+
+func TestChangeTeamAuthenticatesCaller(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	res, err := s.CreateGame(ctx, connect.NewRequest(&gamev1.CreateGameRequest{
+		GameName:  t.Name(),
+		CardCount: 5,
+		Mode:      "TEAMS",
+	}))
+	if err != nil {
+		t.Fatalf("failed creating game: %v", err)
+	}
+	created := res.Msg
+	host := joinTestGame(t, s, created, "host", created.HostToken)
+	guest := joinTestGame(t, s, created, "guest", "")
+	teams, err := testClient.Team.Query().
+		Where(team.HasParentWith(g.IDEQ(int(created.GameId)))).
+		All(ctx)
+	if err != nil || len(teams) < 2 {
+		t.Fatalf("failed querying teams: %v", err)
+	}
+	gameId := strconv.Itoa(int(created.GameId))
+	hostId := strconv.Itoa(int(host.Player.Id))
+	guestId := strconv.Itoa(int(guest.Player.Id))
+
+	tests := []struct {
+		name     string
+		userId   string
+		playerId string
+		token    string
+		want     connect.Code
+	}{
+		{"self without token", guestId, guestId, "", connect.CodeUnauthenticated},
+		{"self with another player's token", guestId, guestId, host.ResumeToken, connect.CodeUnauthenticated},
+		{"guest moves host", guestId, hostId, guest.ResumeToken, connect.CodePermissionDenied},
+		{"host id without token", hostId, guestId, "", connect.CodeUnauthenticated},
+		{"self with token", guestId, guestId, guest.ResumeToken, 0},
+		{"host moves guest", hostId, guestId, host.ResumeToken, 0},
+	}
+	for i, tt := range tests {
+		_, err := s.ChangeTeam(ctx, connect.NewRequest(&gamev1.ChangeTeamRequest{
+			GameId:      gameId,
+			UserId:      tt.userId,
+			PlayerId:    tt.playerId,
+			TeamId:      int32(teams[i%2].ID),
+			ResumeToken: tt.token,
+		}))
+		if got := errorCode(err); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,721 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/websocket"
+
+	"example/ent"
+	g "example/ent/game"
+	"example/ent/player"
+	t "example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	gamev1 "example/gen/game/v1"
+	"example/internal/gamemode"
+	"example/internal/scoring"
+	"example/internal/tournament"
+)
+
+// ブラケットの更新（試合結果の反映と次の試合の作成）を1つずつ行う。
+// ゲームのmutexを保持したまま取ることがあるため、これを保持したままゲームのmutexは取らない
+var tournamentLock sync.Mutex
+
+// 試合のゲームを作ってから両者が揃うまで待つ時間。過ぎたら不戦勝にする
+const tournamentForfeitTimeout = 5 * time.Minute
+
+// トーナメントのWebSocket接続（tournament_id -> 接続）。tournamentLockを保持して使う
+var tournamentClients = make(map[int]map[*websocket.Conn]bool)
+
+// トーナメントと参加者・試合をまとめたもの
+type tournamentView struct {
+	tournament *ent.Tournament
+	entrants   []*ent.TournamentEntrant // シード順
+	matches    []*ent.TournamentMatch   // 試合番号順（開始前は空）
+	bracket    tournament.Bracket
+	results    tournament.Results
+}
+
+func loadTournament(ctx context.Context, client *ent.Client, id int) (*tournamentView, error) {
+	tEnt, err := client.Tournament.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("トーナメント %d は存在しません", id))
+	}
+	if err != nil {
+		log.Printf("failed to get tournament %d: %v", id, err)
+		return nil, err
+	}
+	v := &tournamentView{tournament: tEnt, results: tournament.Results{}}
+	v.entrants, err = tEnt.QueryEntrants().Order(tournamententrant.BySeed()).All(ctx)
+	if err != nil {
+		log.Printf("failed to query entrants of tournament %d: %v", id, err)
+		return nil, err
+	}
+	v.matches, err = tEnt.QueryMatches().Order(tournamentmatch.ByNumber()).All(ctx)
+	if err != nil {
+		log.Printf("failed to query matches of tournament %d: %v", id, err)
+		return nil, err
+	}
+	if len(v.matches) == 0 {
+		return v, nil
+	}
+	// ブラケットの形は形式と人数から決まるため、保存しているのは試合結果のみ
+	v.bracket, err = tournament.Build(tournament.Format(tEnt.Format), len(v.entrants))
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range v.matches {
+		if m.WinnerSeed != nil {
+			v.results[m.Number] = *m.WinnerSeed
+		}
+	}
+	return v, nil
+}
+
+// シードの参加者（不在ならnil）
+func (v *tournamentView) entrant(seed int) *ent.TournamentEntrant {
+	if seed < 1 || seed > len(v.entrants) {
+		return nil
+	}
+	return v.entrants[seed-1]
+}
+
+// 試合の両者のシード
+func (v *tournamentView) seeds(m *ent.TournamentMatch) [2]int {
+	seeds, _ := v.bracket.Seeds(v.results, m.Number)
+	return seeds
+}
+
+func entrantToProto(e *ent.TournamentEntrant) *gamev1.TournamentEntrant {
+	if e == nil {
+		return nil
+	}
+	return &gamev1.TournamentEntrant{
+		Id:   int32(e.ID),
+		Name: e.Name,
+		Seed: int32(e.Seed),
+	}
+}
+
+func (v *tournamentView) toProto() *gamev1.Tournament {
+	res := &gamev1.Tournament{
+		Id:        int32(v.tournament.ID),
+		Name:      v.tournament.Name,
+		Format:    string(v.tournament.Format),
+		Status:    string(v.tournament.Status),
+		Mode:      v.tournament.Mode,
+		CardCount: int32(v.tournament.CardCount),
+	}
+	for _, e := range v.entrants {
+		res.Entrants = append(res.Entrants, entrantToProto(e))
+	}
+	for _, m := range v.matches {
+		seeds := v.seeds(m)
+		pm := &gamev1.TournamentMatch{
+			Number:   int32(m.Number),
+			Side:     string(m.Side),
+			Round:    int32(m.Round),
+			Status:   string(m.Status),
+			Entrant1: entrantToProto(v.entrant(seeds[0])),
+			Entrant2: entrantToProto(v.entrant(seeds[1])),
+			GameId:   int32(m.GameID),
+		}
+		if m.WinnerSeed != nil {
+			pm.WinnerSeed = int32(*m.WinnerSeed)
+		}
+		res.Matches = append(res.Matches, pm)
+	}
+	if v.tournament.ChampionSeed != nil {
+		res.Champion = entrantToProto(v.entrant(*v.tournament.ChampionSeed))
+	}
+	return res
+}
+
+// イベントに載せるブラケット
+func (v *tournamentView) bracketEvent() map[string]interface{} {
+	entrantOf := func(seed int) interface{} {
+		e := v.entrant(seed)
+		if e == nil {
+			return nil
+		}
+		return map[string]interface{}{
+			"entrant_id": e.ID,
+			"name":       e.Name,
+			"seed":       e.Seed,
+		}
+	}
+	var entrants []interface{}
+	for _, e := range v.entrants {
+		entrants = append(entrants, entrantOf(e.Seed))
+	}
+	var matches []map[string]interface{}
+	for _, m := range v.matches {
+		seeds := v.seeds(m)
+		match := map[string]interface{}{
+			"number":   m.Number,
+			"side":     m.Side,
+			"round":    m.Round,
+			"status":   m.Status,
+			"entrant1": entrantOf(seeds[0]),
+			"entrant2": entrantOf(seeds[1]),
+			"game_id":  m.GameID,
+		}
+		if m.WinnerSeed != nil {
+			match["winner_seed"] = *m.WinnerSeed
+		}
+		matches = append(matches, match)
+	}
+	msg := map[string]interface{}{
+		"event":         "BRACKET",
+		"tournament_id": v.tournament.ID,
+		"name":          v.tournament.Name,
+		"format":        v.tournament.Format,
+		"status":        v.tournament.Status,
+		"entrants":      entrants,
+		"matches":       matches,
+	}
+	if v.tournament.ChampionSeed != nil {
+		msg["champion"] = entrantOf(*v.tournament.ChampionSeed)
+	}
+	return msg
+}
+
+// トーナメントの接続にブラケットを送る。tournamentLockを保持して呼ぶ
+func broadcastBracket(v *tournamentView) {
+	b, _ := json.Marshal(v.bracketEvent())
+	for conn := range tournamentClients[v.tournament.ID] {
+		sendMessage(conn, b)
+	}
+}
+
+// 主催者のトークンを確認する
+func requireOrganizer(ctx context.Context, client *ent.Client, id int, token string) (*tournamentView, error) {
+	v, err := loadTournament(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(v.tournament.OrganizerToken)) != 1 {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("主催者のみ実行できます"))
+	}
+	return v, nil
+}
+
+// 試合のゲームモードを検証し、デッキの枚数とラウンド数を決める
+func tournamentGameSetup(modeName string, cardCount int) (gamemode.GameMode, gamemode.Options, []Card, int, error) {
+	mode, ok := gamemode.Lookup(modeName)
+	if !ok {
+		return nil, gamemode.Options{}, nil, 0, fmt.Errorf("不明なゲームモードです: %s", modeName)
+	}
+	// 1対1で勝者が決まらないモードは使えない
+	if !singleWinnerMode(mode) {
+		return nil, gamemode.Options{}, nil, 0, fmt.Errorf("%sモードはトーナメントで使えません", modeName)
+	}
+	deck := newShuffledDeck()
+	if cardCount > 0 && cardCount <= len(deck) {
+		deck = deck[:cardCount]
+	}
+	opts := gamemode.Options{Scoring: scoring.Default()}
+	totalRounds, err := mode.Setup(&opts, len(deck))
+	if err != nil {
+		return nil, gamemode.Options{}, nil, 0, err
+	}
+	return mode, opts, deck, totalRounds, nil
+}
+
+// 試合のゲームを作る。両者が参加すると自動で始まる
+func createTournamentGame(ctx context.Context, client *ent.Client, v *tournamentView, m *ent.TournamentMatch) (*ent.Game, error) {
+	mode, opts, deck, totalRounds, err := tournamentGameSetup(v.tournament.Mode, v.tournament.CardCount)
+	if err != nil {
+		return nil, err
+	}
+	seeds := v.seeds(m)
+	game, err := client.Game.Create().
+		SetName(fmt.Sprintf("%s 第%d試合 %s vs %s", v.tournament.Name, m.Number, v.entrant(seeds[0]).Name, v.entrant(seeds[1]).Name)).
+		SetTotalRounds(totalRounds).
+		SetMode(mode.Name()).
+		SetScoring(opts.Scoring).
+		SetMinPlayers(2).
+		SetMaxPlayers(2).
+		SetVisibility(g.VisibilityPRIVATE).
+		SetAutoStart(true).
+		SetCardCount(len(deck)).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed creating game of match %d: %v", m.Number, err)
+		return nil, err
+	}
+	registerGameState(&gamemode.State{
+		GameID:  game.ID,
+		Options: opts,
+		Deck:    deck,
+	})
+	return game, nil
+}
+
+// 不戦勝を反映し、両者が揃った試合のゲームを作り、決勝が終わっていれば優勝者を決める。
+// 更新後のブラケットを配信して返す。tournamentLockを保持して呼ぶ
+func advanceTournament(ctx context.Context, client *ent.Client, v *tournamentView) (*tournamentView, error) {
+	ready := v.bracket.Advance(v.results)
+	for _, m := range v.matches {
+		winner, decided := v.results[m.Number]
+		if !decided || m.WinnerSeed != nil {
+			continue
+		}
+		if _, err := m.Update().SetStatus(tournamentmatch.StatusFINISHED).SetWinnerSeed(winner).Save(ctx); err != nil {
+			log.Printf("failed to record bye of match %d: %v", m.Number, err)
+			return nil, err
+		}
+	}
+	for _, number := range ready {
+		m := v.matches[number-1]
+		if m.Status != tournamentmatch.StatusPENDING {
+			continue
+		}
+		game, err := createTournamentGame(ctx, client, v, m)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := m.Update().SetStatus(tournamentmatch.StatusPLAYING).SetGameID(game.ID).Save(ctx); err != nil {
+			log.Printf("failed to start match %d: %v", m.Number, err)
+			return nil, err
+		}
+		gameId := game.ID
+		time.AfterFunc(tournamentForfeitTimeout, func() { forfeitTournamentGame(gameId) })
+		log.Printf("match %d of tournament %d is played in game %d", m.Number, v.tournament.ID, game.ID)
+	}
+	if champion, ok := v.bracket.Champion(v.results); ok && v.tournament.Status != t.StatusFINISHED {
+		if _, err := v.tournament.Update().SetStatus(t.StatusFINISHED).SetChampionSeed(champion).Save(ctx); err != nil {
+			log.Printf("failed to finish tournament %d: %v", v.tournament.ID, err)
+			return nil, err
+		}
+		log.Printf("Tournament %d is FINISHED (champion seed %d)", v.tournament.ID, champion)
+	}
+
+	v, err := loadTournament(ctx, client, v.tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+	broadcastBracket(v)
+	return v, nil
+}
+
+// トーナメントの試合のゲームが終わったら勝者を進める。勝者が決まらなかった場合は上位シードを進める
+func tournamentGameFinished(client *ent.Client, gameId int) {
+	ctx := context.Background()
+	winner := 0
+	gameEnt, err := client.Game.Get(ctx, gameId)
+	if err == nil && gameEnt.WinnerID != nil {
+		if p, err := client.Player.Get(ctx, *gameEnt.WinnerID); err == nil {
+			winner = p.TournamentSeed
+		}
+	}
+	recordTournamentMatch(ctx, client, gameId, winner)
+}
+
+// 試合のゲームに両者が揃わないまま時間切れになったら、参加した方（どちらもいなければ上位シード）を不戦勝にする
+func forfeitTournamentGame(gameId int) {
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	mu := getGameMutex(gameId)
+	mu.Lock()
+	defer mu.Unlock()
+
+	n, err := client.Game.Update().
+		Where(g.IDEQ(gameId), g.StatusEQ(g.StatusCREATED)).
+		SetStatus(g.StatusFINISHED).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed to forfeit game %d: %v", gameId, err)
+		return
+	}
+	if n == 0 {
+		// 両者が揃って始まっている
+		return
+	}
+	clearGameState(gameId)
+
+	joined, err := client.Player.Query().
+		Where(player.HasParentWith(g.IDEQ(gameId))).
+		All(ctx)
+	if err != nil {
+		log.Printf("failed to query players of game %d: %v", gameId, err)
+		return
+	}
+	endMsg := map[string]interface{}{
+		"event":   "GAME_OVER",
+		"forfeit": true,
+	}
+	winner := 0
+	if len(joined) == 1 {
+		winner = joined[0].TournamentSeed
+		endMsg["winner_id"] = joined[0].ID
+		endMsg["winner_name"] = joined[0].Name
+		if _, err := client.Game.UpdateOneID(gameId).SetWinnerID(joined[0].ID).Save(ctx); err != nil {
+			log.Printf("failed to save winner of game %d: %v", gameId, err)
+		}
+	}
+	b, _ := json.Marshal(endMsg)
+	broadcastToGame(gameId, b)
+
+	log.Printf("Game %d is forfeited (%d of 2 joined)", gameId, len(joined))
+	recordTournamentMatch(ctx, client, gameId, winner)
+}
+
+// 試合の勝者を記録し、ブラケットを進める。winnerが試合の両者のシードでなければ上位シードを進める
+func recordTournamentMatch(ctx context.Context, client *ent.Client, gameId int, winner int) {
+	m, err := client.TournamentMatch.Query().
+		Where(tournamentmatch.GameIDEQ(gameId)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		log.Printf("failed to query match of game %d: %v", gameId, err)
+		return
+	}
+	tournamentId, err := m.QueryTournament().OnlyID(ctx)
+	if err != nil {
+		log.Printf("failed to query tournament of match %d: %v", m.ID, err)
+		return
+	}
+
+	tournamentLock.Lock()
+	defer tournamentLock.Unlock()
+
+	v, err := loadTournament(ctx, client, tournamentId)
+	if err != nil {
+		return
+	}
+	m = v.matches[m.Number-1]
+	if m.Status != tournamentmatch.StatusPLAYING {
+		return
+	}
+	seeds := v.seeds(m)
+	if winner == 0 || (winner != seeds[0] && winner != seeds[1]) {
+		winner = min(seeds[0], seeds[1])
+	}
+	if err := v.bracket.Record(v.results, m.Number, winner); err != nil {
+		log.Printf("failed to record match %d of tournament %d: %v", m.Number, tournamentId, err)
+		return
+	}
+	if _, err := m.Update().SetStatus(tournamentmatch.StatusFINISHED).SetWinnerSeed(winner).Save(ctx); err != nil {
+		log.Printf("failed to record match %d of tournament %d: %v", m.Number, tournamentId, err)
+		return
+	}
+	log.Printf("match %d of tournament %d won by seed %d", m.Number, tournamentId, winner)
+	advanceTournament(ctx, client, v)
+}
+
+// トーナメントの試合のゲームには、その試合の両者だけが参加者のトークンを示してそれぞれ1回参加できる。
+// 試合のゲームなら参加する参加者を返す（試合のゲームでなければnil）
+func checkTournamentJoin(ctx context.Context, client *ent.Client, gameId int, token string) (*ent.TournamentEntrant, error) {
+	m, err := client.TournamentMatch.Query().
+		Where(tournamentmatch.GameIDEQ(gameId)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	tournamentId, err := m.QueryTournament().OnlyID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, err := loadTournament(ctx, client, tournamentId)
+	if err != nil {
+		return nil, err
+	}
+	var entrant *ent.TournamentEntrant
+	for _, seed := range v.seeds(m) {
+		e := v.entrant(seed)
+		if e != nil && token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(e.EntrantToken)) == 1 {
+			entrant = e
+		}
+	}
+	if entrant == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("この試合の参加者のみ参加できます"))
+	}
+	joined, err := client.Player.Query().
+		Where(
+			player.TournamentSeedEQ(entrant.Seed),
+			player.HasParentWith(g.IDEQ(gameId)),
+		).Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if joined {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s は既に参加しています", entrant.Name))
+	}
+	return entrant, nil
+}
+
+// 参加者を登録する。名前はトーナメント内で重複できない
+func registerEntrant(ctx context.Context, client *ent.Client, v *tournamentView, name string) (*ent.TournamentEntrant, error) {
+	if name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+	}
+	for _, e := range v.entrants {
+		if e.Name == name {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("%s は既に登録されています", name))
+		}
+	}
+	if len(v.entrants) >= tournament.MaxEntrants {
+		return nil, connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("登録できるのは%d人までです", tournament.MaxEntrants))
+	}
+	e, err := client.TournamentEntrant.Create().
+		SetName(name).
+		SetSeed(len(v.entrants) + 1).
+		SetEntrantToken(newResumeToken()).
+		SetTournamentID(v.tournament.ID).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed registering %s: %v", name, err)
+		return nil, err
+	}
+	v.entrants = append(v.entrants, e)
+	return e, nil
+}
+
+func (s *GameServer) CreateTournament(
+	ctx context.Context,
+	req *connect.Request[gamev1.CreateTournamentRequest],
+) (*connect.Response[gamev1.CreateTournamentResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("トーナメント名を指定してください"))
+	}
+	format := t.FormatSINGLE_ELIMINATION
+	if req.Msg.Format != "" {
+		format = t.Format(req.Msg.Format)
+		if err := t.FormatValidator(format); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("不明なトーナメント形式です: %s", req.Msg.Format))
+		}
+	}
+	modeName := req.Msg.Mode
+	if modeName == "" {
+		modeName = gamemode.Normal{}.Name()
+	}
+	if _, _, _, _, err := tournamentGameSetup(modeName, int(req.Msg.CardCount)); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range req.Msg.PlayerNames {
+		if name == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("プレイヤー名を指定してください"))
+		}
+		if seen[name] {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("%s が重複しています", name))
+		}
+		seen[name] = true
+	}
+	if len(seen) > tournament.MaxEntrants {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("登録できるのは%d人までです", tournament.MaxEntrants))
+	}
+
+	tEnt, err := client.Tournament.Create().
+		SetName(req.Msg.Name).
+		SetFormat(format).
+		SetMode(modeName).
+		SetCardCount(int(req.Msg.CardCount)).
+		SetOrganizerToken(newResumeToken()).
+		Save(ctx)
+	if err != nil {
+		log.Printf("failed creating tournament: %v", err)
+		return nil, err
+	}
+	v := &tournamentView{tournament: tEnt}
+	for _, name := range req.Msg.PlayerNames {
+		if _, err := registerEntrant(ctx, client, v, name); err != nil {
+			return nil, err
+		}
+	}
+
+	var entrantTokens []string
+	for _, e := range v.entrants {
+		entrantTokens = append(entrantTokens, e.EntrantToken)
+	}
+
+	log.Printf("Tournament %s id of %d created (%s, %d entrants)", tEnt.Name, tEnt.ID, format, len(v.entrants))
+	return connect.NewResponse(&gamev1.CreateTournamentResponse{
+		Tournament:     v.toProto(),
+		OrganizerToken: tEnt.OrganizerToken,
+		EntrantTokens:  entrantTokens,
+	}), nil
+}
+
+func (s *GameServer) RegisterTournamentPlayer(
+	ctx context.Context,
+	req *connect.Request[gamev1.RegisterTournamentPlayerRequest],
+) (*connect.Response[gamev1.RegisterTournamentPlayerResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	tournamentLock.Lock()
+	defer tournamentLock.Unlock()
+
+	v, err := requireOrganizer(ctx, client, int(req.Msg.TournamentId), req.Msg.OrganizerToken)
+	if err != nil {
+		return nil, err
+	}
+	if v.tournament.Status != t.StatusREGISTERING {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("開始済みのトーナメントには登録できません"))
+	}
+	e, err := registerEntrant(ctx, client, v, req.Msg.PlayerName)
+	if err != nil {
+		return nil, err
+	}
+	broadcastBracket(v)
+
+	log.Printf("%s registered to tournament %d as seed %d", e.Name, v.tournament.ID, e.Seed)
+	return connect.NewResponse(&gamev1.RegisterTournamentPlayerResponse{
+		Entrant:      entrantToProto(e),
+		EntrantToken: e.EntrantToken,
+	}), nil
+}
+
+func (s *GameServer) StartTournament(
+	ctx context.Context,
+	req *connect.Request[gamev1.StartTournamentRequest],
+) (*connect.Response[gamev1.StartTournamentResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	tournamentLock.Lock()
+	defer tournamentLock.Unlock()
+
+	v, err := requireOrganizer(ctx, client, int(req.Msg.TournamentId), req.Msg.OrganizerToken)
+	if err != nil {
+		return nil, err
+	}
+	if v.tournament.Status != t.StatusREGISTERING {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("トーナメントは既に開始しています"))
+	}
+	bracket, err := tournament.Build(tournament.Format(v.tournament.Format), len(v.entrants))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+
+	// 登録順をシードとしてブラケットの全試合を作る
+	var creates []*ent.TournamentMatchCreate
+	for _, m := range bracket.Matches {
+		creates = append(creates, client.TournamentMatch.Create().
+			SetNumber(m.Number).
+			SetSide(tournamentmatch.Side(m.Side)).
+			SetRound(m.Round).
+			SetTournamentID(v.tournament.ID))
+	}
+	if _, err := client.TournamentMatch.CreateBulk(creates...).Save(ctx); err != nil {
+		log.Printf("failed creating matches of tournament %d: %v", v.tournament.ID, err)
+		return nil, err
+	}
+	if _, err := v.tournament.Update().SetStatus(t.StatusSTARTED).Save(ctx); err != nil {
+		log.Printf("failed to start tournament %d: %v", v.tournament.ID, err)
+		return nil, err
+	}
+	v, err = loadTournament(ctx, client, v.tournament.ID)
+	if err != nil {
+		return nil, err
+	}
+	v, err = advanceTournament(ctx, client, v)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Tournament %d started with %d entrants (%d matches)", v.tournament.ID, len(v.entrants), len(v.matches))
+	return connect.NewResponse(&gamev1.StartTournamentResponse{
+		Tournament: v.toProto(),
+	}), nil
+}
+
+func (s *GameServer) GetTournament(
+	ctx context.Context,
+	req *connect.Request[gamev1.GetTournamentRequest],
+) (*connect.Response[gamev1.GetTournamentResponse], error) {
+	endLog := funcCallLog(logFuncName())
+	defer endLog()
+
+	client := GetDbClient(ctx)
+	defer client.Close()
+
+	v, err := loadTournament(ctx, client, int(req.Msg.TournamentId))
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&gamev1.GetTournamentResponse{
+		Tournament: v.toProto(),
+	}), nil
+}
+
+/* tournament websocket */
+func tournamentWebsocketHandler(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println("Tournament WS upgrade error:", err)
+		return
+	}
+	openConn(conn)
+	defer closeConn(conn)
+
+	// 初回通信でtournament_idをJSONで受信し、現在のブラケットを返す
+	var initMsg struct {
+		TournamentID int `json:"tournament_id"`
+	}
+	if err := conn.ReadJSON(&initMsg); err != nil {
+		log.Println("Invalid tournament init message:", err)
+		return
+	}
+	ctx := context.Background()
+	client := GetDbClient(ctx)
+	tournamentLock.Lock()
+	v, err := loadTournament(ctx, client, initMsg.TournamentID)
+	if err == nil {
+		if tournamentClients[initMsg.TournamentID] == nil {
+			tournamentClients[initMsg.TournamentID] = make(map[*websocket.Conn]bool)
+		}
+		tournamentClients[initMsg.TournamentID][conn] = true
+		b, _ := json.Marshal(v.bracketEvent())
+		sendMessage(conn, b)
+	}
+	tournamentLock.Unlock()
+	client.Close()
+	if err != nil {
+		log.Printf("tournament %d not found: %v", initMsg.TournamentID, err)
+		return
+	}
+
+	defer func() {
+		tournamentLock.Lock()
+		delete(tournamentClients[initMsg.TournamentID], conn)
+		tournamentLock.Unlock()
+	}()
+
+	// 接続維持（クライアントからの切断を検知）
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			break
+		}
+	}
+}
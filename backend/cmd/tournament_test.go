@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	g "example/ent/game"
+	gamev1 "example/gen/game/v1"
+)
+
+// startTestTournament creates and starts a two-entrant tournament and returns it with the entrant tokens.
+func startTestTournament(t *testing.T, s *GameServer) (*gamev1.Tournament, []string) {
+	t.Helper()
+	ctx := context.Background()
+	created, err := s.CreateTournament(ctx, connect.NewRequest(&gamev1.CreateTournamentRequest{
+		Name:        t.Name(),
+		PlayerNames: []string{"alice", "bob"},
+		CardCount:   5,
+	}))
+	if err != nil {
+		t.Fatalf("failed creating tournament: %v", err)
+	}
+	if len(created.Msg.EntrantTokens) != 2 {
+		t.Fatalf("expected a token per entrant, got %d", len(created.Msg.EntrantTokens))
+	}
+	started, err := s.StartTournament(ctx, connect.NewRequest(&gamev1.StartTournamentRequest{
+		TournamentId:   created.Msg.Tournament.Id,
+		OrganizerToken: created.Msg.OrganizerToken,
+	}))
+	if err != nil {
+		t.Fatalf("failed starting tournament: %v", err)
+	}
+	return started.Msg.Tournament, created.Msg.EntrantTokens
+}
+
+func TestTournamentJoinRequiresEntrantToken(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	tour, tokens := startTestTournament(t, s)
+	gameId := tour.Matches[0].GameId
+
+	join := func(name, token string) (*connect.Response[gamev1.JoinGameResponse], error) {
+		return s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+			GameId:       strconv.Itoa(int(gameId)),
+			PlayerName:   name,
+			EntrantToken: token,
+		}))
+	}
+	if _, err := join("alice", ""); errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected joining by name only to be denied, got %v", err)
+	}
+	if _, err := join("alice", "wrong"); errorCode(err) != connect.CodePermissionDenied {
+		t.Errorf("expected a wrong token to be denied, got %v", err)
+	}
+	res, err := join("alice", tokens[1])
+	if err != nil {
+		t.Fatalf("expected bob's token to join, got %v", err)
+	}
+	if res.Msg.Player.Name != "bob" {
+		t.Errorf("expected to join as the entrant of the token, got %s", res.Msg.Player.Name)
+	}
+	if _, err := join("bob", tokens[1]); errorCode(err) != connect.CodeAlreadyExists {
+		t.Errorf("expected a second join with the same token to fail, got %v", err)
+	}
+}
+
+func TestTournamentForfeitAdvancesJoinedEntrant(t *testing.T) {
+	ctx := context.Background()
+	s := &GameServer{}
+	tour, tokens := startTestTournament(t, s)
+	gameId := tour.Matches[0].GameId
+
+	if _, err := s.JoinGame(ctx, connect.NewRequest(&gamev1.JoinGameRequest{
+		GameId:       strconv.Itoa(int(gameId)),
+		EntrantToken: tokens[1],
+	})); err != nil {
+		t.Fatalf("failed joining match game: %v", err)
+	}
+	forfeitTournamentGame(int(gameId))
+
+	gameEnt, err := testClient.Game.Get(ctx, int(gameId))
+	if err != nil {
+		t.Fatalf("failed getting game: %v", err)
+	}
+	if gameEnt.Status != g.StatusFINISHED {
+		t.Errorf("expected the forfeited game to be finished, got %s", gameEnt.Status)
+	}
+	res, err := s.GetTournament(ctx, connect.NewRequest(&gamev1.GetTournamentRequest{TournamentId: tour.Id}))
+	if err != nil {
+		t.Fatalf("failed getting tournament: %v", err)
+	}
+	if res.Msg.Tournament.Champion == nil || res.Msg.Tournament.Champion.Seed != 2 {
+		t.Errorf("expected the entrant who joined to win by forfeit, got %v", res.Msg.Tournament.Champion)
+	}
+}
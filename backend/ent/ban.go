@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"example/ent/ban"
+	"example/ent/game"
+	"example/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Ban is the model entity for the Ban schema.
+type Ban struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BanQuery when eager-loading is set.
+	Edges        BanEdges `json:"edges"`
+	ban_game     *int
+	selectValues sql.SelectValues
+}
+
+// BanEdges holds the relations/edges for other nodes in the graph.
+type BanEdges struct {
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BanEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BanEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Ban) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ban.FieldID, ban.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case ban.FieldName:
+			values[i] = new(sql.NullString)
+		case ban.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ban.ForeignKeys[0]: // ban_game
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Ban fields.
+func (b *Ban) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ban.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			b.ID = int(value.Int64)
+		case ban.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				b.Name = value.String
+			}
+		case ban.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				b.UserID = int(value.Int64)
+			}
+		case ban.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				b.CreatedAt = value.Time
+			}
+		case ban.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field ban_game", value)
+			} else if value.Valid {
+				b.ban_game = new(int)
+				*b.ban_game = int(value.Int64)
+			}
+		default:
+			b.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Ban.
+// This includes values selected through modifiers, order, etc.
+func (b *Ban) Value(name string) (ent.Value, error) {
+	return b.selectValues.Get(name)
+}
+
+// QueryGame queries the "game" edge of the Ban entity.
+func (b *Ban) QueryGame() *GameQuery {
+	return NewBanClient(b.config).QueryGame(b)
+}
+
+// QueryUser queries the "user" edge of the Ban entity.
+func (b *Ban) QueryUser() *UserQuery {
+	return NewBanClient(b.config).QueryUser(b)
+}
+
+// Update returns a builder for updating this Ban.
+// Note that you need to call Ban.Unwrap() before calling this method if this Ban
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Ban) Update() *BanUpdateOne {
+	return NewBanClient(b.config).UpdateOne(b)
+}
+
+// Unwrap unwraps the Ban entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (b *Ban) Unwrap() *Ban {
+	_tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Ban is not a transactional entity")
+	}
+	b.config.driver = _tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Ban) String() string {
+	var builder strings.Builder
+	builder.WriteString("Ban(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", b.ID))
+	builder.WriteString("name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", b.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Bans is a parsable slice of Ban.
+type Bans []*Ban
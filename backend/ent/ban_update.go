@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/ban"
+	"example/ent/game"
+	"example/ent/predicate"
+	"example/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BanUpdate is the builder for updating Ban entities.
+type BanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// Where appends a list predicates to the BanUpdate builder.
+func (bu *BanUpdate) Where(ps ...predicate.Ban) *BanUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetName sets the "name" field.
+func (bu *BanUpdate) SetName(s string) *BanUpdate {
+	bu.mutation.SetName(s)
+	return bu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableName(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetName(*s)
+	}
+	return bu
+}
+
+// SetUserID sets the "user_id" field.
+func (bu *BanUpdate) SetUserID(i int) *BanUpdate {
+	bu.mutation.SetUserID(i)
+	return bu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableUserID(i *int) *BanUpdate {
+	if i != nil {
+		bu.SetUserID(*i)
+	}
+	return bu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (bu *BanUpdate) ClearUserID() *BanUpdate {
+	bu.mutation.ClearUserID()
+	return bu
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (bu *BanUpdate) SetGameID(id int) *BanUpdate {
+	bu.mutation.SetGameID(id)
+	return bu
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (bu *BanUpdate) SetGame(g *Game) *BanUpdate {
+	return bu.SetGameID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (bu *BanUpdate) SetUser(u *User) *BanUpdate {
+	return bu.SetUserID(u.ID)
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (bu *BanUpdate) Mutation() *BanMutation {
+	return bu.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (bu *BanUpdate) ClearGame() *BanUpdate {
+	bu.mutation.ClearGame()
+	return bu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (bu *BanUpdate) ClearUser() *BanUpdate {
+	bu.mutation.ClearUser()
+	return bu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BanUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BanUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BanUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BanUpdate) check() error {
+	if v, ok := bu.mutation.Name(); ok {
+		if err := ban.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ban.name": %w`, err)}
+		}
+	}
+	if bu.mutation.GameCleared() && len(bu.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ban.game"`)
+	}
+	return nil
+}
+
+func (bu *BanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ban.Table, ban.Columns, sqlgraph.NewFieldSpec(ban.FieldID, field.TypeInt))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Name(); ok {
+		_spec.SetField(ban.FieldName, field.TypeString, value)
+	}
+	if bu.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.GameTable,
+			Columns: []string{ban.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.GameTable,
+			Columns: []string{ban.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if bu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.UserTable,
+			Columns: []string{ban.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.UserTable,
+			Columns: []string{ban.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BanUpdateOne is the builder for updating a single Ban entity.
+type BanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// SetName sets the "name" field.
+func (buo *BanUpdateOne) SetName(s string) *BanUpdateOne {
+	buo.mutation.SetName(s)
+	return buo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableName(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetName(*s)
+	}
+	return buo
+}
+
+// SetUserID sets the "user_id" field.
+func (buo *BanUpdateOne) SetUserID(i int) *BanUpdateOne {
+	buo.mutation.SetUserID(i)
+	return buo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableUserID(i *int) *BanUpdateOne {
+	if i != nil {
+		buo.SetUserID(*i)
+	}
+	return buo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (buo *BanUpdateOne) ClearUserID() *BanUpdateOne {
+	buo.mutation.ClearUserID()
+	return buo
+}
+
+// SetGameID sets the "game" edge to the Game entity by ID.
+func (buo *BanUpdateOne) SetGameID(id int) *BanUpdateOne {
+	buo.mutation.SetGameID(id)
+	return buo
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (buo *BanUpdateOne) SetGame(g *Game) *BanUpdateOne {
+	return buo.SetGameID(g.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (buo *BanUpdateOne) SetUser(u *User) *BanUpdateOne {
+	return buo.SetUserID(u.ID)
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (buo *BanUpdateOne) Mutation() *BanMutation {
+	return buo.mutation
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (buo *BanUpdateOne) ClearGame() *BanUpdateOne {
+	buo.mutation.ClearGame()
+	return buo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (buo *BanUpdateOne) ClearUser() *BanUpdateOne {
+	buo.mutation.ClearUser()
+	return buo
+}
+
+// Where appends a list predicates to the BanUpdate builder.
+func (buo *BanUpdateOne) Where(ps ...predicate.Ban) *BanUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BanUpdateOne) Select(field string, fields ...string) *BanUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Ban entity.
+func (buo *BanUpdateOne) Save(ctx context.Context) (*Ban, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BanUpdateOne) SaveX(ctx context.Context) *Ban {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BanUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BanUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BanUpdateOne) check() error {
+	if v, ok := buo.mutation.Name(); ok {
+		if err := ban.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Ban.name": %w`, err)}
+		}
+	}
+	if buo.mutation.GameCleared() && len(buo.mutation.GameIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Ban.game"`)
+	}
+	return nil
+}
+
+func (buo *BanUpdateOne) sqlSave(ctx context.Context) (_node *Ban, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ban.Table, ban.Columns, sqlgraph.NewFieldSpec(ban.FieldID, field.TypeInt))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ban.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ban.FieldID)
+		for _, f := range fields {
+			if !ban.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ban.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Name(); ok {
+		_spec.SetField(ban.FieldName, field.TypeString, value)
+	}
+	if buo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.GameTable,
+			Columns: []string{ban.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.GameTable,
+			Columns: []string{ban.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if buo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.UserTable,
+			Columns: []string{ban.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   ban.UserTable,
+			Columns: []string{ban.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Ban{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -11,10 +11,19 @@ import (
 
 	"example/ent/migrate"
 
+	"example/ent/ban"
 	"example/ent/card"
+	"example/ent/dailychallenge"
 	"example/ent/game"
 	"example/ent/item"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/practicerun"
+	"example/ent/team"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	"example/ent/user"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -27,14 +36,32 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// Card is the client for interacting with the Card builders.
 	Card *CardClient
+	// DailyChallenge is the client for interacting with the DailyChallenge builders.
+	DailyChallenge *DailyChallengeClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// Match is the client for interacting with the Match builders.
+	Match *MatchClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// PracticeRun is the client for interacting with the PracticeRun builders.
+	PracticeRun *PracticeRunClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
+	// Tournament is the client for interacting with the Tournament builders.
+	Tournament *TournamentClient
+	// TournamentEntrant is the client for interacting with the TournamentEntrant builders.
+	TournamentEntrant *TournamentEntrantClient
+	// TournamentMatch is the client for interacting with the TournamentMatch builders.
+	TournamentMatch *TournamentMatchClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -46,10 +73,19 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Ban = NewBanClient(c.config)
 	c.Card = NewCardClient(c.config)
+	c.DailyChallenge = NewDailyChallengeClient(c.config)
 	c.Game = NewGameClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.Match = NewMatchClient(c.config)
 	c.Player = NewPlayerClient(c.config)
+	c.PracticeRun = NewPracticeRunClient(c.config)
+	c.Team = NewTeamClient(c.config)
+	c.Tournament = NewTournamentClient(c.config)
+	c.TournamentEntrant = NewTournamentEntrantClient(c.config)
+	c.TournamentMatch = NewTournamentMatchClient(c.config)
+	c.User = NewUserClient(c.config)
 }
 
 type (
@@ -140,12 +176,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Card:   NewCardClient(cfg),
-		Game:   NewGameClient(cfg),
-		Item:   NewItemClient(cfg),
-		Player: NewPlayerClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Ban:               NewBanClient(cfg),
+		Card:              NewCardClient(cfg),
+		DailyChallenge:    NewDailyChallengeClient(cfg),
+		Game:              NewGameClient(cfg),
+		Item:              NewItemClient(cfg),
+		Match:             NewMatchClient(cfg),
+		Player:            NewPlayerClient(cfg),
+		PracticeRun:       NewPracticeRunClient(cfg),
+		Team:              NewTeamClient(cfg),
+		Tournament:        NewTournamentClient(cfg),
+		TournamentEntrant: NewTournamentEntrantClient(cfg),
+		TournamentMatch:   NewTournamentMatchClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
@@ -163,19 +208,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Card:   NewCardClient(cfg),
-		Game:   NewGameClient(cfg),
-		Item:   NewItemClient(cfg),
-		Player: NewPlayerClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Ban:               NewBanClient(cfg),
+		Card:              NewCardClient(cfg),
+		DailyChallenge:    NewDailyChallengeClient(cfg),
+		Game:              NewGameClient(cfg),
+		Item:              NewItemClient(cfg),
+		Match:             NewMatchClient(cfg),
+		Player:            NewPlayerClient(cfg),
+		PracticeRun:       NewPracticeRunClient(cfg),
+		Team:              NewTeamClient(cfg),
+		Tournament:        NewTournamentClient(cfg),
+		TournamentEntrant: NewTournamentEntrantClient(cfg),
+		TournamentMatch:   NewTournamentMatchClient(cfg),
+		User:              NewUserClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Card.
+//		Ban.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -197,37 +251,226 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Card.Use(hooks...)
-	c.Game.Use(hooks...)
-	c.Item.Use(hooks...)
-	c.Player.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Ban, c.Card, c.DailyChallenge, c.Game, c.Item, c.Match, c.Player,
+		c.PracticeRun, c.Team, c.Tournament, c.TournamentEntrant, c.TournamentMatch,
+		c.User,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Card.Intercept(interceptors...)
-	c.Game.Intercept(interceptors...)
-	c.Item.Intercept(interceptors...)
-	c.Player.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Ban, c.Card, c.DailyChallenge, c.Game, c.Item, c.Match, c.Player,
+		c.PracticeRun, c.Team, c.Tournament, c.TournamentEntrant, c.TournamentMatch,
+		c.User,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BanMutation:
+		return c.Ban.mutate(ctx, m)
 	case *CardMutation:
 		return c.Card.mutate(ctx, m)
+	case *DailyChallengeMutation:
+		return c.DailyChallenge.mutate(ctx, m)
 	case *GameMutation:
 		return c.Game.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
+	case *MatchMutation:
+		return c.Match.mutate(ctx, m)
 	case *PlayerMutation:
 		return c.Player.mutate(ctx, m)
+	case *PracticeRunMutation:
+		return c.PracticeRun.mutate(ctx, m)
+	case *TeamMutation:
+		return c.Team.mutate(ctx, m)
+	case *TournamentMutation:
+		return c.Tournament.mutate(ctx, m)
+	case *TournamentEntrantMutation:
+		return c.TournamentEntrant.mutate(ctx, m)
+	case *TournamentMatchMutation:
+		return c.TournamentMatch.mutate(ctx, m)
+	case *UserMutation:
+		return c.User.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// BanClient is a client for the Ban schema.
+type BanClient struct {
+	config
+}
+
+// NewBanClient returns a client for the Ban from the given config.
+func NewBanClient(c config) *BanClient {
+	return &BanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ban.Hooks(f(g(h())))`.
+func (c *BanClient) Use(hooks ...Hook) {
+	c.hooks.Ban = append(c.hooks.Ban, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ban.Intercept(f(g(h())))`.
+func (c *BanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ban = append(c.inters.Ban, interceptors...)
+}
+
+// Create returns a builder for creating a Ban entity.
+func (c *BanClient) Create() *BanCreate {
+	mutation := newBanMutation(c.config, OpCreate)
+	return &BanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ban entities.
+func (c *BanClient) CreateBulk(builders ...*BanCreate) *BanCreateBulk {
+	return &BanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BanClient) MapCreateBulk(slice any, setFunc func(*BanCreate, int)) *BanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BanCreateBulk{err: fmt.Errorf("calling to BanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ban.
+func (c *BanClient) Update() *BanUpdate {
+	mutation := newBanMutation(c.config, OpUpdate)
+	return &BanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BanClient) UpdateOne(b *Ban) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBan(b))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BanClient) UpdateOneID(id int) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBanID(id))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ban.
+func (c *BanClient) Delete() *BanDelete {
+	mutation := newBanMutation(c.config, OpDelete)
+	return &BanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BanClient) DeleteOne(b *Ban) *BanDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BanClient) DeleteOneID(id int) *BanDeleteOne {
+	builder := c.Delete().Where(ban.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BanDeleteOne{builder}
+}
+
+// Query returns a query builder for Ban.
+func (c *BanClient) Query() *BanQuery {
+	return &BanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ban entity by its id.
+func (c *BanClient) Get(ctx context.Context, id int) (*Ban, error) {
+	return c.Query().Where(ban.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BanClient) GetX(ctx context.Context, id int) *Ban {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGame queries the game edge of a Ban.
+func (c *BanClient) QueryGame(b *Ban) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ban.Table, ban.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ban.GameTable, ban.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Ban.
+func (c *BanClient) QueryUser(b *Ban) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ban.Table, ban.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, ban.UserTable, ban.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BanClient) Hooks() []Hook {
+	return c.hooks.Ban
+}
+
+// Interceptors returns the client interceptors.
+func (c *BanClient) Interceptors() []Interceptor {
+	return c.inters.Ban
+}
+
+func (c *BanClient) mutate(ctx context.Context, m *BanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ban mutation op: %q", m.Op())
+	}
+}
+
 // CardClient is a client for the Card schema.
 type CardClient struct {
 	config
@@ -377,6 +620,155 @@ func (c *CardClient) mutate(ctx context.Context, m *CardMutation) (Value, error)
 	}
 }
 
+// DailyChallengeClient is a client for the DailyChallenge schema.
+type DailyChallengeClient struct {
+	config
+}
+
+// NewDailyChallengeClient returns a client for the DailyChallenge from the given config.
+func NewDailyChallengeClient(c config) *DailyChallengeClient {
+	return &DailyChallengeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dailychallenge.Hooks(f(g(h())))`.
+func (c *DailyChallengeClient) Use(hooks ...Hook) {
+	c.hooks.DailyChallenge = append(c.hooks.DailyChallenge, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dailychallenge.Intercept(f(g(h())))`.
+func (c *DailyChallengeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DailyChallenge = append(c.inters.DailyChallenge, interceptors...)
+}
+
+// Create returns a builder for creating a DailyChallenge entity.
+func (c *DailyChallengeClient) Create() *DailyChallengeCreate {
+	mutation := newDailyChallengeMutation(c.config, OpCreate)
+	return &DailyChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DailyChallenge entities.
+func (c *DailyChallengeClient) CreateBulk(builders ...*DailyChallengeCreate) *DailyChallengeCreateBulk {
+	return &DailyChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DailyChallengeClient) MapCreateBulk(slice any, setFunc func(*DailyChallengeCreate, int)) *DailyChallengeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DailyChallengeCreateBulk{err: fmt.Errorf("calling to DailyChallengeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DailyChallengeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DailyChallengeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DailyChallenge.
+func (c *DailyChallengeClient) Update() *DailyChallengeUpdate {
+	mutation := newDailyChallengeMutation(c.config, OpUpdate)
+	return &DailyChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DailyChallengeClient) UpdateOne(dc *DailyChallenge) *DailyChallengeUpdateOne {
+	mutation := newDailyChallengeMutation(c.config, OpUpdateOne, withDailyChallenge(dc))
+	return &DailyChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DailyChallengeClient) UpdateOneID(id int) *DailyChallengeUpdateOne {
+	mutation := newDailyChallengeMutation(c.config, OpUpdateOne, withDailyChallengeID(id))
+	return &DailyChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DailyChallenge.
+func (c *DailyChallengeClient) Delete() *DailyChallengeDelete {
+	mutation := newDailyChallengeMutation(c.config, OpDelete)
+	return &DailyChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DailyChallengeClient) DeleteOne(dc *DailyChallenge) *DailyChallengeDeleteOne {
+	return c.DeleteOneID(dc.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DailyChallengeClient) DeleteOneID(id int) *DailyChallengeDeleteOne {
+	builder := c.Delete().Where(dailychallenge.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DailyChallengeDeleteOne{builder}
+}
+
+// Query returns a query builder for DailyChallenge.
+func (c *DailyChallengeClient) Query() *DailyChallengeQuery {
+	return &DailyChallengeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDailyChallenge},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DailyChallenge entity by its id.
+func (c *DailyChallengeClient) Get(ctx context.Context, id int) (*DailyChallenge, error) {
+	return c.Query().Where(dailychallenge.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DailyChallengeClient) GetX(ctx context.Context, id int) *DailyChallenge {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DailyChallenge.
+func (c *DailyChallengeClient) QueryUser(dc *DailyChallenge) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dc.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dailychallenge.Table, dailychallenge.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, dailychallenge.UserTable, dailychallenge.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(dc.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DailyChallengeClient) Hooks() []Hook {
+	return c.hooks.DailyChallenge
+}
+
+// Interceptors returns the client interceptors.
+func (c *DailyChallengeClient) Interceptors() []Interceptor {
+	return c.inters.DailyChallenge
+}
+
+func (c *DailyChallengeClient) mutate(ctx context.Context, m *DailyChallengeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DailyChallengeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DailyChallengeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DailyChallengeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DailyChallengeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DailyChallenge mutation op: %q", m.Op())
+	}
+}
+
 // GameClient is a client for the Game schema.
 type GameClient struct {
 	config
@@ -501,6 +893,86 @@ func (c *GameClient) QueryPlayers(ga *Game) *PlayerQuery {
 	return query
 }
 
+// QueryTeams queries the teams edge of a Game.
+func (c *GameClient) QueryTeams(ga *Game) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, game.TeamsTable, game.TeamsColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTournamentMatches queries the tournament_matches edge of a Game.
+func (c *GameClient) QueryTournamentMatches(ga *Game) *TournamentMatchQuery {
+	query := (&TournamentMatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(tournamentmatch.Table, tournamentmatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, game.TournamentMatchesTable, game.TournamentMatchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMatch queries the match edge of a Game.
+func (c *GameClient) QueryMatch(ga *Game) *MatchQuery {
+	query := (&MatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, game.MatchTable, game.MatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPrevious queries the previous edge of a Game.
+func (c *GameClient) QueryPrevious(ga *Game) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, game.PreviousTable, game.PreviousColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRematch queries the rematch edge of a Game.
+func (c *GameClient) QueryRematch(ga *Game) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ga.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, game.RematchTable, game.RematchColumn),
+		)
+		fromV = sqlgraph.Neighbors(ga.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *GameClient) Hooks() []Hook {
 	return c.hooks.Game
@@ -675,47 +1147,196 @@ func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error)
 	}
 }
 
-// PlayerClient is a client for the Player schema.
-type PlayerClient struct {
+// MatchClient is a client for the Match schema.
+type MatchClient struct {
 	config
 }
 
-// NewPlayerClient returns a client for the Player from the given config.
-func NewPlayerClient(c config) *PlayerClient {
-	return &PlayerClient{config: c}
+// NewMatchClient returns a client for the Match from the given config.
+func NewMatchClient(c config) *MatchClient {
+	return &MatchClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
-func (c *PlayerClient) Use(hooks ...Hook) {
-	c.hooks.Player = append(c.hooks.Player, hooks...)
+// A call to `Use(f, g, h)` equals to `match.Hooks(f(g(h())))`.
+func (c *MatchClient) Use(hooks ...Hook) {
+	c.hooks.Match = append(c.hooks.Match, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `player.Intercept(f(g(h())))`.
-func (c *PlayerClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Player = append(c.inters.Player, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `match.Intercept(f(g(h())))`.
+func (c *MatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Match = append(c.inters.Match, interceptors...)
 }
 
-// Create returns a builder for creating a Player entity.
-func (c *PlayerClient) Create() *PlayerCreate {
-	mutation := newPlayerMutation(c.config, OpCreate)
-	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Match entity.
+func (c *MatchClient) Create() *MatchCreate {
+	mutation := newMatchMutation(c.config, OpCreate)
+	return &MatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Player entities.
-func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
-	return &PlayerCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Match entities.
+func (c *MatchClient) CreateBulk(builders ...*MatchCreate) *MatchCreateBulk {
+	return &MatchCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlayerClient) MapCreateBulk(slice any, setFunc func(*PlayerCreate, int)) *PlayerCreateBulk {
+func (c *MatchClient) MapCreateBulk(slice any, setFunc func(*MatchCreate, int)) *MatchCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlayerCreateBulk{err: fmt.Errorf("calling to PlayerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MatchCreateBulk{err: fmt.Errorf("calling to MatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlayerCreate, rv.Len())
+	builders := make([]*MatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Match.
+func (c *MatchClient) Update() *MatchUpdate {
+	mutation := newMatchMutation(c.config, OpUpdate)
+	return &MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MatchClient) UpdateOne(m *Match) *MatchUpdateOne {
+	mutation := newMatchMutation(c.config, OpUpdateOne, withMatch(m))
+	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MatchClient) UpdateOneID(id int) *MatchUpdateOne {
+	mutation := newMatchMutation(c.config, OpUpdateOne, withMatchID(id))
+	return &MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Match.
+func (c *MatchClient) Delete() *MatchDelete {
+	mutation := newMatchMutation(c.config, OpDelete)
+	return &MatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MatchClient) DeleteOne(m *Match) *MatchDeleteOne {
+	return c.DeleteOneID(m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MatchClient) DeleteOneID(id int) *MatchDeleteOne {
+	builder := c.Delete().Where(match.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MatchDeleteOne{builder}
+}
+
+// Query returns a query builder for Match.
+func (c *MatchClient) Query() *MatchQuery {
+	return &MatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Match entity by its id.
+func (c *MatchClient) Get(ctx context.Context, id int) (*Match, error) {
+	return c.Query().Where(match.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MatchClient) GetX(ctx context.Context, id int) *Match {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryGames queries the games edge of a Match.
+func (c *MatchClient) QueryGames(m *Match) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(match.Table, match.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, match.GamesTable, match.GamesColumn),
+		)
+		fromV = sqlgraph.Neighbors(m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MatchClient) Hooks() []Hook {
+	return c.hooks.Match
+}
+
+// Interceptors returns the client interceptors.
+func (c *MatchClient) Interceptors() []Interceptor {
+	return c.inters.Match
+}
+
+func (c *MatchClient) mutate(ctx context.Context, m *MatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Match mutation op: %q", m.Op())
+	}
+}
+
+// PlayerClient is a client for the Player schema.
+type PlayerClient struct {
+	config
+}
+
+// NewPlayerClient returns a client for the Player from the given config.
+func NewPlayerClient(c config) *PlayerClient {
+	return &PlayerClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `player.Hooks(f(g(h())))`.
+func (c *PlayerClient) Use(hooks ...Hook) {
+	c.hooks.Player = append(c.hooks.Player, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `player.Intercept(f(g(h())))`.
+func (c *PlayerClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Player = append(c.inters.Player, interceptors...)
+}
+
+// Create returns a builder for creating a Player entity.
+func (c *PlayerClient) Create() *PlayerCreate {
+	mutation := newPlayerMutation(c.config, OpCreate)
+	return &PlayerCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Player entities.
+func (c *PlayerClient) CreateBulk(builders ...*PlayerCreate) *PlayerCreateBulk {
+	return &PlayerCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlayerClient) MapCreateBulk(slice any, setFunc func(*PlayerCreate, int)) *PlayerCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlayerCreateBulk{err: fmt.Errorf("calling to PlayerClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PlayerCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
@@ -799,6 +1420,38 @@ func (c *PlayerClient) QueryParent(pl *Player) *GameQuery {
 	return query
 }
 
+// QueryTeam queries the team edge of a Player.
+func (c *PlayerClient) QueryTeam(pl *Player) *TeamQuery {
+	query := (&TeamClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, player.TeamTable, player.TeamColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a Player.
+func (c *PlayerClient) QueryUser(pl *Player) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pl.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(player.Table, player.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, player.UserTable, player.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(pl.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *PlayerClient) Hooks() []Hook {
 	return c.hooks.Player
@@ -824,12 +1477,940 @@ func (c *PlayerClient) mutate(ctx context.Context, m *PlayerMutation) (Value, er
 	}
 }
 
+// PracticeRunClient is a client for the PracticeRun schema.
+type PracticeRunClient struct {
+	config
+}
+
+// NewPracticeRunClient returns a client for the PracticeRun from the given config.
+func NewPracticeRunClient(c config) *PracticeRunClient {
+	return &PracticeRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `practicerun.Hooks(f(g(h())))`.
+func (c *PracticeRunClient) Use(hooks ...Hook) {
+	c.hooks.PracticeRun = append(c.hooks.PracticeRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `practicerun.Intercept(f(g(h())))`.
+func (c *PracticeRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PracticeRun = append(c.inters.PracticeRun, interceptors...)
+}
+
+// Create returns a builder for creating a PracticeRun entity.
+func (c *PracticeRunClient) Create() *PracticeRunCreate {
+	mutation := newPracticeRunMutation(c.config, OpCreate)
+	return &PracticeRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PracticeRun entities.
+func (c *PracticeRunClient) CreateBulk(builders ...*PracticeRunCreate) *PracticeRunCreateBulk {
+	return &PracticeRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PracticeRunClient) MapCreateBulk(slice any, setFunc func(*PracticeRunCreate, int)) *PracticeRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PracticeRunCreateBulk{err: fmt.Errorf("calling to PracticeRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PracticeRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PracticeRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PracticeRun.
+func (c *PracticeRunClient) Update() *PracticeRunUpdate {
+	mutation := newPracticeRunMutation(c.config, OpUpdate)
+	return &PracticeRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PracticeRunClient) UpdateOne(pr *PracticeRun) *PracticeRunUpdateOne {
+	mutation := newPracticeRunMutation(c.config, OpUpdateOne, withPracticeRun(pr))
+	return &PracticeRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PracticeRunClient) UpdateOneID(id int) *PracticeRunUpdateOne {
+	mutation := newPracticeRunMutation(c.config, OpUpdateOne, withPracticeRunID(id))
+	return &PracticeRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PracticeRun.
+func (c *PracticeRunClient) Delete() *PracticeRunDelete {
+	mutation := newPracticeRunMutation(c.config, OpDelete)
+	return &PracticeRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PracticeRunClient) DeleteOne(pr *PracticeRun) *PracticeRunDeleteOne {
+	return c.DeleteOneID(pr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PracticeRunClient) DeleteOneID(id int) *PracticeRunDeleteOne {
+	builder := c.Delete().Where(practicerun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PracticeRunDeleteOne{builder}
+}
+
+// Query returns a query builder for PracticeRun.
+func (c *PracticeRunClient) Query() *PracticeRunQuery {
+	return &PracticeRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePracticeRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PracticeRun entity by its id.
+func (c *PracticeRunClient) Get(ctx context.Context, id int) (*PracticeRun, error) {
+	return c.Query().Where(practicerun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PracticeRunClient) GetX(ctx context.Context, id int) *PracticeRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PracticeRunClient) Hooks() []Hook {
+	return c.hooks.PracticeRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *PracticeRunClient) Interceptors() []Interceptor {
+	return c.inters.PracticeRun
+}
+
+func (c *PracticeRunClient) mutate(ctx context.Context, m *PracticeRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PracticeRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PracticeRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PracticeRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PracticeRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PracticeRun mutation op: %q", m.Op())
+	}
+}
+
+// TeamClient is a client for the Team schema.
+type TeamClient struct {
+	config
+}
+
+// NewTeamClient returns a client for the Team from the given config.
+func NewTeamClient(c config) *TeamClient {
+	return &TeamClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `team.Hooks(f(g(h())))`.
+func (c *TeamClient) Use(hooks ...Hook) {
+	c.hooks.Team = append(c.hooks.Team, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `team.Intercept(f(g(h())))`.
+func (c *TeamClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Team = append(c.inters.Team, interceptors...)
+}
+
+// Create returns a builder for creating a Team entity.
+func (c *TeamClient) Create() *TeamCreate {
+	mutation := newTeamMutation(c.config, OpCreate)
+	return &TeamCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Team entities.
+func (c *TeamClient) CreateBulk(builders ...*TeamCreate) *TeamCreateBulk {
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TeamClient) MapCreateBulk(slice any, setFunc func(*TeamCreate, int)) *TeamCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TeamCreateBulk{err: fmt.Errorf("calling to TeamClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TeamCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TeamCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Team.
+func (c *TeamClient) Update() *TeamUpdate {
+	mutation := newTeamMutation(c.config, OpUpdate)
+	return &TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TeamClient) UpdateOne(t *Team) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeam(t))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TeamClient) UpdateOneID(id int) *TeamUpdateOne {
+	mutation := newTeamMutation(c.config, OpUpdateOne, withTeamID(id))
+	return &TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Team.
+func (c *TeamClient) Delete() *TeamDelete {
+	mutation := newTeamMutation(c.config, OpDelete)
+	return &TeamDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TeamClient) DeleteOne(t *Team) *TeamDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TeamClient) DeleteOneID(id int) *TeamDeleteOne {
+	builder := c.Delete().Where(team.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TeamDeleteOne{builder}
+}
+
+// Query returns a query builder for Team.
+func (c *TeamClient) Query() *TeamQuery {
+	return &TeamQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTeam},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Team entity by its id.
+func (c *TeamClient) Get(ctx context.Context, id int) (*Team, error) {
+	return c.Query().Where(team.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TeamClient) GetX(ctx context.Context, id int) *Team {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Team.
+func (c *TeamClient) QueryParent(t *Team) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, team.ParentTable, team.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Team.
+func (c *TeamClient) QueryMembers(t *Team) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(team.Table, team.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, team.MembersTable, team.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TeamClient) Hooks() []Hook {
+	return c.hooks.Team
+}
+
+// Interceptors returns the client interceptors.
+func (c *TeamClient) Interceptors() []Interceptor {
+	return c.inters.Team
+}
+
+func (c *TeamClient) mutate(ctx context.Context, m *TeamMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TeamCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TeamUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TeamUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TeamDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Team mutation op: %q", m.Op())
+	}
+}
+
+// TournamentClient is a client for the Tournament schema.
+type TournamentClient struct {
+	config
+}
+
+// NewTournamentClient returns a client for the Tournament from the given config.
+func NewTournamentClient(c config) *TournamentClient {
+	return &TournamentClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tournament.Hooks(f(g(h())))`.
+func (c *TournamentClient) Use(hooks ...Hook) {
+	c.hooks.Tournament = append(c.hooks.Tournament, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tournament.Intercept(f(g(h())))`.
+func (c *TournamentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tournament = append(c.inters.Tournament, interceptors...)
+}
+
+// Create returns a builder for creating a Tournament entity.
+func (c *TournamentClient) Create() *TournamentCreate {
+	mutation := newTournamentMutation(c.config, OpCreate)
+	return &TournamentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tournament entities.
+func (c *TournamentClient) CreateBulk(builders ...*TournamentCreate) *TournamentCreateBulk {
+	return &TournamentCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TournamentClient) MapCreateBulk(slice any, setFunc func(*TournamentCreate, int)) *TournamentCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TournamentCreateBulk{err: fmt.Errorf("calling to TournamentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TournamentCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TournamentCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tournament.
+func (c *TournamentClient) Update() *TournamentUpdate {
+	mutation := newTournamentMutation(c.config, OpUpdate)
+	return &TournamentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TournamentClient) UpdateOne(t *Tournament) *TournamentUpdateOne {
+	mutation := newTournamentMutation(c.config, OpUpdateOne, withTournament(t))
+	return &TournamentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TournamentClient) UpdateOneID(id int) *TournamentUpdateOne {
+	mutation := newTournamentMutation(c.config, OpUpdateOne, withTournamentID(id))
+	return &TournamentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tournament.
+func (c *TournamentClient) Delete() *TournamentDelete {
+	mutation := newTournamentMutation(c.config, OpDelete)
+	return &TournamentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TournamentClient) DeleteOne(t *Tournament) *TournamentDeleteOne {
+	return c.DeleteOneID(t.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TournamentClient) DeleteOneID(id int) *TournamentDeleteOne {
+	builder := c.Delete().Where(tournament.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TournamentDeleteOne{builder}
+}
+
+// Query returns a query builder for Tournament.
+func (c *TournamentClient) Query() *TournamentQuery {
+	return &TournamentQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTournament},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tournament entity by its id.
+func (c *TournamentClient) Get(ctx context.Context, id int) (*Tournament, error) {
+	return c.Query().Where(tournament.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TournamentClient) GetX(ctx context.Context, id int) *Tournament {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEntrants queries the entrants edge of a Tournament.
+func (c *TournamentClient) QueryEntrants(t *Tournament) *TournamentEntrantQuery {
+	query := (&TournamentEntrantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournament.Table, tournament.FieldID, id),
+			sqlgraph.To(tournamententrant.Table, tournamententrant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, tournament.EntrantsTable, tournament.EntrantsColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMatches queries the matches edge of a Tournament.
+func (c *TournamentClient) QueryMatches(t *Tournament) *TournamentMatchQuery {
+	query := (&TournamentMatchClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := t.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournament.Table, tournament.FieldID, id),
+			sqlgraph.To(tournamentmatch.Table, tournamentmatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, tournament.MatchesTable, tournament.MatchesColumn),
+		)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TournamentClient) Hooks() []Hook {
+	return c.hooks.Tournament
+}
+
+// Interceptors returns the client interceptors.
+func (c *TournamentClient) Interceptors() []Interceptor {
+	return c.inters.Tournament
+}
+
+func (c *TournamentClient) mutate(ctx context.Context, m *TournamentMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TournamentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TournamentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TournamentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TournamentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tournament mutation op: %q", m.Op())
+	}
+}
+
+// TournamentEntrantClient is a client for the TournamentEntrant schema.
+type TournamentEntrantClient struct {
+	config
+}
+
+// NewTournamentEntrantClient returns a client for the TournamentEntrant from the given config.
+func NewTournamentEntrantClient(c config) *TournamentEntrantClient {
+	return &TournamentEntrantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tournamententrant.Hooks(f(g(h())))`.
+func (c *TournamentEntrantClient) Use(hooks ...Hook) {
+	c.hooks.TournamentEntrant = append(c.hooks.TournamentEntrant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tournamententrant.Intercept(f(g(h())))`.
+func (c *TournamentEntrantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TournamentEntrant = append(c.inters.TournamentEntrant, interceptors...)
+}
+
+// Create returns a builder for creating a TournamentEntrant entity.
+func (c *TournamentEntrantClient) Create() *TournamentEntrantCreate {
+	mutation := newTournamentEntrantMutation(c.config, OpCreate)
+	return &TournamentEntrantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TournamentEntrant entities.
+func (c *TournamentEntrantClient) CreateBulk(builders ...*TournamentEntrantCreate) *TournamentEntrantCreateBulk {
+	return &TournamentEntrantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TournamentEntrantClient) MapCreateBulk(slice any, setFunc func(*TournamentEntrantCreate, int)) *TournamentEntrantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TournamentEntrantCreateBulk{err: fmt.Errorf("calling to TournamentEntrantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TournamentEntrantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TournamentEntrantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TournamentEntrant.
+func (c *TournamentEntrantClient) Update() *TournamentEntrantUpdate {
+	mutation := newTournamentEntrantMutation(c.config, OpUpdate)
+	return &TournamentEntrantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TournamentEntrantClient) UpdateOne(te *TournamentEntrant) *TournamentEntrantUpdateOne {
+	mutation := newTournamentEntrantMutation(c.config, OpUpdateOne, withTournamentEntrant(te))
+	return &TournamentEntrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TournamentEntrantClient) UpdateOneID(id int) *TournamentEntrantUpdateOne {
+	mutation := newTournamentEntrantMutation(c.config, OpUpdateOne, withTournamentEntrantID(id))
+	return &TournamentEntrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TournamentEntrant.
+func (c *TournamentEntrantClient) Delete() *TournamentEntrantDelete {
+	mutation := newTournamentEntrantMutation(c.config, OpDelete)
+	return &TournamentEntrantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TournamentEntrantClient) DeleteOne(te *TournamentEntrant) *TournamentEntrantDeleteOne {
+	return c.DeleteOneID(te.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TournamentEntrantClient) DeleteOneID(id int) *TournamentEntrantDeleteOne {
+	builder := c.Delete().Where(tournamententrant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TournamentEntrantDeleteOne{builder}
+}
+
+// Query returns a query builder for TournamentEntrant.
+func (c *TournamentEntrantClient) Query() *TournamentEntrantQuery {
+	return &TournamentEntrantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTournamentEntrant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TournamentEntrant entity by its id.
+func (c *TournamentEntrantClient) Get(ctx context.Context, id int) (*TournamentEntrant, error) {
+	return c.Query().Where(tournamententrant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TournamentEntrantClient) GetX(ctx context.Context, id int) *TournamentEntrant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTournament queries the tournament edge of a TournamentEntrant.
+func (c *TournamentEntrantClient) QueryTournament(te *TournamentEntrant) *TournamentQuery {
+	query := (&TournamentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := te.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournamententrant.Table, tournamententrant.FieldID, id),
+			sqlgraph.To(tournament.Table, tournament.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tournamententrant.TournamentTable, tournamententrant.TournamentColumn),
+		)
+		fromV = sqlgraph.Neighbors(te.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TournamentEntrantClient) Hooks() []Hook {
+	return c.hooks.TournamentEntrant
+}
+
+// Interceptors returns the client interceptors.
+func (c *TournamentEntrantClient) Interceptors() []Interceptor {
+	return c.inters.TournamentEntrant
+}
+
+func (c *TournamentEntrantClient) mutate(ctx context.Context, m *TournamentEntrantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TournamentEntrantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TournamentEntrantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TournamentEntrantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TournamentEntrantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TournamentEntrant mutation op: %q", m.Op())
+	}
+}
+
+// TournamentMatchClient is a client for the TournamentMatch schema.
+type TournamentMatchClient struct {
+	config
+}
+
+// NewTournamentMatchClient returns a client for the TournamentMatch from the given config.
+func NewTournamentMatchClient(c config) *TournamentMatchClient {
+	return &TournamentMatchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tournamentmatch.Hooks(f(g(h())))`.
+func (c *TournamentMatchClient) Use(hooks ...Hook) {
+	c.hooks.TournamentMatch = append(c.hooks.TournamentMatch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tournamentmatch.Intercept(f(g(h())))`.
+func (c *TournamentMatchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TournamentMatch = append(c.inters.TournamentMatch, interceptors...)
+}
+
+// Create returns a builder for creating a TournamentMatch entity.
+func (c *TournamentMatchClient) Create() *TournamentMatchCreate {
+	mutation := newTournamentMatchMutation(c.config, OpCreate)
+	return &TournamentMatchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TournamentMatch entities.
+func (c *TournamentMatchClient) CreateBulk(builders ...*TournamentMatchCreate) *TournamentMatchCreateBulk {
+	return &TournamentMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TournamentMatchClient) MapCreateBulk(slice any, setFunc func(*TournamentMatchCreate, int)) *TournamentMatchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TournamentMatchCreateBulk{err: fmt.Errorf("calling to TournamentMatchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TournamentMatchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TournamentMatchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TournamentMatch.
+func (c *TournamentMatchClient) Update() *TournamentMatchUpdate {
+	mutation := newTournamentMatchMutation(c.config, OpUpdate)
+	return &TournamentMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TournamentMatchClient) UpdateOne(tm *TournamentMatch) *TournamentMatchUpdateOne {
+	mutation := newTournamentMatchMutation(c.config, OpUpdateOne, withTournamentMatch(tm))
+	return &TournamentMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TournamentMatchClient) UpdateOneID(id int) *TournamentMatchUpdateOne {
+	mutation := newTournamentMatchMutation(c.config, OpUpdateOne, withTournamentMatchID(id))
+	return &TournamentMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TournamentMatch.
+func (c *TournamentMatchClient) Delete() *TournamentMatchDelete {
+	mutation := newTournamentMatchMutation(c.config, OpDelete)
+	return &TournamentMatchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TournamentMatchClient) DeleteOne(tm *TournamentMatch) *TournamentMatchDeleteOne {
+	return c.DeleteOneID(tm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TournamentMatchClient) DeleteOneID(id int) *TournamentMatchDeleteOne {
+	builder := c.Delete().Where(tournamentmatch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TournamentMatchDeleteOne{builder}
+}
+
+// Query returns a query builder for TournamentMatch.
+func (c *TournamentMatchClient) Query() *TournamentMatchQuery {
+	return &TournamentMatchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTournamentMatch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TournamentMatch entity by its id.
+func (c *TournamentMatchClient) Get(ctx context.Context, id int) (*TournamentMatch, error) {
+	return c.Query().Where(tournamentmatch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TournamentMatchClient) GetX(ctx context.Context, id int) *TournamentMatch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTournament queries the tournament edge of a TournamentMatch.
+func (c *TournamentMatchClient) QueryTournament(tm *TournamentMatch) *TournamentQuery {
+	query := (&TournamentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournamentmatch.Table, tournamentmatch.FieldID, id),
+			sqlgraph.To(tournament.Table, tournament.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tournamentmatch.TournamentTable, tournamentmatch.TournamentColumn),
+		)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGame queries the game edge of a TournamentMatch.
+func (c *TournamentMatchClient) QueryGame(tm *TournamentMatch) *GameQuery {
+	query := (&GameClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := tm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournamentmatch.Table, tournamentmatch.FieldID, id),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tournamentmatch.GameTable, tournamentmatch.GameColumn),
+		)
+		fromV = sqlgraph.Neighbors(tm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TournamentMatchClient) Hooks() []Hook {
+	return c.hooks.TournamentMatch
+}
+
+// Interceptors returns the client interceptors.
+func (c *TournamentMatchClient) Interceptors() []Interceptor {
+	return c.inters.TournamentMatch
+}
+
+func (c *TournamentMatchClient) mutate(ctx context.Context, m *TournamentMatchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TournamentMatchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TournamentMatchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TournamentMatchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TournamentMatchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TournamentMatch mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(u *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(u))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(u *User) *UserDeleteOne {
+	return c.DeleteOneID(u.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPlayers queries the players edge of a User.
+func (c *UserClient) QueryPlayers(u *User) *PlayerQuery {
+	query := (&PlayerClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(player.Table, player.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, user.PlayersTable, user.PlayersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Card, Game, Item, Player []ent.Hook
+		Ban, Card, DailyChallenge, Game, Item, Match, Player, PracticeRun, Team,
+		Tournament, TournamentEntrant, TournamentMatch, User []ent.Hook
 	}
 	inters struct {
-		Card, Game, Item, Player []ent.Interceptor
+		Ban, Card, DailyChallenge, Game, Item, Match, Player, PracticeRun, Team,
+		Tournament, TournamentEntrant, TournamentMatch, User []ent.Interceptor
 	}
 )
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"example/ent/dailychallenge"
+	"example/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DailyChallenge is the model entity for the DailyChallenge schema.
+type DailyChallenge struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Day holds the value of the "day" field.
+	Day string `json:"day,omitempty"`
+	// PlayerName holds the value of the "player_name" field.
+	PlayerName string `json:"player_name,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed int64 `json:"seed,omitempty"`
+	// CardCount holds the value of the "card_count" field.
+	CardCount int `json:"card_count,omitempty"`
+	// TotalMs holds the value of the "total_ms" field.
+	TotalMs *int64 `json:"total_ms,omitempty"`
+	// ReactionMs holds the value of the "reaction_ms" field.
+	ReactionMs []int64 `json:"reaction_ms,omitempty"`
+	// Mistakes holds the value of the "mistakes" field.
+	Mistakes int `json:"mistakes,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DailyChallengeQuery when eager-loading is set.
+	Edges        DailyChallengeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DailyChallengeEdges holds the relations/edges for other nodes in the graph.
+type DailyChallengeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DailyChallengeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DailyChallenge) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dailychallenge.FieldReactionMs:
+			values[i] = new([]byte)
+		case dailychallenge.FieldID, dailychallenge.FieldUserID, dailychallenge.FieldSeed, dailychallenge.FieldCardCount, dailychallenge.FieldTotalMs, dailychallenge.FieldMistakes:
+			values[i] = new(sql.NullInt64)
+		case dailychallenge.FieldDay, dailychallenge.FieldPlayerName:
+			values[i] = new(sql.NullString)
+		case dailychallenge.FieldStartedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DailyChallenge fields.
+func (dc *DailyChallenge) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dailychallenge.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dc.ID = int(value.Int64)
+		case dailychallenge.FieldDay:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field day", values[i])
+			} else if value.Valid {
+				dc.Day = value.String
+			}
+		case dailychallenge.FieldPlayerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_name", values[i])
+			} else if value.Valid {
+				dc.PlayerName = value.String
+			}
+		case dailychallenge.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				dc.UserID = int(value.Int64)
+			}
+		case dailychallenge.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				dc.Seed = value.Int64
+			}
+		case dailychallenge.FieldCardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card_count", values[i])
+			} else if value.Valid {
+				dc.CardCount = int(value.Int64)
+			}
+		case dailychallenge.FieldTotalMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_ms", values[i])
+			} else if value.Valid {
+				dc.TotalMs = new(int64)
+				*dc.TotalMs = value.Int64
+			}
+		case dailychallenge.FieldReactionMs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reaction_ms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &dc.ReactionMs); err != nil {
+					return fmt.Errorf("unmarshal field reaction_ms: %w", err)
+				}
+			}
+		case dailychallenge.FieldMistakes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mistakes", values[i])
+			} else if value.Valid {
+				dc.Mistakes = int(value.Int64)
+			}
+		case dailychallenge.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				dc.StartedAt = value.Time
+			}
+		default:
+			dc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DailyChallenge.
+// This includes values selected through modifiers, order, etc.
+func (dc *DailyChallenge) Value(name string) (ent.Value, error) {
+	return dc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DailyChallenge entity.
+func (dc *DailyChallenge) QueryUser() *UserQuery {
+	return NewDailyChallengeClient(dc.config).QueryUser(dc)
+}
+
+// Update returns a builder for updating this DailyChallenge.
+// Note that you need to call DailyChallenge.Unwrap() before calling this method if this DailyChallenge
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dc *DailyChallenge) Update() *DailyChallengeUpdateOne {
+	return NewDailyChallengeClient(dc.config).UpdateOne(dc)
+}
+
+// Unwrap unwraps the DailyChallenge entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dc *DailyChallenge) Unwrap() *DailyChallenge {
+	_tx, ok := dc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DailyChallenge is not a transactional entity")
+	}
+	dc.config.driver = _tx.drv
+	return dc
+}
+
+// String implements the fmt.Stringer.
+func (dc *DailyChallenge) String() string {
+	var builder strings.Builder
+	builder.WriteString("DailyChallenge(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dc.ID))
+	builder.WriteString("day=")
+	builder.WriteString(dc.Day)
+	builder.WriteString(", ")
+	builder.WriteString("player_name=")
+	builder.WriteString(dc.PlayerName)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", dc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("card_count=")
+	builder.WriteString(fmt.Sprintf("%v", dc.CardCount))
+	builder.WriteString(", ")
+	if v := dc.TotalMs; v != nil {
+		builder.WriteString("total_ms=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reaction_ms=")
+	builder.WriteString(fmt.Sprintf("%v", dc.ReactionMs))
+	builder.WriteString(", ")
+	builder.WriteString("mistakes=")
+	builder.WriteString(fmt.Sprintf("%v", dc.Mistakes))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(dc.StartedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DailyChallenges is a parsable slice of DailyChallenge.
+type DailyChallenges []*DailyChallenge
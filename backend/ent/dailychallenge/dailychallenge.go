@@ -0,0 +1,141 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dailychallenge
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the dailychallenge type in the database.
+	Label = "daily_challenge"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDay holds the string denoting the day field in the database.
+	FieldDay = "day"
+	// FieldPlayerName holds the string denoting the player_name field in the database.
+	FieldPlayerName = "player_name"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldCardCount holds the string denoting the card_count field in the database.
+	FieldCardCount = "card_count"
+	// FieldTotalMs holds the string denoting the total_ms field in the database.
+	FieldTotalMs = "total_ms"
+	// FieldReactionMs holds the string denoting the reaction_ms field in the database.
+	FieldReactionMs = "reaction_ms"
+	// FieldMistakes holds the string denoting the mistakes field in the database.
+	FieldMistakes = "mistakes"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// Table holds the table name of the dailychallenge in the database.
+	Table = "daily_challenges"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "daily_challenges"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+)
+
+// Columns holds all SQL columns for dailychallenge fields.
+var Columns = []string{
+	FieldID,
+	FieldDay,
+	FieldPlayerName,
+	FieldUserID,
+	FieldSeed,
+	FieldCardCount,
+	FieldTotalMs,
+	FieldReactionMs,
+	FieldMistakes,
+	FieldStartedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PlayerNameValidator is a validator for the "player_name" field. It is called by the builders before save.
+	PlayerNameValidator func(string) error
+	// DefaultMistakes holds the default value on creation for the "mistakes" field.
+	DefaultMistakes int
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DailyChallenge queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDay orders the results by the day field.
+func ByDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDay, opts...).ToFunc()
+}
+
+// ByPlayerName orders the results by the player_name field.
+func ByPlayerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerName, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByCardCount orders the results by the card_count field.
+func ByCardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardCount, opts...).ToFunc()
+}
+
+// ByTotalMs orders the results by the total_ms field.
+func ByTotalMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalMs, opts...).ToFunc()
+}
+
+// ByMistakes orders the results by the mistakes field.
+func ByMistakes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMistakes, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
@@ -0,0 +1,514 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dailychallenge
+
+import (
+	"example/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldID, id))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldDay, v))
+}
+
+// PlayerName applies equality check predicate on the "player_name" field. It's identical to PlayerNameEQ.
+func PlayerName(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldPlayerName, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldUserID, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldSeed, v))
+}
+
+// CardCount applies equality check predicate on the "card_count" field. It's identical to CardCountEQ.
+func CardCount(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldCardCount, v))
+}
+
+// TotalMs applies equality check predicate on the "total_ms" field. It's identical to TotalMsEQ.
+func TotalMs(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldTotalMs, v))
+}
+
+// Mistakes applies equality check predicate on the "mistakes" field. It's identical to MistakesEQ.
+func Mistakes(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldMistakes, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldDay, v))
+}
+
+// DayContains applies the Contains predicate on the "day" field.
+func DayContains(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldContains(FieldDay, v))
+}
+
+// DayHasPrefix applies the HasPrefix predicate on the "day" field.
+func DayHasPrefix(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldHasPrefix(FieldDay, v))
+}
+
+// DayHasSuffix applies the HasSuffix predicate on the "day" field.
+func DayHasSuffix(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldHasSuffix(FieldDay, v))
+}
+
+// DayEqualFold applies the EqualFold predicate on the "day" field.
+func DayEqualFold(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEqualFold(FieldDay, v))
+}
+
+// DayContainsFold applies the ContainsFold predicate on the "day" field.
+func DayContainsFold(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldContainsFold(FieldDay, v))
+}
+
+// PlayerNameEQ applies the EQ predicate on the "player_name" field.
+func PlayerNameEQ(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldPlayerName, v))
+}
+
+// PlayerNameNEQ applies the NEQ predicate on the "player_name" field.
+func PlayerNameNEQ(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldPlayerName, v))
+}
+
+// PlayerNameIn applies the In predicate on the "player_name" field.
+func PlayerNameIn(vs ...string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldPlayerName, vs...))
+}
+
+// PlayerNameNotIn applies the NotIn predicate on the "player_name" field.
+func PlayerNameNotIn(vs ...string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldPlayerName, vs...))
+}
+
+// PlayerNameGT applies the GT predicate on the "player_name" field.
+func PlayerNameGT(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldPlayerName, v))
+}
+
+// PlayerNameGTE applies the GTE predicate on the "player_name" field.
+func PlayerNameGTE(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldPlayerName, v))
+}
+
+// PlayerNameLT applies the LT predicate on the "player_name" field.
+func PlayerNameLT(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldPlayerName, v))
+}
+
+// PlayerNameLTE applies the LTE predicate on the "player_name" field.
+func PlayerNameLTE(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldPlayerName, v))
+}
+
+// PlayerNameContains applies the Contains predicate on the "player_name" field.
+func PlayerNameContains(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldContains(FieldPlayerName, v))
+}
+
+// PlayerNameHasPrefix applies the HasPrefix predicate on the "player_name" field.
+func PlayerNameHasPrefix(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldHasPrefix(FieldPlayerName, v))
+}
+
+// PlayerNameHasSuffix applies the HasSuffix predicate on the "player_name" field.
+func PlayerNameHasSuffix(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldHasSuffix(FieldPlayerName, v))
+}
+
+// PlayerNameEqualFold applies the EqualFold predicate on the "player_name" field.
+func PlayerNameEqualFold(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEqualFold(FieldPlayerName, v))
+}
+
+// PlayerNameContainsFold applies the ContainsFold predicate on the "player_name" field.
+func PlayerNameContainsFold(v string) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldContainsFold(FieldPlayerName, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotNull(FieldUserID))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldSeed, v))
+}
+
+// CardCountEQ applies the EQ predicate on the "card_count" field.
+func CardCountEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldCardCount, v))
+}
+
+// CardCountNEQ applies the NEQ predicate on the "card_count" field.
+func CardCountNEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldCardCount, v))
+}
+
+// CardCountIn applies the In predicate on the "card_count" field.
+func CardCountIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldCardCount, vs...))
+}
+
+// CardCountNotIn applies the NotIn predicate on the "card_count" field.
+func CardCountNotIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldCardCount, vs...))
+}
+
+// CardCountGT applies the GT predicate on the "card_count" field.
+func CardCountGT(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldCardCount, v))
+}
+
+// CardCountGTE applies the GTE predicate on the "card_count" field.
+func CardCountGTE(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldCardCount, v))
+}
+
+// CardCountLT applies the LT predicate on the "card_count" field.
+func CardCountLT(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldCardCount, v))
+}
+
+// CardCountLTE applies the LTE predicate on the "card_count" field.
+func CardCountLTE(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldCardCount, v))
+}
+
+// TotalMsEQ applies the EQ predicate on the "total_ms" field.
+func TotalMsEQ(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldTotalMs, v))
+}
+
+// TotalMsNEQ applies the NEQ predicate on the "total_ms" field.
+func TotalMsNEQ(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldTotalMs, v))
+}
+
+// TotalMsIn applies the In predicate on the "total_ms" field.
+func TotalMsIn(vs ...int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldTotalMs, vs...))
+}
+
+// TotalMsNotIn applies the NotIn predicate on the "total_ms" field.
+func TotalMsNotIn(vs ...int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldTotalMs, vs...))
+}
+
+// TotalMsGT applies the GT predicate on the "total_ms" field.
+func TotalMsGT(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldTotalMs, v))
+}
+
+// TotalMsGTE applies the GTE predicate on the "total_ms" field.
+func TotalMsGTE(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldTotalMs, v))
+}
+
+// TotalMsLT applies the LT predicate on the "total_ms" field.
+func TotalMsLT(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldTotalMs, v))
+}
+
+// TotalMsLTE applies the LTE predicate on the "total_ms" field.
+func TotalMsLTE(v int64) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldTotalMs, v))
+}
+
+// TotalMsIsNil applies the IsNil predicate on the "total_ms" field.
+func TotalMsIsNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIsNull(FieldTotalMs))
+}
+
+// TotalMsNotNil applies the NotNil predicate on the "total_ms" field.
+func TotalMsNotNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotNull(FieldTotalMs))
+}
+
+// ReactionMsIsNil applies the IsNil predicate on the "reaction_ms" field.
+func ReactionMsIsNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIsNull(FieldReactionMs))
+}
+
+// ReactionMsNotNil applies the NotNil predicate on the "reaction_ms" field.
+func ReactionMsNotNil() predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotNull(FieldReactionMs))
+}
+
+// MistakesEQ applies the EQ predicate on the "mistakes" field.
+func MistakesEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldMistakes, v))
+}
+
+// MistakesNEQ applies the NEQ predicate on the "mistakes" field.
+func MistakesNEQ(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldMistakes, v))
+}
+
+// MistakesIn applies the In predicate on the "mistakes" field.
+func MistakesIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldMistakes, vs...))
+}
+
+// MistakesNotIn applies the NotIn predicate on the "mistakes" field.
+func MistakesNotIn(vs ...int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldMistakes, vs...))
+}
+
+// MistakesGT applies the GT predicate on the "mistakes" field.
+func MistakesGT(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldMistakes, v))
+}
+
+// MistakesGTE applies the GTE predicate on the "mistakes" field.
+func MistakesGTE(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldMistakes, v))
+}
+
+// MistakesLT applies the LT predicate on the "mistakes" field.
+func MistakesLT(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldMistakes, v))
+}
+
+// MistakesLTE applies the LTE predicate on the "mistakes" field.
+func MistakesLTE(v int) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldMistakes, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.DailyChallenge {
+	return predicate.DailyChallenge(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.DailyChallenge {
+	return predicate.DailyChallenge(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DailyChallenge) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DailyChallenge) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DailyChallenge) predicate.DailyChallenge {
+	return predicate.DailyChallenge(sql.NotPredicates(p))
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/dailychallenge"
+	"example/ent/user"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DailyChallengeCreate is the builder for creating a DailyChallenge entity.
+type DailyChallengeCreate struct {
+	config
+	mutation *DailyChallengeMutation
+	hooks    []Hook
+}
+
+// SetDay sets the "day" field.
+func (dcc *DailyChallengeCreate) SetDay(s string) *DailyChallengeCreate {
+	dcc.mutation.SetDay(s)
+	return dcc
+}
+
+// SetPlayerName sets the "player_name" field.
+func (dcc *DailyChallengeCreate) SetPlayerName(s string) *DailyChallengeCreate {
+	dcc.mutation.SetPlayerName(s)
+	return dcc
+}
+
+// SetUserID sets the "user_id" field.
+func (dcc *DailyChallengeCreate) SetUserID(i int) *DailyChallengeCreate {
+	dcc.mutation.SetUserID(i)
+	return dcc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dcc *DailyChallengeCreate) SetNillableUserID(i *int) *DailyChallengeCreate {
+	if i != nil {
+		dcc.SetUserID(*i)
+	}
+	return dcc
+}
+
+// SetSeed sets the "seed" field.
+func (dcc *DailyChallengeCreate) SetSeed(i int64) *DailyChallengeCreate {
+	dcc.mutation.SetSeed(i)
+	return dcc
+}
+
+// SetCardCount sets the "card_count" field.
+func (dcc *DailyChallengeCreate) SetCardCount(i int) *DailyChallengeCreate {
+	dcc.mutation.SetCardCount(i)
+	return dcc
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (dcc *DailyChallengeCreate) SetTotalMs(i int64) *DailyChallengeCreate {
+	dcc.mutation.SetTotalMs(i)
+	return dcc
+}
+
+// SetNillableTotalMs sets the "total_ms" field if the given value is not nil.
+func (dcc *DailyChallengeCreate) SetNillableTotalMs(i *int64) *DailyChallengeCreate {
+	if i != nil {
+		dcc.SetTotalMs(*i)
+	}
+	return dcc
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (dcc *DailyChallengeCreate) SetReactionMs(i []int64) *DailyChallengeCreate {
+	dcc.mutation.SetReactionMs(i)
+	return dcc
+}
+
+// SetMistakes sets the "mistakes" field.
+func (dcc *DailyChallengeCreate) SetMistakes(i int) *DailyChallengeCreate {
+	dcc.mutation.SetMistakes(i)
+	return dcc
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (dcc *DailyChallengeCreate) SetNillableMistakes(i *int) *DailyChallengeCreate {
+	if i != nil {
+		dcc.SetMistakes(*i)
+	}
+	return dcc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (dcc *DailyChallengeCreate) SetStartedAt(t time.Time) *DailyChallengeCreate {
+	dcc.mutation.SetStartedAt(t)
+	return dcc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (dcc *DailyChallengeCreate) SetNillableStartedAt(t *time.Time) *DailyChallengeCreate {
+	if t != nil {
+		dcc.SetStartedAt(*t)
+	}
+	return dcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dcc *DailyChallengeCreate) SetUser(u *User) *DailyChallengeCreate {
+	return dcc.SetUserID(u.ID)
+}
+
+// Mutation returns the DailyChallengeMutation object of the builder.
+func (dcc *DailyChallengeCreate) Mutation() *DailyChallengeMutation {
+	return dcc.mutation
+}
+
+// Save creates the DailyChallenge in the database.
+func (dcc *DailyChallengeCreate) Save(ctx context.Context) (*DailyChallenge, error) {
+	dcc.defaults()
+	return withHooks(ctx, dcc.sqlSave, dcc.mutation, dcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dcc *DailyChallengeCreate) SaveX(ctx context.Context) *DailyChallenge {
+	v, err := dcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcc *DailyChallengeCreate) Exec(ctx context.Context) error {
+	_, err := dcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcc *DailyChallengeCreate) ExecX(ctx context.Context) {
+	if err := dcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dcc *DailyChallengeCreate) defaults() {
+	if _, ok := dcc.mutation.Mistakes(); !ok {
+		v := dailychallenge.DefaultMistakes
+		dcc.mutation.SetMistakes(v)
+	}
+	if _, ok := dcc.mutation.StartedAt(); !ok {
+		v := dailychallenge.DefaultStartedAt()
+		dcc.mutation.SetStartedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcc *DailyChallengeCreate) check() error {
+	if _, ok := dcc.mutation.Day(); !ok {
+		return &ValidationError{Name: "day", err: errors.New(`ent: missing required field "DailyChallenge.day"`)}
+	}
+	if _, ok := dcc.mutation.PlayerName(); !ok {
+		return &ValidationError{Name: "player_name", err: errors.New(`ent: missing required field "DailyChallenge.player_name"`)}
+	}
+	if v, ok := dcc.mutation.PlayerName(); ok {
+		if err := dailychallenge.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "DailyChallenge.player_name": %w`, err)}
+		}
+	}
+	if _, ok := dcc.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "DailyChallenge.seed"`)}
+	}
+	if _, ok := dcc.mutation.CardCount(); !ok {
+		return &ValidationError{Name: "card_count", err: errors.New(`ent: missing required field "DailyChallenge.card_count"`)}
+	}
+	if _, ok := dcc.mutation.Mistakes(); !ok {
+		return &ValidationError{Name: "mistakes", err: errors.New(`ent: missing required field "DailyChallenge.mistakes"`)}
+	}
+	if _, ok := dcc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "DailyChallenge.started_at"`)}
+	}
+	return nil
+}
+
+func (dcc *DailyChallengeCreate) sqlSave(ctx context.Context) (*DailyChallenge, error) {
+	if err := dcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dcc.mutation.id = &_node.ID
+	dcc.mutation.done = true
+	return _node, nil
+}
+
+func (dcc *DailyChallengeCreate) createSpec() (*DailyChallenge, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DailyChallenge{config: dcc.config}
+		_spec = sqlgraph.NewCreateSpec(dailychallenge.Table, sqlgraph.NewFieldSpec(dailychallenge.FieldID, field.TypeInt))
+	)
+	if value, ok := dcc.mutation.Day(); ok {
+		_spec.SetField(dailychallenge.FieldDay, field.TypeString, value)
+		_node.Day = value
+	}
+	if value, ok := dcc.mutation.PlayerName(); ok {
+		_spec.SetField(dailychallenge.FieldPlayerName, field.TypeString, value)
+		_node.PlayerName = value
+	}
+	if value, ok := dcc.mutation.Seed(); ok {
+		_spec.SetField(dailychallenge.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := dcc.mutation.CardCount(); ok {
+		_spec.SetField(dailychallenge.FieldCardCount, field.TypeInt, value)
+		_node.CardCount = value
+	}
+	if value, ok := dcc.mutation.TotalMs(); ok {
+		_spec.SetField(dailychallenge.FieldTotalMs, field.TypeInt64, value)
+		_node.TotalMs = &value
+	}
+	if value, ok := dcc.mutation.ReactionMs(); ok {
+		_spec.SetField(dailychallenge.FieldReactionMs, field.TypeJSON, value)
+		_node.ReactionMs = value
+	}
+	if value, ok := dcc.mutation.Mistakes(); ok {
+		_spec.SetField(dailychallenge.FieldMistakes, field.TypeInt, value)
+		_node.Mistakes = value
+	}
+	if value, ok := dcc.mutation.StartedAt(); ok {
+		_spec.SetField(dailychallenge.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if nodes := dcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dailychallenge.UserTable,
+			Columns: []string{dailychallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// DailyChallengeCreateBulk is the builder for creating many DailyChallenge entities in bulk.
+type DailyChallengeCreateBulk struct {
+	config
+	err      error
+	builders []*DailyChallengeCreate
+}
+
+// Save creates the DailyChallenge entities in the database.
+func (dccb *DailyChallengeCreateBulk) Save(ctx context.Context) ([]*DailyChallenge, error) {
+	if dccb.err != nil {
+		return nil, dccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dccb.builders))
+	nodes := make([]*DailyChallenge, len(dccb.builders))
+	mutators := make([]Mutator, len(dccb.builders))
+	for i := range dccb.builders {
+		func(i int, root context.Context) {
+			builder := dccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DailyChallengeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dccb *DailyChallengeCreateBulk) SaveX(ctx context.Context) []*DailyChallenge {
+	v, err := dccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dccb *DailyChallengeCreateBulk) Exec(ctx context.Context) error {
+	_, err := dccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dccb *DailyChallengeCreateBulk) ExecX(ctx context.Context) {
+	if err := dccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
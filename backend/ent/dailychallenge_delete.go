@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"example/ent/dailychallenge"
+	"example/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DailyChallengeDelete is the builder for deleting a DailyChallenge entity.
+type DailyChallengeDelete struct {
+	config
+	hooks    []Hook
+	mutation *DailyChallengeMutation
+}
+
+// Where appends a list predicates to the DailyChallengeDelete builder.
+func (dcd *DailyChallengeDelete) Where(ps ...predicate.DailyChallenge) *DailyChallengeDelete {
+	dcd.mutation.Where(ps...)
+	return dcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dcd *DailyChallengeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dcd.sqlExec, dcd.mutation, dcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcd *DailyChallengeDelete) ExecX(ctx context.Context) int {
+	n, err := dcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dcd *DailyChallengeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dailychallenge.Table, sqlgraph.NewFieldSpec(dailychallenge.FieldID, field.TypeInt))
+	if ps := dcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dcd.mutation.done = true
+	return affected, err
+}
+
+// DailyChallengeDeleteOne is the builder for deleting a single DailyChallenge entity.
+type DailyChallengeDeleteOne struct {
+	dcd *DailyChallengeDelete
+}
+
+// Where appends a list predicates to the DailyChallengeDelete builder.
+func (dcdo *DailyChallengeDeleteOne) Where(ps ...predicate.DailyChallenge) *DailyChallengeDeleteOne {
+	dcdo.dcd.mutation.Where(ps...)
+	return dcdo
+}
+
+// Exec executes the deletion query.
+func (dcdo *DailyChallengeDeleteOne) Exec(ctx context.Context) error {
+	n, err := dcdo.dcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dailychallenge.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcdo *DailyChallengeDeleteOne) ExecX(ctx context.Context) {
+	if err := dcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"example/ent/dailychallenge"
+	"example/ent/predicate"
+	"example/ent/user"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DailyChallengeQuery is the builder for querying DailyChallenge entities.
+type DailyChallengeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dailychallenge.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DailyChallenge
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DailyChallengeQuery builder.
+func (dcq *DailyChallengeQuery) Where(ps ...predicate.DailyChallenge) *DailyChallengeQuery {
+	dcq.predicates = append(dcq.predicates, ps...)
+	return dcq
+}
+
+// Limit the number of records to be returned by this query.
+func (dcq *DailyChallengeQuery) Limit(limit int) *DailyChallengeQuery {
+	dcq.ctx.Limit = &limit
+	return dcq
+}
+
+// Offset to start from.
+func (dcq *DailyChallengeQuery) Offset(offset int) *DailyChallengeQuery {
+	dcq.ctx.Offset = &offset
+	return dcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dcq *DailyChallengeQuery) Unique(unique bool) *DailyChallengeQuery {
+	dcq.ctx.Unique = &unique
+	return dcq
+}
+
+// Order specifies how the records should be ordered.
+func (dcq *DailyChallengeQuery) Order(o ...dailychallenge.OrderOption) *DailyChallengeQuery {
+	dcq.order = append(dcq.order, o...)
+	return dcq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (dcq *DailyChallengeQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: dcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dailychallenge.Table, dailychallenge.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, dailychallenge.UserTable, dailychallenge.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DailyChallenge entity from the query.
+// Returns a *NotFoundError when no DailyChallenge was found.
+func (dcq *DailyChallengeQuery) First(ctx context.Context) (*DailyChallenge, error) {
+	nodes, err := dcq.Limit(1).All(setContextOp(ctx, dcq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dailychallenge.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) FirstX(ctx context.Context) *DailyChallenge {
+	node, err := dcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DailyChallenge ID from the query.
+// Returns a *NotFoundError when no DailyChallenge ID was found.
+func (dcq *DailyChallengeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dcq.Limit(1).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dailychallenge.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) FirstIDX(ctx context.Context) int {
+	id, err := dcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DailyChallenge entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DailyChallenge entity is found.
+// Returns a *NotFoundError when no DailyChallenge entities are found.
+func (dcq *DailyChallengeQuery) Only(ctx context.Context) (*DailyChallenge, error) {
+	nodes, err := dcq.Limit(2).All(setContextOp(ctx, dcq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dailychallenge.Label}
+	default:
+		return nil, &NotSingularError{dailychallenge.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) OnlyX(ctx context.Context) *DailyChallenge {
+	node, err := dcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DailyChallenge ID in the query.
+// Returns a *NotSingularError when more than one DailyChallenge ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dcq *DailyChallengeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dcq.Limit(2).IDs(setContextOp(ctx, dcq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dailychallenge.Label}
+	default:
+		err = &NotSingularError{dailychallenge.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DailyChallenges.
+func (dcq *DailyChallengeQuery) All(ctx context.Context) ([]*DailyChallenge, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryAll)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DailyChallenge, *DailyChallengeQuery]()
+	return withInterceptors[[]*DailyChallenge](ctx, dcq, qr, dcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) AllX(ctx context.Context) []*DailyChallenge {
+	nodes, err := dcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DailyChallenge IDs.
+func (dcq *DailyChallengeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dcq.ctx.Unique == nil && dcq.path != nil {
+		dcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryIDs)
+	if err = dcq.Select(dailychallenge.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) IDsX(ctx context.Context) []int {
+	ids, err := dcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dcq *DailyChallengeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryCount)
+	if err := dcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dcq, querierCount[*DailyChallengeQuery](), dcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) CountX(ctx context.Context) int {
+	count, err := dcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dcq *DailyChallengeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dcq.ctx, ent.OpQueryExist)
+	switch _, err := dcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dcq *DailyChallengeQuery) ExistX(ctx context.Context) bool {
+	exist, err := dcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DailyChallengeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dcq *DailyChallengeQuery) Clone() *DailyChallengeQuery {
+	if dcq == nil {
+		return nil
+	}
+	return &DailyChallengeQuery{
+		config:     dcq.config,
+		ctx:        dcq.ctx.Clone(),
+		order:      append([]dailychallenge.OrderOption{}, dcq.order...),
+		inters:     append([]Interceptor{}, dcq.inters...),
+		predicates: append([]predicate.DailyChallenge{}, dcq.predicates...),
+		withUser:   dcq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  dcq.sql.Clone(),
+		path: dcq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (dcq *DailyChallengeQuery) WithUser(opts ...func(*UserQuery)) *DailyChallengeQuery {
+	query := (&UserClient{config: dcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dcq.withUser = query
+	return dcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Day string `json:"day,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DailyChallenge.Query().
+//		GroupBy(dailychallenge.FieldDay).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dcq *DailyChallengeQuery) GroupBy(field string, fields ...string) *DailyChallengeGroupBy {
+	dcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DailyChallengeGroupBy{build: dcq}
+	grbuild.flds = &dcq.ctx.Fields
+	grbuild.label = dailychallenge.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Day string `json:"day,omitempty"`
+//	}
+//
+//	client.DailyChallenge.Query().
+//		Select(dailychallenge.FieldDay).
+//		Scan(ctx, &v)
+func (dcq *DailyChallengeQuery) Select(fields ...string) *DailyChallengeSelect {
+	dcq.ctx.Fields = append(dcq.ctx.Fields, fields...)
+	sbuild := &DailyChallengeSelect{DailyChallengeQuery: dcq}
+	sbuild.label = dailychallenge.Label
+	sbuild.flds, sbuild.scan = &dcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DailyChallengeSelect configured with the given aggregations.
+func (dcq *DailyChallengeQuery) Aggregate(fns ...AggregateFunc) *DailyChallengeSelect {
+	return dcq.Select().Aggregate(fns...)
+}
+
+func (dcq *DailyChallengeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dcq.ctx.Fields {
+		if !dailychallenge.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dcq.path != nil {
+		prev, err := dcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dcq.sql = prev
+	}
+	return nil
+}
+
+func (dcq *DailyChallengeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DailyChallenge, error) {
+	var (
+		nodes       = []*DailyChallenge{}
+		_spec       = dcq.querySpec()
+		loadedTypes = [1]bool{
+			dcq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DailyChallenge).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DailyChallenge{config: dcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dcq.withUser; query != nil {
+		if err := dcq.loadUser(ctx, query, nodes, nil,
+			func(n *DailyChallenge, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dcq *DailyChallengeQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*DailyChallenge, init func(*DailyChallenge), assign func(*DailyChallenge, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DailyChallenge)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dcq *DailyChallengeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dcq.querySpec()
+	_spec.Node.Columns = dcq.ctx.Fields
+	if len(dcq.ctx.Fields) > 0 {
+		_spec.Unique = dcq.ctx.Unique != nil && *dcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dcq.driver, _spec)
+}
+
+func (dcq *DailyChallengeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dailychallenge.Table, dailychallenge.Columns, sqlgraph.NewFieldSpec(dailychallenge.FieldID, field.TypeInt))
+	_spec.From = dcq.sql
+	if unique := dcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailychallenge.FieldID)
+		for i := range fields {
+			if fields[i] != dailychallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if dcq.withUser != nil {
+			_spec.Node.AddColumnOnce(dailychallenge.FieldUserID)
+		}
+	}
+	if ps := dcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dcq *DailyChallengeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dcq.driver.Dialect())
+	t1 := builder.Table(dailychallenge.Table)
+	columns := dcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dailychallenge.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dcq.sql != nil {
+		selector = dcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dcq.ctx.Unique != nil && *dcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range dcq.predicates {
+		p(selector)
+	}
+	for _, p := range dcq.order {
+		p(selector)
+	}
+	if offset := dcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DailyChallengeGroupBy is the group-by builder for DailyChallenge entities.
+type DailyChallengeGroupBy struct {
+	selector
+	build *DailyChallengeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dcgb *DailyChallengeGroupBy) Aggregate(fns ...AggregateFunc) *DailyChallengeGroupBy {
+	dcgb.fns = append(dcgb.fns, fns...)
+	return dcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcgb *DailyChallengeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyChallengeQuery, *DailyChallengeGroupBy](ctx, dcgb.build, dcgb, dcgb.build.inters, v)
+}
+
+func (dcgb *DailyChallengeGroupBy) sqlScan(ctx context.Context, root *DailyChallengeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dcgb.fns))
+	for _, fn := range dcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dcgb.flds)+len(dcgb.fns))
+		for _, f := range *dcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DailyChallengeSelect is the builder for selecting fields of DailyChallenge entities.
+type DailyChallengeSelect struct {
+	*DailyChallengeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dcs *DailyChallengeSelect) Aggregate(fns ...AggregateFunc) *DailyChallengeSelect {
+	dcs.fns = append(dcs.fns, fns...)
+	return dcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dcs *DailyChallengeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dcs.ctx, ent.OpQuerySelect)
+	if err := dcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DailyChallengeQuery, *DailyChallengeSelect](ctx, dcs.DailyChallengeQuery, dcs, dcs.inters, v)
+}
+
+func (dcs *DailyChallengeSelect) sqlScan(ctx context.Context, root *DailyChallengeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dcs.fns))
+	for _, fn := range dcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
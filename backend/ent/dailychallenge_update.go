@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/dailychallenge"
+	"example/ent/predicate"
+	"example/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DailyChallengeUpdate is the builder for updating DailyChallenge entities.
+type DailyChallengeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DailyChallengeMutation
+}
+
+// Where appends a list predicates to the DailyChallengeUpdate builder.
+func (dcu *DailyChallengeUpdate) Where(ps ...predicate.DailyChallenge) *DailyChallengeUpdate {
+	dcu.mutation.Where(ps...)
+	return dcu
+}
+
+// SetDay sets the "day" field.
+func (dcu *DailyChallengeUpdate) SetDay(s string) *DailyChallengeUpdate {
+	dcu.mutation.SetDay(s)
+	return dcu
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableDay(s *string) *DailyChallengeUpdate {
+	if s != nil {
+		dcu.SetDay(*s)
+	}
+	return dcu
+}
+
+// SetPlayerName sets the "player_name" field.
+func (dcu *DailyChallengeUpdate) SetPlayerName(s string) *DailyChallengeUpdate {
+	dcu.mutation.SetPlayerName(s)
+	return dcu
+}
+
+// SetNillablePlayerName sets the "player_name" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillablePlayerName(s *string) *DailyChallengeUpdate {
+	if s != nil {
+		dcu.SetPlayerName(*s)
+	}
+	return dcu
+}
+
+// SetUserID sets the "user_id" field.
+func (dcu *DailyChallengeUpdate) SetUserID(i int) *DailyChallengeUpdate {
+	dcu.mutation.SetUserID(i)
+	return dcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableUserID(i *int) *DailyChallengeUpdate {
+	if i != nil {
+		dcu.SetUserID(*i)
+	}
+	return dcu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dcu *DailyChallengeUpdate) ClearUserID() *DailyChallengeUpdate {
+	dcu.mutation.ClearUserID()
+	return dcu
+}
+
+// SetSeed sets the "seed" field.
+func (dcu *DailyChallengeUpdate) SetSeed(i int64) *DailyChallengeUpdate {
+	dcu.mutation.ResetSeed()
+	dcu.mutation.SetSeed(i)
+	return dcu
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableSeed(i *int64) *DailyChallengeUpdate {
+	if i != nil {
+		dcu.SetSeed(*i)
+	}
+	return dcu
+}
+
+// AddSeed adds i to the "seed" field.
+func (dcu *DailyChallengeUpdate) AddSeed(i int64) *DailyChallengeUpdate {
+	dcu.mutation.AddSeed(i)
+	return dcu
+}
+
+// SetCardCount sets the "card_count" field.
+func (dcu *DailyChallengeUpdate) SetCardCount(i int) *DailyChallengeUpdate {
+	dcu.mutation.ResetCardCount()
+	dcu.mutation.SetCardCount(i)
+	return dcu
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableCardCount(i *int) *DailyChallengeUpdate {
+	if i != nil {
+		dcu.SetCardCount(*i)
+	}
+	return dcu
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (dcu *DailyChallengeUpdate) AddCardCount(i int) *DailyChallengeUpdate {
+	dcu.mutation.AddCardCount(i)
+	return dcu
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (dcu *DailyChallengeUpdate) SetTotalMs(i int64) *DailyChallengeUpdate {
+	dcu.mutation.ResetTotalMs()
+	dcu.mutation.SetTotalMs(i)
+	return dcu
+}
+
+// SetNillableTotalMs sets the "total_ms" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableTotalMs(i *int64) *DailyChallengeUpdate {
+	if i != nil {
+		dcu.SetTotalMs(*i)
+	}
+	return dcu
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (dcu *DailyChallengeUpdate) AddTotalMs(i int64) *DailyChallengeUpdate {
+	dcu.mutation.AddTotalMs(i)
+	return dcu
+}
+
+// ClearTotalMs clears the value of the "total_ms" field.
+func (dcu *DailyChallengeUpdate) ClearTotalMs() *DailyChallengeUpdate {
+	dcu.mutation.ClearTotalMs()
+	return dcu
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (dcu *DailyChallengeUpdate) SetReactionMs(i []int64) *DailyChallengeUpdate {
+	dcu.mutation.SetReactionMs(i)
+	return dcu
+}
+
+// AppendReactionMs appends i to the "reaction_ms" field.
+func (dcu *DailyChallengeUpdate) AppendReactionMs(i []int64) *DailyChallengeUpdate {
+	dcu.mutation.AppendReactionMs(i)
+	return dcu
+}
+
+// ClearReactionMs clears the value of the "reaction_ms" field.
+func (dcu *DailyChallengeUpdate) ClearReactionMs() *DailyChallengeUpdate {
+	dcu.mutation.ClearReactionMs()
+	return dcu
+}
+
+// SetMistakes sets the "mistakes" field.
+func (dcu *DailyChallengeUpdate) SetMistakes(i int) *DailyChallengeUpdate {
+	dcu.mutation.ResetMistakes()
+	dcu.mutation.SetMistakes(i)
+	return dcu
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (dcu *DailyChallengeUpdate) SetNillableMistakes(i *int) *DailyChallengeUpdate {
+	if i != nil {
+		dcu.SetMistakes(*i)
+	}
+	return dcu
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (dcu *DailyChallengeUpdate) AddMistakes(i int) *DailyChallengeUpdate {
+	dcu.mutation.AddMistakes(i)
+	return dcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dcu *DailyChallengeUpdate) SetUser(u *User) *DailyChallengeUpdate {
+	return dcu.SetUserID(u.ID)
+}
+
+// Mutation returns the DailyChallengeMutation object of the builder.
+func (dcu *DailyChallengeUpdate) Mutation() *DailyChallengeMutation {
+	return dcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dcu *DailyChallengeUpdate) ClearUser() *DailyChallengeUpdate {
+	dcu.mutation.ClearUser()
+	return dcu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dcu *DailyChallengeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dcu.sqlSave, dcu.mutation, dcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcu *DailyChallengeUpdate) SaveX(ctx context.Context) int {
+	affected, err := dcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dcu *DailyChallengeUpdate) Exec(ctx context.Context) error {
+	_, err := dcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcu *DailyChallengeUpdate) ExecX(ctx context.Context) {
+	if err := dcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcu *DailyChallengeUpdate) check() error {
+	if v, ok := dcu.mutation.PlayerName(); ok {
+		if err := dailychallenge.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "DailyChallenge.player_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dcu *DailyChallengeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dailychallenge.Table, dailychallenge.Columns, sqlgraph.NewFieldSpec(dailychallenge.FieldID, field.TypeInt))
+	if ps := dcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dcu.mutation.Day(); ok {
+		_spec.SetField(dailychallenge.FieldDay, field.TypeString, value)
+	}
+	if value, ok := dcu.mutation.PlayerName(); ok {
+		_spec.SetField(dailychallenge.FieldPlayerName, field.TypeString, value)
+	}
+	if value, ok := dcu.mutation.Seed(); ok {
+		_spec.SetField(dailychallenge.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := dcu.mutation.AddedSeed(); ok {
+		_spec.AddField(dailychallenge.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := dcu.mutation.CardCount(); ok {
+		_spec.SetField(dailychallenge.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedCardCount(); ok {
+		_spec.AddField(dailychallenge.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.TotalMs(); ok {
+		_spec.SetField(dailychallenge.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := dcu.mutation.AddedTotalMs(); ok {
+		_spec.AddField(dailychallenge.FieldTotalMs, field.TypeInt64, value)
+	}
+	if dcu.mutation.TotalMsCleared() {
+		_spec.ClearField(dailychallenge.FieldTotalMs, field.TypeInt64)
+	}
+	if value, ok := dcu.mutation.ReactionMs(); ok {
+		_spec.SetField(dailychallenge.FieldReactionMs, field.TypeJSON, value)
+	}
+	if value, ok := dcu.mutation.AppendedReactionMs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dailychallenge.FieldReactionMs, value)
+		})
+	}
+	if dcu.mutation.ReactionMsCleared() {
+		_spec.ClearField(dailychallenge.FieldReactionMs, field.TypeJSON)
+	}
+	if value, ok := dcu.mutation.Mistakes(); ok {
+		_spec.SetField(dailychallenge.FieldMistakes, field.TypeInt, value)
+	}
+	if value, ok := dcu.mutation.AddedMistakes(); ok {
+		_spec.AddField(dailychallenge.FieldMistakes, field.TypeInt, value)
+	}
+	if dcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dailychallenge.UserTable,
+			Columns: []string{dailychallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dailychallenge.UserTable,
+			Columns: []string{dailychallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, dcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailychallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dcu.mutation.done = true
+	return n, nil
+}
+
+// DailyChallengeUpdateOne is the builder for updating a single DailyChallenge entity.
+type DailyChallengeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DailyChallengeMutation
+}
+
+// SetDay sets the "day" field.
+func (dcuo *DailyChallengeUpdateOne) SetDay(s string) *DailyChallengeUpdateOne {
+	dcuo.mutation.SetDay(s)
+	return dcuo
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableDay(s *string) *DailyChallengeUpdateOne {
+	if s != nil {
+		dcuo.SetDay(*s)
+	}
+	return dcuo
+}
+
+// SetPlayerName sets the "player_name" field.
+func (dcuo *DailyChallengeUpdateOne) SetPlayerName(s string) *DailyChallengeUpdateOne {
+	dcuo.mutation.SetPlayerName(s)
+	return dcuo
+}
+
+// SetNillablePlayerName sets the "player_name" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillablePlayerName(s *string) *DailyChallengeUpdateOne {
+	if s != nil {
+		dcuo.SetPlayerName(*s)
+	}
+	return dcuo
+}
+
+// SetUserID sets the "user_id" field.
+func (dcuo *DailyChallengeUpdateOne) SetUserID(i int) *DailyChallengeUpdateOne {
+	dcuo.mutation.SetUserID(i)
+	return dcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableUserID(i *int) *DailyChallengeUpdateOne {
+	if i != nil {
+		dcuo.SetUserID(*i)
+	}
+	return dcuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (dcuo *DailyChallengeUpdateOne) ClearUserID() *DailyChallengeUpdateOne {
+	dcuo.mutation.ClearUserID()
+	return dcuo
+}
+
+// SetSeed sets the "seed" field.
+func (dcuo *DailyChallengeUpdateOne) SetSeed(i int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.ResetSeed()
+	dcuo.mutation.SetSeed(i)
+	return dcuo
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableSeed(i *int64) *DailyChallengeUpdateOne {
+	if i != nil {
+		dcuo.SetSeed(*i)
+	}
+	return dcuo
+}
+
+// AddSeed adds i to the "seed" field.
+func (dcuo *DailyChallengeUpdateOne) AddSeed(i int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.AddSeed(i)
+	return dcuo
+}
+
+// SetCardCount sets the "card_count" field.
+func (dcuo *DailyChallengeUpdateOne) SetCardCount(i int) *DailyChallengeUpdateOne {
+	dcuo.mutation.ResetCardCount()
+	dcuo.mutation.SetCardCount(i)
+	return dcuo
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableCardCount(i *int) *DailyChallengeUpdateOne {
+	if i != nil {
+		dcuo.SetCardCount(*i)
+	}
+	return dcuo
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (dcuo *DailyChallengeUpdateOne) AddCardCount(i int) *DailyChallengeUpdateOne {
+	dcuo.mutation.AddCardCount(i)
+	return dcuo
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (dcuo *DailyChallengeUpdateOne) SetTotalMs(i int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.ResetTotalMs()
+	dcuo.mutation.SetTotalMs(i)
+	return dcuo
+}
+
+// SetNillableTotalMs sets the "total_ms" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableTotalMs(i *int64) *DailyChallengeUpdateOne {
+	if i != nil {
+		dcuo.SetTotalMs(*i)
+	}
+	return dcuo
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (dcuo *DailyChallengeUpdateOne) AddTotalMs(i int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.AddTotalMs(i)
+	return dcuo
+}
+
+// ClearTotalMs clears the value of the "total_ms" field.
+func (dcuo *DailyChallengeUpdateOne) ClearTotalMs() *DailyChallengeUpdateOne {
+	dcuo.mutation.ClearTotalMs()
+	return dcuo
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (dcuo *DailyChallengeUpdateOne) SetReactionMs(i []int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.SetReactionMs(i)
+	return dcuo
+}
+
+// AppendReactionMs appends i to the "reaction_ms" field.
+func (dcuo *DailyChallengeUpdateOne) AppendReactionMs(i []int64) *DailyChallengeUpdateOne {
+	dcuo.mutation.AppendReactionMs(i)
+	return dcuo
+}
+
+// ClearReactionMs clears the value of the "reaction_ms" field.
+func (dcuo *DailyChallengeUpdateOne) ClearReactionMs() *DailyChallengeUpdateOne {
+	dcuo.mutation.ClearReactionMs()
+	return dcuo
+}
+
+// SetMistakes sets the "mistakes" field.
+func (dcuo *DailyChallengeUpdateOne) SetMistakes(i int) *DailyChallengeUpdateOne {
+	dcuo.mutation.ResetMistakes()
+	dcuo.mutation.SetMistakes(i)
+	return dcuo
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (dcuo *DailyChallengeUpdateOne) SetNillableMistakes(i *int) *DailyChallengeUpdateOne {
+	if i != nil {
+		dcuo.SetMistakes(*i)
+	}
+	return dcuo
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (dcuo *DailyChallengeUpdateOne) AddMistakes(i int) *DailyChallengeUpdateOne {
+	dcuo.mutation.AddMistakes(i)
+	return dcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dcuo *DailyChallengeUpdateOne) SetUser(u *User) *DailyChallengeUpdateOne {
+	return dcuo.SetUserID(u.ID)
+}
+
+// Mutation returns the DailyChallengeMutation object of the builder.
+func (dcuo *DailyChallengeUpdateOne) Mutation() *DailyChallengeMutation {
+	return dcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (dcuo *DailyChallengeUpdateOne) ClearUser() *DailyChallengeUpdateOne {
+	dcuo.mutation.ClearUser()
+	return dcuo
+}
+
+// Where appends a list predicates to the DailyChallengeUpdate builder.
+func (dcuo *DailyChallengeUpdateOne) Where(ps ...predicate.DailyChallenge) *DailyChallengeUpdateOne {
+	dcuo.mutation.Where(ps...)
+	return dcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dcuo *DailyChallengeUpdateOne) Select(field string, fields ...string) *DailyChallengeUpdateOne {
+	dcuo.fields = append([]string{field}, fields...)
+	return dcuo
+}
+
+// Save executes the query and returns the updated DailyChallenge entity.
+func (dcuo *DailyChallengeUpdateOne) Save(ctx context.Context) (*DailyChallenge, error) {
+	return withHooks(ctx, dcuo.sqlSave, dcuo.mutation, dcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcuo *DailyChallengeUpdateOne) SaveX(ctx context.Context) *DailyChallenge {
+	node, err := dcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dcuo *DailyChallengeUpdateOne) Exec(ctx context.Context) error {
+	_, err := dcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcuo *DailyChallengeUpdateOne) ExecX(ctx context.Context) {
+	if err := dcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dcuo *DailyChallengeUpdateOne) check() error {
+	if v, ok := dcuo.mutation.PlayerName(); ok {
+		if err := dailychallenge.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "DailyChallenge.player_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (dcuo *DailyChallengeUpdateOne) sqlSave(ctx context.Context) (_node *DailyChallenge, err error) {
+	if err := dcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dailychallenge.Table, dailychallenge.Columns, sqlgraph.NewFieldSpec(dailychallenge.FieldID, field.TypeInt))
+	id, ok := dcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DailyChallenge.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dailychallenge.FieldID)
+		for _, f := range fields {
+			if !dailychallenge.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dailychallenge.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dcuo.mutation.Day(); ok {
+		_spec.SetField(dailychallenge.FieldDay, field.TypeString, value)
+	}
+	if value, ok := dcuo.mutation.PlayerName(); ok {
+		_spec.SetField(dailychallenge.FieldPlayerName, field.TypeString, value)
+	}
+	if value, ok := dcuo.mutation.Seed(); ok {
+		_spec.SetField(dailychallenge.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := dcuo.mutation.AddedSeed(); ok {
+		_spec.AddField(dailychallenge.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := dcuo.mutation.CardCount(); ok {
+		_spec.SetField(dailychallenge.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedCardCount(); ok {
+		_spec.AddField(dailychallenge.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.TotalMs(); ok {
+		_spec.SetField(dailychallenge.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := dcuo.mutation.AddedTotalMs(); ok {
+		_spec.AddField(dailychallenge.FieldTotalMs, field.TypeInt64, value)
+	}
+	if dcuo.mutation.TotalMsCleared() {
+		_spec.ClearField(dailychallenge.FieldTotalMs, field.TypeInt64)
+	}
+	if value, ok := dcuo.mutation.ReactionMs(); ok {
+		_spec.SetField(dailychallenge.FieldReactionMs, field.TypeJSON, value)
+	}
+	if value, ok := dcuo.mutation.AppendedReactionMs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, dailychallenge.FieldReactionMs, value)
+		})
+	}
+	if dcuo.mutation.ReactionMsCleared() {
+		_spec.ClearField(dailychallenge.FieldReactionMs, field.TypeJSON)
+	}
+	if value, ok := dcuo.mutation.Mistakes(); ok {
+		_spec.SetField(dailychallenge.FieldMistakes, field.TypeInt, value)
+	}
+	if value, ok := dcuo.mutation.AddedMistakes(); ok {
+		_spec.AddField(dailychallenge.FieldMistakes, field.TypeInt, value)
+	}
+	if dcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dailychallenge.UserTable,
+			Columns: []string{dailychallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   dailychallenge.UserTable,
+			Columns: []string{dailychallenge.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DailyChallenge{config: dcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dailychallenge.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dcuo.mutation.done = true
+	return _node, nil
+}
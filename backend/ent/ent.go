@@ -5,10 +5,19 @@ package ent
 import (
 	"context"
 	"errors"
+	"example/ent/ban"
 	"example/ent/card"
+	"example/ent/dailychallenge"
 	"example/ent/game"
 	"example/ent/item"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/practicerun"
+	"example/ent/team"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	"example/ent/user"
 	"fmt"
 	"reflect"
 	"sync"
@@ -76,10 +85,19 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			card.Table:   card.ValidColumn,
-			game.Table:   game.ValidColumn,
-			item.Table:   item.ValidColumn,
-			player.Table: player.ValidColumn,
+			ban.Table:               ban.ValidColumn,
+			card.Table:              card.ValidColumn,
+			dailychallenge.Table:    dailychallenge.ValidColumn,
+			game.Table:              game.ValidColumn,
+			item.Table:              item.ValidColumn,
+			match.Table:             match.ValidColumn,
+			player.Table:            player.ValidColumn,
+			practicerun.Table:       practicerun.ValidColumn,
+			team.Table:              team.ValidColumn,
+			tournament.Table:        tournament.ValidColumn,
+			tournamententrant.Table: tournamententrant.ValidColumn,
+			tournamentmatch.Table:   tournamentmatch.ValidColumn,
+			user.Table:              user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -3,7 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"example/ent/game"
+	"example/ent/match"
+	"example/internal/scoring"
 	"fmt"
 	"strings"
 
@@ -22,9 +25,44 @@ type Game struct {
 	Status game.Status `json:"status,omitempty"`
 	// TotalRounds holds the value of the "total_rounds" field.
 	TotalRounds int `json:"total_rounds,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// TeamScore holds the value of the "team_score" field.
+	TeamScore int `json:"team_score,omitempty"`
+	// EliminationInterval holds the value of the "elimination_interval" field.
+	EliminationInterval int `json:"elimination_interval,omitempty"`
+	// CenterCount holds the value of the "center_count" field.
+	CenterCount int `json:"center_count,omitempty"`
+	// TieBreak holds the value of the "tie_break" field.
+	TieBreak game.TieBreak `json:"tie_break,omitempty"`
+	// Scoring holds the value of the "scoring" field.
+	Scoring scoring.Rules `json:"scoring,omitempty"`
+	// MaxPlayers holds the value of the "max_players" field.
+	MaxPlayers int `json:"max_players,omitempty"`
+	// MinPlayers holds the value of the "min_players" field.
+	MinPlayers int `json:"min_players,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility game.Visibility `json:"visibility,omitempty"`
+	// InviteCode holds the value of the "invite_code" field.
+	InviteCode string `json:"-"`
+	// PasswordHash holds the value of the "password_hash" field.
+	PasswordHash string `json:"-"`
+	// HostToken holds the value of the "host_token" field.
+	HostToken string `json:"-"`
+	// AutoStart holds the value of the "auto_start" field.
+	AutoStart bool `json:"auto_start,omitempty"`
+	// CardCount holds the value of the "card_count" field.
+	CardCount int `json:"card_count,omitempty"`
+	// WinnerID holds the value of the "winner_id" field.
+	WinnerID *int `json:"winner_id,omitempty"`
+	// AutoBalance holds the value of the "auto_balance" field.
+	AutoBalance bool `json:"auto_balance,omitempty"`
+	// MatchID holds the value of the "match_id" field.
+	MatchID int `json:"match_id,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the GameQuery when eager-loading is set.
 	Edges        GameEdges `json:"edges"`
+	game_rematch *int
 	selectValues sql.SelectValues
 }
 
@@ -32,9 +70,19 @@ type Game struct {
 type GameEdges struct {
 	// Players holds the value of the players edge.
 	Players []*Player `json:"players,omitempty"`
+	// Teams holds the value of the teams edge.
+	Teams []*Team `json:"teams,omitempty"`
+	// TournamentMatches holds the value of the tournament_matches edge.
+	TournamentMatches []*TournamentMatch `json:"tournament_matches,omitempty"`
+	// Match holds the value of the match edge.
+	Match *Match `json:"match,omitempty"`
+	// Previous holds the value of the previous edge.
+	Previous *Game `json:"previous,omitempty"`
+	// Rematch holds the value of the rematch edge.
+	Rematch *Game `json:"rematch,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [6]bool
 }
 
 // PlayersOrErr returns the Players value or an error if the edge
@@ -46,15 +94,72 @@ func (e GameEdges) PlayersOrErr() ([]*Player, error) {
 	return nil, &NotLoadedError{edge: "players"}
 }
 
+// TeamsOrErr returns the Teams value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) TeamsOrErr() ([]*Team, error) {
+	if e.loadedTypes[1] {
+		return e.Teams, nil
+	}
+	return nil, &NotLoadedError{edge: "teams"}
+}
+
+// TournamentMatchesOrErr returns the TournamentMatches value or an error if the edge
+// was not loaded in eager-loading.
+func (e GameEdges) TournamentMatchesOrErr() ([]*TournamentMatch, error) {
+	if e.loadedTypes[2] {
+		return e.TournamentMatches, nil
+	}
+	return nil, &NotLoadedError{edge: "tournament_matches"}
+}
+
+// MatchOrErr returns the Match value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameEdges) MatchOrErr() (*Match, error) {
+	if e.Match != nil {
+		return e.Match, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: match.Label}
+	}
+	return nil, &NotLoadedError{edge: "match"}
+}
+
+// PreviousOrErr returns the Previous value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameEdges) PreviousOrErr() (*Game, error) {
+	if e.Previous != nil {
+		return e.Previous, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "previous"}
+}
+
+// RematchOrErr returns the Rematch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e GameEdges) RematchOrErr() (*Game, error) {
+	if e.Rematch != nil {
+		return e.Rematch, nil
+	} else if e.loadedTypes[5] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "rematch"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Game) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case game.FieldID, game.FieldTotalRounds:
+		case game.FieldScoring:
+			values[i] = new([]byte)
+		case game.FieldAutoStart, game.FieldAutoBalance:
+			values[i] = new(sql.NullBool)
+		case game.FieldID, game.FieldTotalRounds, game.FieldTeamScore, game.FieldEliminationInterval, game.FieldCenterCount, game.FieldMaxPlayers, game.FieldMinPlayers, game.FieldCardCount, game.FieldWinnerID, game.FieldMatchID:
 			values[i] = new(sql.NullInt64)
-		case game.FieldName, game.FieldStatus:
+		case game.FieldName, game.FieldStatus, game.FieldMode, game.FieldTieBreak, game.FieldVisibility, game.FieldInviteCode, game.FieldPasswordHash, game.FieldHostToken:
 			values[i] = new(sql.NullString)
+		case game.ForeignKeys[0]: // game_rematch
+			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -94,6 +199,118 @@ func (ga *Game) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ga.TotalRounds = int(value.Int64)
 			}
+		case game.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				ga.Mode = value.String
+			}
+		case game.FieldTeamScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_score", values[i])
+			} else if value.Valid {
+				ga.TeamScore = int(value.Int64)
+			}
+		case game.FieldEliminationInterval:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field elimination_interval", values[i])
+			} else if value.Valid {
+				ga.EliminationInterval = int(value.Int64)
+			}
+		case game.FieldCenterCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field center_count", values[i])
+			} else if value.Valid {
+				ga.CenterCount = int(value.Int64)
+			}
+		case game.FieldTieBreak:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tie_break", values[i])
+			} else if value.Valid {
+				ga.TieBreak = game.TieBreak(value.String)
+			}
+		case game.FieldScoring:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scoring", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ga.Scoring); err != nil {
+					return fmt.Errorf("unmarshal field scoring: %w", err)
+				}
+			}
+		case game.FieldMaxPlayers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_players", values[i])
+			} else if value.Valid {
+				ga.MaxPlayers = int(value.Int64)
+			}
+		case game.FieldMinPlayers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field min_players", values[i])
+			} else if value.Valid {
+				ga.MinPlayers = int(value.Int64)
+			}
+		case game.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				ga.Visibility = game.Visibility(value.String)
+			}
+		case game.FieldInviteCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field invite_code", values[i])
+			} else if value.Valid {
+				ga.InviteCode = value.String
+			}
+		case game.FieldPasswordHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hash", values[i])
+			} else if value.Valid {
+				ga.PasswordHash = value.String
+			}
+		case game.FieldHostToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field host_token", values[i])
+			} else if value.Valid {
+				ga.HostToken = value.String
+			}
+		case game.FieldAutoStart:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_start", values[i])
+			} else if value.Valid {
+				ga.AutoStart = value.Bool
+			}
+		case game.FieldCardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card_count", values[i])
+			} else if value.Valid {
+				ga.CardCount = int(value.Int64)
+			}
+		case game.FieldWinnerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field winner_id", values[i])
+			} else if value.Valid {
+				ga.WinnerID = new(int)
+				*ga.WinnerID = int(value.Int64)
+			}
+		case game.FieldAutoBalance:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_balance", values[i])
+			} else if value.Valid {
+				ga.AutoBalance = value.Bool
+			}
+		case game.FieldMatchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field match_id", values[i])
+			} else if value.Valid {
+				ga.MatchID = int(value.Int64)
+			}
+		case game.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field game_rematch", value)
+			} else if value.Valid {
+				ga.game_rematch = new(int)
+				*ga.game_rematch = int(value.Int64)
+			}
 		default:
 			ga.selectValues.Set(columns[i], values[i])
 		}
@@ -112,6 +329,31 @@ func (ga *Game) QueryPlayers() *PlayerQuery {
 	return NewGameClient(ga.config).QueryPlayers(ga)
 }
 
+// QueryTeams queries the "teams" edge of the Game entity.
+func (ga *Game) QueryTeams() *TeamQuery {
+	return NewGameClient(ga.config).QueryTeams(ga)
+}
+
+// QueryTournamentMatches queries the "tournament_matches" edge of the Game entity.
+func (ga *Game) QueryTournamentMatches() *TournamentMatchQuery {
+	return NewGameClient(ga.config).QueryTournamentMatches(ga)
+}
+
+// QueryMatch queries the "match" edge of the Game entity.
+func (ga *Game) QueryMatch() *MatchQuery {
+	return NewGameClient(ga.config).QueryMatch(ga)
+}
+
+// QueryPrevious queries the "previous" edge of the Game entity.
+func (ga *Game) QueryPrevious() *GameQuery {
+	return NewGameClient(ga.config).QueryPrevious(ga)
+}
+
+// QueryRematch queries the "rematch" edge of the Game entity.
+func (ga *Game) QueryRematch() *GameQuery {
+	return NewGameClient(ga.config).QueryRematch(ga)
+}
+
 // Update returns a builder for updating this Game.
 // Note that you need to call Game.Unwrap() before calling this method if this Game
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -143,6 +385,56 @@ func (ga *Game) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("total_rounds=")
 	builder.WriteString(fmt.Sprintf("%v", ga.TotalRounds))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(ga.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("team_score=")
+	builder.WriteString(fmt.Sprintf("%v", ga.TeamScore))
+	builder.WriteString(", ")
+	builder.WriteString("elimination_interval=")
+	builder.WriteString(fmt.Sprintf("%v", ga.EliminationInterval))
+	builder.WriteString(", ")
+	builder.WriteString("center_count=")
+	builder.WriteString(fmt.Sprintf("%v", ga.CenterCount))
+	builder.WriteString(", ")
+	builder.WriteString("tie_break=")
+	builder.WriteString(fmt.Sprintf("%v", ga.TieBreak))
+	builder.WriteString(", ")
+	builder.WriteString("scoring=")
+	builder.WriteString(fmt.Sprintf("%v", ga.Scoring))
+	builder.WriteString(", ")
+	builder.WriteString("max_players=")
+	builder.WriteString(fmt.Sprintf("%v", ga.MaxPlayers))
+	builder.WriteString(", ")
+	builder.WriteString("min_players=")
+	builder.WriteString(fmt.Sprintf("%v", ga.MinPlayers))
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", ga.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("invite_code=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("password_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("host_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("auto_start=")
+	builder.WriteString(fmt.Sprintf("%v", ga.AutoStart))
+	builder.WriteString(", ")
+	builder.WriteString("card_count=")
+	builder.WriteString(fmt.Sprintf("%v", ga.CardCount))
+	builder.WriteString(", ")
+	if v := ga.WinnerID; v != nil {
+		builder.WriteString("winner_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("auto_balance=")
+	builder.WriteString(fmt.Sprintf("%v", ga.AutoBalance))
+	builder.WriteString(", ")
+	builder.WriteString("match_id=")
+	builder.WriteString(fmt.Sprintf("%v", ga.MatchID))
 	builder.WriteByte(')')
 	return builder.String()
 }
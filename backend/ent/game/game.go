@@ -3,6 +3,7 @@
 package game
 
 import (
+	"example/internal/scoring"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -20,8 +21,52 @@ const (
 	FieldStatus = "status"
 	// FieldTotalRounds holds the string denoting the total_rounds field in the database.
 	FieldTotalRounds = "total_rounds"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldTeamScore holds the string denoting the team_score field in the database.
+	FieldTeamScore = "team_score"
+	// FieldEliminationInterval holds the string denoting the elimination_interval field in the database.
+	FieldEliminationInterval = "elimination_interval"
+	// FieldCenterCount holds the string denoting the center_count field in the database.
+	FieldCenterCount = "center_count"
+	// FieldTieBreak holds the string denoting the tie_break field in the database.
+	FieldTieBreak = "tie_break"
+	// FieldScoring holds the string denoting the scoring field in the database.
+	FieldScoring = "scoring"
+	// FieldMaxPlayers holds the string denoting the max_players field in the database.
+	FieldMaxPlayers = "max_players"
+	// FieldMinPlayers holds the string denoting the min_players field in the database.
+	FieldMinPlayers = "min_players"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
+	// FieldInviteCode holds the string denoting the invite_code field in the database.
+	FieldInviteCode = "invite_code"
+	// FieldPasswordHash holds the string denoting the password_hash field in the database.
+	FieldPasswordHash = "password_hash"
+	// FieldHostToken holds the string denoting the host_token field in the database.
+	FieldHostToken = "host_token"
+	// FieldAutoStart holds the string denoting the auto_start field in the database.
+	FieldAutoStart = "auto_start"
+	// FieldCardCount holds the string denoting the card_count field in the database.
+	FieldCardCount = "card_count"
+	// FieldWinnerID holds the string denoting the winner_id field in the database.
+	FieldWinnerID = "winner_id"
+	// FieldAutoBalance holds the string denoting the auto_balance field in the database.
+	FieldAutoBalance = "auto_balance"
+	// FieldMatchID holds the string denoting the match_id field in the database.
+	FieldMatchID = "match_id"
 	// EdgePlayers holds the string denoting the players edge name in mutations.
 	EdgePlayers = "players"
+	// EdgeTeams holds the string denoting the teams edge name in mutations.
+	EdgeTeams = "teams"
+	// EdgeTournamentMatches holds the string denoting the tournament_matches edge name in mutations.
+	EdgeTournamentMatches = "tournament_matches"
+	// EdgeMatch holds the string denoting the match edge name in mutations.
+	EdgeMatch = "match"
+	// EdgePrevious holds the string denoting the previous edge name in mutations.
+	EdgePrevious = "previous"
+	// EdgeRematch holds the string denoting the rematch edge name in mutations.
+	EdgeRematch = "rematch"
 	// Table holds the table name of the game in the database.
 	Table = "games"
 	// PlayersTable is the table that holds the players relation/edge.
@@ -31,6 +76,35 @@ const (
 	PlayersInverseTable = "players"
 	// PlayersColumn is the table column denoting the players relation/edge.
 	PlayersColumn = "player_parent"
+	// TeamsTable is the table that holds the teams relation/edge.
+	TeamsTable = "teams"
+	// TeamsInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamsInverseTable = "teams"
+	// TeamsColumn is the table column denoting the teams relation/edge.
+	TeamsColumn = "team_parent"
+	// TournamentMatchesTable is the table that holds the tournament_matches relation/edge.
+	TournamentMatchesTable = "tournament_matches"
+	// TournamentMatchesInverseTable is the table name for the TournamentMatch entity.
+	// It exists in this package in order to avoid circular dependency with the "tournamentmatch" package.
+	TournamentMatchesInverseTable = "tournament_matches"
+	// TournamentMatchesColumn is the table column denoting the tournament_matches relation/edge.
+	TournamentMatchesColumn = "game_id"
+	// MatchTable is the table that holds the match relation/edge.
+	MatchTable = "games"
+	// MatchInverseTable is the table name for the Match entity.
+	// It exists in this package in order to avoid circular dependency with the "match" package.
+	MatchInverseTable = "matches"
+	// MatchColumn is the table column denoting the match relation/edge.
+	MatchColumn = "match_id"
+	// PreviousTable is the table that holds the previous relation/edge.
+	PreviousTable = "games"
+	// PreviousColumn is the table column denoting the previous relation/edge.
+	PreviousColumn = "game_rematch"
+	// RematchTable is the table that holds the rematch relation/edge.
+	RematchTable = "games"
+	// RematchColumn is the table column denoting the rematch relation/edge.
+	RematchColumn = "game_rematch"
 )
 
 // Columns holds all SQL columns for game fields.
@@ -39,6 +113,29 @@ var Columns = []string{
 	FieldName,
 	FieldStatus,
 	FieldTotalRounds,
+	FieldMode,
+	FieldTeamScore,
+	FieldEliminationInterval,
+	FieldCenterCount,
+	FieldTieBreak,
+	FieldScoring,
+	FieldMaxPlayers,
+	FieldMinPlayers,
+	FieldVisibility,
+	FieldInviteCode,
+	FieldPasswordHash,
+	FieldHostToken,
+	FieldAutoStart,
+	FieldCardCount,
+	FieldWinnerID,
+	FieldAutoBalance,
+	FieldMatchID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "games"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"game_rematch",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -48,6 +145,11 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
 	return false
 }
 
@@ -56,6 +158,26 @@ var (
 	NameValidator func(string) error
 	// DefaultTotalRounds holds the default value on creation for the "total_rounds" field.
 	DefaultTotalRounds int
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode string
+	// DefaultTeamScore holds the default value on creation for the "team_score" field.
+	DefaultTeamScore int
+	// DefaultEliminationInterval holds the default value on creation for the "elimination_interval" field.
+	DefaultEliminationInterval int
+	// DefaultCenterCount holds the default value on creation for the "center_count" field.
+	DefaultCenterCount int
+	// DefaultScoring holds the default value on creation for the "scoring" field.
+	DefaultScoring scoring.Rules
+	// DefaultMaxPlayers holds the default value on creation for the "max_players" field.
+	DefaultMaxPlayers int
+	// DefaultMinPlayers holds the default value on creation for the "min_players" field.
+	DefaultMinPlayers int
+	// DefaultAutoStart holds the default value on creation for the "auto_start" field.
+	DefaultAutoStart bool
+	// DefaultCardCount holds the default value on creation for the "card_count" field.
+	DefaultCardCount int
+	// DefaultAutoBalance holds the default value on creation for the "auto_balance" field.
+	DefaultAutoBalance bool
 )
 
 // Status defines the type for the "status" enum field.
@@ -68,6 +190,7 @@ const DefaultStatus = StatusCREATED
 const (
 	StatusCREATED  Status = "CREATED"
 	StatusSTARTED  Status = "STARTED"
+	StatusPAUSED   Status = "PAUSED"
 	StatusFINISHED Status = "FINISHED"
 )
 
@@ -78,13 +201,66 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusCREATED, StatusSTARTED, StatusFINISHED:
+	case StatusCREATED, StatusSTARTED, StatusPAUSED, StatusFINISHED:
 		return nil
 	default:
 		return fmt.Errorf("game: invalid enum value for status field: %q", s)
 	}
 }
 
+// TieBreak defines the type for the "tie_break" enum field.
+type TieBreak string
+
+// TieBreakNONE is the default value of the TieBreak enum.
+const DefaultTieBreak = TieBreakNONE
+
+// TieBreak values.
+const (
+	TieBreakNONE       TieBreak = "NONE"
+	TieBreakREDRAW     TieBreak = "REDRAW"
+	TieBreakFRESH_DECK TieBreak = "FRESH_DECK"
+)
+
+func (tb TieBreak) String() string {
+	return string(tb)
+}
+
+// TieBreakValidator is a validator for the "tie_break" field enum values. It is called by the builders before save.
+func TieBreakValidator(tb TieBreak) error {
+	switch tb {
+	case TieBreakNONE, TieBreakREDRAW, TieBreakFRESH_DECK:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for tie_break field: %q", tb)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityPUBLIC is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityPUBLIC
+
+// Visibility values.
+const (
+	VisibilityPUBLIC  Visibility = "PUBLIC"
+	VisibilityPRIVATE Visibility = "PRIVATE"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityPUBLIC, VisibilityPRIVATE:
+		return nil
+	default:
+		return fmt.Errorf("game: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Game queries.
 type OrderOption func(*sql.Selector)
 
@@ -108,6 +284,86 @@ func ByTotalRounds(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTotalRounds, opts...).ToFunc()
 }
 
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByTeamScore orders the results by the team_score field.
+func ByTeamScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamScore, opts...).ToFunc()
+}
+
+// ByEliminationInterval orders the results by the elimination_interval field.
+func ByEliminationInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEliminationInterval, opts...).ToFunc()
+}
+
+// ByCenterCount orders the results by the center_count field.
+func ByCenterCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCenterCount, opts...).ToFunc()
+}
+
+// ByTieBreak orders the results by the tie_break field.
+func ByTieBreak(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTieBreak, opts...).ToFunc()
+}
+
+// ByMaxPlayers orders the results by the max_players field.
+func ByMaxPlayers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxPlayers, opts...).ToFunc()
+}
+
+// ByMinPlayers orders the results by the min_players field.
+func ByMinPlayers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMinPlayers, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
+// ByInviteCode orders the results by the invite_code field.
+func ByInviteCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInviteCode, opts...).ToFunc()
+}
+
+// ByPasswordHash orders the results by the password_hash field.
+func ByPasswordHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHash, opts...).ToFunc()
+}
+
+// ByHostToken orders the results by the host_token field.
+func ByHostToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHostToken, opts...).ToFunc()
+}
+
+// ByAutoStart orders the results by the auto_start field.
+func ByAutoStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoStart, opts...).ToFunc()
+}
+
+// ByCardCount orders the results by the card_count field.
+func ByCardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardCount, opts...).ToFunc()
+}
+
+// ByWinnerID orders the results by the winner_id field.
+func ByWinnerID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWinnerID, opts...).ToFunc()
+}
+
+// ByAutoBalance orders the results by the auto_balance field.
+func ByAutoBalance(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoBalance, opts...).ToFunc()
+}
+
+// ByMatchID orders the results by the match_id field.
+func ByMatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMatchID, opts...).ToFunc()
+}
+
 // ByPlayersCount orders the results by players count.
 func ByPlayersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -121,6 +377,55 @@ func ByPlayers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPlayersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTeamsCount orders the results by teams count.
+func ByTeamsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTeamsStep(), opts...)
+	}
+}
+
+// ByTeams orders the results by teams terms.
+func ByTeams(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTournamentMatchesCount orders the results by tournament_matches count.
+func ByTournamentMatchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTournamentMatchesStep(), opts...)
+	}
+}
+
+// ByTournamentMatches orders the results by tournament_matches terms.
+func ByTournamentMatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTournamentMatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMatchField orders the results by match field.
+func ByMatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPreviousField orders the results by previous field.
+func ByPreviousField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPreviousStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRematchField orders the results by rematch field.
+func ByRematchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRematchStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newPlayersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -128,3 +433,38 @@ func newPlayersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, PlayersTable, PlayersColumn),
 	)
 }
+func newTeamsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, TeamsTable, TeamsColumn),
+	)
+}
+func newTournamentMatchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TournamentMatchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, TournamentMatchesTable, TournamentMatchesColumn),
+	)
+}
+func newMatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, MatchTable, MatchColumn),
+	)
+}
+func newPreviousStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, PreviousTable, PreviousColumn),
+	)
+}
+func newRematchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, RematchTable, RematchColumn),
+	)
+}
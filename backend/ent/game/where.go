@@ -64,6 +64,76 @@ func TotalRounds(v int) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldTotalRounds, v))
 }
 
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMode, v))
+}
+
+// TeamScore applies equality check predicate on the "team_score" field. It's identical to TeamScoreEQ.
+func TeamScore(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldTeamScore, v))
+}
+
+// EliminationInterval applies equality check predicate on the "elimination_interval" field. It's identical to EliminationIntervalEQ.
+func EliminationInterval(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldEliminationInterval, v))
+}
+
+// CenterCount applies equality check predicate on the "center_count" field. It's identical to CenterCountEQ.
+func CenterCount(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldCenterCount, v))
+}
+
+// MaxPlayers applies equality check predicate on the "max_players" field. It's identical to MaxPlayersEQ.
+func MaxPlayers(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMaxPlayers, v))
+}
+
+// MinPlayers applies equality check predicate on the "min_players" field. It's identical to MinPlayersEQ.
+func MinPlayers(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMinPlayers, v))
+}
+
+// InviteCode applies equality check predicate on the "invite_code" field. It's identical to InviteCodeEQ.
+func InviteCode(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldInviteCode, v))
+}
+
+// PasswordHash applies equality check predicate on the "password_hash" field. It's identical to PasswordHashEQ.
+func PasswordHash(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// HostToken applies equality check predicate on the "host_token" field. It's identical to HostTokenEQ.
+func HostToken(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldHostToken, v))
+}
+
+// AutoStart applies equality check predicate on the "auto_start" field. It's identical to AutoStartEQ.
+func AutoStart(v bool) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldAutoStart, v))
+}
+
+// CardCount applies equality check predicate on the "card_count" field. It's identical to CardCountEQ.
+func CardCount(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldCardCount, v))
+}
+
+// WinnerID applies equality check predicate on the "winner_id" field. It's identical to WinnerIDEQ.
+func WinnerID(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldWinnerID, v))
+}
+
+// AutoBalance applies equality check predicate on the "auto_balance" field. It's identical to AutoBalanceEQ.
+func AutoBalance(v bool) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldAutoBalance, v))
+}
+
+// MatchID applies equality check predicate on the "match_id" field. It's identical to MatchIDEQ.
+func MatchID(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMatchID, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Game {
 	return predicate.Game(sql.FieldEQ(FieldName, v))
@@ -189,6 +259,676 @@ func TotalRoundsLTE(v int) predicate.Game {
 	return predicate.Game(sql.FieldLTE(FieldTotalRounds, v))
 }
 
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.Game {
+	return predicate.Game(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldContainsFold(FieldMode, v))
+}
+
+// TeamScoreEQ applies the EQ predicate on the "team_score" field.
+func TeamScoreEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldTeamScore, v))
+}
+
+// TeamScoreNEQ applies the NEQ predicate on the "team_score" field.
+func TeamScoreNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldTeamScore, v))
+}
+
+// TeamScoreIn applies the In predicate on the "team_score" field.
+func TeamScoreIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldTeamScore, vs...))
+}
+
+// TeamScoreNotIn applies the NotIn predicate on the "team_score" field.
+func TeamScoreNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldTeamScore, vs...))
+}
+
+// TeamScoreGT applies the GT predicate on the "team_score" field.
+func TeamScoreGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldTeamScore, v))
+}
+
+// TeamScoreGTE applies the GTE predicate on the "team_score" field.
+func TeamScoreGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldTeamScore, v))
+}
+
+// TeamScoreLT applies the LT predicate on the "team_score" field.
+func TeamScoreLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldTeamScore, v))
+}
+
+// TeamScoreLTE applies the LTE predicate on the "team_score" field.
+func TeamScoreLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldTeamScore, v))
+}
+
+// EliminationIntervalEQ applies the EQ predicate on the "elimination_interval" field.
+func EliminationIntervalEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldEliminationInterval, v))
+}
+
+// EliminationIntervalNEQ applies the NEQ predicate on the "elimination_interval" field.
+func EliminationIntervalNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldEliminationInterval, v))
+}
+
+// EliminationIntervalIn applies the In predicate on the "elimination_interval" field.
+func EliminationIntervalIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldEliminationInterval, vs...))
+}
+
+// EliminationIntervalNotIn applies the NotIn predicate on the "elimination_interval" field.
+func EliminationIntervalNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldEliminationInterval, vs...))
+}
+
+// EliminationIntervalGT applies the GT predicate on the "elimination_interval" field.
+func EliminationIntervalGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldEliminationInterval, v))
+}
+
+// EliminationIntervalGTE applies the GTE predicate on the "elimination_interval" field.
+func EliminationIntervalGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldEliminationInterval, v))
+}
+
+// EliminationIntervalLT applies the LT predicate on the "elimination_interval" field.
+func EliminationIntervalLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldEliminationInterval, v))
+}
+
+// EliminationIntervalLTE applies the LTE predicate on the "elimination_interval" field.
+func EliminationIntervalLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldEliminationInterval, v))
+}
+
+// CenterCountEQ applies the EQ predicate on the "center_count" field.
+func CenterCountEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldCenterCount, v))
+}
+
+// CenterCountNEQ applies the NEQ predicate on the "center_count" field.
+func CenterCountNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldCenterCount, v))
+}
+
+// CenterCountIn applies the In predicate on the "center_count" field.
+func CenterCountIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldCenterCount, vs...))
+}
+
+// CenterCountNotIn applies the NotIn predicate on the "center_count" field.
+func CenterCountNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldCenterCount, vs...))
+}
+
+// CenterCountGT applies the GT predicate on the "center_count" field.
+func CenterCountGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldCenterCount, v))
+}
+
+// CenterCountGTE applies the GTE predicate on the "center_count" field.
+func CenterCountGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldCenterCount, v))
+}
+
+// CenterCountLT applies the LT predicate on the "center_count" field.
+func CenterCountLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldCenterCount, v))
+}
+
+// CenterCountLTE applies the LTE predicate on the "center_count" field.
+func CenterCountLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldCenterCount, v))
+}
+
+// TieBreakEQ applies the EQ predicate on the "tie_break" field.
+func TieBreakEQ(v TieBreak) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldTieBreak, v))
+}
+
+// TieBreakNEQ applies the NEQ predicate on the "tie_break" field.
+func TieBreakNEQ(v TieBreak) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldTieBreak, v))
+}
+
+// TieBreakIn applies the In predicate on the "tie_break" field.
+func TieBreakIn(vs ...TieBreak) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldTieBreak, vs...))
+}
+
+// TieBreakNotIn applies the NotIn predicate on the "tie_break" field.
+func TieBreakNotIn(vs ...TieBreak) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldTieBreak, vs...))
+}
+
+// MaxPlayersEQ applies the EQ predicate on the "max_players" field.
+func MaxPlayersEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMaxPlayers, v))
+}
+
+// MaxPlayersNEQ applies the NEQ predicate on the "max_players" field.
+func MaxPlayersNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldMaxPlayers, v))
+}
+
+// MaxPlayersIn applies the In predicate on the "max_players" field.
+func MaxPlayersIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldMaxPlayers, vs...))
+}
+
+// MaxPlayersNotIn applies the NotIn predicate on the "max_players" field.
+func MaxPlayersNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldMaxPlayers, vs...))
+}
+
+// MaxPlayersGT applies the GT predicate on the "max_players" field.
+func MaxPlayersGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldMaxPlayers, v))
+}
+
+// MaxPlayersGTE applies the GTE predicate on the "max_players" field.
+func MaxPlayersGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldMaxPlayers, v))
+}
+
+// MaxPlayersLT applies the LT predicate on the "max_players" field.
+func MaxPlayersLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldMaxPlayers, v))
+}
+
+// MaxPlayersLTE applies the LTE predicate on the "max_players" field.
+func MaxPlayersLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldMaxPlayers, v))
+}
+
+// MinPlayersEQ applies the EQ predicate on the "min_players" field.
+func MinPlayersEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMinPlayers, v))
+}
+
+// MinPlayersNEQ applies the NEQ predicate on the "min_players" field.
+func MinPlayersNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldMinPlayers, v))
+}
+
+// MinPlayersIn applies the In predicate on the "min_players" field.
+func MinPlayersIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldMinPlayers, vs...))
+}
+
+// MinPlayersNotIn applies the NotIn predicate on the "min_players" field.
+func MinPlayersNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldMinPlayers, vs...))
+}
+
+// MinPlayersGT applies the GT predicate on the "min_players" field.
+func MinPlayersGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldMinPlayers, v))
+}
+
+// MinPlayersGTE applies the GTE predicate on the "min_players" field.
+func MinPlayersGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldMinPlayers, v))
+}
+
+// MinPlayersLT applies the LT predicate on the "min_players" field.
+func MinPlayersLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldMinPlayers, v))
+}
+
+// MinPlayersLTE applies the LTE predicate on the "min_players" field.
+func MinPlayersLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldMinPlayers, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// InviteCodeEQ applies the EQ predicate on the "invite_code" field.
+func InviteCodeEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldInviteCode, v))
+}
+
+// InviteCodeNEQ applies the NEQ predicate on the "invite_code" field.
+func InviteCodeNEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldInviteCode, v))
+}
+
+// InviteCodeIn applies the In predicate on the "invite_code" field.
+func InviteCodeIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldInviteCode, vs...))
+}
+
+// InviteCodeNotIn applies the NotIn predicate on the "invite_code" field.
+func InviteCodeNotIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldInviteCode, vs...))
+}
+
+// InviteCodeGT applies the GT predicate on the "invite_code" field.
+func InviteCodeGT(v string) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldInviteCode, v))
+}
+
+// InviteCodeGTE applies the GTE predicate on the "invite_code" field.
+func InviteCodeGTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldInviteCode, v))
+}
+
+// InviteCodeLT applies the LT predicate on the "invite_code" field.
+func InviteCodeLT(v string) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldInviteCode, v))
+}
+
+// InviteCodeLTE applies the LTE predicate on the "invite_code" field.
+func InviteCodeLTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldInviteCode, v))
+}
+
+// InviteCodeContains applies the Contains predicate on the "invite_code" field.
+func InviteCodeContains(v string) predicate.Game {
+	return predicate.Game(sql.FieldContains(FieldInviteCode, v))
+}
+
+// InviteCodeHasPrefix applies the HasPrefix predicate on the "invite_code" field.
+func InviteCodeHasPrefix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasPrefix(FieldInviteCode, v))
+}
+
+// InviteCodeHasSuffix applies the HasSuffix predicate on the "invite_code" field.
+func InviteCodeHasSuffix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasSuffix(FieldInviteCode, v))
+}
+
+// InviteCodeIsNil applies the IsNil predicate on the "invite_code" field.
+func InviteCodeIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldInviteCode))
+}
+
+// InviteCodeNotNil applies the NotNil predicate on the "invite_code" field.
+func InviteCodeNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldInviteCode))
+}
+
+// InviteCodeEqualFold applies the EqualFold predicate on the "invite_code" field.
+func InviteCodeEqualFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldEqualFold(FieldInviteCode, v))
+}
+
+// InviteCodeContainsFold applies the ContainsFold predicate on the "invite_code" field.
+func InviteCodeContainsFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldContainsFold(FieldInviteCode, v))
+}
+
+// PasswordHashEQ applies the EQ predicate on the "password_hash" field.
+func PasswordHashEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashNEQ applies the NEQ predicate on the "password_hash" field.
+func PasswordHashNEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldPasswordHash, v))
+}
+
+// PasswordHashIn applies the In predicate on the "password_hash" field.
+func PasswordHashIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashNotIn applies the NotIn predicate on the "password_hash" field.
+func PasswordHashNotIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldPasswordHash, vs...))
+}
+
+// PasswordHashGT applies the GT predicate on the "password_hash" field.
+func PasswordHashGT(v string) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldPasswordHash, v))
+}
+
+// PasswordHashGTE applies the GTE predicate on the "password_hash" field.
+func PasswordHashGTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldPasswordHash, v))
+}
+
+// PasswordHashLT applies the LT predicate on the "password_hash" field.
+func PasswordHashLT(v string) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldPasswordHash, v))
+}
+
+// PasswordHashLTE applies the LTE predicate on the "password_hash" field.
+func PasswordHashLTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldPasswordHash, v))
+}
+
+// PasswordHashContains applies the Contains predicate on the "password_hash" field.
+func PasswordHashContains(v string) predicate.Game {
+	return predicate.Game(sql.FieldContains(FieldPasswordHash, v))
+}
+
+// PasswordHashHasPrefix applies the HasPrefix predicate on the "password_hash" field.
+func PasswordHashHasPrefix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasPrefix(FieldPasswordHash, v))
+}
+
+// PasswordHashHasSuffix applies the HasSuffix predicate on the "password_hash" field.
+func PasswordHashHasSuffix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasSuffix(FieldPasswordHash, v))
+}
+
+// PasswordHashIsNil applies the IsNil predicate on the "password_hash" field.
+func PasswordHashIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldPasswordHash))
+}
+
+// PasswordHashNotNil applies the NotNil predicate on the "password_hash" field.
+func PasswordHashNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldPasswordHash))
+}
+
+// PasswordHashEqualFold applies the EqualFold predicate on the "password_hash" field.
+func PasswordHashEqualFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldEqualFold(FieldPasswordHash, v))
+}
+
+// PasswordHashContainsFold applies the ContainsFold predicate on the "password_hash" field.
+func PasswordHashContainsFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldContainsFold(FieldPasswordHash, v))
+}
+
+// HostTokenEQ applies the EQ predicate on the "host_token" field.
+func HostTokenEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldHostToken, v))
+}
+
+// HostTokenNEQ applies the NEQ predicate on the "host_token" field.
+func HostTokenNEQ(v string) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldHostToken, v))
+}
+
+// HostTokenIn applies the In predicate on the "host_token" field.
+func HostTokenIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldHostToken, vs...))
+}
+
+// HostTokenNotIn applies the NotIn predicate on the "host_token" field.
+func HostTokenNotIn(vs ...string) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldHostToken, vs...))
+}
+
+// HostTokenGT applies the GT predicate on the "host_token" field.
+func HostTokenGT(v string) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldHostToken, v))
+}
+
+// HostTokenGTE applies the GTE predicate on the "host_token" field.
+func HostTokenGTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldHostToken, v))
+}
+
+// HostTokenLT applies the LT predicate on the "host_token" field.
+func HostTokenLT(v string) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldHostToken, v))
+}
+
+// HostTokenLTE applies the LTE predicate on the "host_token" field.
+func HostTokenLTE(v string) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldHostToken, v))
+}
+
+// HostTokenContains applies the Contains predicate on the "host_token" field.
+func HostTokenContains(v string) predicate.Game {
+	return predicate.Game(sql.FieldContains(FieldHostToken, v))
+}
+
+// HostTokenHasPrefix applies the HasPrefix predicate on the "host_token" field.
+func HostTokenHasPrefix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasPrefix(FieldHostToken, v))
+}
+
+// HostTokenHasSuffix applies the HasSuffix predicate on the "host_token" field.
+func HostTokenHasSuffix(v string) predicate.Game {
+	return predicate.Game(sql.FieldHasSuffix(FieldHostToken, v))
+}
+
+// HostTokenIsNil applies the IsNil predicate on the "host_token" field.
+func HostTokenIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldHostToken))
+}
+
+// HostTokenNotNil applies the NotNil predicate on the "host_token" field.
+func HostTokenNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldHostToken))
+}
+
+// HostTokenEqualFold applies the EqualFold predicate on the "host_token" field.
+func HostTokenEqualFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldEqualFold(FieldHostToken, v))
+}
+
+// HostTokenContainsFold applies the ContainsFold predicate on the "host_token" field.
+func HostTokenContainsFold(v string) predicate.Game {
+	return predicate.Game(sql.FieldContainsFold(FieldHostToken, v))
+}
+
+// AutoStartEQ applies the EQ predicate on the "auto_start" field.
+func AutoStartEQ(v bool) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldAutoStart, v))
+}
+
+// AutoStartNEQ applies the NEQ predicate on the "auto_start" field.
+func AutoStartNEQ(v bool) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldAutoStart, v))
+}
+
+// CardCountEQ applies the EQ predicate on the "card_count" field.
+func CardCountEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldCardCount, v))
+}
+
+// CardCountNEQ applies the NEQ predicate on the "card_count" field.
+func CardCountNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldCardCount, v))
+}
+
+// CardCountIn applies the In predicate on the "card_count" field.
+func CardCountIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldCardCount, vs...))
+}
+
+// CardCountNotIn applies the NotIn predicate on the "card_count" field.
+func CardCountNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldCardCount, vs...))
+}
+
+// CardCountGT applies the GT predicate on the "card_count" field.
+func CardCountGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldCardCount, v))
+}
+
+// CardCountGTE applies the GTE predicate on the "card_count" field.
+func CardCountGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldCardCount, v))
+}
+
+// CardCountLT applies the LT predicate on the "card_count" field.
+func CardCountLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldCardCount, v))
+}
+
+// CardCountLTE applies the LTE predicate on the "card_count" field.
+func CardCountLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldCardCount, v))
+}
+
+// WinnerIDEQ applies the EQ predicate on the "winner_id" field.
+func WinnerIDEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldWinnerID, v))
+}
+
+// WinnerIDNEQ applies the NEQ predicate on the "winner_id" field.
+func WinnerIDNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldWinnerID, v))
+}
+
+// WinnerIDIn applies the In predicate on the "winner_id" field.
+func WinnerIDIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldWinnerID, vs...))
+}
+
+// WinnerIDNotIn applies the NotIn predicate on the "winner_id" field.
+func WinnerIDNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldWinnerID, vs...))
+}
+
+// WinnerIDGT applies the GT predicate on the "winner_id" field.
+func WinnerIDGT(v int) predicate.Game {
+	return predicate.Game(sql.FieldGT(FieldWinnerID, v))
+}
+
+// WinnerIDGTE applies the GTE predicate on the "winner_id" field.
+func WinnerIDGTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldGTE(FieldWinnerID, v))
+}
+
+// WinnerIDLT applies the LT predicate on the "winner_id" field.
+func WinnerIDLT(v int) predicate.Game {
+	return predicate.Game(sql.FieldLT(FieldWinnerID, v))
+}
+
+// WinnerIDLTE applies the LTE predicate on the "winner_id" field.
+func WinnerIDLTE(v int) predicate.Game {
+	return predicate.Game(sql.FieldLTE(FieldWinnerID, v))
+}
+
+// WinnerIDIsNil applies the IsNil predicate on the "winner_id" field.
+func WinnerIDIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldWinnerID))
+}
+
+// WinnerIDNotNil applies the NotNil predicate on the "winner_id" field.
+func WinnerIDNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldWinnerID))
+}
+
+// AutoBalanceEQ applies the EQ predicate on the "auto_balance" field.
+func AutoBalanceEQ(v bool) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldAutoBalance, v))
+}
+
+// AutoBalanceNEQ applies the NEQ predicate on the "auto_balance" field.
+func AutoBalanceNEQ(v bool) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldAutoBalance, v))
+}
+
+// MatchIDEQ applies the EQ predicate on the "match_id" field.
+func MatchIDEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldEQ(FieldMatchID, v))
+}
+
+// MatchIDNEQ applies the NEQ predicate on the "match_id" field.
+func MatchIDNEQ(v int) predicate.Game {
+	return predicate.Game(sql.FieldNEQ(FieldMatchID, v))
+}
+
+// MatchIDIn applies the In predicate on the "match_id" field.
+func MatchIDIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldIn(FieldMatchID, vs...))
+}
+
+// MatchIDNotIn applies the NotIn predicate on the "match_id" field.
+func MatchIDNotIn(vs ...int) predicate.Game {
+	return predicate.Game(sql.FieldNotIn(FieldMatchID, vs...))
+}
+
+// MatchIDIsNil applies the IsNil predicate on the "match_id" field.
+func MatchIDIsNil() predicate.Game {
+	return predicate.Game(sql.FieldIsNull(FieldMatchID))
+}
+
+// MatchIDNotNil applies the NotNil predicate on the "match_id" field.
+func MatchIDNotNil() predicate.Game {
+	return predicate.Game(sql.FieldNotNull(FieldMatchID))
+}
+
 // HasPlayers applies the HasEdge predicate on the "players" edge.
 func HasPlayers() predicate.Game {
 	return predicate.Game(func(s *sql.Selector) {
@@ -212,6 +952,121 @@ func HasPlayersWith(preds ...predicate.Player) predicate.Game {
 	})
 }
 
+// HasTeams applies the HasEdge predicate on the "teams" edge.
+func HasTeams() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TeamsTable, TeamsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamsWith applies the HasEdge predicate on the "teams" edge with a given conditions (other predicates).
+func HasTeamsWith(preds ...predicate.Team) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newTeamsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTournamentMatches applies the HasEdge predicate on the "tournament_matches" edge.
+func HasTournamentMatches() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, TournamentMatchesTable, TournamentMatchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTournamentMatchesWith applies the HasEdge predicate on the "tournament_matches" edge with a given conditions (other predicates).
+func HasTournamentMatchesWith(preds ...predicate.TournamentMatch) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newTournamentMatchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatch applies the HasEdge predicate on the "match" edge.
+func HasMatch() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, MatchTable, MatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchWith applies the HasEdge predicate on the "match" edge with a given conditions (other predicates).
+func HasMatchWith(preds ...predicate.Match) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrevious applies the HasEdge predicate on the "previous" edge.
+func HasPrevious() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, PreviousTable, PreviousColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPreviousWith applies the HasEdge predicate on the "previous" edge with a given conditions (other predicates).
+func HasPreviousWith(preds ...predicate.Game) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newPreviousStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRematch applies the HasEdge predicate on the "rematch" edge.
+func HasRematch() predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, RematchTable, RematchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRematchWith applies the HasEdge predicate on the "rematch" edge with a given conditions (other predicates).
+func HasRematchWith(preds ...predicate.Game) predicate.Game {
+	return predicate.Game(func(s *sql.Selector) {
+		step := newRematchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Game) predicate.Game {
 	return predicate.Game(sql.AndPredicates(predicates...))
@@ -6,7 +6,11 @@ import (
 	"context"
 	"errors"
 	"example/ent/game"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/team"
+	"example/ent/tournamentmatch"
+	"example/internal/scoring"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -54,6 +58,244 @@ func (gc *GameCreate) SetNillableTotalRounds(i *int) *GameCreate {
 	return gc
 }
 
+// SetMode sets the "mode" field.
+func (gc *GameCreate) SetMode(s string) *GameCreate {
+	gc.mutation.SetMode(s)
+	return gc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (gc *GameCreate) SetNillableMode(s *string) *GameCreate {
+	if s != nil {
+		gc.SetMode(*s)
+	}
+	return gc
+}
+
+// SetTeamScore sets the "team_score" field.
+func (gc *GameCreate) SetTeamScore(i int) *GameCreate {
+	gc.mutation.SetTeamScore(i)
+	return gc
+}
+
+// SetNillableTeamScore sets the "team_score" field if the given value is not nil.
+func (gc *GameCreate) SetNillableTeamScore(i *int) *GameCreate {
+	if i != nil {
+		gc.SetTeamScore(*i)
+	}
+	return gc
+}
+
+// SetEliminationInterval sets the "elimination_interval" field.
+func (gc *GameCreate) SetEliminationInterval(i int) *GameCreate {
+	gc.mutation.SetEliminationInterval(i)
+	return gc
+}
+
+// SetNillableEliminationInterval sets the "elimination_interval" field if the given value is not nil.
+func (gc *GameCreate) SetNillableEliminationInterval(i *int) *GameCreate {
+	if i != nil {
+		gc.SetEliminationInterval(*i)
+	}
+	return gc
+}
+
+// SetCenterCount sets the "center_count" field.
+func (gc *GameCreate) SetCenterCount(i int) *GameCreate {
+	gc.mutation.SetCenterCount(i)
+	return gc
+}
+
+// SetNillableCenterCount sets the "center_count" field if the given value is not nil.
+func (gc *GameCreate) SetNillableCenterCount(i *int) *GameCreate {
+	if i != nil {
+		gc.SetCenterCount(*i)
+	}
+	return gc
+}
+
+// SetTieBreak sets the "tie_break" field.
+func (gc *GameCreate) SetTieBreak(gb game.TieBreak) *GameCreate {
+	gc.mutation.SetTieBreak(gb)
+	return gc
+}
+
+// SetNillableTieBreak sets the "tie_break" field if the given value is not nil.
+func (gc *GameCreate) SetNillableTieBreak(gb *game.TieBreak) *GameCreate {
+	if gb != nil {
+		gc.SetTieBreak(*gb)
+	}
+	return gc
+}
+
+// SetScoring sets the "scoring" field.
+func (gc *GameCreate) SetScoring(s scoring.Rules) *GameCreate {
+	gc.mutation.SetScoring(s)
+	return gc
+}
+
+// SetNillableScoring sets the "scoring" field if the given value is not nil.
+func (gc *GameCreate) SetNillableScoring(s *scoring.Rules) *GameCreate {
+	if s != nil {
+		gc.SetScoring(*s)
+	}
+	return gc
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (gc *GameCreate) SetMaxPlayers(i int) *GameCreate {
+	gc.mutation.SetMaxPlayers(i)
+	return gc
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (gc *GameCreate) SetNillableMaxPlayers(i *int) *GameCreate {
+	if i != nil {
+		gc.SetMaxPlayers(*i)
+	}
+	return gc
+}
+
+// SetMinPlayers sets the "min_players" field.
+func (gc *GameCreate) SetMinPlayers(i int) *GameCreate {
+	gc.mutation.SetMinPlayers(i)
+	return gc
+}
+
+// SetNillableMinPlayers sets the "min_players" field if the given value is not nil.
+func (gc *GameCreate) SetNillableMinPlayers(i *int) *GameCreate {
+	if i != nil {
+		gc.SetMinPlayers(*i)
+	}
+	return gc
+}
+
+// SetVisibility sets the "visibility" field.
+func (gc *GameCreate) SetVisibility(ga game.Visibility) *GameCreate {
+	gc.mutation.SetVisibility(ga)
+	return gc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (gc *GameCreate) SetNillableVisibility(ga *game.Visibility) *GameCreate {
+	if ga != nil {
+		gc.SetVisibility(*ga)
+	}
+	return gc
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (gc *GameCreate) SetInviteCode(s string) *GameCreate {
+	gc.mutation.SetInviteCode(s)
+	return gc
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (gc *GameCreate) SetNillableInviteCode(s *string) *GameCreate {
+	if s != nil {
+		gc.SetInviteCode(*s)
+	}
+	return gc
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (gc *GameCreate) SetPasswordHash(s string) *GameCreate {
+	gc.mutation.SetPasswordHash(s)
+	return gc
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (gc *GameCreate) SetNillablePasswordHash(s *string) *GameCreate {
+	if s != nil {
+		gc.SetPasswordHash(*s)
+	}
+	return gc
+}
+
+// SetHostToken sets the "host_token" field.
+func (gc *GameCreate) SetHostToken(s string) *GameCreate {
+	gc.mutation.SetHostToken(s)
+	return gc
+}
+
+// SetNillableHostToken sets the "host_token" field if the given value is not nil.
+func (gc *GameCreate) SetNillableHostToken(s *string) *GameCreate {
+	if s != nil {
+		gc.SetHostToken(*s)
+	}
+	return gc
+}
+
+// SetAutoStart sets the "auto_start" field.
+func (gc *GameCreate) SetAutoStart(b bool) *GameCreate {
+	gc.mutation.SetAutoStart(b)
+	return gc
+}
+
+// SetNillableAutoStart sets the "auto_start" field if the given value is not nil.
+func (gc *GameCreate) SetNillableAutoStart(b *bool) *GameCreate {
+	if b != nil {
+		gc.SetAutoStart(*b)
+	}
+	return gc
+}
+
+// SetCardCount sets the "card_count" field.
+func (gc *GameCreate) SetCardCount(i int) *GameCreate {
+	gc.mutation.SetCardCount(i)
+	return gc
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (gc *GameCreate) SetNillableCardCount(i *int) *GameCreate {
+	if i != nil {
+		gc.SetCardCount(*i)
+	}
+	return gc
+}
+
+// SetWinnerID sets the "winner_id" field.
+func (gc *GameCreate) SetWinnerID(i int) *GameCreate {
+	gc.mutation.SetWinnerID(i)
+	return gc
+}
+
+// SetNillableWinnerID sets the "winner_id" field if the given value is not nil.
+func (gc *GameCreate) SetNillableWinnerID(i *int) *GameCreate {
+	if i != nil {
+		gc.SetWinnerID(*i)
+	}
+	return gc
+}
+
+// SetAutoBalance sets the "auto_balance" field.
+func (gc *GameCreate) SetAutoBalance(b bool) *GameCreate {
+	gc.mutation.SetAutoBalance(b)
+	return gc
+}
+
+// SetNillableAutoBalance sets the "auto_balance" field if the given value is not nil.
+func (gc *GameCreate) SetNillableAutoBalance(b *bool) *GameCreate {
+	if b != nil {
+		gc.SetAutoBalance(*b)
+	}
+	return gc
+}
+
+// SetMatchID sets the "match_id" field.
+func (gc *GameCreate) SetMatchID(i int) *GameCreate {
+	gc.mutation.SetMatchID(i)
+	return gc
+}
+
+// SetNillableMatchID sets the "match_id" field if the given value is not nil.
+func (gc *GameCreate) SetNillableMatchID(i *int) *GameCreate {
+	if i != nil {
+		gc.SetMatchID(*i)
+	}
+	return gc
+}
+
 // AddPlayerIDs adds the "players" edge to the Player entity by IDs.
 func (gc *GameCreate) AddPlayerIDs(ids ...int) *GameCreate {
 	gc.mutation.AddPlayerIDs(ids...)
@@ -69,6 +311,79 @@ func (gc *GameCreate) AddPlayers(p ...*Player) *GameCreate {
 	return gc.AddPlayerIDs(ids...)
 }
 
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (gc *GameCreate) AddTeamIDs(ids ...int) *GameCreate {
+	gc.mutation.AddTeamIDs(ids...)
+	return gc
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (gc *GameCreate) AddTeams(t ...*Team) *GameCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gc.AddTeamIDs(ids...)
+}
+
+// AddTournamentMatchIDs adds the "tournament_matches" edge to the TournamentMatch entity by IDs.
+func (gc *GameCreate) AddTournamentMatchIDs(ids ...int) *GameCreate {
+	gc.mutation.AddTournamentMatchIDs(ids...)
+	return gc
+}
+
+// AddTournamentMatches adds the "tournament_matches" edges to the TournamentMatch entity.
+func (gc *GameCreate) AddTournamentMatches(t ...*TournamentMatch) *GameCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gc.AddTournamentMatchIDs(ids...)
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (gc *GameCreate) SetMatch(m *Match) *GameCreate {
+	return gc.SetMatchID(m.ID)
+}
+
+// SetPreviousID sets the "previous" edge to the Game entity by ID.
+func (gc *GameCreate) SetPreviousID(id int) *GameCreate {
+	gc.mutation.SetPreviousID(id)
+	return gc
+}
+
+// SetNillablePreviousID sets the "previous" edge to the Game entity by ID if the given value is not nil.
+func (gc *GameCreate) SetNillablePreviousID(id *int) *GameCreate {
+	if id != nil {
+		gc = gc.SetPreviousID(*id)
+	}
+	return gc
+}
+
+// SetPrevious sets the "previous" edge to the Game entity.
+func (gc *GameCreate) SetPrevious(g *Game) *GameCreate {
+	return gc.SetPreviousID(g.ID)
+}
+
+// SetRematchID sets the "rematch" edge to the Game entity by ID.
+func (gc *GameCreate) SetRematchID(id int) *GameCreate {
+	gc.mutation.SetRematchID(id)
+	return gc
+}
+
+// SetNillableRematchID sets the "rematch" edge to the Game entity by ID if the given value is not nil.
+func (gc *GameCreate) SetNillableRematchID(id *int) *GameCreate {
+	if id != nil {
+		gc = gc.SetRematchID(*id)
+	}
+	return gc
+}
+
+// SetRematch sets the "rematch" edge to the Game entity.
+func (gc *GameCreate) SetRematch(g *Game) *GameCreate {
+	return gc.SetRematchID(g.ID)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gc *GameCreate) Mutation() *GameMutation {
 	return gc.mutation
@@ -112,6 +427,54 @@ func (gc *GameCreate) defaults() {
 		v := game.DefaultTotalRounds
 		gc.mutation.SetTotalRounds(v)
 	}
+	if _, ok := gc.mutation.Mode(); !ok {
+		v := game.DefaultMode
+		gc.mutation.SetMode(v)
+	}
+	if _, ok := gc.mutation.TeamScore(); !ok {
+		v := game.DefaultTeamScore
+		gc.mutation.SetTeamScore(v)
+	}
+	if _, ok := gc.mutation.EliminationInterval(); !ok {
+		v := game.DefaultEliminationInterval
+		gc.mutation.SetEliminationInterval(v)
+	}
+	if _, ok := gc.mutation.CenterCount(); !ok {
+		v := game.DefaultCenterCount
+		gc.mutation.SetCenterCount(v)
+	}
+	if _, ok := gc.mutation.TieBreak(); !ok {
+		v := game.DefaultTieBreak
+		gc.mutation.SetTieBreak(v)
+	}
+	if _, ok := gc.mutation.Scoring(); !ok {
+		v := game.DefaultScoring
+		gc.mutation.SetScoring(v)
+	}
+	if _, ok := gc.mutation.MaxPlayers(); !ok {
+		v := game.DefaultMaxPlayers
+		gc.mutation.SetMaxPlayers(v)
+	}
+	if _, ok := gc.mutation.MinPlayers(); !ok {
+		v := game.DefaultMinPlayers
+		gc.mutation.SetMinPlayers(v)
+	}
+	if _, ok := gc.mutation.Visibility(); !ok {
+		v := game.DefaultVisibility
+		gc.mutation.SetVisibility(v)
+	}
+	if _, ok := gc.mutation.AutoStart(); !ok {
+		v := game.DefaultAutoStart
+		gc.mutation.SetAutoStart(v)
+	}
+	if _, ok := gc.mutation.CardCount(); !ok {
+		v := game.DefaultCardCount
+		gc.mutation.SetCardCount(v)
+	}
+	if _, ok := gc.mutation.AutoBalance(); !ok {
+		v := game.DefaultAutoBalance
+		gc.mutation.SetAutoBalance(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -135,6 +498,57 @@ func (gc *GameCreate) check() error {
 	if _, ok := gc.mutation.TotalRounds(); !ok {
 		return &ValidationError{Name: "total_rounds", err: errors.New(`ent: missing required field "Game.total_rounds"`)}
 	}
+	if _, ok := gc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Game.mode"`)}
+	}
+	if _, ok := gc.mutation.TeamScore(); !ok {
+		return &ValidationError{Name: "team_score", err: errors.New(`ent: missing required field "Game.team_score"`)}
+	}
+	if _, ok := gc.mutation.EliminationInterval(); !ok {
+		return &ValidationError{Name: "elimination_interval", err: errors.New(`ent: missing required field "Game.elimination_interval"`)}
+	}
+	if _, ok := gc.mutation.CenterCount(); !ok {
+		return &ValidationError{Name: "center_count", err: errors.New(`ent: missing required field "Game.center_count"`)}
+	}
+	if _, ok := gc.mutation.TieBreak(); !ok {
+		return &ValidationError{Name: "tie_break", err: errors.New(`ent: missing required field "Game.tie_break"`)}
+	}
+	if v, ok := gc.mutation.TieBreak(); ok {
+		if err := game.TieBreakValidator(v); err != nil {
+			return &ValidationError{Name: "tie_break", err: fmt.Errorf(`ent: validator failed for field "Game.tie_break": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.Scoring(); !ok {
+		return &ValidationError{Name: "scoring", err: errors.New(`ent: missing required field "Game.scoring"`)}
+	}
+	if v, ok := gc.mutation.Scoring(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "scoring", err: fmt.Errorf(`ent: validator failed for field "Game.scoring": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.MaxPlayers(); !ok {
+		return &ValidationError{Name: "max_players", err: errors.New(`ent: missing required field "Game.max_players"`)}
+	}
+	if _, ok := gc.mutation.MinPlayers(); !ok {
+		return &ValidationError{Name: "min_players", err: errors.New(`ent: missing required field "Game.min_players"`)}
+	}
+	if _, ok := gc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Game.visibility"`)}
+	}
+	if v, ok := gc.mutation.Visibility(); ok {
+		if err := game.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Game.visibility": %w`, err)}
+		}
+	}
+	if _, ok := gc.mutation.AutoStart(); !ok {
+		return &ValidationError{Name: "auto_start", err: errors.New(`ent: missing required field "Game.auto_start"`)}
+	}
+	if _, ok := gc.mutation.CardCount(); !ok {
+		return &ValidationError{Name: "card_count", err: errors.New(`ent: missing required field "Game.card_count"`)}
+	}
+	if _, ok := gc.mutation.AutoBalance(); !ok {
+		return &ValidationError{Name: "auto_balance", err: errors.New(`ent: missing required field "Game.auto_balance"`)}
+	}
 	return nil
 }
 
@@ -173,6 +587,70 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		_spec.SetField(game.FieldTotalRounds, field.TypeInt, value)
 		_node.TotalRounds = value
 	}
+	if value, ok := gc.mutation.Mode(); ok {
+		_spec.SetField(game.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := gc.mutation.TeamScore(); ok {
+		_spec.SetField(game.FieldTeamScore, field.TypeInt, value)
+		_node.TeamScore = value
+	}
+	if value, ok := gc.mutation.EliminationInterval(); ok {
+		_spec.SetField(game.FieldEliminationInterval, field.TypeInt, value)
+		_node.EliminationInterval = value
+	}
+	if value, ok := gc.mutation.CenterCount(); ok {
+		_spec.SetField(game.FieldCenterCount, field.TypeInt, value)
+		_node.CenterCount = value
+	}
+	if value, ok := gc.mutation.TieBreak(); ok {
+		_spec.SetField(game.FieldTieBreak, field.TypeEnum, value)
+		_node.TieBreak = value
+	}
+	if value, ok := gc.mutation.Scoring(); ok {
+		_spec.SetField(game.FieldScoring, field.TypeJSON, value)
+		_node.Scoring = value
+	}
+	if value, ok := gc.mutation.MaxPlayers(); ok {
+		_spec.SetField(game.FieldMaxPlayers, field.TypeInt, value)
+		_node.MaxPlayers = value
+	}
+	if value, ok := gc.mutation.MinPlayers(); ok {
+		_spec.SetField(game.FieldMinPlayers, field.TypeInt, value)
+		_node.MinPlayers = value
+	}
+	if value, ok := gc.mutation.Visibility(); ok {
+		_spec.SetField(game.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := gc.mutation.InviteCode(); ok {
+		_spec.SetField(game.FieldInviteCode, field.TypeString, value)
+		_node.InviteCode = value
+	}
+	if value, ok := gc.mutation.PasswordHash(); ok {
+		_spec.SetField(game.FieldPasswordHash, field.TypeString, value)
+		_node.PasswordHash = value
+	}
+	if value, ok := gc.mutation.HostToken(); ok {
+		_spec.SetField(game.FieldHostToken, field.TypeString, value)
+		_node.HostToken = value
+	}
+	if value, ok := gc.mutation.AutoStart(); ok {
+		_spec.SetField(game.FieldAutoStart, field.TypeBool, value)
+		_node.AutoStart = value
+	}
+	if value, ok := gc.mutation.CardCount(); ok {
+		_spec.SetField(game.FieldCardCount, field.TypeInt, value)
+		_node.CardCount = value
+	}
+	if value, ok := gc.mutation.WinnerID(); ok {
+		_spec.SetField(game.FieldWinnerID, field.TypeInt, value)
+		_node.WinnerID = &value
+	}
+	if value, ok := gc.mutation.AutoBalance(); ok {
+		_spec.SetField(game.FieldAutoBalance, field.TypeBool, value)
+		_node.AutoBalance = value
+	}
 	if nodes := gc.mutation.PlayersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -189,6 +667,88 @@ func (gc *GameCreate) createSpec() (*Game, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := gc.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.TournamentMatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   game.MatchTable,
+			Columns: []string{game.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.MatchID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   game.PreviousTable,
+			Columns: []string{game.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.game_rematch = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := gc.mutation.RematchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.RematchTable,
+			Columns: []string{game.RematchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -6,8 +6,11 @@ import (
 	"context"
 	"database/sql/driver"
 	"example/ent/game"
+	"example/ent/match"
 	"example/ent/player"
 	"example/ent/predicate"
+	"example/ent/team"
+	"example/ent/tournamentmatch"
 	"fmt"
 	"math"
 
@@ -20,11 +23,17 @@ import (
 // GameQuery is the builder for querying Game entities.
 type GameQuery struct {
 	config
-	ctx         *QueryContext
-	order       []game.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.Game
-	withPlayers *PlayerQuery
+	ctx                   *QueryContext
+	order                 []game.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.Game
+	withPlayers           *PlayerQuery
+	withTeams             *TeamQuery
+	withTournamentMatches *TournamentMatchQuery
+	withMatch             *MatchQuery
+	withPrevious          *GameQuery
+	withRematch           *GameQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +92,116 @@ func (gq *GameQuery) QueryPlayers() *PlayerQuery {
 	return query
 }
 
+// QueryTeams chains the current query on the "teams" edge.
+func (gq *GameQuery) QueryTeams() *TeamQuery {
+	query := (&TeamClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(team.Table, team.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, game.TeamsTable, game.TeamsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTournamentMatches chains the current query on the "tournament_matches" edge.
+func (gq *GameQuery) QueryTournamentMatches() *TournamentMatchQuery {
+	query := (&TournamentMatchClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(tournamentmatch.Table, tournamentmatch.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, game.TournamentMatchesTable, game.TournamentMatchesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMatch chains the current query on the "match" edge.
+func (gq *GameQuery) QueryMatch() *MatchQuery {
+	query := (&MatchClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(match.Table, match.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, game.MatchTable, game.MatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrevious chains the current query on the "previous" edge.
+func (gq *GameQuery) QueryPrevious() *GameQuery {
+	query := (&GameClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, game.PreviousTable, game.PreviousColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRematch chains the current query on the "rematch" edge.
+func (gq *GameQuery) QueryRematch() *GameQuery {
+	query := (&GameClient{config: gq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := gq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := gq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(game.Table, game.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, game.RematchTable, game.RematchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(gq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Game entity from the query.
 // Returns a *NotFoundError when no Game was found.
 func (gq *GameQuery) First(ctx context.Context) (*Game, error) {
@@ -270,12 +389,17 @@ func (gq *GameQuery) Clone() *GameQuery {
 		return nil
 	}
 	return &GameQuery{
-		config:      gq.config,
-		ctx:         gq.ctx.Clone(),
-		order:       append([]game.OrderOption{}, gq.order...),
-		inters:      append([]Interceptor{}, gq.inters...),
-		predicates:  append([]predicate.Game{}, gq.predicates...),
-		withPlayers: gq.withPlayers.Clone(),
+		config:                gq.config,
+		ctx:                   gq.ctx.Clone(),
+		order:                 append([]game.OrderOption{}, gq.order...),
+		inters:                append([]Interceptor{}, gq.inters...),
+		predicates:            append([]predicate.Game{}, gq.predicates...),
+		withPlayers:           gq.withPlayers.Clone(),
+		withTeams:             gq.withTeams.Clone(),
+		withTournamentMatches: gq.withTournamentMatches.Clone(),
+		withMatch:             gq.withMatch.Clone(),
+		withPrevious:          gq.withPrevious.Clone(),
+		withRematch:           gq.withRematch.Clone(),
 		// clone intermediate query.
 		sql:  gq.sql.Clone(),
 		path: gq.path,
@@ -293,6 +417,61 @@ func (gq *GameQuery) WithPlayers(opts ...func(*PlayerQuery)) *GameQuery {
 	return gq
 }
 
+// WithTeams tells the query-builder to eager-load the nodes that are connected to
+// the "teams" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithTeams(opts ...func(*TeamQuery)) *GameQuery {
+	query := (&TeamClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withTeams = query
+	return gq
+}
+
+// WithTournamentMatches tells the query-builder to eager-load the nodes that are connected to
+// the "tournament_matches" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithTournamentMatches(opts ...func(*TournamentMatchQuery)) *GameQuery {
+	query := (&TournamentMatchClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withTournamentMatches = query
+	return gq
+}
+
+// WithMatch tells the query-builder to eager-load the nodes that are connected to
+// the "match" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithMatch(opts ...func(*MatchQuery)) *GameQuery {
+	query := (&MatchClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withMatch = query
+	return gq
+}
+
+// WithPrevious tells the query-builder to eager-load the nodes that are connected to
+// the "previous" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithPrevious(opts ...func(*GameQuery)) *GameQuery {
+	query := (&GameClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withPrevious = query
+	return gq
+}
+
+// WithRematch tells the query-builder to eager-load the nodes that are connected to
+// the "rematch" edge. The optional arguments are used to configure the query builder of the edge.
+func (gq *GameQuery) WithRematch(opts ...func(*GameQuery)) *GameQuery {
+	query := (&GameClient{config: gq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	gq.withRematch = query
+	return gq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -370,11 +549,23 @@ func (gq *GameQuery) prepareQuery(ctx context.Context) error {
 func (gq *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, error) {
 	var (
 		nodes       = []*Game{}
+		withFKs     = gq.withFKs
 		_spec       = gq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [6]bool{
 			gq.withPlayers != nil,
+			gq.withTeams != nil,
+			gq.withTournamentMatches != nil,
+			gq.withMatch != nil,
+			gq.withPrevious != nil,
+			gq.withRematch != nil,
 		}
 	)
+	if gq.withPrevious != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, game.ForeignKeys...)
+	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Game).scanValues(nil, columns)
 	}
@@ -400,6 +591,38 @@ func (gq *GameQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Game, e
 			return nil, err
 		}
 	}
+	if query := gq.withTeams; query != nil {
+		if err := gq.loadTeams(ctx, query, nodes,
+			func(n *Game) { n.Edges.Teams = []*Team{} },
+			func(n *Game, e *Team) { n.Edges.Teams = append(n.Edges.Teams, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withTournamentMatches; query != nil {
+		if err := gq.loadTournamentMatches(ctx, query, nodes,
+			func(n *Game) { n.Edges.TournamentMatches = []*TournamentMatch{} },
+			func(n *Game, e *TournamentMatch) { n.Edges.TournamentMatches = append(n.Edges.TournamentMatches, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withMatch; query != nil {
+		if err := gq.loadMatch(ctx, query, nodes, nil,
+			func(n *Game, e *Match) { n.Edges.Match = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withPrevious; query != nil {
+		if err := gq.loadPrevious(ctx, query, nodes, nil,
+			func(n *Game, e *Game) { n.Edges.Previous = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := gq.withRematch; query != nil {
+		if err := gq.loadRematch(ctx, query, nodes, nil,
+			func(n *Game, e *Game) { n.Edges.Rematch = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -434,6 +657,157 @@ func (gq *GameQuery) loadPlayers(ctx context.Context, query *PlayerQuery, nodes
 	}
 	return nil
 }
+func (gq *GameQuery) loadTeams(ctx context.Context, query *TeamQuery, nodes []*Game, init func(*Game), assign func(*Game, *Team)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Team(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.TeamsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.team_parent
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "team_parent" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "team_parent" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadTournamentMatches(ctx context.Context, query *TournamentMatchQuery, nodes []*Game, init func(*Game), assign func(*Game, *TournamentMatch)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tournamentmatch.FieldGameID)
+	}
+	query.Where(predicate.TournamentMatch(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.TournamentMatchesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.GameID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (gq *GameQuery) loadMatch(ctx context.Context, query *MatchQuery, nodes []*Game, init func(*Game), assign func(*Game, *Match)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Game)
+	for i := range nodes {
+		fk := nodes[i].MatchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(match.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "match_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gq *GameQuery) loadPrevious(ctx context.Context, query *GameQuery, nodes []*Game, init func(*Game), assign func(*Game, *Game)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Game)
+	for i := range nodes {
+		if nodes[i].game_rematch == nil {
+			continue
+		}
+		fk := *nodes[i].game_rematch
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(game.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "game_rematch" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (gq *GameQuery) loadRematch(ctx context.Context, query *GameQuery, nodes []*Game, init func(*Game), assign func(*Game, *Game)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Game)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.Game(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(game.RematchColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.game_rematch
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "game_rematch" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "game_rematch" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (gq *GameQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := gq.querySpec()
@@ -460,6 +834,9 @@ func (gq *GameQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if gq.withMatch != nil {
+			_spec.Node.AddColumnOnce(game.FieldMatchID)
+		}
 	}
 	if ps := gq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,8 +6,12 @@ import (
 	"context"
 	"errors"
 	"example/ent/game"
+	"example/ent/match"
 	"example/ent/player"
 	"example/ent/predicate"
+	"example/ent/team"
+	"example/ent/tournamentmatch"
+	"example/internal/scoring"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -77,6 +81,323 @@ func (gu *GameUpdate) AddTotalRounds(i int) *GameUpdate {
 	return gu
 }
 
+// SetMode sets the "mode" field.
+func (gu *GameUpdate) SetMode(s string) *GameUpdate {
+	gu.mutation.SetMode(s)
+	return gu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableMode(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetMode(*s)
+	}
+	return gu
+}
+
+// SetTeamScore sets the "team_score" field.
+func (gu *GameUpdate) SetTeamScore(i int) *GameUpdate {
+	gu.mutation.ResetTeamScore()
+	gu.mutation.SetTeamScore(i)
+	return gu
+}
+
+// SetNillableTeamScore sets the "team_score" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableTeamScore(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetTeamScore(*i)
+	}
+	return gu
+}
+
+// AddTeamScore adds i to the "team_score" field.
+func (gu *GameUpdate) AddTeamScore(i int) *GameUpdate {
+	gu.mutation.AddTeamScore(i)
+	return gu
+}
+
+// SetEliminationInterval sets the "elimination_interval" field.
+func (gu *GameUpdate) SetEliminationInterval(i int) *GameUpdate {
+	gu.mutation.ResetEliminationInterval()
+	gu.mutation.SetEliminationInterval(i)
+	return gu
+}
+
+// SetNillableEliminationInterval sets the "elimination_interval" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableEliminationInterval(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetEliminationInterval(*i)
+	}
+	return gu
+}
+
+// AddEliminationInterval adds i to the "elimination_interval" field.
+func (gu *GameUpdate) AddEliminationInterval(i int) *GameUpdate {
+	gu.mutation.AddEliminationInterval(i)
+	return gu
+}
+
+// SetCenterCount sets the "center_count" field.
+func (gu *GameUpdate) SetCenterCount(i int) *GameUpdate {
+	gu.mutation.ResetCenterCount()
+	gu.mutation.SetCenterCount(i)
+	return gu
+}
+
+// SetNillableCenterCount sets the "center_count" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableCenterCount(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetCenterCount(*i)
+	}
+	return gu
+}
+
+// AddCenterCount adds i to the "center_count" field.
+func (gu *GameUpdate) AddCenterCount(i int) *GameUpdate {
+	gu.mutation.AddCenterCount(i)
+	return gu
+}
+
+// SetTieBreak sets the "tie_break" field.
+func (gu *GameUpdate) SetTieBreak(gb game.TieBreak) *GameUpdate {
+	gu.mutation.SetTieBreak(gb)
+	return gu
+}
+
+// SetNillableTieBreak sets the "tie_break" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableTieBreak(gb *game.TieBreak) *GameUpdate {
+	if gb != nil {
+		gu.SetTieBreak(*gb)
+	}
+	return gu
+}
+
+// SetScoring sets the "scoring" field.
+func (gu *GameUpdate) SetScoring(s scoring.Rules) *GameUpdate {
+	gu.mutation.SetScoring(s)
+	return gu
+}
+
+// SetNillableScoring sets the "scoring" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableScoring(s *scoring.Rules) *GameUpdate {
+	if s != nil {
+		gu.SetScoring(*s)
+	}
+	return gu
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (gu *GameUpdate) SetMaxPlayers(i int) *GameUpdate {
+	gu.mutation.ResetMaxPlayers()
+	gu.mutation.SetMaxPlayers(i)
+	return gu
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableMaxPlayers(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetMaxPlayers(*i)
+	}
+	return gu
+}
+
+// AddMaxPlayers adds i to the "max_players" field.
+func (gu *GameUpdate) AddMaxPlayers(i int) *GameUpdate {
+	gu.mutation.AddMaxPlayers(i)
+	return gu
+}
+
+// SetMinPlayers sets the "min_players" field.
+func (gu *GameUpdate) SetMinPlayers(i int) *GameUpdate {
+	gu.mutation.ResetMinPlayers()
+	gu.mutation.SetMinPlayers(i)
+	return gu
+}
+
+// SetNillableMinPlayers sets the "min_players" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableMinPlayers(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetMinPlayers(*i)
+	}
+	return gu
+}
+
+// AddMinPlayers adds i to the "min_players" field.
+func (gu *GameUpdate) AddMinPlayers(i int) *GameUpdate {
+	gu.mutation.AddMinPlayers(i)
+	return gu
+}
+
+// SetVisibility sets the "visibility" field.
+func (gu *GameUpdate) SetVisibility(ga game.Visibility) *GameUpdate {
+	gu.mutation.SetVisibility(ga)
+	return gu
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableVisibility(ga *game.Visibility) *GameUpdate {
+	if ga != nil {
+		gu.SetVisibility(*ga)
+	}
+	return gu
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (gu *GameUpdate) SetInviteCode(s string) *GameUpdate {
+	gu.mutation.SetInviteCode(s)
+	return gu
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableInviteCode(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetInviteCode(*s)
+	}
+	return gu
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (gu *GameUpdate) ClearInviteCode() *GameUpdate {
+	gu.mutation.ClearInviteCode()
+	return gu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (gu *GameUpdate) SetPasswordHash(s string) *GameUpdate {
+	gu.mutation.SetPasswordHash(s)
+	return gu
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (gu *GameUpdate) SetNillablePasswordHash(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetPasswordHash(*s)
+	}
+	return gu
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (gu *GameUpdate) ClearPasswordHash() *GameUpdate {
+	gu.mutation.ClearPasswordHash()
+	return gu
+}
+
+// SetHostToken sets the "host_token" field.
+func (gu *GameUpdate) SetHostToken(s string) *GameUpdate {
+	gu.mutation.SetHostToken(s)
+	return gu
+}
+
+// SetNillableHostToken sets the "host_token" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableHostToken(s *string) *GameUpdate {
+	if s != nil {
+		gu.SetHostToken(*s)
+	}
+	return gu
+}
+
+// ClearHostToken clears the value of the "host_token" field.
+func (gu *GameUpdate) ClearHostToken() *GameUpdate {
+	gu.mutation.ClearHostToken()
+	return gu
+}
+
+// SetAutoStart sets the "auto_start" field.
+func (gu *GameUpdate) SetAutoStart(b bool) *GameUpdate {
+	gu.mutation.SetAutoStart(b)
+	return gu
+}
+
+// SetNillableAutoStart sets the "auto_start" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableAutoStart(b *bool) *GameUpdate {
+	if b != nil {
+		gu.SetAutoStart(*b)
+	}
+	return gu
+}
+
+// SetCardCount sets the "card_count" field.
+func (gu *GameUpdate) SetCardCount(i int) *GameUpdate {
+	gu.mutation.ResetCardCount()
+	gu.mutation.SetCardCount(i)
+	return gu
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableCardCount(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetCardCount(*i)
+	}
+	return gu
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (gu *GameUpdate) AddCardCount(i int) *GameUpdate {
+	gu.mutation.AddCardCount(i)
+	return gu
+}
+
+// SetWinnerID sets the "winner_id" field.
+func (gu *GameUpdate) SetWinnerID(i int) *GameUpdate {
+	gu.mutation.ResetWinnerID()
+	gu.mutation.SetWinnerID(i)
+	return gu
+}
+
+// SetNillableWinnerID sets the "winner_id" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableWinnerID(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetWinnerID(*i)
+	}
+	return gu
+}
+
+// AddWinnerID adds i to the "winner_id" field.
+func (gu *GameUpdate) AddWinnerID(i int) *GameUpdate {
+	gu.mutation.AddWinnerID(i)
+	return gu
+}
+
+// ClearWinnerID clears the value of the "winner_id" field.
+func (gu *GameUpdate) ClearWinnerID() *GameUpdate {
+	gu.mutation.ClearWinnerID()
+	return gu
+}
+
+// SetAutoBalance sets the "auto_balance" field.
+func (gu *GameUpdate) SetAutoBalance(b bool) *GameUpdate {
+	gu.mutation.SetAutoBalance(b)
+	return gu
+}
+
+// SetNillableAutoBalance sets the "auto_balance" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableAutoBalance(b *bool) *GameUpdate {
+	if b != nil {
+		gu.SetAutoBalance(*b)
+	}
+	return gu
+}
+
+// SetMatchID sets the "match_id" field.
+func (gu *GameUpdate) SetMatchID(i int) *GameUpdate {
+	gu.mutation.SetMatchID(i)
+	return gu
+}
+
+// SetNillableMatchID sets the "match_id" field if the given value is not nil.
+func (gu *GameUpdate) SetNillableMatchID(i *int) *GameUpdate {
+	if i != nil {
+		gu.SetMatchID(*i)
+	}
+	return gu
+}
+
+// ClearMatchID clears the value of the "match_id" field.
+func (gu *GameUpdate) ClearMatchID() *GameUpdate {
+	gu.mutation.ClearMatchID()
+	return gu
+}
+
 // AddPlayerIDs adds the "players" edge to the Player entity by IDs.
 func (gu *GameUpdate) AddPlayerIDs(ids ...int) *GameUpdate {
 	gu.mutation.AddPlayerIDs(ids...)
@@ -92,6 +413,79 @@ func (gu *GameUpdate) AddPlayers(p ...*Player) *GameUpdate {
 	return gu.AddPlayerIDs(ids...)
 }
 
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (gu *GameUpdate) AddTeamIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddTeamIDs(ids...)
+	return gu
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (gu *GameUpdate) AddTeams(t ...*Team) *GameUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.AddTeamIDs(ids...)
+}
+
+// AddTournamentMatchIDs adds the "tournament_matches" edge to the TournamentMatch entity by IDs.
+func (gu *GameUpdate) AddTournamentMatchIDs(ids ...int) *GameUpdate {
+	gu.mutation.AddTournamentMatchIDs(ids...)
+	return gu
+}
+
+// AddTournamentMatches adds the "tournament_matches" edges to the TournamentMatch entity.
+func (gu *GameUpdate) AddTournamentMatches(t ...*TournamentMatch) *GameUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.AddTournamentMatchIDs(ids...)
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (gu *GameUpdate) SetMatch(m *Match) *GameUpdate {
+	return gu.SetMatchID(m.ID)
+}
+
+// SetPreviousID sets the "previous" edge to the Game entity by ID.
+func (gu *GameUpdate) SetPreviousID(id int) *GameUpdate {
+	gu.mutation.SetPreviousID(id)
+	return gu
+}
+
+// SetNillablePreviousID sets the "previous" edge to the Game entity by ID if the given value is not nil.
+func (gu *GameUpdate) SetNillablePreviousID(id *int) *GameUpdate {
+	if id != nil {
+		gu = gu.SetPreviousID(*id)
+	}
+	return gu
+}
+
+// SetPrevious sets the "previous" edge to the Game entity.
+func (gu *GameUpdate) SetPrevious(g *Game) *GameUpdate {
+	return gu.SetPreviousID(g.ID)
+}
+
+// SetRematchID sets the "rematch" edge to the Game entity by ID.
+func (gu *GameUpdate) SetRematchID(id int) *GameUpdate {
+	gu.mutation.SetRematchID(id)
+	return gu
+}
+
+// SetNillableRematchID sets the "rematch" edge to the Game entity by ID if the given value is not nil.
+func (gu *GameUpdate) SetNillableRematchID(id *int) *GameUpdate {
+	if id != nil {
+		gu = gu.SetRematchID(*id)
+	}
+	return gu
+}
+
+// SetRematch sets the "rematch" edge to the Game entity.
+func (gu *GameUpdate) SetRematch(g *Game) *GameUpdate {
+	return gu.SetRematchID(g.ID)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (gu *GameUpdate) Mutation() *GameMutation {
 	return gu.mutation
@@ -118,6 +512,66 @@ func (gu *GameUpdate) RemovePlayers(p ...*Player) *GameUpdate {
 	return gu.RemovePlayerIDs(ids...)
 }
 
+// ClearTeams clears all "teams" edges to the Team entity.
+func (gu *GameUpdate) ClearTeams() *GameUpdate {
+	gu.mutation.ClearTeams()
+	return gu
+}
+
+// RemoveTeamIDs removes the "teams" edge to Team entities by IDs.
+func (gu *GameUpdate) RemoveTeamIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveTeamIDs(ids...)
+	return gu
+}
+
+// RemoveTeams removes "teams" edges to Team entities.
+func (gu *GameUpdate) RemoveTeams(t ...*Team) *GameUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.RemoveTeamIDs(ids...)
+}
+
+// ClearTournamentMatches clears all "tournament_matches" edges to the TournamentMatch entity.
+func (gu *GameUpdate) ClearTournamentMatches() *GameUpdate {
+	gu.mutation.ClearTournamentMatches()
+	return gu
+}
+
+// RemoveTournamentMatchIDs removes the "tournament_matches" edge to TournamentMatch entities by IDs.
+func (gu *GameUpdate) RemoveTournamentMatchIDs(ids ...int) *GameUpdate {
+	gu.mutation.RemoveTournamentMatchIDs(ids...)
+	return gu
+}
+
+// RemoveTournamentMatches removes "tournament_matches" edges to TournamentMatch entities.
+func (gu *GameUpdate) RemoveTournamentMatches(t ...*TournamentMatch) *GameUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return gu.RemoveTournamentMatchIDs(ids...)
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (gu *GameUpdate) ClearMatch() *GameUpdate {
+	gu.mutation.ClearMatch()
+	return gu
+}
+
+// ClearPrevious clears the "previous" edge to the Game entity.
+func (gu *GameUpdate) ClearPrevious() *GameUpdate {
+	gu.mutation.ClearPrevious()
+	return gu
+}
+
+// ClearRematch clears the "rematch" edge to the Game entity.
+func (gu *GameUpdate) ClearRematch() *GameUpdate {
+	gu.mutation.ClearRematch()
+	return gu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (gu *GameUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, gu.sqlSave, gu.mutation, gu.hooks)
@@ -157,6 +611,21 @@ func (gu *GameUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Game.status": %w`, err)}
 		}
 	}
+	if v, ok := gu.mutation.TieBreak(); ok {
+		if err := game.TieBreakValidator(v); err != nil {
+			return &ValidationError{Name: "tie_break", err: fmt.Errorf(`ent: validator failed for field "Game.tie_break": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Scoring(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "scoring", err: fmt.Errorf(`ent: validator failed for field "Game.scoring": %w`, err)}
+		}
+	}
+	if v, ok := gu.mutation.Visibility(); ok {
+		if err := game.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Game.visibility": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -184,29 +653,110 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := gu.mutation.AddedTotalRounds(); ok {
 		_spec.AddField(game.FieldTotalRounds, field.TypeInt, value)
 	}
-	if gu.mutation.PlayersCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   game.PlayersTable,
-			Columns: []string{game.PlayersColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
-			},
-		}
-		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	if value, ok := gu.mutation.Mode(); ok {
+		_spec.SetField(game.FieldMode, field.TypeString, value)
 	}
-	if nodes := gu.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !gu.mutation.PlayersCleared() {
-		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2M,
-			Inverse: true,
-			Table:   game.PlayersTable,
-			Columns: []string{game.PlayersColumn},
-			Bidi:    false,
-			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
-			},
+	if value, ok := gu.mutation.TeamScore(); ok {
+		_spec.SetField(game.FieldTeamScore, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedTeamScore(); ok {
+		_spec.AddField(game.FieldTeamScore, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.EliminationInterval(); ok {
+		_spec.SetField(game.FieldEliminationInterval, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedEliminationInterval(); ok {
+		_spec.AddField(game.FieldEliminationInterval, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.CenterCount(); ok {
+		_spec.SetField(game.FieldCenterCount, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedCenterCount(); ok {
+		_spec.AddField(game.FieldCenterCount, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.TieBreak(); ok {
+		_spec.SetField(game.FieldTieBreak, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.Scoring(); ok {
+		_spec.SetField(game.FieldScoring, field.TypeJSON, value)
+	}
+	if value, ok := gu.mutation.MaxPlayers(); ok {
+		_spec.SetField(game.FieldMaxPlayers, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedMaxPlayers(); ok {
+		_spec.AddField(game.FieldMaxPlayers, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.MinPlayers(); ok {
+		_spec.SetField(game.FieldMinPlayers, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedMinPlayers(); ok {
+		_spec.AddField(game.FieldMinPlayers, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.Visibility(); ok {
+		_spec.SetField(game.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := gu.mutation.InviteCode(); ok {
+		_spec.SetField(game.FieldInviteCode, field.TypeString, value)
+	}
+	if gu.mutation.InviteCodeCleared() {
+		_spec.ClearField(game.FieldInviteCode, field.TypeString)
+	}
+	if value, ok := gu.mutation.PasswordHash(); ok {
+		_spec.SetField(game.FieldPasswordHash, field.TypeString, value)
+	}
+	if gu.mutation.PasswordHashCleared() {
+		_spec.ClearField(game.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := gu.mutation.HostToken(); ok {
+		_spec.SetField(game.FieldHostToken, field.TypeString, value)
+	}
+	if gu.mutation.HostTokenCleared() {
+		_spec.ClearField(game.FieldHostToken, field.TypeString)
+	}
+	if value, ok := gu.mutation.AutoStart(); ok {
+		_spec.SetField(game.FieldAutoStart, field.TypeBool, value)
+	}
+	if value, ok := gu.mutation.CardCount(); ok {
+		_spec.SetField(game.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedCardCount(); ok {
+		_spec.AddField(game.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.WinnerID(); ok {
+		_spec.SetField(game.FieldWinnerID, field.TypeInt, value)
+	}
+	if value, ok := gu.mutation.AddedWinnerID(); ok {
+		_spec.AddField(game.FieldWinnerID, field.TypeInt, value)
+	}
+	if gu.mutation.WinnerIDCleared() {
+		_spec.ClearField(game.FieldWinnerID, field.TypeInt)
+	}
+	if value, ok := gu.mutation.AutoBalance(); ok {
+		_spec.SetField(game.FieldAutoBalance, field.TypeBool, value)
+	}
+	if gu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.PlayersTable,
+			Columns: []string{game.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !gu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.PlayersTable,
+			Columns: []string{game.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
 		}
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
@@ -229,6 +779,183 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if gu.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedTeamsIDs(); len(nodes) > 0 && !gu.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.TournamentMatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RemovedTournamentMatchesIDs(); len(nodes) > 0 && !gu.mutation.TournamentMatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.TournamentMatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   game.MatchTable,
+			Columns: []string{game.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   game.MatchTable,
+			Columns: []string{game.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.PreviousCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   game.PreviousTable,
+			Columns: []string{game.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   game.PreviousTable,
+			Columns: []string{game.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if gu.mutation.RematchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.RematchTable,
+			Columns: []string{game.RematchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := gu.mutation.RematchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.RematchTable,
+			Columns: []string{game.RematchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, gu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{game.Label}
@@ -237,64 +964,381 @@ func (gu *GameUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		return 0, err
 	}
-	gu.mutation.done = true
-	return n, nil
+	gu.mutation.done = true
+	return n, nil
+}
+
+// GameUpdateOne is the builder for updating a single Game entity.
+type GameUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *GameMutation
+}
+
+// SetName sets the "name" field.
+func (guo *GameUpdateOne) SetName(s string) *GameUpdateOne {
+	guo.mutation.SetName(s)
+	return guo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableName(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetName(*s)
+	}
+	return guo
+}
+
+// SetStatus sets the "status" field.
+func (guo *GameUpdateOne) SetStatus(ga game.Status) *GameUpdateOne {
+	guo.mutation.SetStatus(ga)
+	return guo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableStatus(ga *game.Status) *GameUpdateOne {
+	if ga != nil {
+		guo.SetStatus(*ga)
+	}
+	return guo
+}
+
+// SetTotalRounds sets the "total_rounds" field.
+func (guo *GameUpdateOne) SetTotalRounds(i int) *GameUpdateOne {
+	guo.mutation.ResetTotalRounds()
+	guo.mutation.SetTotalRounds(i)
+	return guo
+}
+
+// SetNillableTotalRounds sets the "total_rounds" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableTotalRounds(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetTotalRounds(*i)
+	}
+	return guo
+}
+
+// AddTotalRounds adds i to the "total_rounds" field.
+func (guo *GameUpdateOne) AddTotalRounds(i int) *GameUpdateOne {
+	guo.mutation.AddTotalRounds(i)
+	return guo
+}
+
+// SetMode sets the "mode" field.
+func (guo *GameUpdateOne) SetMode(s string) *GameUpdateOne {
+	guo.mutation.SetMode(s)
+	return guo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableMode(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetMode(*s)
+	}
+	return guo
+}
+
+// SetTeamScore sets the "team_score" field.
+func (guo *GameUpdateOne) SetTeamScore(i int) *GameUpdateOne {
+	guo.mutation.ResetTeamScore()
+	guo.mutation.SetTeamScore(i)
+	return guo
+}
+
+// SetNillableTeamScore sets the "team_score" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableTeamScore(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetTeamScore(*i)
+	}
+	return guo
+}
+
+// AddTeamScore adds i to the "team_score" field.
+func (guo *GameUpdateOne) AddTeamScore(i int) *GameUpdateOne {
+	guo.mutation.AddTeamScore(i)
+	return guo
+}
+
+// SetEliminationInterval sets the "elimination_interval" field.
+func (guo *GameUpdateOne) SetEliminationInterval(i int) *GameUpdateOne {
+	guo.mutation.ResetEliminationInterval()
+	guo.mutation.SetEliminationInterval(i)
+	return guo
+}
+
+// SetNillableEliminationInterval sets the "elimination_interval" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableEliminationInterval(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetEliminationInterval(*i)
+	}
+	return guo
+}
+
+// AddEliminationInterval adds i to the "elimination_interval" field.
+func (guo *GameUpdateOne) AddEliminationInterval(i int) *GameUpdateOne {
+	guo.mutation.AddEliminationInterval(i)
+	return guo
+}
+
+// SetCenterCount sets the "center_count" field.
+func (guo *GameUpdateOne) SetCenterCount(i int) *GameUpdateOne {
+	guo.mutation.ResetCenterCount()
+	guo.mutation.SetCenterCount(i)
+	return guo
+}
+
+// SetNillableCenterCount sets the "center_count" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableCenterCount(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetCenterCount(*i)
+	}
+	return guo
+}
+
+// AddCenterCount adds i to the "center_count" field.
+func (guo *GameUpdateOne) AddCenterCount(i int) *GameUpdateOne {
+	guo.mutation.AddCenterCount(i)
+	return guo
+}
+
+// SetTieBreak sets the "tie_break" field.
+func (guo *GameUpdateOne) SetTieBreak(gb game.TieBreak) *GameUpdateOne {
+	guo.mutation.SetTieBreak(gb)
+	return guo
+}
+
+// SetNillableTieBreak sets the "tie_break" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableTieBreak(gb *game.TieBreak) *GameUpdateOne {
+	if gb != nil {
+		guo.SetTieBreak(*gb)
+	}
+	return guo
+}
+
+// SetScoring sets the "scoring" field.
+func (guo *GameUpdateOne) SetScoring(s scoring.Rules) *GameUpdateOne {
+	guo.mutation.SetScoring(s)
+	return guo
+}
+
+// SetNillableScoring sets the "scoring" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableScoring(s *scoring.Rules) *GameUpdateOne {
+	if s != nil {
+		guo.SetScoring(*s)
+	}
+	return guo
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (guo *GameUpdateOne) SetMaxPlayers(i int) *GameUpdateOne {
+	guo.mutation.ResetMaxPlayers()
+	guo.mutation.SetMaxPlayers(i)
+	return guo
+}
+
+// SetNillableMaxPlayers sets the "max_players" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableMaxPlayers(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetMaxPlayers(*i)
+	}
+	return guo
+}
+
+// AddMaxPlayers adds i to the "max_players" field.
+func (guo *GameUpdateOne) AddMaxPlayers(i int) *GameUpdateOne {
+	guo.mutation.AddMaxPlayers(i)
+	return guo
+}
+
+// SetMinPlayers sets the "min_players" field.
+func (guo *GameUpdateOne) SetMinPlayers(i int) *GameUpdateOne {
+	guo.mutation.ResetMinPlayers()
+	guo.mutation.SetMinPlayers(i)
+	return guo
+}
+
+// SetNillableMinPlayers sets the "min_players" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableMinPlayers(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetMinPlayers(*i)
+	}
+	return guo
+}
+
+// AddMinPlayers adds i to the "min_players" field.
+func (guo *GameUpdateOne) AddMinPlayers(i int) *GameUpdateOne {
+	guo.mutation.AddMinPlayers(i)
+	return guo
+}
+
+// SetVisibility sets the "visibility" field.
+func (guo *GameUpdateOne) SetVisibility(ga game.Visibility) *GameUpdateOne {
+	guo.mutation.SetVisibility(ga)
+	return guo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableVisibility(ga *game.Visibility) *GameUpdateOne {
+	if ga != nil {
+		guo.SetVisibility(*ga)
+	}
+	return guo
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (guo *GameUpdateOne) SetInviteCode(s string) *GameUpdateOne {
+	guo.mutation.SetInviteCode(s)
+	return guo
+}
+
+// SetNillableInviteCode sets the "invite_code" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableInviteCode(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetInviteCode(*s)
+	}
+	return guo
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (guo *GameUpdateOne) ClearInviteCode() *GameUpdateOne {
+	guo.mutation.ClearInviteCode()
+	return guo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (guo *GameUpdateOne) SetPasswordHash(s string) *GameUpdateOne {
+	guo.mutation.SetPasswordHash(s)
+	return guo
+}
+
+// SetNillablePasswordHash sets the "password_hash" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillablePasswordHash(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetPasswordHash(*s)
+	}
+	return guo
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (guo *GameUpdateOne) ClearPasswordHash() *GameUpdateOne {
+	guo.mutation.ClearPasswordHash()
+	return guo
+}
+
+// SetHostToken sets the "host_token" field.
+func (guo *GameUpdateOne) SetHostToken(s string) *GameUpdateOne {
+	guo.mutation.SetHostToken(s)
+	return guo
+}
+
+// SetNillableHostToken sets the "host_token" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableHostToken(s *string) *GameUpdateOne {
+	if s != nil {
+		guo.SetHostToken(*s)
+	}
+	return guo
+}
+
+// ClearHostToken clears the value of the "host_token" field.
+func (guo *GameUpdateOne) ClearHostToken() *GameUpdateOne {
+	guo.mutation.ClearHostToken()
+	return guo
+}
+
+// SetAutoStart sets the "auto_start" field.
+func (guo *GameUpdateOne) SetAutoStart(b bool) *GameUpdateOne {
+	guo.mutation.SetAutoStart(b)
+	return guo
+}
+
+// SetNillableAutoStart sets the "auto_start" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableAutoStart(b *bool) *GameUpdateOne {
+	if b != nil {
+		guo.SetAutoStart(*b)
+	}
+	return guo
+}
+
+// SetCardCount sets the "card_count" field.
+func (guo *GameUpdateOne) SetCardCount(i int) *GameUpdateOne {
+	guo.mutation.ResetCardCount()
+	guo.mutation.SetCardCount(i)
+	return guo
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableCardCount(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetCardCount(*i)
+	}
+	return guo
 }
 
-// GameUpdateOne is the builder for updating a single Game entity.
-type GameUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *GameMutation
+// AddCardCount adds i to the "card_count" field.
+func (guo *GameUpdateOne) AddCardCount(i int) *GameUpdateOne {
+	guo.mutation.AddCardCount(i)
+	return guo
 }
 
-// SetName sets the "name" field.
-func (guo *GameUpdateOne) SetName(s string) *GameUpdateOne {
-	guo.mutation.SetName(s)
+// SetWinnerID sets the "winner_id" field.
+func (guo *GameUpdateOne) SetWinnerID(i int) *GameUpdateOne {
+	guo.mutation.ResetWinnerID()
+	guo.mutation.SetWinnerID(i)
 	return guo
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (guo *GameUpdateOne) SetNillableName(s *string) *GameUpdateOne {
-	if s != nil {
-		guo.SetName(*s)
+// SetNillableWinnerID sets the "winner_id" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableWinnerID(i *int) *GameUpdateOne {
+	if i != nil {
+		guo.SetWinnerID(*i)
 	}
 	return guo
 }
 
-// SetStatus sets the "status" field.
-func (guo *GameUpdateOne) SetStatus(ga game.Status) *GameUpdateOne {
-	guo.mutation.SetStatus(ga)
+// AddWinnerID adds i to the "winner_id" field.
+func (guo *GameUpdateOne) AddWinnerID(i int) *GameUpdateOne {
+	guo.mutation.AddWinnerID(i)
 	return guo
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (guo *GameUpdateOne) SetNillableStatus(ga *game.Status) *GameUpdateOne {
-	if ga != nil {
-		guo.SetStatus(*ga)
+// ClearWinnerID clears the value of the "winner_id" field.
+func (guo *GameUpdateOne) ClearWinnerID() *GameUpdateOne {
+	guo.mutation.ClearWinnerID()
+	return guo
+}
+
+// SetAutoBalance sets the "auto_balance" field.
+func (guo *GameUpdateOne) SetAutoBalance(b bool) *GameUpdateOne {
+	guo.mutation.SetAutoBalance(b)
+	return guo
+}
+
+// SetNillableAutoBalance sets the "auto_balance" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableAutoBalance(b *bool) *GameUpdateOne {
+	if b != nil {
+		guo.SetAutoBalance(*b)
 	}
 	return guo
 }
 
-// SetTotalRounds sets the "total_rounds" field.
-func (guo *GameUpdateOne) SetTotalRounds(i int) *GameUpdateOne {
-	guo.mutation.ResetTotalRounds()
-	guo.mutation.SetTotalRounds(i)
+// SetMatchID sets the "match_id" field.
+func (guo *GameUpdateOne) SetMatchID(i int) *GameUpdateOne {
+	guo.mutation.SetMatchID(i)
 	return guo
 }
 
-// SetNillableTotalRounds sets the "total_rounds" field if the given value is not nil.
-func (guo *GameUpdateOne) SetNillableTotalRounds(i *int) *GameUpdateOne {
+// SetNillableMatchID sets the "match_id" field if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableMatchID(i *int) *GameUpdateOne {
 	if i != nil {
-		guo.SetTotalRounds(*i)
+		guo.SetMatchID(*i)
 	}
 	return guo
 }
 
-// AddTotalRounds adds i to the "total_rounds" field.
-func (guo *GameUpdateOne) AddTotalRounds(i int) *GameUpdateOne {
-	guo.mutation.AddTotalRounds(i)
+// ClearMatchID clears the value of the "match_id" field.
+func (guo *GameUpdateOne) ClearMatchID() *GameUpdateOne {
+	guo.mutation.ClearMatchID()
 	return guo
 }
 
@@ -313,6 +1357,79 @@ func (guo *GameUpdateOne) AddPlayers(p ...*Player) *GameUpdateOne {
 	return guo.AddPlayerIDs(ids...)
 }
 
+// AddTeamIDs adds the "teams" edge to the Team entity by IDs.
+func (guo *GameUpdateOne) AddTeamIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddTeamIDs(ids...)
+	return guo
+}
+
+// AddTeams adds the "teams" edges to the Team entity.
+func (guo *GameUpdateOne) AddTeams(t ...*Team) *GameUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.AddTeamIDs(ids...)
+}
+
+// AddTournamentMatchIDs adds the "tournament_matches" edge to the TournamentMatch entity by IDs.
+func (guo *GameUpdateOne) AddTournamentMatchIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.AddTournamentMatchIDs(ids...)
+	return guo
+}
+
+// AddTournamentMatches adds the "tournament_matches" edges to the TournamentMatch entity.
+func (guo *GameUpdateOne) AddTournamentMatches(t ...*TournamentMatch) *GameUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.AddTournamentMatchIDs(ids...)
+}
+
+// SetMatch sets the "match" edge to the Match entity.
+func (guo *GameUpdateOne) SetMatch(m *Match) *GameUpdateOne {
+	return guo.SetMatchID(m.ID)
+}
+
+// SetPreviousID sets the "previous" edge to the Game entity by ID.
+func (guo *GameUpdateOne) SetPreviousID(id int) *GameUpdateOne {
+	guo.mutation.SetPreviousID(id)
+	return guo
+}
+
+// SetNillablePreviousID sets the "previous" edge to the Game entity by ID if the given value is not nil.
+func (guo *GameUpdateOne) SetNillablePreviousID(id *int) *GameUpdateOne {
+	if id != nil {
+		guo = guo.SetPreviousID(*id)
+	}
+	return guo
+}
+
+// SetPrevious sets the "previous" edge to the Game entity.
+func (guo *GameUpdateOne) SetPrevious(g *Game) *GameUpdateOne {
+	return guo.SetPreviousID(g.ID)
+}
+
+// SetRematchID sets the "rematch" edge to the Game entity by ID.
+func (guo *GameUpdateOne) SetRematchID(id int) *GameUpdateOne {
+	guo.mutation.SetRematchID(id)
+	return guo
+}
+
+// SetNillableRematchID sets the "rematch" edge to the Game entity by ID if the given value is not nil.
+func (guo *GameUpdateOne) SetNillableRematchID(id *int) *GameUpdateOne {
+	if id != nil {
+		guo = guo.SetRematchID(*id)
+	}
+	return guo
+}
+
+// SetRematch sets the "rematch" edge to the Game entity.
+func (guo *GameUpdateOne) SetRematch(g *Game) *GameUpdateOne {
+	return guo.SetRematchID(g.ID)
+}
+
 // Mutation returns the GameMutation object of the builder.
 func (guo *GameUpdateOne) Mutation() *GameMutation {
 	return guo.mutation
@@ -339,6 +1456,66 @@ func (guo *GameUpdateOne) RemovePlayers(p ...*Player) *GameUpdateOne {
 	return guo.RemovePlayerIDs(ids...)
 }
 
+// ClearTeams clears all "teams" edges to the Team entity.
+func (guo *GameUpdateOne) ClearTeams() *GameUpdateOne {
+	guo.mutation.ClearTeams()
+	return guo
+}
+
+// RemoveTeamIDs removes the "teams" edge to Team entities by IDs.
+func (guo *GameUpdateOne) RemoveTeamIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveTeamIDs(ids...)
+	return guo
+}
+
+// RemoveTeams removes "teams" edges to Team entities.
+func (guo *GameUpdateOne) RemoveTeams(t ...*Team) *GameUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.RemoveTeamIDs(ids...)
+}
+
+// ClearTournamentMatches clears all "tournament_matches" edges to the TournamentMatch entity.
+func (guo *GameUpdateOne) ClearTournamentMatches() *GameUpdateOne {
+	guo.mutation.ClearTournamentMatches()
+	return guo
+}
+
+// RemoveTournamentMatchIDs removes the "tournament_matches" edge to TournamentMatch entities by IDs.
+func (guo *GameUpdateOne) RemoveTournamentMatchIDs(ids ...int) *GameUpdateOne {
+	guo.mutation.RemoveTournamentMatchIDs(ids...)
+	return guo
+}
+
+// RemoveTournamentMatches removes "tournament_matches" edges to TournamentMatch entities.
+func (guo *GameUpdateOne) RemoveTournamentMatches(t ...*TournamentMatch) *GameUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return guo.RemoveTournamentMatchIDs(ids...)
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (guo *GameUpdateOne) ClearMatch() *GameUpdateOne {
+	guo.mutation.ClearMatch()
+	return guo
+}
+
+// ClearPrevious clears the "previous" edge to the Game entity.
+func (guo *GameUpdateOne) ClearPrevious() *GameUpdateOne {
+	guo.mutation.ClearPrevious()
+	return guo
+}
+
+// ClearRematch clears the "rematch" edge to the Game entity.
+func (guo *GameUpdateOne) ClearRematch() *GameUpdateOne {
+	guo.mutation.ClearRematch()
+	return guo
+}
+
 // Where appends a list predicates to the GameUpdate builder.
 func (guo *GameUpdateOne) Where(ps ...predicate.Game) *GameUpdateOne {
 	guo.mutation.Where(ps...)
@@ -391,6 +1568,21 @@ func (guo *GameUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Game.status": %w`, err)}
 		}
 	}
+	if v, ok := guo.mutation.TieBreak(); ok {
+		if err := game.TieBreakValidator(v); err != nil {
+			return &ValidationError{Name: "tie_break", err: fmt.Errorf(`ent: validator failed for field "Game.tie_break": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Scoring(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "scoring", err: fmt.Errorf(`ent: validator failed for field "Game.scoring": %w`, err)}
+		}
+	}
+	if v, ok := guo.mutation.Visibility(); ok {
+		if err := game.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Game.visibility": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -435,6 +1627,87 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 	if value, ok := guo.mutation.AddedTotalRounds(); ok {
 		_spec.AddField(game.FieldTotalRounds, field.TypeInt, value)
 	}
+	if value, ok := guo.mutation.Mode(); ok {
+		_spec.SetField(game.FieldMode, field.TypeString, value)
+	}
+	if value, ok := guo.mutation.TeamScore(); ok {
+		_spec.SetField(game.FieldTeamScore, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedTeamScore(); ok {
+		_spec.AddField(game.FieldTeamScore, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.EliminationInterval(); ok {
+		_spec.SetField(game.FieldEliminationInterval, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedEliminationInterval(); ok {
+		_spec.AddField(game.FieldEliminationInterval, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.CenterCount(); ok {
+		_spec.SetField(game.FieldCenterCount, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedCenterCount(); ok {
+		_spec.AddField(game.FieldCenterCount, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.TieBreak(); ok {
+		_spec.SetField(game.FieldTieBreak, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.Scoring(); ok {
+		_spec.SetField(game.FieldScoring, field.TypeJSON, value)
+	}
+	if value, ok := guo.mutation.MaxPlayers(); ok {
+		_spec.SetField(game.FieldMaxPlayers, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedMaxPlayers(); ok {
+		_spec.AddField(game.FieldMaxPlayers, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.MinPlayers(); ok {
+		_spec.SetField(game.FieldMinPlayers, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedMinPlayers(); ok {
+		_spec.AddField(game.FieldMinPlayers, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.Visibility(); ok {
+		_spec.SetField(game.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := guo.mutation.InviteCode(); ok {
+		_spec.SetField(game.FieldInviteCode, field.TypeString, value)
+	}
+	if guo.mutation.InviteCodeCleared() {
+		_spec.ClearField(game.FieldInviteCode, field.TypeString)
+	}
+	if value, ok := guo.mutation.PasswordHash(); ok {
+		_spec.SetField(game.FieldPasswordHash, field.TypeString, value)
+	}
+	if guo.mutation.PasswordHashCleared() {
+		_spec.ClearField(game.FieldPasswordHash, field.TypeString)
+	}
+	if value, ok := guo.mutation.HostToken(); ok {
+		_spec.SetField(game.FieldHostToken, field.TypeString, value)
+	}
+	if guo.mutation.HostTokenCleared() {
+		_spec.ClearField(game.FieldHostToken, field.TypeString)
+	}
+	if value, ok := guo.mutation.AutoStart(); ok {
+		_spec.SetField(game.FieldAutoStart, field.TypeBool, value)
+	}
+	if value, ok := guo.mutation.CardCount(); ok {
+		_spec.SetField(game.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedCardCount(); ok {
+		_spec.AddField(game.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.WinnerID(); ok {
+		_spec.SetField(game.FieldWinnerID, field.TypeInt, value)
+	}
+	if value, ok := guo.mutation.AddedWinnerID(); ok {
+		_spec.AddField(game.FieldWinnerID, field.TypeInt, value)
+	}
+	if guo.mutation.WinnerIDCleared() {
+		_spec.ClearField(game.FieldWinnerID, field.TypeInt)
+	}
+	if value, ok := guo.mutation.AutoBalance(); ok {
+		_spec.SetField(game.FieldAutoBalance, field.TypeBool, value)
+	}
 	if guo.mutation.PlayersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -480,6 +1753,183 @@ func (guo *GameUpdateOne) sqlSave(ctx context.Context) (_node *Game, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if guo.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedTeamsIDs(); len(nodes) > 0 && !guo.mutation.TeamsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.TeamsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TeamsTable,
+			Columns: []string{game.TeamsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.TournamentMatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RemovedTournamentMatchesIDs(); len(nodes) > 0 && !guo.mutation.TournamentMatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.TournamentMatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   game.TournamentMatchesTable,
+			Columns: []string{game.TournamentMatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.MatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   game.MatchTable,
+			Columns: []string{game.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.MatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   game.MatchTable,
+			Columns: []string{game.MatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.PreviousCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   game.PreviousTable,
+			Columns: []string{game.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.PreviousIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   game.PreviousTable,
+			Columns: []string{game.PreviousColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if guo.mutation.RematchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.RematchTable,
+			Columns: []string{game.RematchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := guo.mutation.RematchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   game.RematchTable,
+			Columns: []string{game.RematchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Game{config: guo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"example/ent/match"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Match is the model entity for the Match schema.
+type Match struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format match.Format `json:"format,omitempty"`
+	// Length holds the value of the "length" field.
+	Length int `json:"length,omitempty"`
+	// Status holds the value of the "status" field.
+	Status match.Status `json:"status,omitempty"`
+	// WinnerName holds the value of the "winner_name" field.
+	WinnerName *string `json:"winner_name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the MatchQuery when eager-loading is set.
+	Edges        MatchEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// MatchEdges holds the relations/edges for other nodes in the graph.
+type MatchEdges struct {
+	// Games holds the value of the games edge.
+	Games []*Game `json:"games,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// GamesOrErr returns the Games value or an error if the edge
+// was not loaded in eager-loading.
+func (e MatchEdges) GamesOrErr() ([]*Game, error) {
+	if e.loadedTypes[0] {
+		return e.Games, nil
+	}
+	return nil, &NotLoadedError{edge: "games"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Match) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case match.FieldID, match.FieldLength:
+			values[i] = new(sql.NullInt64)
+		case match.FieldFormat, match.FieldStatus, match.FieldWinnerName:
+			values[i] = new(sql.NullString)
+		case match.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Match fields.
+func (m *Match) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case match.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int(value.Int64)
+		case match.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				m.Format = match.Format(value.String)
+			}
+		case match.FieldLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field length", values[i])
+			} else if value.Valid {
+				m.Length = int(value.Int64)
+			}
+		case match.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				m.Status = match.Status(value.String)
+			}
+		case match.FieldWinnerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field winner_name", values[i])
+			} else if value.Valid {
+				m.WinnerName = new(string)
+				*m.WinnerName = value.String
+			}
+		case match.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Match.
+// This includes values selected through modifiers, order, etc.
+func (m *Match) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// QueryGames queries the "games" edge of the Match entity.
+func (m *Match) QueryGames() *GameQuery {
+	return NewMatchClient(m.config).QueryGames(m)
+}
+
+// Update returns a builder for updating this Match.
+// Note that you need to call Match.Unwrap() before calling this method if this Match
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Match) Update() *MatchUpdateOne {
+	return NewMatchClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Match entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Match) Unwrap() *Match {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Match is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Match) String() string {
+	var builder strings.Builder
+	builder.WriteString("Match(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("length=")
+	builder.WriteString(fmt.Sprintf("%v", m.Length))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", m.Status))
+	builder.WriteString(", ")
+	if v := m.WinnerName; v != nil {
+		builder.WriteString("winner_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Matches is a parsable slice of Match.
+type Matches []*Match
@@ -0,0 +1,304 @@
+// Code generated by ent, DO NOT EDIT.
+
+package match
+
+import (
+	"example/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldID, id))
+}
+
+// Length applies equality check predicate on the "length" field. It's identical to LengthEQ.
+func Length(v int) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldLength, v))
+}
+
+// WinnerName applies equality check predicate on the "winner_name" field. It's identical to WinnerNameEQ.
+func WinnerName(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldWinnerName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// LengthEQ applies the EQ predicate on the "length" field.
+func LengthEQ(v int) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldLength, v))
+}
+
+// LengthNEQ applies the NEQ predicate on the "length" field.
+func LengthNEQ(v int) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldLength, v))
+}
+
+// LengthIn applies the In predicate on the "length" field.
+func LengthIn(vs ...int) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldLength, vs...))
+}
+
+// LengthNotIn applies the NotIn predicate on the "length" field.
+func LengthNotIn(vs ...int) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldLength, vs...))
+}
+
+// LengthGT applies the GT predicate on the "length" field.
+func LengthGT(v int) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldLength, v))
+}
+
+// LengthGTE applies the GTE predicate on the "length" field.
+func LengthGTE(v int) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldLength, v))
+}
+
+// LengthLT applies the LT predicate on the "length" field.
+func LengthLT(v int) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldLength, v))
+}
+
+// LengthLTE applies the LTE predicate on the "length" field.
+func LengthLTE(v int) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldLength, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// WinnerNameEQ applies the EQ predicate on the "winner_name" field.
+func WinnerNameEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldWinnerName, v))
+}
+
+// WinnerNameNEQ applies the NEQ predicate on the "winner_name" field.
+func WinnerNameNEQ(v string) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldWinnerName, v))
+}
+
+// WinnerNameIn applies the In predicate on the "winner_name" field.
+func WinnerNameIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldWinnerName, vs...))
+}
+
+// WinnerNameNotIn applies the NotIn predicate on the "winner_name" field.
+func WinnerNameNotIn(vs ...string) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldWinnerName, vs...))
+}
+
+// WinnerNameGT applies the GT predicate on the "winner_name" field.
+func WinnerNameGT(v string) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldWinnerName, v))
+}
+
+// WinnerNameGTE applies the GTE predicate on the "winner_name" field.
+func WinnerNameGTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldWinnerName, v))
+}
+
+// WinnerNameLT applies the LT predicate on the "winner_name" field.
+func WinnerNameLT(v string) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldWinnerName, v))
+}
+
+// WinnerNameLTE applies the LTE predicate on the "winner_name" field.
+func WinnerNameLTE(v string) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldWinnerName, v))
+}
+
+// WinnerNameContains applies the Contains predicate on the "winner_name" field.
+func WinnerNameContains(v string) predicate.Match {
+	return predicate.Match(sql.FieldContains(FieldWinnerName, v))
+}
+
+// WinnerNameHasPrefix applies the HasPrefix predicate on the "winner_name" field.
+func WinnerNameHasPrefix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasPrefix(FieldWinnerName, v))
+}
+
+// WinnerNameHasSuffix applies the HasSuffix predicate on the "winner_name" field.
+func WinnerNameHasSuffix(v string) predicate.Match {
+	return predicate.Match(sql.FieldHasSuffix(FieldWinnerName, v))
+}
+
+// WinnerNameIsNil applies the IsNil predicate on the "winner_name" field.
+func WinnerNameIsNil() predicate.Match {
+	return predicate.Match(sql.FieldIsNull(FieldWinnerName))
+}
+
+// WinnerNameNotNil applies the NotNil predicate on the "winner_name" field.
+func WinnerNameNotNil() predicate.Match {
+	return predicate.Match(sql.FieldNotNull(FieldWinnerName))
+}
+
+// WinnerNameEqualFold applies the EqualFold predicate on the "winner_name" field.
+func WinnerNameEqualFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldEqualFold(FieldWinnerName, v))
+}
+
+// WinnerNameContainsFold applies the ContainsFold predicate on the "winner_name" field.
+func WinnerNameContainsFold(v string) predicate.Match {
+	return predicate.Match(sql.FieldContainsFold(FieldWinnerName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Match {
+	return predicate.Match(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Match {
+	return predicate.Match(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Match {
+	return predicate.Match(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasGames applies the HasEdge predicate on the "games" edge.
+func HasGames() predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, GamesTable, GamesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGamesWith applies the HasEdge predicate on the "games" edge with a given conditions (other predicates).
+func HasGamesWith(preds ...predicate.Game) predicate.Match {
+	return predicate.Match(func(s *sql.Selector) {
+		step := newGamesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Match) predicate.Match {
+	return predicate.Match(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Match) predicate.Match {
+	return predicate.Match(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Match) predicate.Match {
+	return predicate.Match(sql.NotPredicates(p))
+}
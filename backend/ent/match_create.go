@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/game"
+	"example/ent/match"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MatchCreate is the builder for creating a Match entity.
+type MatchCreate struct {
+	config
+	mutation *MatchMutation
+	hooks    []Hook
+}
+
+// SetFormat sets the "format" field.
+func (mc *MatchCreate) SetFormat(m match.Format) *MatchCreate {
+	mc.mutation.SetFormat(m)
+	return mc
+}
+
+// SetLength sets the "length" field.
+func (mc *MatchCreate) SetLength(i int) *MatchCreate {
+	mc.mutation.SetLength(i)
+	return mc
+}
+
+// SetStatus sets the "status" field.
+func (mc *MatchCreate) SetStatus(m match.Status) *MatchCreate {
+	mc.mutation.SetStatus(m)
+	return mc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mc *MatchCreate) SetNillableStatus(m *match.Status) *MatchCreate {
+	if m != nil {
+		mc.SetStatus(*m)
+	}
+	return mc
+}
+
+// SetWinnerName sets the "winner_name" field.
+func (mc *MatchCreate) SetWinnerName(s string) *MatchCreate {
+	mc.mutation.SetWinnerName(s)
+	return mc
+}
+
+// SetNillableWinnerName sets the "winner_name" field if the given value is not nil.
+func (mc *MatchCreate) SetNillableWinnerName(s *string) *MatchCreate {
+	if s != nil {
+		mc.SetWinnerName(*s)
+	}
+	return mc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MatchCreate) SetCreatedAt(t time.Time) *MatchCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MatchCreate) SetNillableCreatedAt(t *time.Time) *MatchCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// AddGameIDs adds the "games" edge to the Game entity by IDs.
+func (mc *MatchCreate) AddGameIDs(ids ...int) *MatchCreate {
+	mc.mutation.AddGameIDs(ids...)
+	return mc
+}
+
+// AddGames adds the "games" edges to the Game entity.
+func (mc *MatchCreate) AddGames(g ...*Game) *MatchCreate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mc.AddGameIDs(ids...)
+}
+
+// Mutation returns the MatchMutation object of the builder.
+func (mc *MatchCreate) Mutation() *MatchMutation {
+	return mc.mutation
+}
+
+// Save creates the Match in the database.
+func (mc *MatchCreate) Save(ctx context.Context) (*Match, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MatchCreate) SaveX(ctx context.Context) *Match {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MatchCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MatchCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MatchCreate) defaults() {
+	if _, ok := mc.mutation.Status(); !ok {
+		v := match.DefaultStatus
+		mc.mutation.SetStatus(v)
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := match.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MatchCreate) check() error {
+	if _, ok := mc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Match.format"`)}
+	}
+	if v, ok := mc.mutation.Format(); ok {
+		if err := match.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Match.format": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.Length(); !ok {
+		return &ValidationError{Name: "length", err: errors.New(`ent: missing required field "Match.length"`)}
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Match.status"`)}
+	}
+	if v, ok := mc.mutation.Status(); ok {
+		if err := match.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Match.status": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Match.created_at"`)}
+	}
+	return nil
+}
+
+func (mc *MatchCreate) sqlSave(ctx context.Context) (*Match, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MatchCreate) createSpec() (*Match, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Match{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(match.Table, sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt))
+	)
+	if value, ok := mc.mutation.Format(); ok {
+		_spec.SetField(match.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := mc.mutation.Length(); ok {
+		_spec.SetField(match.FieldLength, field.TypeInt, value)
+		_node.Length = value
+	}
+	if value, ok := mc.mutation.Status(); ok {
+		_spec.SetField(match.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := mc.mutation.WinnerName(); ok {
+		_spec.SetField(match.FieldWinnerName, field.TypeString, value)
+		_node.WinnerName = &value
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(match.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := mc.mutation.GamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MatchCreateBulk is the builder for creating many Match entities in bulk.
+type MatchCreateBulk struct {
+	config
+	err      error
+	builders []*MatchCreate
+}
+
+// Save creates the Match entities in the database.
+func (mcb *MatchCreateBulk) Save(ctx context.Context) ([]*Match, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Match, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MatchCreateBulk) SaveX(ctx context.Context) []*Match {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MatchCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/game"
+	"example/ent/match"
+	"example/ent/predicate"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MatchUpdate is the builder for updating Match entities.
+type MatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MatchMutation
+}
+
+// Where appends a list predicates to the MatchUpdate builder.
+func (mu *MatchUpdate) Where(ps ...predicate.Match) *MatchUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetFormat sets the "format" field.
+func (mu *MatchUpdate) SetFormat(m match.Format) *MatchUpdate {
+	mu.mutation.SetFormat(m)
+	return mu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableFormat(m *match.Format) *MatchUpdate {
+	if m != nil {
+		mu.SetFormat(*m)
+	}
+	return mu
+}
+
+// SetLength sets the "length" field.
+func (mu *MatchUpdate) SetLength(i int) *MatchUpdate {
+	mu.mutation.ResetLength()
+	mu.mutation.SetLength(i)
+	return mu
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableLength(i *int) *MatchUpdate {
+	if i != nil {
+		mu.SetLength(*i)
+	}
+	return mu
+}
+
+// AddLength adds i to the "length" field.
+func (mu *MatchUpdate) AddLength(i int) *MatchUpdate {
+	mu.mutation.AddLength(i)
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *MatchUpdate) SetStatus(m match.Status) *MatchUpdate {
+	mu.mutation.SetStatus(m)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableStatus(m *match.Status) *MatchUpdate {
+	if m != nil {
+		mu.SetStatus(*m)
+	}
+	return mu
+}
+
+// SetWinnerName sets the "winner_name" field.
+func (mu *MatchUpdate) SetWinnerName(s string) *MatchUpdate {
+	mu.mutation.SetWinnerName(s)
+	return mu
+}
+
+// SetNillableWinnerName sets the "winner_name" field if the given value is not nil.
+func (mu *MatchUpdate) SetNillableWinnerName(s *string) *MatchUpdate {
+	if s != nil {
+		mu.SetWinnerName(*s)
+	}
+	return mu
+}
+
+// ClearWinnerName clears the value of the "winner_name" field.
+func (mu *MatchUpdate) ClearWinnerName() *MatchUpdate {
+	mu.mutation.ClearWinnerName()
+	return mu
+}
+
+// AddGameIDs adds the "games" edge to the Game entity by IDs.
+func (mu *MatchUpdate) AddGameIDs(ids ...int) *MatchUpdate {
+	mu.mutation.AddGameIDs(ids...)
+	return mu
+}
+
+// AddGames adds the "games" edges to the Game entity.
+func (mu *MatchUpdate) AddGames(g ...*Game) *MatchUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mu.AddGameIDs(ids...)
+}
+
+// Mutation returns the MatchMutation object of the builder.
+func (mu *MatchUpdate) Mutation() *MatchMutation {
+	return mu.mutation
+}
+
+// ClearGames clears all "games" edges to the Game entity.
+func (mu *MatchUpdate) ClearGames() *MatchUpdate {
+	mu.mutation.ClearGames()
+	return mu
+}
+
+// RemoveGameIDs removes the "games" edge to Game entities by IDs.
+func (mu *MatchUpdate) RemoveGameIDs(ids ...int) *MatchUpdate {
+	mu.mutation.RemoveGameIDs(ids...)
+	return mu
+}
+
+// RemoveGames removes "games" edges to Game entities.
+func (mu *MatchUpdate) RemoveGames(g ...*Game) *MatchUpdate {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return mu.RemoveGameIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MatchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MatchUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MatchUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MatchUpdate) check() error {
+	if v, ok := mu.mutation.Format(); ok {
+		if err := match.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Match.format": %w`, err)}
+		}
+	}
+	if v, ok := mu.mutation.Status(); ok {
+		if err := match.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Match.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(match.Table, match.Columns, sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.Format(); ok {
+		_spec.SetField(match.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.Length(); ok {
+		_spec.SetField(match.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedLength(); ok {
+		_spec.AddField(match.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.SetField(match.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := mu.mutation.WinnerName(); ok {
+		_spec.SetField(match.FieldWinnerName, field.TypeString, value)
+	}
+	if mu.mutation.WinnerNameCleared() {
+		_spec.ClearField(match.FieldWinnerName, field.TypeString)
+	}
+	if mu.mutation.GamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.RemovedGamesIDs(); len(nodes) > 0 && !mu.mutation.GamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := mu.mutation.GamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{match.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MatchUpdateOne is the builder for updating a single Match entity.
+type MatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MatchMutation
+}
+
+// SetFormat sets the "format" field.
+func (muo *MatchUpdateOne) SetFormat(m match.Format) *MatchUpdateOne {
+	muo.mutation.SetFormat(m)
+	return muo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableFormat(m *match.Format) *MatchUpdateOne {
+	if m != nil {
+		muo.SetFormat(*m)
+	}
+	return muo
+}
+
+// SetLength sets the "length" field.
+func (muo *MatchUpdateOne) SetLength(i int) *MatchUpdateOne {
+	muo.mutation.ResetLength()
+	muo.mutation.SetLength(i)
+	return muo
+}
+
+// SetNillableLength sets the "length" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableLength(i *int) *MatchUpdateOne {
+	if i != nil {
+		muo.SetLength(*i)
+	}
+	return muo
+}
+
+// AddLength adds i to the "length" field.
+func (muo *MatchUpdateOne) AddLength(i int) *MatchUpdateOne {
+	muo.mutation.AddLength(i)
+	return muo
+}
+
+// SetStatus sets the "status" field.
+func (muo *MatchUpdateOne) SetStatus(m match.Status) *MatchUpdateOne {
+	muo.mutation.SetStatus(m)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableStatus(m *match.Status) *MatchUpdateOne {
+	if m != nil {
+		muo.SetStatus(*m)
+	}
+	return muo
+}
+
+// SetWinnerName sets the "winner_name" field.
+func (muo *MatchUpdateOne) SetWinnerName(s string) *MatchUpdateOne {
+	muo.mutation.SetWinnerName(s)
+	return muo
+}
+
+// SetNillableWinnerName sets the "winner_name" field if the given value is not nil.
+func (muo *MatchUpdateOne) SetNillableWinnerName(s *string) *MatchUpdateOne {
+	if s != nil {
+		muo.SetWinnerName(*s)
+	}
+	return muo
+}
+
+// ClearWinnerName clears the value of the "winner_name" field.
+func (muo *MatchUpdateOne) ClearWinnerName() *MatchUpdateOne {
+	muo.mutation.ClearWinnerName()
+	return muo
+}
+
+// AddGameIDs adds the "games" edge to the Game entity by IDs.
+func (muo *MatchUpdateOne) AddGameIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.AddGameIDs(ids...)
+	return muo
+}
+
+// AddGames adds the "games" edges to the Game entity.
+func (muo *MatchUpdateOne) AddGames(g ...*Game) *MatchUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return muo.AddGameIDs(ids...)
+}
+
+// Mutation returns the MatchMutation object of the builder.
+func (muo *MatchUpdateOne) Mutation() *MatchMutation {
+	return muo.mutation
+}
+
+// ClearGames clears all "games" edges to the Game entity.
+func (muo *MatchUpdateOne) ClearGames() *MatchUpdateOne {
+	muo.mutation.ClearGames()
+	return muo
+}
+
+// RemoveGameIDs removes the "games" edge to Game entities by IDs.
+func (muo *MatchUpdateOne) RemoveGameIDs(ids ...int) *MatchUpdateOne {
+	muo.mutation.RemoveGameIDs(ids...)
+	return muo
+}
+
+// RemoveGames removes "games" edges to Game entities.
+func (muo *MatchUpdateOne) RemoveGames(g ...*Game) *MatchUpdateOne {
+	ids := make([]int, len(g))
+	for i := range g {
+		ids[i] = g[i].ID
+	}
+	return muo.RemoveGameIDs(ids...)
+}
+
+// Where appends a list predicates to the MatchUpdate builder.
+func (muo *MatchUpdateOne) Where(ps ...predicate.Match) *MatchUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MatchUpdateOne) Select(field string, fields ...string) *MatchUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Match entity.
+func (muo *MatchUpdateOne) Save(ctx context.Context) (*Match, error) {
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MatchUpdateOne) SaveX(ctx context.Context) *Match {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MatchUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MatchUpdateOne) check() error {
+	if v, ok := muo.mutation.Format(); ok {
+		if err := match.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Match.format": %w`, err)}
+		}
+	}
+	if v, ok := muo.mutation.Status(); ok {
+		if err := match.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Match.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MatchUpdateOne) sqlSave(ctx context.Context) (_node *Match, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(match.Table, match.Columns, sqlgraph.NewFieldSpec(match.FieldID, field.TypeInt))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Match.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, match.FieldID)
+		for _, f := range fields {
+			if !match.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != match.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.Format(); ok {
+		_spec.SetField(match.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.Length(); ok {
+		_spec.SetField(match.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedLength(); ok {
+		_spec.AddField(match.FieldLength, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.SetField(match.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := muo.mutation.WinnerName(); ok {
+		_spec.SetField(match.FieldWinnerName, field.TypeString, value)
+	}
+	if muo.mutation.WinnerNameCleared() {
+		_spec.ClearField(match.FieldWinnerName, field.TypeString)
+	}
+	if muo.mutation.GamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.RemovedGamesIDs(); len(nodes) > 0 && !muo.mutation.GamesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := muo.mutation.GamesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   match.GamesTable,
+			Columns: []string{match.GamesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Match{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{match.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
@@ -8,6 +8,34 @@ import (
 )
 
 var (
+	// BansColumns holds the columns for the "bans" table.
+	BansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ban_game", Type: field.TypeInt},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// BansTable holds the schema information for the "bans" table.
+	BansTable = &schema.Table{
+		Name:       "bans",
+		Columns:    BansColumns,
+		PrimaryKey: []*schema.Column{BansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "bans_games_game",
+				Columns:    []*schema.Column{BansColumns[3]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "bans_users_user",
+				Columns:    []*schema.Column{BansColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// CardsColumns holds the columns for the "cards" table.
 	CardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -18,18 +46,91 @@ var (
 		Columns:    CardsColumns,
 		PrimaryKey: []*schema.Column{CardsColumns[0]},
 	}
+	// DailyChallengesColumns holds the columns for the "daily_challenges" table.
+	DailyChallengesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "day", Type: field.TypeString},
+		{Name: "player_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "card_count", Type: field.TypeInt},
+		{Name: "total_ms", Type: field.TypeInt64, Nullable: true},
+		{Name: "reaction_ms", Type: field.TypeJSON, Nullable: true},
+		{Name: "mistakes", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
+	}
+	// DailyChallengesTable holds the schema information for the "daily_challenges" table.
+	DailyChallengesTable = &schema.Table{
+		Name:       "daily_challenges",
+		Columns:    DailyChallengesColumns,
+		PrimaryKey: []*schema.Column{DailyChallengesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "daily_challenges_users_user",
+				Columns:    []*schema.Column{DailyChallengesColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dailychallenge_day_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{DailyChallengesColumns[1], DailyChallengesColumns[9]},
+			},
+		},
+	}
 	// GamesColumns holds the columns for the "games" table.
 	GamesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 2147483647},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"CREATED", "STARTED", "FINISHED"}, Default: "CREATED"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"CREATED", "STARTED", "PAUSED", "FINISHED"}, Default: "CREATED"},
 		{Name: "total_rounds", Type: field.TypeInt, Default: 0},
+		{Name: "mode", Type: field.TypeString, Default: "NORMAL"},
+		{Name: "team_score", Type: field.TypeInt, Default: 0},
+		{Name: "elimination_interval", Type: field.TypeInt, Default: 3},
+		{Name: "center_count", Type: field.TypeInt, Default: 3},
+		{Name: "tie_break", Type: field.TypeEnum, Enums: []string{"NONE", "REDRAW", "FRESH_DECK"}, Default: "NONE"},
+		{Name: "scoring", Type: field.TypeJSON},
+		{Name: "max_players", Type: field.TypeInt, Default: 0},
+		{Name: "min_players", Type: field.TypeInt, Default: 1},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"PUBLIC", "PRIVATE"}, Default: "PUBLIC"},
+		{Name: "invite_code", Type: field.TypeString, Nullable: true},
+		{Name: "password_hash", Type: field.TypeString, Nullable: true},
+		{Name: "host_token", Type: field.TypeString, Nullable: true},
+		{Name: "auto_start", Type: field.TypeBool, Default: false},
+		{Name: "card_count", Type: field.TypeInt, Default: 0},
+		{Name: "winner_id", Type: field.TypeInt, Nullable: true},
+		{Name: "auto_balance", Type: field.TypeBool, Default: false},
+		{Name: "match_id", Type: field.TypeInt, Nullable: true},
+		{Name: "game_rematch", Type: field.TypeInt, Unique: true, Nullable: true},
 	}
 	// GamesTable holds the schema information for the "games" table.
 	GamesTable = &schema.Table{
 		Name:       "games",
 		Columns:    GamesColumns,
 		PrimaryKey: []*schema.Column{GamesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "games_matches_match",
+				Columns:    []*schema.Column{GamesColumns[20]},
+				RefColumns: []*schema.Column{MatchesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "games_games_rematch",
+				Columns:    []*schema.Column{GamesColumns[21]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "game_invite_code",
+				Unique:  false,
+				Columns: []*schema.Column{GamesColumns[13]},
+			},
+		},
 	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
@@ -42,13 +143,40 @@ var (
 		Columns:    ItemsColumns,
 		PrimaryKey: []*schema.Column{ItemsColumns[0]},
 	}
+	// MatchesColumns holds the columns for the "matches" table.
+	MatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"BEST_OF", "FIRST_TO"}},
+		{Name: "length", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PLAYING", "FINISHED"}, Default: "PLAYING"},
+		{Name: "winner_name", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MatchesTable holds the schema information for the "matches" table.
+	MatchesTable = &schema.Table{
+		Name:       "matches",
+		Columns:    MatchesColumns,
+		PrimaryKey: []*schema.Column{MatchesColumns[0]},
+	}
 	// PlayersColumns holds the columns for the "players" table.
 	PlayersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString, Size: 2147483647},
-		{Name: "status", Type: field.TypeEnum, Enums: []string{"JOINING", "STARTED", "READY", "PLAYING", "FINISHED"}, Default: "JOINING"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"JOINING", "STARTED", "READY", "PLAYING", "FINISHED", "ELIMINATED", "DISCONNECTED"}, Default: "JOINING"},
 		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "wrong_count", Type: field.TypeInt, Default: 0},
+		{Name: "streak", Type: field.TypeInt, Default: 0},
+		{Name: "is_host", Type: field.TypeBool, Default: false},
+		{Name: "is_bot", Type: field.TypeBool, Default: false},
+		{Name: "series_wins", Type: field.TypeInt, Default: 0},
+		{Name: "series_id", Type: field.TypeInt, Nullable: true},
+		{Name: "resume_token", Type: field.TypeString, Nullable: true},
+		{Name: "handicap", Type: field.TypeJSON, Nullable: true},
+		{Name: "muted", Type: field.TypeBool, Default: false},
+		{Name: "tournament_seed", Type: field.TypeInt, Nullable: true},
 		{Name: "player_parent", Type: field.TypeInt, Nullable: true},
+		{Name: "team_id", Type: field.TypeInt, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt, Nullable: true},
 	}
 	// PlayersTable holds the schema information for the "players" table.
 	PlayersTable = &schema.Table{
@@ -58,12 +186,162 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "players_games_parent",
-				Columns:    []*schema.Column{PlayersColumns[4]},
+				Columns:    []*schema.Column{PlayersColumns[14]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "players_teams_team",
+				Columns:    []*schema.Column{PlayersColumns[15]},
+				RefColumns: []*schema.Column{TeamsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "players_users_user",
+				Columns:    []*schema.Column{PlayersColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PracticeRunsColumns holds the columns for the "practice_runs" table.
+	PracticeRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "player_name", Type: field.TypeString, Size: 2147483647},
+		{Name: "card_count", Type: field.TypeInt},
+		{Name: "seed", Type: field.TypeInt64},
+		{Name: "total_ms", Type: field.TypeInt64},
+		{Name: "reaction_ms", Type: field.TypeJSON},
+		{Name: "mistakes", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PracticeRunsTable holds the schema information for the "practice_runs" table.
+	PracticeRunsTable = &schema.Table{
+		Name:       "practice_runs",
+		Columns:    PracticeRunsColumns,
+		PrimaryKey: []*schema.Column{PracticeRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "practicerun_player_name_card_count_total_ms",
+				Unique:  false,
+				Columns: []*schema.Column{PracticeRunsColumns[1], PracticeRunsColumns[2], PracticeRunsColumns[4]},
+			},
+		},
+	}
+	// TeamsColumns holds the columns for the "teams" table.
+	TeamsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "score", Type: field.TypeInt, Default: 0},
+		{Name: "team_parent", Type: field.TypeInt, Nullable: true},
+	}
+	// TeamsTable holds the schema information for the "teams" table.
+	TeamsTable = &schema.Table{
+		Name:       "teams",
+		Columns:    TeamsColumns,
+		PrimaryKey: []*schema.Column{TeamsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "teams_games_parent",
+				Columns:    []*schema.Column{TeamsColumns[3]},
 				RefColumns: []*schema.Column{GamesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// TournamentsColumns holds the columns for the "tournaments" table.
+	TournamentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"SINGLE_ELIMINATION", "DOUBLE_ELIMINATION"}, Default: "SINGLE_ELIMINATION"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"REGISTERING", "STARTED", "FINISHED"}, Default: "REGISTERING"},
+		{Name: "mode", Type: field.TypeString, Default: "NORMAL"},
+		{Name: "card_count", Type: field.TypeInt, Default: 0},
+		{Name: "organizer_token", Type: field.TypeString},
+		{Name: "champion_seed", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TournamentsTable holds the schema information for the "tournaments" table.
+	TournamentsTable = &schema.Table{
+		Name:       "tournaments",
+		Columns:    TournamentsColumns,
+		PrimaryKey: []*schema.Column{TournamentsColumns[0]},
+	}
+	// TournamentEntrantsColumns holds the columns for the "tournament_entrants" table.
+	TournamentEntrantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 2147483647},
+		{Name: "seed", Type: field.TypeInt},
+		{Name: "entrant_token", Type: field.TypeString, Nullable: true},
+		{Name: "tournament_entrant_tournament", Type: field.TypeInt},
+	}
+	// TournamentEntrantsTable holds the schema information for the "tournament_entrants" table.
+	TournamentEntrantsTable = &schema.Table{
+		Name:       "tournament_entrants",
+		Columns:    TournamentEntrantsColumns,
+		PrimaryKey: []*schema.Column{TournamentEntrantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tournament_entrants_tournaments_tournament",
+				Columns:    []*schema.Column{TournamentEntrantsColumns[4]},
+				RefColumns: []*schema.Column{TournamentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// TournamentMatchesColumns holds the columns for the "tournament_matches" table.
+	TournamentMatchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "number", Type: field.TypeInt},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"WINNERS", "LOSERS", "GRAND_FINAL"}},
+		{Name: "round", Type: field.TypeInt},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "PLAYING", "FINISHED"}, Default: "PENDING"},
+		{Name: "winner_seed", Type: field.TypeInt, Nullable: true},
+		{Name: "tournament_match_tournament", Type: field.TypeInt},
+		{Name: "game_id", Type: field.TypeInt, Nullable: true},
+	}
+	// TournamentMatchesTable holds the schema information for the "tournament_matches" table.
+	TournamentMatchesTable = &schema.Table{
+		Name:       "tournament_matches",
+		Columns:    TournamentMatchesColumns,
+		PrimaryKey: []*schema.Column{TournamentMatchesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tournament_matches_tournaments_tournament",
+				Columns:    []*schema.Column{TournamentMatchesColumns[6]},
+				RefColumns: []*schema.Column{TournamentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "tournament_matches_games_game",
+				Columns:    []*schema.Column{TournamentMatchesColumns[7]},
+				RefColumns: []*schema.Column{GamesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tournamentmatch_number_tournament_match_tournament",
+				Unique:  true,
+				Columns: []*schema.Column{TournamentMatchesColumns[1], TournamentMatchesColumns[6]},
+			},
+		},
+	}
+	// UsersColumns holds the columns for the "users" table.
+	UsersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true, Size: 2147483647},
+		{Name: "token", Type: field.TypeString, Unique: true},
+		{Name: "rating", Type: field.TypeFloat64, Default: 1500},
+		{Name: "rated_games", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// UsersTable holds the schema information for the "users" table.
+	UsersTable = &schema.Table{
+		Name:       "users",
+		Columns:    UsersColumns,
+		PrimaryKey: []*schema.Column{UsersColumns[0]},
+	}
 	// ItemParentColumns holds the columns for the "item_parent" table.
 	ItemParentColumns = []*schema.Column{
 		{Name: "item_id", Type: field.TypeInt},
@@ -91,16 +369,36 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BansTable,
 		CardsTable,
+		DailyChallengesTable,
 		GamesTable,
 		ItemsTable,
+		MatchesTable,
 		PlayersTable,
+		PracticeRunsTable,
+		TeamsTable,
+		TournamentsTable,
+		TournamentEntrantsTable,
+		TournamentMatchesTable,
+		UsersTable,
 		ItemParentTable,
 	}
 )
 
 func init() {
+	BansTable.ForeignKeys[0].RefTable = GamesTable
+	BansTable.ForeignKeys[1].RefTable = UsersTable
+	DailyChallengesTable.ForeignKeys[0].RefTable = UsersTable
+	GamesTable.ForeignKeys[0].RefTable = MatchesTable
+	GamesTable.ForeignKeys[1].RefTable = GamesTable
 	PlayersTable.ForeignKeys[0].RefTable = GamesTable
+	PlayersTable.ForeignKeys[1].RefTable = TeamsTable
+	PlayersTable.ForeignKeys[2].RefTable = UsersTable
+	TeamsTable.ForeignKeys[0].RefTable = GamesTable
+	TournamentEntrantsTable.ForeignKeys[0].RefTable = TournamentsTable
+	TournamentMatchesTable.ForeignKeys[0].RefTable = TournamentsTable
+	TournamentMatchesTable.ForeignKeys[1].RefTable = GamesTable
 	ItemParentTable.ForeignKeys[0].RefTable = ItemsTable
 	ItemParentTable.ForeignKeys[1].RefTable = CardsTable
 }
@@ -5,13 +5,25 @@ package ent
 import (
 	"context"
 	"errors"
+	"example/ent/ban"
 	"example/ent/card"
+	"example/ent/dailychallenge"
 	"example/ent/game"
 	"example/ent/item"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/practicerun"
 	"example/ent/predicate"
+	"example/ent/team"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	"example/ent/user"
+	"example/internal/handicap"
+	"example/internal/scoring"
 	"fmt"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -26,38 +38,50 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeCard   = "Card"
-	TypeGame   = "Game"
-	TypeItem   = "Item"
-	TypePlayer = "Player"
+	TypeBan               = "Ban"
+	TypeCard              = "Card"
+	TypeDailyChallenge    = "DailyChallenge"
+	TypeGame              = "Game"
+	TypeItem              = "Item"
+	TypeMatch             = "Match"
+	TypePlayer            = "Player"
+	TypePracticeRun       = "PracticeRun"
+	TypeTeam              = "Team"
+	TypeTournament        = "Tournament"
+	TypeTournamentEntrant = "TournamentEntrant"
+	TypeTournamentMatch   = "TournamentMatch"
+	TypeUser              = "User"
 )
 
-// CardMutation represents an operation that mutates the Card nodes in the graph.
-type CardMutation struct {
+// BanMutation represents an operation that mutates the Ban nodes in the graph.
+type BanMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *int
+	name          *string
+	created_at    *time.Time
 	clearedFields map[string]struct{}
-	items         map[int]struct{}
-	removeditems  map[int]struct{}
-	cleareditems  bool
+	game          *int
+	clearedgame   bool
+	user          *int
+	cleareduser   bool
 	done          bool
-	oldValue      func(context.Context) (*Card, error)
-	predicates    []predicate.Card
+	oldValue      func(context.Context) (*Ban, error)
+	predicates    []predicate.Ban
 }
 
-var _ ent.Mutation = (*CardMutation)(nil)
+var _ ent.Mutation = (*BanMutation)(nil)
 
-// cardOption allows management of the mutation configuration using functional options.
-type cardOption func(*CardMutation)
+// banOption allows management of the mutation configuration using functional options.
+type banOption func(*BanMutation)
 
-// newCardMutation creates new mutation for the Card entity.
-func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
-	m := &CardMutation{
+// newBanMutation creates new mutation for the Ban entity.
+func newBanMutation(c config, op Op, opts ...banOption) *BanMutation {
+	m := &BanMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCard,
+		typ:           TypeBan,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -66,20 +90,20 @@ func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
 	return m
 }
 
-// withCardID sets the ID field of the mutation.
-func withCardID(id int) cardOption {
-	return func(m *CardMutation) {
+// withBanID sets the ID field of the mutation.
+func withBanID(id int) banOption {
+	return func(m *BanMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Card
+			value *Ban
 		)
-		m.oldValue = func(ctx context.Context) (*Card, error) {
+		m.oldValue = func(ctx context.Context) (*Ban, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Card.Get(ctx, id)
+					value, err = m.Client().Ban.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -88,10 +112,10 @@ func withCardID(id int) cardOption {
 	}
 }
 
-// withCard sets the old Card of the mutation.
-func withCard(node *Card) cardOption {
-	return func(m *CardMutation) {
-		m.oldValue = func(context.Context) (*Card, error) {
+// withBan sets the old Ban of the mutation.
+func withBan(node *Ban) banOption {
+	return func(m *BanMutation) {
+		m.oldValue = func(context.Context) (*Ban, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -100,7 +124,7 @@ func withCard(node *Card) cardOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CardMutation) Client() *Client {
+func (m BanMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -108,7 +132,7 @@ func (m CardMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CardMutation) Tx() (*Tx, error) {
+func (m BanMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -119,7 +143,7 @@ func (m CardMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CardMutation) ID() (id int, exists bool) {
+func (m *BanMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -130,7 +154,7 @@ func (m *CardMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *BanMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -139,75 +163,208 @@ func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Card.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Ban.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// AddItemIDs adds the "items" edge to the Item entity by ids.
-func (m *CardMutation) AddItemIDs(ids ...int) {
-	if m.items == nil {
-		m.items = make(map[int]struct{})
+// SetName sets the "name" field.
+func (m *BanMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *BanMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.items[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
+	return oldValue.Name, nil
 }
 
-// ClearItems clears the "items" edge to the Item entity.
-func (m *CardMutation) ClearItems() {
-	m.cleareditems = true
+// ResetName resets all changes to the "name" field.
+func (m *BanMutation) ResetName() {
+	m.name = nil
 }
 
-// ItemsCleared reports if the "items" edge to the Item entity was cleared.
-func (m *CardMutation) ItemsCleared() bool {
-	return m.cleareditems
+// SetUserID sets the "user_id" field.
+func (m *BanMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
-func (m *CardMutation) RemoveItemIDs(ids ...int) {
-	if m.removeditems == nil {
-		m.removeditems = make(map[int]struct{})
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BanMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.items, ids[i])
-		m.removeditems[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
-func (m *CardMutation) RemovedItemsIDs() (ids []int) {
-	for id := range m.removeditems {
-		ids = append(ids, id)
+// ClearUserID clears the value of the "user_id" field.
+func (m *BanMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[ban.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *BanMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[ban.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BanMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, ban.FieldUserID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Ban entity.
+// If the Ban object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetGameID sets the "game" edge to the Game entity by id.
+func (m *BanMutation) SetGameID(id int) {
+	m.game = &id
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *BanMutation) ClearGame() {
+	m.clearedgame = true
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *BanMutation) GameCleared() bool {
+	return m.clearedgame
+}
+
+// GameID returns the "game" edge ID in the mutation.
+func (m *BanMutation) GameID() (id int, exists bool) {
+	if m.game != nil {
+		return *m.game, true
 	}
 	return
 }
 
-// ItemsIDs returns the "items" edge IDs in the mutation.
-func (m *CardMutation) ItemsIDs() (ids []int) {
-	for id := range m.items {
-		ids = append(ids, id)
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *BanMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetItems resets all changes to the "items" edge.
-func (m *CardMutation) ResetItems() {
-	m.items = nil
-	m.cleareditems = false
-	m.removeditems = nil
+// ResetGame resets all changes to the "game" edge.
+func (m *BanMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
 }
 
-// Where appends a list predicates to the CardMutation builder.
-func (m *CardMutation) Where(ps ...predicate.Card) {
+// ClearUser clears the "user" edge to the User entity.
+func (m *BanMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[ban.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *BanMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *BanMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *BanMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the BanMutation builder.
+func (m *BanMutation) Where(ps ...predicate.Ban) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BanMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Card, len(ps))
+func (m *BanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Ban, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -215,210 +372,8825 @@ func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CardMutation) Op() Op {
+func (m *BanMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CardMutation) SetOp(op Op) {
+func (m *BanMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Card).
-func (m *CardMutation) Type() string {
+// Type returns the node type of this mutation (Ban).
+func (m *BanMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CardMutation) Fields() []string {
-	fields := make([]string, 0, 0)
+func (m *BanMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, ban.FieldName)
+	}
+	if m.user != nil {
+		fields = append(fields, ban.FieldUserID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ban.FieldCreatedAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CardMutation) Field(name string) (ent.Value, bool) {
+func (m *BanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ban.FieldName:
+		return m.Name()
+	case ban.FieldUserID:
+		return m.UserID()
+	case ban.FieldCreatedAt:
+		return m.CreatedAt()
+	}
 	return nil, false
 }
 
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	return nil, fmt.Errorf("unknown Card field %s", name)
+func (m *BanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ban.FieldName:
+		return m.OldName(ctx)
+	case ban.FieldUserID:
+		return m.OldUserID(ctx)
+	case ban.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Ban field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardMutation) SetField(name string, value ent.Value) error {
+func (m *BanMutation) SetField(name string, value ent.Value) error {
 	switch name {
+	case ban.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ban.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case ban.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Card field %s", name)
+	return fmt.Errorf("unknown Ban field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CardMutation) AddedFields() []string {
-	return nil
+func (m *BanMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CardMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CardMutation) AddField(name string, value ent.Value) error {
-	return fmt.Errorf("unknown Card numeric field %s", name)
+func (m *BanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Ban numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CardMutation) ClearedFields() []string {
-	return nil
+func (m *BanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ban.FieldUserID) {
+		fields = append(fields, ban.FieldUserID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CardMutation) FieldCleared(name string) bool {
+func (m *BanMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CardMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Card nullable field %s", name)
+func (m *BanMutation) ClearField(name string) error {
+	switch name {
+	case ban.FieldUserID:
+		m.ClearUserID()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CardMutation) ResetField(name string) error {
-	return fmt.Errorf("unknown Card field %s", name)
+func (m *BanMutation) ResetField(name string) error {
+	switch name {
+	case ban.FieldName:
+		m.ResetName()
+		return nil
+	case ban.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case ban.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CardMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.items != nil {
-		edges = append(edges, card.EdgeItems)
+func (m *BanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.game != nil {
+		edges = append(edges, ban.EdgeGame)
+	}
+	if m.user != nil {
+		edges = append(edges, ban.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CardMutation) AddedIDs(name string) []ent.Value {
+func (m *BanMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case card.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.items))
-		for id := range m.items {
-			ids = append(ids, id)
+	case ban.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
+		}
+	case ban.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CardMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removeditems != nil {
-		edges = append(edges, card.EdgeItems)
-	}
+func (m *BanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CardMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case card.EdgeItems:
-		ids := make([]ent.Value, 0, len(m.removeditems))
-		for id := range m.removeditems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BanMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CardMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareditems {
-		edges = append(edges, card.EdgeItems)
+func (m *BanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedgame {
+		edges = append(edges, ban.EdgeGame)
+	}
+	if m.cleareduser {
+		edges = append(edges, ban.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CardMutation) EdgeCleared(name string) bool {
+func (m *BanMutation) EdgeCleared(name string) bool {
 	switch name {
-	case card.EdgeItems:
-		return m.cleareditems
+	case ban.EdgeGame:
+		return m.clearedgame
+	case ban.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CardMutation) ClearEdge(name string) error {
+func (m *BanMutation) ClearEdge(name string) error {
+	switch name {
+	case ban.EdgeGame:
+		m.ClearGame()
+		return nil
+	case ban.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BanMutation) ResetEdge(name string) error {
+	switch name {
+	case ban.EdgeGame:
+		m.ResetGame()
+		return nil
+	case ban.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Ban edge %s", name)
+}
+
+// CardMutation represents an operation that mutates the Card nodes in the graph.
+type CardMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	clearedFields map[string]struct{}
+	items         map[int]struct{}
+	removeditems  map[int]struct{}
+	cleareditems  bool
+	done          bool
+	oldValue      func(context.Context) (*Card, error)
+	predicates    []predicate.Card
+}
+
+var _ ent.Mutation = (*CardMutation)(nil)
+
+// cardOption allows management of the mutation configuration using functional options.
+type cardOption func(*CardMutation)
+
+// newCardMutation creates new mutation for the Card entity.
+func newCardMutation(c config, op Op, opts ...cardOption) *CardMutation {
+	m := &CardMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCard,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCardID sets the ID field of the mutation.
+func withCardID(id int) cardOption {
+	return func(m *CardMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Card
+		)
+		m.oldValue = func(ctx context.Context) (*Card, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Card.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCard sets the old Card of the mutation.
+func withCard(node *Card) cardOption {
+	return func(m *CardMutation) {
+		m.oldValue = func(context.Context) (*Card, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CardMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CardMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CardMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CardMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Card.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// AddItemIDs adds the "items" edge to the Item entity by ids.
+func (m *CardMutation) AddItemIDs(ids ...int) {
+	if m.items == nil {
+		m.items = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearItems clears the "items" edge to the Item entity.
+func (m *CardMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the Item entity was cleared.
+func (m *CardMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the Item entity by IDs.
+func (m *CardMutation) RemoveItemIDs(ids ...int) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedItems returns the removed IDs of the "items" edge to the Item entity.
+func (m *CardMutation) RemovedItemsIDs() (ids []int) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *CardMutation) ItemsIDs() (ids []int) {
+	for id := range m.items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetItems resets all changes to the "items" edge.
+func (m *CardMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
+}
+
+// Where appends a list predicates to the CardMutation builder.
+func (m *CardMutation) Where(ps ...predicate.Card) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CardMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CardMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Card, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CardMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CardMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Card).
+func (m *CardMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CardMutation) Fields() []string {
+	fields := make([]string, 0, 0)
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CardMutation) Field(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CardMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, fmt.Errorf("unknown Card field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CardMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Card field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CardMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CardMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CardMutation) AddField(name string, value ent.Value) error {
+	return fmt.Errorf("unknown Card numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CardMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CardMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CardMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Card nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CardMutation) ResetField(name string) error {
+	return fmt.Errorf("unknown Card field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CardMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.items != nil {
+		edges = append(edges, card.EdgeItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CardMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case card.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CardMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeditems != nil {
+		edges = append(edges, card.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CardMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case card.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CardMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditems {
+		edges = append(edges, card.EdgeItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CardMutation) EdgeCleared(name string) bool {
+	switch name {
+	case card.EdgeItems:
+		return m.cleareditems
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CardMutation) ClearEdge(name string) error {
 	switch name {
 	}
 	return fmt.Errorf("unknown Card unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CardMutation) ResetEdge(name string) error {
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CardMutation) ResetEdge(name string) error {
+	switch name {
+	case card.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Card edge %s", name)
+}
+
+// DailyChallengeMutation represents an operation that mutates the DailyChallenge nodes in the graph.
+type DailyChallengeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	day               *string
+	player_name       *string
+	seed              *int64
+	addseed           *int64
+	card_count        *int
+	addcard_count     *int
+	total_ms          *int64
+	addtotal_ms       *int64
+	reaction_ms       *[]int64
+	appendreaction_ms []int64
+	mistakes          *int
+	addmistakes       *int
+	started_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	done              bool
+	oldValue          func(context.Context) (*DailyChallenge, error)
+	predicates        []predicate.DailyChallenge
+}
+
+var _ ent.Mutation = (*DailyChallengeMutation)(nil)
+
+// dailychallengeOption allows management of the mutation configuration using functional options.
+type dailychallengeOption func(*DailyChallengeMutation)
+
+// newDailyChallengeMutation creates new mutation for the DailyChallenge entity.
+func newDailyChallengeMutation(c config, op Op, opts ...dailychallengeOption) *DailyChallengeMutation {
+	m := &DailyChallengeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDailyChallenge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDailyChallengeID sets the ID field of the mutation.
+func withDailyChallengeID(id int) dailychallengeOption {
+	return func(m *DailyChallengeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DailyChallenge
+		)
+		m.oldValue = func(ctx context.Context) (*DailyChallenge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DailyChallenge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDailyChallenge sets the old DailyChallenge of the mutation.
+func withDailyChallenge(node *DailyChallenge) dailychallengeOption {
+	return func(m *DailyChallengeMutation) {
+		m.oldValue = func(context.Context) (*DailyChallenge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DailyChallengeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DailyChallengeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DailyChallengeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DailyChallengeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DailyChallenge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDay sets the "day" field.
+func (m *DailyChallengeMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *DailyChallengeMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *DailyChallengeMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetPlayerName sets the "player_name" field.
+func (m *DailyChallengeMutation) SetPlayerName(s string) {
+	m.player_name = &s
+}
+
+// PlayerName returns the value of the "player_name" field in the mutation.
+func (m *DailyChallengeMutation) PlayerName() (r string, exists bool) {
+	v := m.player_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerName returns the old "player_name" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldPlayerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerName: %w", err)
+	}
+	return oldValue.PlayerName, nil
+}
+
+// ResetPlayerName resets all changes to the "player_name" field.
+func (m *DailyChallengeMutation) ResetPlayerName() {
+	m.player_name = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DailyChallengeMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DailyChallengeMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *DailyChallengeMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[dailychallenge.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *DailyChallengeMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[dailychallenge.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DailyChallengeMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, dailychallenge.FieldUserID)
+}
+
+// SetSeed sets the "seed" field.
+func (m *DailyChallengeMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *DailyChallengeMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *DailyChallengeMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *DailyChallengeMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *DailyChallengeMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetCardCount sets the "card_count" field.
+func (m *DailyChallengeMutation) SetCardCount(i int) {
+	m.card_count = &i
+	m.addcard_count = nil
+}
+
+// CardCount returns the value of the "card_count" field in the mutation.
+func (m *DailyChallengeMutation) CardCount() (r int, exists bool) {
+	v := m.card_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardCount returns the old "card_count" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldCardCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardCount: %w", err)
+	}
+	return oldValue.CardCount, nil
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (m *DailyChallengeMutation) AddCardCount(i int) {
+	if m.addcard_count != nil {
+		*m.addcard_count += i
+	} else {
+		m.addcard_count = &i
+	}
+}
+
+// AddedCardCount returns the value that was added to the "card_count" field in this mutation.
+func (m *DailyChallengeMutation) AddedCardCount() (r int, exists bool) {
+	v := m.addcard_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCardCount resets all changes to the "card_count" field.
+func (m *DailyChallengeMutation) ResetCardCount() {
+	m.card_count = nil
+	m.addcard_count = nil
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (m *DailyChallengeMutation) SetTotalMs(i int64) {
+	m.total_ms = &i
+	m.addtotal_ms = nil
+}
+
+// TotalMs returns the value of the "total_ms" field in the mutation.
+func (m *DailyChallengeMutation) TotalMs() (r int64, exists bool) {
+	v := m.total_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalMs returns the old "total_ms" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldTotalMs(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalMs: %w", err)
+	}
+	return oldValue.TotalMs, nil
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (m *DailyChallengeMutation) AddTotalMs(i int64) {
+	if m.addtotal_ms != nil {
+		*m.addtotal_ms += i
+	} else {
+		m.addtotal_ms = &i
+	}
+}
+
+// AddedTotalMs returns the value that was added to the "total_ms" field in this mutation.
+func (m *DailyChallengeMutation) AddedTotalMs() (r int64, exists bool) {
+	v := m.addtotal_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTotalMs clears the value of the "total_ms" field.
+func (m *DailyChallengeMutation) ClearTotalMs() {
+	m.total_ms = nil
+	m.addtotal_ms = nil
+	m.clearedFields[dailychallenge.FieldTotalMs] = struct{}{}
+}
+
+// TotalMsCleared returns if the "total_ms" field was cleared in this mutation.
+func (m *DailyChallengeMutation) TotalMsCleared() bool {
+	_, ok := m.clearedFields[dailychallenge.FieldTotalMs]
+	return ok
+}
+
+// ResetTotalMs resets all changes to the "total_ms" field.
+func (m *DailyChallengeMutation) ResetTotalMs() {
+	m.total_ms = nil
+	m.addtotal_ms = nil
+	delete(m.clearedFields, dailychallenge.FieldTotalMs)
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (m *DailyChallengeMutation) SetReactionMs(i []int64) {
+	m.reaction_ms = &i
+	m.appendreaction_ms = nil
+}
+
+// ReactionMs returns the value of the "reaction_ms" field in the mutation.
+func (m *DailyChallengeMutation) ReactionMs() (r []int64, exists bool) {
+	v := m.reaction_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReactionMs returns the old "reaction_ms" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldReactionMs(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReactionMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReactionMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReactionMs: %w", err)
+	}
+	return oldValue.ReactionMs, nil
+}
+
+// AppendReactionMs adds i to the "reaction_ms" field.
+func (m *DailyChallengeMutation) AppendReactionMs(i []int64) {
+	m.appendreaction_ms = append(m.appendreaction_ms, i...)
+}
+
+// AppendedReactionMs returns the list of values that were appended to the "reaction_ms" field in this mutation.
+func (m *DailyChallengeMutation) AppendedReactionMs() ([]int64, bool) {
+	if len(m.appendreaction_ms) == 0 {
+		return nil, false
+	}
+	return m.appendreaction_ms, true
+}
+
+// ClearReactionMs clears the value of the "reaction_ms" field.
+func (m *DailyChallengeMutation) ClearReactionMs() {
+	m.reaction_ms = nil
+	m.appendreaction_ms = nil
+	m.clearedFields[dailychallenge.FieldReactionMs] = struct{}{}
+}
+
+// ReactionMsCleared returns if the "reaction_ms" field was cleared in this mutation.
+func (m *DailyChallengeMutation) ReactionMsCleared() bool {
+	_, ok := m.clearedFields[dailychallenge.FieldReactionMs]
+	return ok
+}
+
+// ResetReactionMs resets all changes to the "reaction_ms" field.
+func (m *DailyChallengeMutation) ResetReactionMs() {
+	m.reaction_ms = nil
+	m.appendreaction_ms = nil
+	delete(m.clearedFields, dailychallenge.FieldReactionMs)
+}
+
+// SetMistakes sets the "mistakes" field.
+func (m *DailyChallengeMutation) SetMistakes(i int) {
+	m.mistakes = &i
+	m.addmistakes = nil
+}
+
+// Mistakes returns the value of the "mistakes" field in the mutation.
+func (m *DailyChallengeMutation) Mistakes() (r int, exists bool) {
+	v := m.mistakes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMistakes returns the old "mistakes" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldMistakes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMistakes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMistakes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMistakes: %w", err)
+	}
+	return oldValue.Mistakes, nil
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (m *DailyChallengeMutation) AddMistakes(i int) {
+	if m.addmistakes != nil {
+		*m.addmistakes += i
+	} else {
+		m.addmistakes = &i
+	}
+}
+
+// AddedMistakes returns the value that was added to the "mistakes" field in this mutation.
+func (m *DailyChallengeMutation) AddedMistakes() (r int, exists bool) {
+	v := m.addmistakes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMistakes resets all changes to the "mistakes" field.
+func (m *DailyChallengeMutation) ResetMistakes() {
+	m.mistakes = nil
+	m.addmistakes = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *DailyChallengeMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DailyChallengeMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the DailyChallenge entity.
+// If the DailyChallenge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DailyChallengeMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DailyChallengeMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *DailyChallengeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[dailychallenge.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DailyChallengeMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DailyChallengeMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DailyChallengeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DailyChallengeMutation builder.
+func (m *DailyChallengeMutation) Where(ps ...predicate.DailyChallenge) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DailyChallengeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DailyChallengeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DailyChallenge, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DailyChallengeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DailyChallengeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DailyChallenge).
+func (m *DailyChallengeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DailyChallengeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.day != nil {
+		fields = append(fields, dailychallenge.FieldDay)
+	}
+	if m.player_name != nil {
+		fields = append(fields, dailychallenge.FieldPlayerName)
+	}
+	if m.user != nil {
+		fields = append(fields, dailychallenge.FieldUserID)
+	}
+	if m.seed != nil {
+		fields = append(fields, dailychallenge.FieldSeed)
+	}
+	if m.card_count != nil {
+		fields = append(fields, dailychallenge.FieldCardCount)
+	}
+	if m.total_ms != nil {
+		fields = append(fields, dailychallenge.FieldTotalMs)
+	}
+	if m.reaction_ms != nil {
+		fields = append(fields, dailychallenge.FieldReactionMs)
+	}
+	if m.mistakes != nil {
+		fields = append(fields, dailychallenge.FieldMistakes)
+	}
+	if m.started_at != nil {
+		fields = append(fields, dailychallenge.FieldStartedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DailyChallengeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dailychallenge.FieldDay:
+		return m.Day()
+	case dailychallenge.FieldPlayerName:
+		return m.PlayerName()
+	case dailychallenge.FieldUserID:
+		return m.UserID()
+	case dailychallenge.FieldSeed:
+		return m.Seed()
+	case dailychallenge.FieldCardCount:
+		return m.CardCount()
+	case dailychallenge.FieldTotalMs:
+		return m.TotalMs()
+	case dailychallenge.FieldReactionMs:
+		return m.ReactionMs()
+	case dailychallenge.FieldMistakes:
+		return m.Mistakes()
+	case dailychallenge.FieldStartedAt:
+		return m.StartedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DailyChallengeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dailychallenge.FieldDay:
+		return m.OldDay(ctx)
+	case dailychallenge.FieldPlayerName:
+		return m.OldPlayerName(ctx)
+	case dailychallenge.FieldUserID:
+		return m.OldUserID(ctx)
+	case dailychallenge.FieldSeed:
+		return m.OldSeed(ctx)
+	case dailychallenge.FieldCardCount:
+		return m.OldCardCount(ctx)
+	case dailychallenge.FieldTotalMs:
+		return m.OldTotalMs(ctx)
+	case dailychallenge.FieldReactionMs:
+		return m.OldReactionMs(ctx)
+	case dailychallenge.FieldMistakes:
+		return m.OldMistakes(ctx)
+	case dailychallenge.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DailyChallenge field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DailyChallengeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dailychallenge.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case dailychallenge.FieldPlayerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerName(v)
+		return nil
+	case dailychallenge.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case dailychallenge.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case dailychallenge.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardCount(v)
+		return nil
+	case dailychallenge.FieldTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalMs(v)
+		return nil
+	case dailychallenge.FieldReactionMs:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReactionMs(v)
+		return nil
+	case dailychallenge.FieldMistakes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMistakes(v)
+		return nil
+	case dailychallenge.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DailyChallengeMutation) AddedFields() []string {
+	var fields []string
+	if m.addseed != nil {
+		fields = append(fields, dailychallenge.FieldSeed)
+	}
+	if m.addcard_count != nil {
+		fields = append(fields, dailychallenge.FieldCardCount)
+	}
+	if m.addtotal_ms != nil {
+		fields = append(fields, dailychallenge.FieldTotalMs)
+	}
+	if m.addmistakes != nil {
+		fields = append(fields, dailychallenge.FieldMistakes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DailyChallengeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dailychallenge.FieldSeed:
+		return m.AddedSeed()
+	case dailychallenge.FieldCardCount:
+		return m.AddedCardCount()
+	case dailychallenge.FieldTotalMs:
+		return m.AddedTotalMs()
+	case dailychallenge.FieldMistakes:
+		return m.AddedMistakes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DailyChallengeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case dailychallenge.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case dailychallenge.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCardCount(v)
+		return nil
+	case dailychallenge.FieldTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalMs(v)
+		return nil
+	case dailychallenge.FieldMistakes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMistakes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DailyChallengeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dailychallenge.FieldUserID) {
+		fields = append(fields, dailychallenge.FieldUserID)
+	}
+	if m.FieldCleared(dailychallenge.FieldTotalMs) {
+		fields = append(fields, dailychallenge.FieldTotalMs)
+	}
+	if m.FieldCleared(dailychallenge.FieldReactionMs) {
+		fields = append(fields, dailychallenge.FieldReactionMs)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DailyChallengeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DailyChallengeMutation) ClearField(name string) error {
+	switch name {
+	case dailychallenge.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case dailychallenge.FieldTotalMs:
+		m.ClearTotalMs()
+		return nil
+	case dailychallenge.FieldReactionMs:
+		m.ClearReactionMs()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DailyChallengeMutation) ResetField(name string) error {
+	switch name {
+	case dailychallenge.FieldDay:
+		m.ResetDay()
+		return nil
+	case dailychallenge.FieldPlayerName:
+		m.ResetPlayerName()
+		return nil
+	case dailychallenge.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case dailychallenge.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case dailychallenge.FieldCardCount:
+		m.ResetCardCount()
+		return nil
+	case dailychallenge.FieldTotalMs:
+		m.ResetTotalMs()
+		return nil
+	case dailychallenge.FieldReactionMs:
+		m.ResetReactionMs()
+		return nil
+	case dailychallenge.FieldMistakes:
+		m.ResetMistakes()
+		return nil
+	case dailychallenge.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DailyChallengeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, dailychallenge.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DailyChallengeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dailychallenge.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DailyChallengeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DailyChallengeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DailyChallengeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, dailychallenge.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DailyChallengeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dailychallenge.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DailyChallengeMutation) ClearEdge(name string) error {
+	switch name {
+	case dailychallenge.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DailyChallengeMutation) ResetEdge(name string) error {
+	switch name {
+	case dailychallenge.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DailyChallenge edge %s", name)
+}
+
+// GameMutation represents an operation that mutates the Game nodes in the graph.
+type GameMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *int
+	name                      *string
+	status                    *game.Status
+	total_rounds              *int
+	addtotal_rounds           *int
+	mode                      *string
+	team_score                *int
+	addteam_score             *int
+	elimination_interval      *int
+	addelimination_interval   *int
+	center_count              *int
+	addcenter_count           *int
+	tie_break                 *game.TieBreak
+	scoring                   *scoring.Rules
+	max_players               *int
+	addmax_players            *int
+	min_players               *int
+	addmin_players            *int
+	visibility                *game.Visibility
+	invite_code               *string
+	password_hash             *string
+	host_token                *string
+	auto_start                *bool
+	card_count                *int
+	addcard_count             *int
+	winner_id                 *int
+	addwinner_id              *int
+	auto_balance              *bool
+	clearedFields             map[string]struct{}
+	players                   map[int]struct{}
+	removedplayers            map[int]struct{}
+	clearedplayers            bool
+	teams                     map[int]struct{}
+	removedteams              map[int]struct{}
+	clearedteams              bool
+	tournament_matches        map[int]struct{}
+	removedtournament_matches map[int]struct{}
+	clearedtournament_matches bool
+	match                     *int
+	clearedmatch              bool
+	previous                  *int
+	clearedprevious           bool
+	rematch                   *int
+	clearedrematch            bool
+	done                      bool
+	oldValue                  func(context.Context) (*Game, error)
+	predicates                []predicate.Game
+}
+
+var _ ent.Mutation = (*GameMutation)(nil)
+
+// gameOption allows management of the mutation configuration using functional options.
+type gameOption func(*GameMutation)
+
+// newGameMutation creates new mutation for the Game entity.
+func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
+	m := &GameMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGame,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGameID sets the ID field of the mutation.
+func withGameID(id int) gameOption {
+	return func(m *GameMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Game
+		)
+		m.oldValue = func(ctx context.Context) (*Game, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Game.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGame sets the old Game of the mutation.
+func withGame(node *Game) gameOption {
+	return func(m *GameMutation) {
+		m.oldValue = func(context.Context) (*Game, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GameMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GameMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GameMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GameMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Game.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *GameMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GameMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GameMutation) ResetName() {
+	m.name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *GameMutation) SetStatus(ga game.Status) {
+	m.status = &ga
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *GameMutation) Status() (r game.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldStatus(ctx context.Context) (v game.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *GameMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetTotalRounds sets the "total_rounds" field.
+func (m *GameMutation) SetTotalRounds(i int) {
+	m.total_rounds = &i
+	m.addtotal_rounds = nil
+}
+
+// TotalRounds returns the value of the "total_rounds" field in the mutation.
+func (m *GameMutation) TotalRounds() (r int, exists bool) {
+	v := m.total_rounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalRounds returns the old "total_rounds" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldTotalRounds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalRounds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalRounds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalRounds: %w", err)
+	}
+	return oldValue.TotalRounds, nil
+}
+
+// AddTotalRounds adds i to the "total_rounds" field.
+func (m *GameMutation) AddTotalRounds(i int) {
+	if m.addtotal_rounds != nil {
+		*m.addtotal_rounds += i
+	} else {
+		m.addtotal_rounds = &i
+	}
+}
+
+// AddedTotalRounds returns the value that was added to the "total_rounds" field in this mutation.
+func (m *GameMutation) AddedTotalRounds() (r int, exists bool) {
+	v := m.addtotal_rounds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalRounds resets all changes to the "total_rounds" field.
+func (m *GameMutation) ResetTotalRounds() {
+	m.total_rounds = nil
+	m.addtotal_rounds = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *GameMutation) SetMode(s string) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *GameMutation) Mode() (r string, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *GameMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetTeamScore sets the "team_score" field.
+func (m *GameMutation) SetTeamScore(i int) {
+	m.team_score = &i
+	m.addteam_score = nil
+}
+
+// TeamScore returns the value of the "team_score" field in the mutation.
+func (m *GameMutation) TeamScore() (r int, exists bool) {
+	v := m.team_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamScore returns the old "team_score" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldTeamScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamScore: %w", err)
+	}
+	return oldValue.TeamScore, nil
+}
+
+// AddTeamScore adds i to the "team_score" field.
+func (m *GameMutation) AddTeamScore(i int) {
+	if m.addteam_score != nil {
+		*m.addteam_score += i
+	} else {
+		m.addteam_score = &i
+	}
+}
+
+// AddedTeamScore returns the value that was added to the "team_score" field in this mutation.
+func (m *GameMutation) AddedTeamScore() (r int, exists bool) {
+	v := m.addteam_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTeamScore resets all changes to the "team_score" field.
+func (m *GameMutation) ResetTeamScore() {
+	m.team_score = nil
+	m.addteam_score = nil
+}
+
+// SetEliminationInterval sets the "elimination_interval" field.
+func (m *GameMutation) SetEliminationInterval(i int) {
+	m.elimination_interval = &i
+	m.addelimination_interval = nil
+}
+
+// EliminationInterval returns the value of the "elimination_interval" field in the mutation.
+func (m *GameMutation) EliminationInterval() (r int, exists bool) {
+	v := m.elimination_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEliminationInterval returns the old "elimination_interval" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldEliminationInterval(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEliminationInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEliminationInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEliminationInterval: %w", err)
+	}
+	return oldValue.EliminationInterval, nil
+}
+
+// AddEliminationInterval adds i to the "elimination_interval" field.
+func (m *GameMutation) AddEliminationInterval(i int) {
+	if m.addelimination_interval != nil {
+		*m.addelimination_interval += i
+	} else {
+		m.addelimination_interval = &i
+	}
+}
+
+// AddedEliminationInterval returns the value that was added to the "elimination_interval" field in this mutation.
+func (m *GameMutation) AddedEliminationInterval() (r int, exists bool) {
+	v := m.addelimination_interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEliminationInterval resets all changes to the "elimination_interval" field.
+func (m *GameMutation) ResetEliminationInterval() {
+	m.elimination_interval = nil
+	m.addelimination_interval = nil
+}
+
+// SetCenterCount sets the "center_count" field.
+func (m *GameMutation) SetCenterCount(i int) {
+	m.center_count = &i
+	m.addcenter_count = nil
+}
+
+// CenterCount returns the value of the "center_count" field in the mutation.
+func (m *GameMutation) CenterCount() (r int, exists bool) {
+	v := m.center_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCenterCount returns the old "center_count" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldCenterCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCenterCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCenterCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCenterCount: %w", err)
+	}
+	return oldValue.CenterCount, nil
+}
+
+// AddCenterCount adds i to the "center_count" field.
+func (m *GameMutation) AddCenterCount(i int) {
+	if m.addcenter_count != nil {
+		*m.addcenter_count += i
+	} else {
+		m.addcenter_count = &i
+	}
+}
+
+// AddedCenterCount returns the value that was added to the "center_count" field in this mutation.
+func (m *GameMutation) AddedCenterCount() (r int, exists bool) {
+	v := m.addcenter_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCenterCount resets all changes to the "center_count" field.
+func (m *GameMutation) ResetCenterCount() {
+	m.center_count = nil
+	m.addcenter_count = nil
+}
+
+// SetTieBreak sets the "tie_break" field.
+func (m *GameMutation) SetTieBreak(gb game.TieBreak) {
+	m.tie_break = &gb
+}
+
+// TieBreak returns the value of the "tie_break" field in the mutation.
+func (m *GameMutation) TieBreak() (r game.TieBreak, exists bool) {
+	v := m.tie_break
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTieBreak returns the old "tie_break" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldTieBreak(ctx context.Context) (v game.TieBreak, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTieBreak is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTieBreak requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTieBreak: %w", err)
+	}
+	return oldValue.TieBreak, nil
+}
+
+// ResetTieBreak resets all changes to the "tie_break" field.
+func (m *GameMutation) ResetTieBreak() {
+	m.tie_break = nil
+}
+
+// SetScoring sets the "scoring" field.
+func (m *GameMutation) SetScoring(s scoring.Rules) {
+	m.scoring = &s
+}
+
+// Scoring returns the value of the "scoring" field in the mutation.
+func (m *GameMutation) Scoring() (r scoring.Rules, exists bool) {
+	v := m.scoring
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScoring returns the old "scoring" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldScoring(ctx context.Context) (v scoring.Rules, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScoring is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScoring requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScoring: %w", err)
+	}
+	return oldValue.Scoring, nil
+}
+
+// ResetScoring resets all changes to the "scoring" field.
+func (m *GameMutation) ResetScoring() {
+	m.scoring = nil
+}
+
+// SetMaxPlayers sets the "max_players" field.
+func (m *GameMutation) SetMaxPlayers(i int) {
+	m.max_players = &i
+	m.addmax_players = nil
+}
+
+// MaxPlayers returns the value of the "max_players" field in the mutation.
+func (m *GameMutation) MaxPlayers() (r int, exists bool) {
+	v := m.max_players
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxPlayers returns the old "max_players" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldMaxPlayers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxPlayers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxPlayers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxPlayers: %w", err)
+	}
+	return oldValue.MaxPlayers, nil
+}
+
+// AddMaxPlayers adds i to the "max_players" field.
+func (m *GameMutation) AddMaxPlayers(i int) {
+	if m.addmax_players != nil {
+		*m.addmax_players += i
+	} else {
+		m.addmax_players = &i
+	}
+}
+
+// AddedMaxPlayers returns the value that was added to the "max_players" field in this mutation.
+func (m *GameMutation) AddedMaxPlayers() (r int, exists bool) {
+	v := m.addmax_players
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxPlayers resets all changes to the "max_players" field.
+func (m *GameMutation) ResetMaxPlayers() {
+	m.max_players = nil
+	m.addmax_players = nil
+}
+
+// SetMinPlayers sets the "min_players" field.
+func (m *GameMutation) SetMinPlayers(i int) {
+	m.min_players = &i
+	m.addmin_players = nil
+}
+
+// MinPlayers returns the value of the "min_players" field in the mutation.
+func (m *GameMutation) MinPlayers() (r int, exists bool) {
+	v := m.min_players
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinPlayers returns the old "min_players" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldMinPlayers(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinPlayers is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinPlayers requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinPlayers: %w", err)
+	}
+	return oldValue.MinPlayers, nil
+}
+
+// AddMinPlayers adds i to the "min_players" field.
+func (m *GameMutation) AddMinPlayers(i int) {
+	if m.addmin_players != nil {
+		*m.addmin_players += i
+	} else {
+		m.addmin_players = &i
+	}
+}
+
+// AddedMinPlayers returns the value that was added to the "min_players" field in this mutation.
+func (m *GameMutation) AddedMinPlayers() (r int, exists bool) {
+	v := m.addmin_players
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinPlayers resets all changes to the "min_players" field.
+func (m *GameMutation) ResetMinPlayers() {
+	m.min_players = nil
+	m.addmin_players = nil
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *GameMutation) SetVisibility(ga game.Visibility) {
+	m.visibility = &ga
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *GameMutation) Visibility() (r game.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldVisibility(ctx context.Context) (v game.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *GameMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetInviteCode sets the "invite_code" field.
+func (m *GameMutation) SetInviteCode(s string) {
+	m.invite_code = &s
+}
+
+// InviteCode returns the value of the "invite_code" field in the mutation.
+func (m *GameMutation) InviteCode() (r string, exists bool) {
+	v := m.invite_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInviteCode returns the old "invite_code" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldInviteCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInviteCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInviteCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInviteCode: %w", err)
+	}
+	return oldValue.InviteCode, nil
+}
+
+// ClearInviteCode clears the value of the "invite_code" field.
+func (m *GameMutation) ClearInviteCode() {
+	m.invite_code = nil
+	m.clearedFields[game.FieldInviteCode] = struct{}{}
+}
+
+// InviteCodeCleared returns if the "invite_code" field was cleared in this mutation.
+func (m *GameMutation) InviteCodeCleared() bool {
+	_, ok := m.clearedFields[game.FieldInviteCode]
+	return ok
+}
+
+// ResetInviteCode resets all changes to the "invite_code" field.
+func (m *GameMutation) ResetInviteCode() {
+	m.invite_code = nil
+	delete(m.clearedFields, game.FieldInviteCode)
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *GameMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *GameMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *GameMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[game.FieldPasswordHash] = struct{}{}
+}
+
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *GameMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[game.FieldPasswordHash]
+	return ok
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *GameMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, game.FieldPasswordHash)
+}
+
+// SetHostToken sets the "host_token" field.
+func (m *GameMutation) SetHostToken(s string) {
+	m.host_token = &s
+}
+
+// HostToken returns the value of the "host_token" field in the mutation.
+func (m *GameMutation) HostToken() (r string, exists bool) {
+	v := m.host_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHostToken returns the old "host_token" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldHostToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHostToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHostToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHostToken: %w", err)
+	}
+	return oldValue.HostToken, nil
+}
+
+// ClearHostToken clears the value of the "host_token" field.
+func (m *GameMutation) ClearHostToken() {
+	m.host_token = nil
+	m.clearedFields[game.FieldHostToken] = struct{}{}
+}
+
+// HostTokenCleared returns if the "host_token" field was cleared in this mutation.
+func (m *GameMutation) HostTokenCleared() bool {
+	_, ok := m.clearedFields[game.FieldHostToken]
+	return ok
+}
+
+// ResetHostToken resets all changes to the "host_token" field.
+func (m *GameMutation) ResetHostToken() {
+	m.host_token = nil
+	delete(m.clearedFields, game.FieldHostToken)
+}
+
+// SetAutoStart sets the "auto_start" field.
+func (m *GameMutation) SetAutoStart(b bool) {
+	m.auto_start = &b
+}
+
+// AutoStart returns the value of the "auto_start" field in the mutation.
+func (m *GameMutation) AutoStart() (r bool, exists bool) {
+	v := m.auto_start
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoStart returns the old "auto_start" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldAutoStart(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoStart is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoStart requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoStart: %w", err)
+	}
+	return oldValue.AutoStart, nil
+}
+
+// ResetAutoStart resets all changes to the "auto_start" field.
+func (m *GameMutation) ResetAutoStart() {
+	m.auto_start = nil
+}
+
+// SetCardCount sets the "card_count" field.
+func (m *GameMutation) SetCardCount(i int) {
+	m.card_count = &i
+	m.addcard_count = nil
+}
+
+// CardCount returns the value of the "card_count" field in the mutation.
+func (m *GameMutation) CardCount() (r int, exists bool) {
+	v := m.card_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardCount returns the old "card_count" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldCardCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardCount: %w", err)
+	}
+	return oldValue.CardCount, nil
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (m *GameMutation) AddCardCount(i int) {
+	if m.addcard_count != nil {
+		*m.addcard_count += i
+	} else {
+		m.addcard_count = &i
+	}
+}
+
+// AddedCardCount returns the value that was added to the "card_count" field in this mutation.
+func (m *GameMutation) AddedCardCount() (r int, exists bool) {
+	v := m.addcard_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCardCount resets all changes to the "card_count" field.
+func (m *GameMutation) ResetCardCount() {
+	m.card_count = nil
+	m.addcard_count = nil
+}
+
+// SetWinnerID sets the "winner_id" field.
+func (m *GameMutation) SetWinnerID(i int) {
+	m.winner_id = &i
+	m.addwinner_id = nil
+}
+
+// WinnerID returns the value of the "winner_id" field in the mutation.
+func (m *GameMutation) WinnerID() (r int, exists bool) {
+	v := m.winner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinnerID returns the old "winner_id" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldWinnerID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinnerID: %w", err)
+	}
+	return oldValue.WinnerID, nil
+}
+
+// AddWinnerID adds i to the "winner_id" field.
+func (m *GameMutation) AddWinnerID(i int) {
+	if m.addwinner_id != nil {
+		*m.addwinner_id += i
+	} else {
+		m.addwinner_id = &i
+	}
+}
+
+// AddedWinnerID returns the value that was added to the "winner_id" field in this mutation.
+func (m *GameMutation) AddedWinnerID() (r int, exists bool) {
+	v := m.addwinner_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWinnerID clears the value of the "winner_id" field.
+func (m *GameMutation) ClearWinnerID() {
+	m.winner_id = nil
+	m.addwinner_id = nil
+	m.clearedFields[game.FieldWinnerID] = struct{}{}
+}
+
+// WinnerIDCleared returns if the "winner_id" field was cleared in this mutation.
+func (m *GameMutation) WinnerIDCleared() bool {
+	_, ok := m.clearedFields[game.FieldWinnerID]
+	return ok
+}
+
+// ResetWinnerID resets all changes to the "winner_id" field.
+func (m *GameMutation) ResetWinnerID() {
+	m.winner_id = nil
+	m.addwinner_id = nil
+	delete(m.clearedFields, game.FieldWinnerID)
+}
+
+// SetAutoBalance sets the "auto_balance" field.
+func (m *GameMutation) SetAutoBalance(b bool) {
+	m.auto_balance = &b
+}
+
+// AutoBalance returns the value of the "auto_balance" field in the mutation.
+func (m *GameMutation) AutoBalance() (r bool, exists bool) {
+	v := m.auto_balance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAutoBalance returns the old "auto_balance" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldAutoBalance(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAutoBalance is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAutoBalance requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAutoBalance: %w", err)
+	}
+	return oldValue.AutoBalance, nil
+}
+
+// ResetAutoBalance resets all changes to the "auto_balance" field.
+func (m *GameMutation) ResetAutoBalance() {
+	m.auto_balance = nil
+}
+
+// SetMatchID sets the "match_id" field.
+func (m *GameMutation) SetMatchID(i int) {
+	m.match = &i
+}
+
+// MatchID returns the value of the "match_id" field in the mutation.
+func (m *GameMutation) MatchID() (r int, exists bool) {
+	v := m.match
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMatchID returns the old "match_id" field's value of the Game entity.
+// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GameMutation) OldMatchID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMatchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMatchID: %w", err)
+	}
+	return oldValue.MatchID, nil
+}
+
+// ClearMatchID clears the value of the "match_id" field.
+func (m *GameMutation) ClearMatchID() {
+	m.match = nil
+	m.clearedFields[game.FieldMatchID] = struct{}{}
+}
+
+// MatchIDCleared returns if the "match_id" field was cleared in this mutation.
+func (m *GameMutation) MatchIDCleared() bool {
+	_, ok := m.clearedFields[game.FieldMatchID]
+	return ok
+}
+
+// ResetMatchID resets all changes to the "match_id" field.
+func (m *GameMutation) ResetMatchID() {
+	m.match = nil
+	delete(m.clearedFields, game.FieldMatchID)
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *GameMutation) AddPlayerIDs(ids ...int) {
+	if m.players == nil {
+		m.players = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *GameMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared reports if the "players" edge to the Player entity was cleared.
+func (m *GameMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *GameMutation) RemovePlayerIDs(ids ...int) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.players, ids[i])
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *GameMutation) RemovedPlayersIDs() (ids []int) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *GameMutation) PlayersIDs() (ids []int) {
+	for id := range m.players {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPlayers resets all changes to the "players" edge.
+func (m *GameMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
+}
+
+// AddTeamIDs adds the "teams" edge to the Team entity by ids.
+func (m *GameMutation) AddTeamIDs(ids ...int) {
+	if m.teams == nil {
+		m.teams = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.teams[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTeams clears the "teams" edge to the Team entity.
+func (m *GameMutation) ClearTeams() {
+	m.clearedteams = true
+}
+
+// TeamsCleared reports if the "teams" edge to the Team entity was cleared.
+func (m *GameMutation) TeamsCleared() bool {
+	return m.clearedteams
+}
+
+// RemoveTeamIDs removes the "teams" edge to the Team entity by IDs.
+func (m *GameMutation) RemoveTeamIDs(ids ...int) {
+	if m.removedteams == nil {
+		m.removedteams = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.teams, ids[i])
+		m.removedteams[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTeams returns the removed IDs of the "teams" edge to the Team entity.
+func (m *GameMutation) RemovedTeamsIDs() (ids []int) {
+	for id := range m.removedteams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TeamsIDs returns the "teams" edge IDs in the mutation.
+func (m *GameMutation) TeamsIDs() (ids []int) {
+	for id := range m.teams {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTeams resets all changes to the "teams" edge.
+func (m *GameMutation) ResetTeams() {
+	m.teams = nil
+	m.clearedteams = false
+	m.removedteams = nil
+}
+
+// AddTournamentMatchIDs adds the "tournament_matches" edge to the TournamentMatch entity by ids.
+func (m *GameMutation) AddTournamentMatchIDs(ids ...int) {
+	if m.tournament_matches == nil {
+		m.tournament_matches = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tournament_matches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTournamentMatches clears the "tournament_matches" edge to the TournamentMatch entity.
+func (m *GameMutation) ClearTournamentMatches() {
+	m.clearedtournament_matches = true
+}
+
+// TournamentMatchesCleared reports if the "tournament_matches" edge to the TournamentMatch entity was cleared.
+func (m *GameMutation) TournamentMatchesCleared() bool {
+	return m.clearedtournament_matches
+}
+
+// RemoveTournamentMatchIDs removes the "tournament_matches" edge to the TournamentMatch entity by IDs.
+func (m *GameMutation) RemoveTournamentMatchIDs(ids ...int) {
+	if m.removedtournament_matches == nil {
+		m.removedtournament_matches = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tournament_matches, ids[i])
+		m.removedtournament_matches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTournamentMatches returns the removed IDs of the "tournament_matches" edge to the TournamentMatch entity.
+func (m *GameMutation) RemovedTournamentMatchesIDs() (ids []int) {
+	for id := range m.removedtournament_matches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TournamentMatchesIDs returns the "tournament_matches" edge IDs in the mutation.
+func (m *GameMutation) TournamentMatchesIDs() (ids []int) {
+	for id := range m.tournament_matches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTournamentMatches resets all changes to the "tournament_matches" edge.
+func (m *GameMutation) ResetTournamentMatches() {
+	m.tournament_matches = nil
+	m.clearedtournament_matches = false
+	m.removedtournament_matches = nil
+}
+
+// ClearMatch clears the "match" edge to the Match entity.
+func (m *GameMutation) ClearMatch() {
+	m.clearedmatch = true
+	m.clearedFields[game.FieldMatchID] = struct{}{}
+}
+
+// MatchCleared reports if the "match" edge to the Match entity was cleared.
+func (m *GameMutation) MatchCleared() bool {
+	return m.MatchIDCleared() || m.clearedmatch
+}
+
+// MatchIDs returns the "match" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MatchID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) MatchIDs() (ids []int) {
+	if id := m.match; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMatch resets all changes to the "match" edge.
+func (m *GameMutation) ResetMatch() {
+	m.match = nil
+	m.clearedmatch = false
+}
+
+// SetPreviousID sets the "previous" edge to the Game entity by id.
+func (m *GameMutation) SetPreviousID(id int) {
+	m.previous = &id
+}
+
+// ClearPrevious clears the "previous" edge to the Game entity.
+func (m *GameMutation) ClearPrevious() {
+	m.clearedprevious = true
+}
+
+// PreviousCleared reports if the "previous" edge to the Game entity was cleared.
+func (m *GameMutation) PreviousCleared() bool {
+	return m.clearedprevious
+}
+
+// PreviousID returns the "previous" edge ID in the mutation.
+func (m *GameMutation) PreviousID() (id int, exists bool) {
+	if m.previous != nil {
+		return *m.previous, true
+	}
+	return
+}
+
+// PreviousIDs returns the "previous" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreviousID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) PreviousIDs() (ids []int) {
+	if id := m.previous; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPrevious resets all changes to the "previous" edge.
+func (m *GameMutation) ResetPrevious() {
+	m.previous = nil
+	m.clearedprevious = false
+}
+
+// SetRematchID sets the "rematch" edge to the Game entity by id.
+func (m *GameMutation) SetRematchID(id int) {
+	m.rematch = &id
+}
+
+// ClearRematch clears the "rematch" edge to the Game entity.
+func (m *GameMutation) ClearRematch() {
+	m.clearedrematch = true
+}
+
+// RematchCleared reports if the "rematch" edge to the Game entity was cleared.
+func (m *GameMutation) RematchCleared() bool {
+	return m.clearedrematch
+}
+
+// RematchID returns the "rematch" edge ID in the mutation.
+func (m *GameMutation) RematchID() (id int, exists bool) {
+	if m.rematch != nil {
+		return *m.rematch, true
+	}
+	return
+}
+
+// RematchIDs returns the "rematch" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RematchID instead. It exists only for internal usage by the builders.
+func (m *GameMutation) RematchIDs() (ids []int) {
+	if id := m.rematch; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRematch resets all changes to the "rematch" edge.
+func (m *GameMutation) ResetRematch() {
+	m.rematch = nil
+	m.clearedrematch = false
+}
+
+// Where appends a list predicates to the GameMutation builder.
+func (m *GameMutation) Where(ps ...predicate.Game) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GameMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GameMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Game, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GameMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GameMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Game).
+func (m *GameMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GameMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.name != nil {
+		fields = append(fields, game.FieldName)
+	}
+	if m.status != nil {
+		fields = append(fields, game.FieldStatus)
+	}
+	if m.total_rounds != nil {
+		fields = append(fields, game.FieldTotalRounds)
+	}
+	if m.mode != nil {
+		fields = append(fields, game.FieldMode)
+	}
+	if m.team_score != nil {
+		fields = append(fields, game.FieldTeamScore)
+	}
+	if m.elimination_interval != nil {
+		fields = append(fields, game.FieldEliminationInterval)
+	}
+	if m.center_count != nil {
+		fields = append(fields, game.FieldCenterCount)
+	}
+	if m.tie_break != nil {
+		fields = append(fields, game.FieldTieBreak)
+	}
+	if m.scoring != nil {
+		fields = append(fields, game.FieldScoring)
+	}
+	if m.max_players != nil {
+		fields = append(fields, game.FieldMaxPlayers)
+	}
+	if m.min_players != nil {
+		fields = append(fields, game.FieldMinPlayers)
+	}
+	if m.visibility != nil {
+		fields = append(fields, game.FieldVisibility)
+	}
+	if m.invite_code != nil {
+		fields = append(fields, game.FieldInviteCode)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, game.FieldPasswordHash)
+	}
+	if m.host_token != nil {
+		fields = append(fields, game.FieldHostToken)
+	}
+	if m.auto_start != nil {
+		fields = append(fields, game.FieldAutoStart)
+	}
+	if m.card_count != nil {
+		fields = append(fields, game.FieldCardCount)
+	}
+	if m.winner_id != nil {
+		fields = append(fields, game.FieldWinnerID)
+	}
+	if m.auto_balance != nil {
+		fields = append(fields, game.FieldAutoBalance)
+	}
+	if m.match != nil {
+		fields = append(fields, game.FieldMatchID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GameMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldName:
+		return m.Name()
+	case game.FieldStatus:
+		return m.Status()
+	case game.FieldTotalRounds:
+		return m.TotalRounds()
+	case game.FieldMode:
+		return m.Mode()
+	case game.FieldTeamScore:
+		return m.TeamScore()
+	case game.FieldEliminationInterval:
+		return m.EliminationInterval()
+	case game.FieldCenterCount:
+		return m.CenterCount()
+	case game.FieldTieBreak:
+		return m.TieBreak()
+	case game.FieldScoring:
+		return m.Scoring()
+	case game.FieldMaxPlayers:
+		return m.MaxPlayers()
+	case game.FieldMinPlayers:
+		return m.MinPlayers()
+	case game.FieldVisibility:
+		return m.Visibility()
+	case game.FieldInviteCode:
+		return m.InviteCode()
+	case game.FieldPasswordHash:
+		return m.PasswordHash()
+	case game.FieldHostToken:
+		return m.HostToken()
+	case game.FieldAutoStart:
+		return m.AutoStart()
+	case game.FieldCardCount:
+		return m.CardCount()
+	case game.FieldWinnerID:
+		return m.WinnerID()
+	case game.FieldAutoBalance:
+		return m.AutoBalance()
+	case game.FieldMatchID:
+		return m.MatchID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case game.FieldName:
+		return m.OldName(ctx)
+	case game.FieldStatus:
+		return m.OldStatus(ctx)
+	case game.FieldTotalRounds:
+		return m.OldTotalRounds(ctx)
+	case game.FieldMode:
+		return m.OldMode(ctx)
+	case game.FieldTeamScore:
+		return m.OldTeamScore(ctx)
+	case game.FieldEliminationInterval:
+		return m.OldEliminationInterval(ctx)
+	case game.FieldCenterCount:
+		return m.OldCenterCount(ctx)
+	case game.FieldTieBreak:
+		return m.OldTieBreak(ctx)
+	case game.FieldScoring:
+		return m.OldScoring(ctx)
+	case game.FieldMaxPlayers:
+		return m.OldMaxPlayers(ctx)
+	case game.FieldMinPlayers:
+		return m.OldMinPlayers(ctx)
+	case game.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case game.FieldInviteCode:
+		return m.OldInviteCode(ctx)
+	case game.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case game.FieldHostToken:
+		return m.OldHostToken(ctx)
+	case game.FieldAutoStart:
+		return m.OldAutoStart(ctx)
+	case game.FieldCardCount:
+		return m.OldCardCount(ctx)
+	case game.FieldWinnerID:
+		return m.OldWinnerID(ctx)
+	case game.FieldAutoBalance:
+		return m.OldAutoBalance(ctx)
+	case game.FieldMatchID:
+		return m.OldMatchID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Game field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case game.FieldStatus:
+		v, ok := value.(game.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case game.FieldTotalRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalRounds(v)
+		return nil
+	case game.FieldMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case game.FieldTeamScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamScore(v)
+		return nil
+	case game.FieldEliminationInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEliminationInterval(v)
+		return nil
+	case game.FieldCenterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCenterCount(v)
+		return nil
+	case game.FieldTieBreak:
+		v, ok := value.(game.TieBreak)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTieBreak(v)
+		return nil
+	case game.FieldScoring:
+		v, ok := value.(scoring.Rules)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScoring(v)
+		return nil
+	case game.FieldMaxPlayers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxPlayers(v)
+		return nil
+	case game.FieldMinPlayers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinPlayers(v)
+		return nil
+	case game.FieldVisibility:
+		v, ok := value.(game.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case game.FieldInviteCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInviteCode(v)
+		return nil
+	case game.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case game.FieldHostToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHostToken(v)
+		return nil
+	case game.FieldAutoStart:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoStart(v)
+		return nil
+	case game.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardCount(v)
+		return nil
+	case game.FieldWinnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinnerID(v)
+		return nil
+	case game.FieldAutoBalance:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAutoBalance(v)
+		return nil
+	case game.FieldMatchID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMatchID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GameMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_rounds != nil {
+		fields = append(fields, game.FieldTotalRounds)
+	}
+	if m.addteam_score != nil {
+		fields = append(fields, game.FieldTeamScore)
+	}
+	if m.addelimination_interval != nil {
+		fields = append(fields, game.FieldEliminationInterval)
+	}
+	if m.addcenter_count != nil {
+		fields = append(fields, game.FieldCenterCount)
+	}
+	if m.addmax_players != nil {
+		fields = append(fields, game.FieldMaxPlayers)
+	}
+	if m.addmin_players != nil {
+		fields = append(fields, game.FieldMinPlayers)
+	}
+	if m.addcard_count != nil {
+		fields = append(fields, game.FieldCardCount)
+	}
+	if m.addwinner_id != nil {
+		fields = append(fields, game.FieldWinnerID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case game.FieldTotalRounds:
+		return m.AddedTotalRounds()
+	case game.FieldTeamScore:
+		return m.AddedTeamScore()
+	case game.FieldEliminationInterval:
+		return m.AddedEliminationInterval()
+	case game.FieldCenterCount:
+		return m.AddedCenterCount()
+	case game.FieldMaxPlayers:
+		return m.AddedMaxPlayers()
+	case game.FieldMinPlayers:
+		return m.AddedMinPlayers()
+	case game.FieldCardCount:
+		return m.AddedCardCount()
+	case game.FieldWinnerID:
+		return m.AddedWinnerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GameMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case game.FieldTotalRounds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalRounds(v)
+		return nil
+	case game.FieldTeamScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTeamScore(v)
+		return nil
+	case game.FieldEliminationInterval:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEliminationInterval(v)
+		return nil
+	case game.FieldCenterCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCenterCount(v)
+		return nil
+	case game.FieldMaxPlayers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxPlayers(v)
+		return nil
+	case game.FieldMinPlayers:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinPlayers(v)
+		return nil
+	case game.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCardCount(v)
+		return nil
+	case game.FieldWinnerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWinnerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Game numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GameMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(game.FieldInviteCode) {
+		fields = append(fields, game.FieldInviteCode)
+	}
+	if m.FieldCleared(game.FieldPasswordHash) {
+		fields = append(fields, game.FieldPasswordHash)
+	}
+	if m.FieldCleared(game.FieldHostToken) {
+		fields = append(fields, game.FieldHostToken)
+	}
+	if m.FieldCleared(game.FieldWinnerID) {
+		fields = append(fields, game.FieldWinnerID)
+	}
+	if m.FieldCleared(game.FieldMatchID) {
+		fields = append(fields, game.FieldMatchID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GameMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GameMutation) ClearField(name string) error {
+	switch name {
+	case game.FieldInviteCode:
+		m.ClearInviteCode()
+		return nil
+	case game.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case game.FieldHostToken:
+		m.ClearHostToken()
+		return nil
+	case game.FieldWinnerID:
+		m.ClearWinnerID()
+		return nil
+	case game.FieldMatchID:
+		m.ClearMatchID()
+		return nil
+	}
+	return fmt.Errorf("unknown Game nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GameMutation) ResetField(name string) error {
+	switch name {
+	case game.FieldName:
+		m.ResetName()
+		return nil
+	case game.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case game.FieldTotalRounds:
+		m.ResetTotalRounds()
+		return nil
+	case game.FieldMode:
+		m.ResetMode()
+		return nil
+	case game.FieldTeamScore:
+		m.ResetTeamScore()
+		return nil
+	case game.FieldEliminationInterval:
+		m.ResetEliminationInterval()
+		return nil
+	case game.FieldCenterCount:
+		m.ResetCenterCount()
+		return nil
+	case game.FieldTieBreak:
+		m.ResetTieBreak()
+		return nil
+	case game.FieldScoring:
+		m.ResetScoring()
+		return nil
+	case game.FieldMaxPlayers:
+		m.ResetMaxPlayers()
+		return nil
+	case game.FieldMinPlayers:
+		m.ResetMinPlayers()
+		return nil
+	case game.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case game.FieldInviteCode:
+		m.ResetInviteCode()
+		return nil
+	case game.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case game.FieldHostToken:
+		m.ResetHostToken()
+		return nil
+	case game.FieldAutoStart:
+		m.ResetAutoStart()
+		return nil
+	case game.FieldCardCount:
+		m.ResetCardCount()
+		return nil
+	case game.FieldWinnerID:
+		m.ResetWinnerID()
+		return nil
+	case game.FieldAutoBalance:
+		m.ResetAutoBalance()
+		return nil
+	case game.FieldMatchID:
+		m.ResetMatchID()
+		return nil
+	}
+	return fmt.Errorf("unknown Game field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GameMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.players != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.teams != nil {
+		edges = append(edges, game.EdgeTeams)
+	}
+	if m.tournament_matches != nil {
+		edges = append(edges, game.EdgeTournamentMatches)
+	}
+	if m.match != nil {
+		edges = append(edges, game.EdgeMatch)
+	}
+	if m.previous != nil {
+		edges = append(edges, game.EdgePrevious)
+	}
+	if m.rematch != nil {
+		edges = append(edges, game.EdgeRematch)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GameMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.teams))
+		for id := range m.teams {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeTournamentMatches:
+		ids := make([]ent.Value, 0, len(m.tournament_matches))
+		for id := range m.tournament_matches {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeMatch:
+		if id := m.match; id != nil {
+			return []ent.Value{*id}
+		}
+	case game.EdgePrevious:
+		if id := m.previous; id != nil {
+			return []ent.Value{*id}
+		}
+	case game.EdgeRematch:
+		if id := m.rematch; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GameMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedplayers != nil {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.removedteams != nil {
+		edges = append(edges, game.EdgeTeams)
+	}
+	if m.removedtournament_matches != nil {
+		edges = append(edges, game.EdgeTournamentMatches)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GameMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case game.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeTeams:
+		ids := make([]ent.Value, 0, len(m.removedteams))
+		for id := range m.removedteams {
+			ids = append(ids, id)
+		}
+		return ids
+	case game.EdgeTournamentMatches:
+		ids := make([]ent.Value, 0, len(m.removedtournament_matches))
+		for id := range m.removedtournament_matches {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GameMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedplayers {
+		edges = append(edges, game.EdgePlayers)
+	}
+	if m.clearedteams {
+		edges = append(edges, game.EdgeTeams)
+	}
+	if m.clearedtournament_matches {
+		edges = append(edges, game.EdgeTournamentMatches)
+	}
+	if m.clearedmatch {
+		edges = append(edges, game.EdgeMatch)
+	}
+	if m.clearedprevious {
+		edges = append(edges, game.EdgePrevious)
+	}
+	if m.clearedrematch {
+		edges = append(edges, game.EdgeRematch)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GameMutation) EdgeCleared(name string) bool {
+	switch name {
+	case game.EdgePlayers:
+		return m.clearedplayers
+	case game.EdgeTeams:
+		return m.clearedteams
+	case game.EdgeTournamentMatches:
+		return m.clearedtournament_matches
+	case game.EdgeMatch:
+		return m.clearedmatch
+	case game.EdgePrevious:
+		return m.clearedprevious
+	case game.EdgeRematch:
+		return m.clearedrematch
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GameMutation) ClearEdge(name string) error {
+	switch name {
+	case game.EdgeMatch:
+		m.ClearMatch()
+		return nil
+	case game.EdgePrevious:
+		m.ClearPrevious()
+		return nil
+	case game.EdgeRematch:
+		m.ClearRematch()
+		return nil
+	}
+	return fmt.Errorf("unknown Game unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GameMutation) ResetEdge(name string) error {
+	switch name {
+	case game.EdgePlayers:
+		m.ResetPlayers()
+		return nil
+	case game.EdgeTeams:
+		m.ResetTeams()
+		return nil
+	case game.EdgeTournamentMatches:
+		m.ResetTournamentMatches()
+		return nil
+	case game.EdgeMatch:
+		m.ResetMatch()
+		return nil
+	case game.EdgePrevious:
+		m.ResetPrevious()
+		return nil
+	case game.EdgeRematch:
+		m.ResetRematch()
+		return nil
+	}
+	return fmt.Errorf("unknown Game edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	symbol        *string
+	clearedFields map[string]struct{}
+	parent        map[int]struct{}
+	removedparent map[int]struct{}
+	clearedparent bool
+	done          bool
+	oldValue      func(context.Context) (*Item, error)
+	predicates    []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id int) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *ItemMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *ItemMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *ItemMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// AddParentIDs adds the "parent" edge to the Card entity by ids.
+func (m *ItemMutation) AddParentIDs(ids ...int) {
+	if m.parent == nil {
+		m.parent = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.parent[ids[i]] = struct{}{}
+	}
+}
+
+// ClearParent clears the "parent" edge to the Card entity.
+func (m *ItemMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Card entity was cleared.
+func (m *ItemMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// RemoveParentIDs removes the "parent" edge to the Card entity by IDs.
+func (m *ItemMutation) RemoveParentIDs(ids ...int) {
+	if m.removedparent == nil {
+		m.removedparent = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.parent, ids[i])
+		m.removedparent[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedParent returns the removed IDs of the "parent" edge to the Card entity.
+func (m *ItemMutation) RemovedParentIDs() (ids []int) {
+	for id := range m.removedparent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+func (m *ItemMutation) ParentIDs() (ids []int) {
+	for id := range m.parent {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ItemMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+	m.removedparent = nil
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.symbol != nil {
+		fields = append(fields, item.FieldSymbol)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldSymbol:
+		return m.Symbol()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldSymbol:
+		return m.OldSymbol(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.parent != nil {
+		edges = append(edges, item.EdgeParent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeParent:
+		ids := make([]ent.Value, 0, len(m.parent))
+		for id := range m.parent {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedparent != nil {
+		edges = append(edges, item.EdgeParent)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeParent:
+		ids := make([]ent.Value, 0, len(m.removedparent))
+		for id := range m.removedparent {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedparent {
+		edges = append(edges, item.EdgeParent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeParent:
+		return m.clearedparent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeParent:
+		m.ResetParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// MatchMutation represents an operation that mutates the Match nodes in the graph.
+type MatchMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	format        *match.Format
+	length        *int
+	addlength     *int
+	status        *match.Status
+	winner_name   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	games         map[int]struct{}
+	removedgames  map[int]struct{}
+	clearedgames  bool
+	done          bool
+	oldValue      func(context.Context) (*Match, error)
+	predicates    []predicate.Match
+}
+
+var _ ent.Mutation = (*MatchMutation)(nil)
+
+// matchOption allows management of the mutation configuration using functional options.
+type matchOption func(*MatchMutation)
+
+// newMatchMutation creates new mutation for the Match entity.
+func newMatchMutation(c config, op Op, opts ...matchOption) *MatchMutation {
+	m := &MatchMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMatch,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMatchID sets the ID field of the mutation.
+func withMatchID(id int) matchOption {
+	return func(m *MatchMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Match
+		)
+		m.oldValue = func(ctx context.Context) (*Match, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Match.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMatch sets the old Match of the mutation.
+func withMatch(node *Match) matchOption {
+	return func(m *MatchMutation) {
+		m.oldValue = func(context.Context) (*Match, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MatchMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MatchMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MatchMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MatchMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Match.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFormat sets the "format" field.
+func (m *MatchMutation) SetFormat(value match.Format) {
+	m.format = &value
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *MatchMutation) Format() (r match.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldFormat(ctx context.Context) (v match.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *MatchMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetLength sets the "length" field.
+func (m *MatchMutation) SetLength(i int) {
+	m.length = &i
+	m.addlength = nil
+}
+
+// Length returns the value of the "length" field in the mutation.
+func (m *MatchMutation) Length() (r int, exists bool) {
+	v := m.length
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLength returns the old "length" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLength is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLength requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLength: %w", err)
+	}
+	return oldValue.Length, nil
+}
+
+// AddLength adds i to the "length" field.
+func (m *MatchMutation) AddLength(i int) {
+	if m.addlength != nil {
+		*m.addlength += i
+	} else {
+		m.addlength = &i
+	}
+}
+
+// AddedLength returns the value that was added to the "length" field in this mutation.
+func (m *MatchMutation) AddedLength() (r int, exists bool) {
+	v := m.addlength
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLength resets all changes to the "length" field.
+func (m *MatchMutation) ResetLength() {
+	m.length = nil
+	m.addlength = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *MatchMutation) SetStatus(value match.Status) {
+	m.status = &value
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *MatchMutation) Status() (r match.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldStatus(ctx context.Context) (v match.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *MatchMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetWinnerName sets the "winner_name" field.
+func (m *MatchMutation) SetWinnerName(s string) {
+	m.winner_name = &s
+}
+
+// WinnerName returns the value of the "winner_name" field in the mutation.
+func (m *MatchMutation) WinnerName() (r string, exists bool) {
+	v := m.winner_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinnerName returns the old "winner_name" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldWinnerName(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinnerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinnerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinnerName: %w", err)
+	}
+	return oldValue.WinnerName, nil
+}
+
+// ClearWinnerName clears the value of the "winner_name" field.
+func (m *MatchMutation) ClearWinnerName() {
+	m.winner_name = nil
+	m.clearedFields[match.FieldWinnerName] = struct{}{}
+}
+
+// WinnerNameCleared returns if the "winner_name" field was cleared in this mutation.
+func (m *MatchMutation) WinnerNameCleared() bool {
+	_, ok := m.clearedFields[match.FieldWinnerName]
+	return ok
+}
+
+// ResetWinnerName resets all changes to the "winner_name" field.
+func (m *MatchMutation) ResetWinnerName() {
+	m.winner_name = nil
+	delete(m.clearedFields, match.FieldWinnerName)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MatchMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MatchMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Match entity.
+// If the Match object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MatchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MatchMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddGameIDs adds the "games" edge to the Game entity by ids.
+func (m *MatchMutation) AddGameIDs(ids ...int) {
+	if m.games == nil {
+		m.games = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.games[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGames clears the "games" edge to the Game entity.
+func (m *MatchMutation) ClearGames() {
+	m.clearedgames = true
+}
+
+// GamesCleared reports if the "games" edge to the Game entity was cleared.
+func (m *MatchMutation) GamesCleared() bool {
+	return m.clearedgames
+}
+
+// RemoveGameIDs removes the "games" edge to the Game entity by IDs.
+func (m *MatchMutation) RemoveGameIDs(ids ...int) {
+	if m.removedgames == nil {
+		m.removedgames = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.games, ids[i])
+		m.removedgames[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGames returns the removed IDs of the "games" edge to the Game entity.
+func (m *MatchMutation) RemovedGamesIDs() (ids []int) {
+	for id := range m.removedgames {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GamesIDs returns the "games" edge IDs in the mutation.
+func (m *MatchMutation) GamesIDs() (ids []int) {
+	for id := range m.games {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGames resets all changes to the "games" edge.
+func (m *MatchMutation) ResetGames() {
+	m.games = nil
+	m.clearedgames = false
+	m.removedgames = nil
+}
+
+// Where appends a list predicates to the MatchMutation builder.
+func (m *MatchMutation) Where(ps ...predicate.Match) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MatchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Match, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MatchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MatchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Match).
+func (m *MatchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MatchMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.format != nil {
+		fields = append(fields, match.FieldFormat)
+	}
+	if m.length != nil {
+		fields = append(fields, match.FieldLength)
+	}
+	if m.status != nil {
+		fields = append(fields, match.FieldStatus)
+	}
+	if m.winner_name != nil {
+		fields = append(fields, match.FieldWinnerName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, match.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MatchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case match.FieldFormat:
+		return m.Format()
+	case match.FieldLength:
+		return m.Length()
+	case match.FieldStatus:
+		return m.Status()
+	case match.FieldWinnerName:
+		return m.WinnerName()
+	case match.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case match.FieldFormat:
+		return m.OldFormat(ctx)
+	case match.FieldLength:
+		return m.OldLength(ctx)
+	case match.FieldStatus:
+		return m.OldStatus(ctx)
+	case match.FieldWinnerName:
+		return m.OldWinnerName(ctx)
+	case match.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Match field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case match.FieldFormat:
+		v, ok := value.(match.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case match.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLength(v)
+		return nil
+	case match.FieldStatus:
+		v, ok := value.(match.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case match.FieldWinnerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinnerName(v)
+		return nil
+	case match.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Match field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MatchMutation) AddedFields() []string {
+	var fields []string
+	if m.addlength != nil {
+		fields = append(fields, match.FieldLength)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MatchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case match.FieldLength:
+		return m.AddedLength()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MatchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case match.FieldLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLength(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Match numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(match.FieldWinnerName) {
+		fields = append(fields, match.FieldWinnerName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MatchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MatchMutation) ClearField(name string) error {
+	switch name {
+	case match.FieldWinnerName:
+		m.ClearWinnerName()
+		return nil
+	}
+	return fmt.Errorf("unknown Match nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MatchMutation) ResetField(name string) error {
+	switch name {
+	case match.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case match.FieldLength:
+		m.ResetLength()
+		return nil
+	case match.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case match.FieldWinnerName:
+		m.ResetWinnerName()
+		return nil
+	case match.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Match field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.games != nil {
+		edges = append(edges, match.EdgeGames)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MatchMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case match.EdgeGames:
+		ids := make([]ent.Value, 0, len(m.games))
+		for id := range m.games {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedgames != nil {
+		edges = append(edges, match.EdgeGames)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MatchMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case match.EdgeGames:
+		ids := make([]ent.Value, 0, len(m.removedgames))
+		for id := range m.removedgames {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedgames {
+		edges = append(edges, match.EdgeGames)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MatchMutation) EdgeCleared(name string) bool {
+	switch name {
+	case match.EdgeGames:
+		return m.clearedgames
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MatchMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Match unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MatchMutation) ResetEdge(name string) error {
+	switch name {
+	case match.EdgeGames:
+		m.ResetGames()
+		return nil
+	}
+	return fmt.Errorf("unknown Match edge %s", name)
+}
+
+// PlayerMutation represents an operation that mutates the Player nodes in the graph.
+type PlayerMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	status             *player.Status
+	score              *int
+	addscore           *int
+	wrong_count        *int
+	addwrong_count     *int
+	streak             *int
+	addstreak          *int
+	is_host            *bool
+	is_bot             *bool
+	series_wins        *int
+	addseries_wins     *int
+	series_id          *int
+	addseries_id       *int
+	resume_token       *string
+	handicap           *handicap.Handicap
+	muted              *bool
+	tournament_seed    *int
+	addtournament_seed *int
+	clearedFields      map[string]struct{}
+	parent             *int
+	clearedparent      bool
+	team               *int
+	clearedteam        bool
+	user               *int
+	cleareduser        bool
+	done               bool
+	oldValue           func(context.Context) (*Player, error)
+	predicates         []predicate.Player
+}
+
+var _ ent.Mutation = (*PlayerMutation)(nil)
+
+// playerOption allows management of the mutation configuration using functional options.
+type playerOption func(*PlayerMutation)
+
+// newPlayerMutation creates new mutation for the Player entity.
+func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
+	m := &PlayerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlayer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlayerID sets the ID field of the mutation.
+func withPlayerID(id int) playerOption {
+	return func(m *PlayerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Player
+		)
+		m.oldValue = func(ctx context.Context) (*Player, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Player.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlayer sets the old Player of the mutation.
+func withPlayer(node *Player) playerOption {
+	return func(m *PlayerMutation) {
+		m.oldValue = func(context.Context) (*Player, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlayerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlayerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlayerMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlayerMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlayerMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlayerMutation) ResetName() {
+	m.name = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *PlayerMutation) SetStatus(pl player.Status) {
+	m.status = &pl
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PlayerMutation) Status() (r player.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldStatus(ctx context.Context) (v player.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PlayerMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetScore sets the "score" field.
+func (m *PlayerMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *PlayerMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *PlayerMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *PlayerMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *PlayerMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetWrongCount sets the "wrong_count" field.
+func (m *PlayerMutation) SetWrongCount(i int) {
+	m.wrong_count = &i
+	m.addwrong_count = nil
+}
+
+// WrongCount returns the value of the "wrong_count" field in the mutation.
+func (m *PlayerMutation) WrongCount() (r int, exists bool) {
+	v := m.wrong_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWrongCount returns the old "wrong_count" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldWrongCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWrongCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWrongCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWrongCount: %w", err)
+	}
+	return oldValue.WrongCount, nil
+}
+
+// AddWrongCount adds i to the "wrong_count" field.
+func (m *PlayerMutation) AddWrongCount(i int) {
+	if m.addwrong_count != nil {
+		*m.addwrong_count += i
+	} else {
+		m.addwrong_count = &i
+	}
+}
+
+// AddedWrongCount returns the value that was added to the "wrong_count" field in this mutation.
+func (m *PlayerMutation) AddedWrongCount() (r int, exists bool) {
+	v := m.addwrong_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWrongCount resets all changes to the "wrong_count" field.
+func (m *PlayerMutation) ResetWrongCount() {
+	m.wrong_count = nil
+	m.addwrong_count = nil
+}
+
+// SetStreak sets the "streak" field.
+func (m *PlayerMutation) SetStreak(i int) {
+	m.streak = &i
+	m.addstreak = nil
+}
+
+// Streak returns the value of the "streak" field in the mutation.
+func (m *PlayerMutation) Streak() (r int, exists bool) {
+	v := m.streak
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStreak returns the old "streak" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldStreak(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStreak is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStreak requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStreak: %w", err)
+	}
+	return oldValue.Streak, nil
+}
+
+// AddStreak adds i to the "streak" field.
+func (m *PlayerMutation) AddStreak(i int) {
+	if m.addstreak != nil {
+		*m.addstreak += i
+	} else {
+		m.addstreak = &i
+	}
+}
+
+// AddedStreak returns the value that was added to the "streak" field in this mutation.
+func (m *PlayerMutation) AddedStreak() (r int, exists bool) {
+	v := m.addstreak
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStreak resets all changes to the "streak" field.
+func (m *PlayerMutation) ResetStreak() {
+	m.streak = nil
+	m.addstreak = nil
+}
+
+// SetIsHost sets the "is_host" field.
+func (m *PlayerMutation) SetIsHost(b bool) {
+	m.is_host = &b
+}
+
+// IsHost returns the value of the "is_host" field in the mutation.
+func (m *PlayerMutation) IsHost() (r bool, exists bool) {
+	v := m.is_host
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsHost returns the old "is_host" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldIsHost(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsHost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsHost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsHost: %w", err)
+	}
+	return oldValue.IsHost, nil
+}
+
+// ResetIsHost resets all changes to the "is_host" field.
+func (m *PlayerMutation) ResetIsHost() {
+	m.is_host = nil
+}
+
+// SetIsBot sets the "is_bot" field.
+func (m *PlayerMutation) SetIsBot(b bool) {
+	m.is_bot = &b
+}
+
+// IsBot returns the value of the "is_bot" field in the mutation.
+func (m *PlayerMutation) IsBot() (r bool, exists bool) {
+	v := m.is_bot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBot returns the old "is_bot" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldIsBot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBot: %w", err)
+	}
+	return oldValue.IsBot, nil
+}
+
+// ResetIsBot resets all changes to the "is_bot" field.
+func (m *PlayerMutation) ResetIsBot() {
+	m.is_bot = nil
+}
+
+// SetSeriesWins sets the "series_wins" field.
+func (m *PlayerMutation) SetSeriesWins(i int) {
+	m.series_wins = &i
+	m.addseries_wins = nil
+}
+
+// SeriesWins returns the value of the "series_wins" field in the mutation.
+func (m *PlayerMutation) SeriesWins() (r int, exists bool) {
+	v := m.series_wins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesWins returns the old "series_wins" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSeriesWins(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesWins is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesWins requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesWins: %w", err)
+	}
+	return oldValue.SeriesWins, nil
+}
+
+// AddSeriesWins adds i to the "series_wins" field.
+func (m *PlayerMutation) AddSeriesWins(i int) {
+	if m.addseries_wins != nil {
+		*m.addseries_wins += i
+	} else {
+		m.addseries_wins = &i
+	}
+}
+
+// AddedSeriesWins returns the value that was added to the "series_wins" field in this mutation.
+func (m *PlayerMutation) AddedSeriesWins() (r int, exists bool) {
+	v := m.addseries_wins
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeriesWins resets all changes to the "series_wins" field.
+func (m *PlayerMutation) ResetSeriesWins() {
+	m.series_wins = nil
+	m.addseries_wins = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *PlayerMutation) SetSeriesID(i int) {
+	m.series_id = &i
+	m.addseries_id = nil
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *PlayerMutation) SeriesID() (r int, exists bool) {
+	v := m.series_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldSeriesID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// AddSeriesID adds i to the "series_id" field.
+func (m *PlayerMutation) AddSeriesID(i int) {
+	if m.addseries_id != nil {
+		*m.addseries_id += i
+	} else {
+		m.addseries_id = &i
+	}
+}
+
+// AddedSeriesID returns the value that was added to the "series_id" field in this mutation.
+func (m *PlayerMutation) AddedSeriesID() (r int, exists bool) {
+	v := m.addseries_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (m *PlayerMutation) ClearSeriesID() {
+	m.series_id = nil
+	m.addseries_id = nil
+	m.clearedFields[player.FieldSeriesID] = struct{}{}
+}
+
+// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
+func (m *PlayerMutation) SeriesIDCleared() bool {
+	_, ok := m.clearedFields[player.FieldSeriesID]
+	return ok
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *PlayerMutation) ResetSeriesID() {
+	m.series_id = nil
+	m.addseries_id = nil
+	delete(m.clearedFields, player.FieldSeriesID)
+}
+
+// SetResumeToken sets the "resume_token" field.
+func (m *PlayerMutation) SetResumeToken(s string) {
+	m.resume_token = &s
+}
+
+// ResumeToken returns the value of the "resume_token" field in the mutation.
+func (m *PlayerMutation) ResumeToken() (r string, exists bool) {
+	v := m.resume_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResumeToken returns the old "resume_token" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldResumeToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResumeToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResumeToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResumeToken: %w", err)
+	}
+	return oldValue.ResumeToken, nil
+}
+
+// ClearResumeToken clears the value of the "resume_token" field.
+func (m *PlayerMutation) ClearResumeToken() {
+	m.resume_token = nil
+	m.clearedFields[player.FieldResumeToken] = struct{}{}
+}
+
+// ResumeTokenCleared returns if the "resume_token" field was cleared in this mutation.
+func (m *PlayerMutation) ResumeTokenCleared() bool {
+	_, ok := m.clearedFields[player.FieldResumeToken]
+	return ok
+}
+
+// ResetResumeToken resets all changes to the "resume_token" field.
+func (m *PlayerMutation) ResetResumeToken() {
+	m.resume_token = nil
+	delete(m.clearedFields, player.FieldResumeToken)
+}
+
+// SetTeamID sets the "team_id" field.
+func (m *PlayerMutation) SetTeamID(i int) {
+	m.team = &i
+}
+
+// TeamID returns the value of the "team_id" field in the mutation.
+func (m *PlayerMutation) TeamID() (r int, exists bool) {
+	v := m.team
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTeamID returns the old "team_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTeamID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTeamID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTeamID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTeamID: %w", err)
+	}
+	return oldValue.TeamID, nil
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (m *PlayerMutation) ClearTeamID() {
+	m.team = nil
+	m.clearedFields[player.FieldTeamID] = struct{}{}
+}
+
+// TeamIDCleared returns if the "team_id" field was cleared in this mutation.
+func (m *PlayerMutation) TeamIDCleared() bool {
+	_, ok := m.clearedFields[player.FieldTeamID]
+	return ok
+}
+
+// ResetTeamID resets all changes to the "team_id" field.
+func (m *PlayerMutation) ResetTeamID() {
+	m.team = nil
+	delete(m.clearedFields, player.FieldTeamID)
+}
+
+// SetHandicap sets the "handicap" field.
+func (m *PlayerMutation) SetHandicap(h handicap.Handicap) {
+	m.handicap = &h
+}
+
+// Handicap returns the value of the "handicap" field in the mutation.
+func (m *PlayerMutation) Handicap() (r handicap.Handicap, exists bool) {
+	v := m.handicap
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandicap returns the old "handicap" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldHandicap(ctx context.Context) (v handicap.Handicap, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandicap is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandicap requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandicap: %w", err)
+	}
+	return oldValue.Handicap, nil
+}
+
+// ClearHandicap clears the value of the "handicap" field.
+func (m *PlayerMutation) ClearHandicap() {
+	m.handicap = nil
+	m.clearedFields[player.FieldHandicap] = struct{}{}
+}
+
+// HandicapCleared returns if the "handicap" field was cleared in this mutation.
+func (m *PlayerMutation) HandicapCleared() bool {
+	_, ok := m.clearedFields[player.FieldHandicap]
+	return ok
+}
+
+// ResetHandicap resets all changes to the "handicap" field.
+func (m *PlayerMutation) ResetHandicap() {
+	m.handicap = nil
+	delete(m.clearedFields, player.FieldHandicap)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PlayerMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PlayerMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *PlayerMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[player.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PlayerMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[player.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PlayerMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, player.FieldUserID)
+}
+
+// SetMuted sets the "muted" field.
+func (m *PlayerMutation) SetMuted(b bool) {
+	m.muted = &b
+}
+
+// Muted returns the value of the "muted" field in the mutation.
+func (m *PlayerMutation) Muted() (r bool, exists bool) {
+	v := m.muted
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMuted returns the old "muted" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldMuted(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMuted is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMuted requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMuted: %w", err)
+	}
+	return oldValue.Muted, nil
+}
+
+// ResetMuted resets all changes to the "muted" field.
+func (m *PlayerMutation) ResetMuted() {
+	m.muted = nil
+}
+
+// SetTournamentSeed sets the "tournament_seed" field.
+func (m *PlayerMutation) SetTournamentSeed(i int) {
+	m.tournament_seed = &i
+	m.addtournament_seed = nil
+}
+
+// TournamentSeed returns the value of the "tournament_seed" field in the mutation.
+func (m *PlayerMutation) TournamentSeed() (r int, exists bool) {
+	v := m.tournament_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTournamentSeed returns the old "tournament_seed" field's value of the Player entity.
+// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlayerMutation) OldTournamentSeed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTournamentSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTournamentSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTournamentSeed: %w", err)
+	}
+	return oldValue.TournamentSeed, nil
+}
+
+// AddTournamentSeed adds i to the "tournament_seed" field.
+func (m *PlayerMutation) AddTournamentSeed(i int) {
+	if m.addtournament_seed != nil {
+		*m.addtournament_seed += i
+	} else {
+		m.addtournament_seed = &i
+	}
+}
+
+// AddedTournamentSeed returns the value that was added to the "tournament_seed" field in this mutation.
+func (m *PlayerMutation) AddedTournamentSeed() (r int, exists bool) {
+	v := m.addtournament_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTournamentSeed clears the value of the "tournament_seed" field.
+func (m *PlayerMutation) ClearTournamentSeed() {
+	m.tournament_seed = nil
+	m.addtournament_seed = nil
+	m.clearedFields[player.FieldTournamentSeed] = struct{}{}
+}
+
+// TournamentSeedCleared returns if the "tournament_seed" field was cleared in this mutation.
+func (m *PlayerMutation) TournamentSeedCleared() bool {
+	_, ok := m.clearedFields[player.FieldTournamentSeed]
+	return ok
+}
+
+// ResetTournamentSeed resets all changes to the "tournament_seed" field.
+func (m *PlayerMutation) ResetTournamentSeed() {
+	m.tournament_seed = nil
+	m.addtournament_seed = nil
+	delete(m.clearedFields, player.FieldTournamentSeed)
+}
+
+// SetParentID sets the "parent" edge to the Game entity by id.
+func (m *PlayerMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Game entity.
+func (m *PlayerMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Game entity was cleared.
+func (m *PlayerMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *PlayerMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *PlayerMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// ClearTeam clears the "team" edge to the Team entity.
+func (m *PlayerMutation) ClearTeam() {
+	m.clearedteam = true
+	m.clearedFields[player.FieldTeamID] = struct{}{}
+}
+
+// TeamCleared reports if the "team" edge to the Team entity was cleared.
+func (m *PlayerMutation) TeamCleared() bool {
+	return m.TeamIDCleared() || m.clearedteam
+}
+
+// TeamIDs returns the "team" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TeamID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) TeamIDs() (ids []int) {
+	if id := m.team; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTeam resets all changes to the "team" edge.
+func (m *PlayerMutation) ResetTeam() {
+	m.team = nil
+	m.clearedteam = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PlayerMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[player.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PlayerMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PlayerMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PlayerMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PlayerMutation builder.
+func (m *PlayerMutation) Where(ps ...predicate.Player) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Player, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlayerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlayerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Player).
+func (m *PlayerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlayerMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.name != nil {
+		fields = append(fields, player.FieldName)
+	}
+	if m.status != nil {
+		fields = append(fields, player.FieldStatus)
+	}
+	if m.score != nil {
+		fields = append(fields, player.FieldScore)
+	}
+	if m.wrong_count != nil {
+		fields = append(fields, player.FieldWrongCount)
+	}
+	if m.streak != nil {
+		fields = append(fields, player.FieldStreak)
+	}
+	if m.is_host != nil {
+		fields = append(fields, player.FieldIsHost)
+	}
+	if m.is_bot != nil {
+		fields = append(fields, player.FieldIsBot)
+	}
+	if m.series_wins != nil {
+		fields = append(fields, player.FieldSeriesWins)
+	}
+	if m.series_id != nil {
+		fields = append(fields, player.FieldSeriesID)
+	}
+	if m.resume_token != nil {
+		fields = append(fields, player.FieldResumeToken)
+	}
+	if m.team != nil {
+		fields = append(fields, player.FieldTeamID)
+	}
+	if m.handicap != nil {
+		fields = append(fields, player.FieldHandicap)
+	}
+	if m.user != nil {
+		fields = append(fields, player.FieldUserID)
+	}
+	if m.muted != nil {
+		fields = append(fields, player.FieldMuted)
+	}
+	if m.tournament_seed != nil {
+		fields = append(fields, player.FieldTournamentSeed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldName:
+		return m.Name()
+	case player.FieldStatus:
+		return m.Status()
+	case player.FieldScore:
+		return m.Score()
+	case player.FieldWrongCount:
+		return m.WrongCount()
+	case player.FieldStreak:
+		return m.Streak()
+	case player.FieldIsHost:
+		return m.IsHost()
+	case player.FieldIsBot:
+		return m.IsBot()
+	case player.FieldSeriesWins:
+		return m.SeriesWins()
+	case player.FieldSeriesID:
+		return m.SeriesID()
+	case player.FieldResumeToken:
+		return m.ResumeToken()
+	case player.FieldTeamID:
+		return m.TeamID()
+	case player.FieldHandicap:
+		return m.Handicap()
+	case player.FieldUserID:
+		return m.UserID()
+	case player.FieldMuted:
+		return m.Muted()
+	case player.FieldTournamentSeed:
+		return m.TournamentSeed()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case player.FieldName:
+		return m.OldName(ctx)
+	case player.FieldStatus:
+		return m.OldStatus(ctx)
+	case player.FieldScore:
+		return m.OldScore(ctx)
+	case player.FieldWrongCount:
+		return m.OldWrongCount(ctx)
+	case player.FieldStreak:
+		return m.OldStreak(ctx)
+	case player.FieldIsHost:
+		return m.OldIsHost(ctx)
+	case player.FieldIsBot:
+		return m.OldIsBot(ctx)
+	case player.FieldSeriesWins:
+		return m.OldSeriesWins(ctx)
+	case player.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case player.FieldResumeToken:
+		return m.OldResumeToken(ctx)
+	case player.FieldTeamID:
+		return m.OldTeamID(ctx)
+	case player.FieldHandicap:
+		return m.OldHandicap(ctx)
+	case player.FieldUserID:
+		return m.OldUserID(ctx)
+	case player.FieldMuted:
+		return m.OldMuted(ctx)
+	case player.FieldTournamentSeed:
+		return m.OldTournamentSeed(ctx)
+	}
+	return nil, fmt.Errorf("unknown Player field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case player.FieldStatus:
+		v, ok := value.(player.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case player.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case player.FieldWrongCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrongCount(v)
+		return nil
+	case player.FieldStreak:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStreak(v)
+		return nil
+	case player.FieldIsHost:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsHost(v)
+		return nil
+	case player.FieldIsBot:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBot(v)
+		return nil
+	case player.FieldSeriesWins:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesWins(v)
+		return nil
+	case player.FieldSeriesID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case player.FieldResumeToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResumeToken(v)
+		return nil
+	case player.FieldTeamID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTeamID(v)
+		return nil
+	case player.FieldHandicap:
+		v, ok := value.(handicap.Handicap)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandicap(v)
+		return nil
+	case player.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case player.FieldMuted:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMuted(v)
+		return nil
+	case player.FieldTournamentSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTournamentSeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlayerMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, player.FieldScore)
+	}
+	if m.addwrong_count != nil {
+		fields = append(fields, player.FieldWrongCount)
+	}
+	if m.addstreak != nil {
+		fields = append(fields, player.FieldStreak)
+	}
+	if m.addseries_wins != nil {
+		fields = append(fields, player.FieldSeriesWins)
+	}
+	if m.addseries_id != nil {
+		fields = append(fields, player.FieldSeriesID)
+	}
+	if m.addtournament_seed != nil {
+		fields = append(fields, player.FieldTournamentSeed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case player.FieldScore:
+		return m.AddedScore()
+	case player.FieldWrongCount:
+		return m.AddedWrongCount()
+	case player.FieldStreak:
+		return m.AddedStreak()
+	case player.FieldSeriesWins:
+		return m.AddedSeriesWins()
+	case player.FieldSeriesID:
+		return m.AddedSeriesID()
+	case player.FieldTournamentSeed:
+		return m.AddedTournamentSeed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case player.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case player.FieldWrongCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWrongCount(v)
+		return nil
+	case player.FieldStreak:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStreak(v)
+		return nil
+	case player.FieldSeriesWins:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeriesWins(v)
+		return nil
+	case player.FieldSeriesID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeriesID(v)
+		return nil
+	case player.FieldTournamentSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTournamentSeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Player numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlayerMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(player.FieldSeriesID) {
+		fields = append(fields, player.FieldSeriesID)
+	}
+	if m.FieldCleared(player.FieldResumeToken) {
+		fields = append(fields, player.FieldResumeToken)
+	}
+	if m.FieldCleared(player.FieldTeamID) {
+		fields = append(fields, player.FieldTeamID)
+	}
+	if m.FieldCleared(player.FieldHandicap) {
+		fields = append(fields, player.FieldHandicap)
+	}
+	if m.FieldCleared(player.FieldUserID) {
+		fields = append(fields, player.FieldUserID)
+	}
+	if m.FieldCleared(player.FieldTournamentSeed) {
+		fields = append(fields, player.FieldTournamentSeed)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlayerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlayerMutation) ClearField(name string) error {
+	switch name {
+	case player.FieldSeriesID:
+		m.ClearSeriesID()
+		return nil
+	case player.FieldResumeToken:
+		m.ClearResumeToken()
+		return nil
+	case player.FieldTeamID:
+		m.ClearTeamID()
+		return nil
+	case player.FieldHandicap:
+		m.ClearHandicap()
+		return nil
+	case player.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case player.FieldTournamentSeed:
+		m.ClearTournamentSeed()
+		return nil
+	}
+	return fmt.Errorf("unknown Player nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlayerMutation) ResetField(name string) error {
+	switch name {
+	case player.FieldName:
+		m.ResetName()
+		return nil
+	case player.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case player.FieldScore:
+		m.ResetScore()
+		return nil
+	case player.FieldWrongCount:
+		m.ResetWrongCount()
+		return nil
+	case player.FieldStreak:
+		m.ResetStreak()
+		return nil
+	case player.FieldIsHost:
+		m.ResetIsHost()
+		return nil
+	case player.FieldIsBot:
+		m.ResetIsBot()
+		return nil
+	case player.FieldSeriesWins:
+		m.ResetSeriesWins()
+		return nil
+	case player.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case player.FieldResumeToken:
+		m.ResetResumeToken()
+		return nil
+	case player.FieldTeamID:
+		m.ResetTeamID()
+		return nil
+	case player.FieldHandicap:
+		m.ResetHandicap()
+		return nil
+	case player.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case player.FieldMuted:
+		m.ResetMuted()
+		return nil
+	case player.FieldTournamentSeed:
+		m.ResetTournamentSeed()
+		return nil
+	}
+	return fmt.Errorf("unknown Player field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlayerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.parent != nil {
+		edges = append(edges, player.EdgeParent)
+	}
+	if m.team != nil {
+		edges = append(edges, player.EdgeTeam)
+	}
+	if m.user != nil {
+		edges = append(edges, player.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case player.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgeTeam:
+		if id := m.team; id != nil {
+			return []ent.Value{*id}
+		}
+	case player.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlayerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlayerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedparent {
+		edges = append(edges, player.EdgeParent)
+	}
+	if m.clearedteam {
+		edges = append(edges, player.EdgeTeam)
+	}
+	if m.cleareduser {
+		edges = append(edges, player.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlayerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case player.EdgeParent:
+		return m.clearedparent
+	case player.EdgeTeam:
+		return m.clearedteam
+	case player.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlayerMutation) ClearEdge(name string) error {
+	switch name {
+	case player.EdgeParent:
+		m.ClearParent()
+		return nil
+	case player.EdgeTeam:
+		m.ClearTeam()
+		return nil
+	case player.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Player unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlayerMutation) ResetEdge(name string) error {
+	switch name {
+	case player.EdgeParent:
+		m.ResetParent()
+		return nil
+	case player.EdgeTeam:
+		m.ResetTeam()
+		return nil
+	case player.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Player edge %s", name)
+}
+
+// PracticeRunMutation represents an operation that mutates the PracticeRun nodes in the graph.
+type PracticeRunMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	player_name       *string
+	card_count        *int
+	addcard_count     *int
+	seed              *int64
+	addseed           *int64
+	total_ms          *int64
+	addtotal_ms       *int64
+	reaction_ms       *[]int64
+	appendreaction_ms []int64
+	mistakes          *int
+	addmistakes       *int
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*PracticeRun, error)
+	predicates        []predicate.PracticeRun
+}
+
+var _ ent.Mutation = (*PracticeRunMutation)(nil)
+
+// practicerunOption allows management of the mutation configuration using functional options.
+type practicerunOption func(*PracticeRunMutation)
+
+// newPracticeRunMutation creates new mutation for the PracticeRun entity.
+func newPracticeRunMutation(c config, op Op, opts ...practicerunOption) *PracticeRunMutation {
+	m := &PracticeRunMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePracticeRun,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPracticeRunID sets the ID field of the mutation.
+func withPracticeRunID(id int) practicerunOption {
+	return func(m *PracticeRunMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PracticeRun
+		)
+		m.oldValue = func(ctx context.Context) (*PracticeRun, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PracticeRun.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPracticeRun sets the old PracticeRun of the mutation.
+func withPracticeRun(node *PracticeRun) practicerunOption {
+	return func(m *PracticeRunMutation) {
+		m.oldValue = func(context.Context) (*PracticeRun, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PracticeRunMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PracticeRunMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PracticeRunMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PracticeRunMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PracticeRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPlayerName sets the "player_name" field.
+func (m *PracticeRunMutation) SetPlayerName(s string) {
+	m.player_name = &s
+}
+
+// PlayerName returns the value of the "player_name" field in the mutation.
+func (m *PracticeRunMutation) PlayerName() (r string, exists bool) {
+	v := m.player_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlayerName returns the old "player_name" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldPlayerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlayerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlayerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlayerName: %w", err)
+	}
+	return oldValue.PlayerName, nil
+}
+
+// ResetPlayerName resets all changes to the "player_name" field.
+func (m *PracticeRunMutation) ResetPlayerName() {
+	m.player_name = nil
+}
+
+// SetCardCount sets the "card_count" field.
+func (m *PracticeRunMutation) SetCardCount(i int) {
+	m.card_count = &i
+	m.addcard_count = nil
+}
+
+// CardCount returns the value of the "card_count" field in the mutation.
+func (m *PracticeRunMutation) CardCount() (r int, exists bool) {
+	v := m.card_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardCount returns the old "card_count" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldCardCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardCount: %w", err)
+	}
+	return oldValue.CardCount, nil
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (m *PracticeRunMutation) AddCardCount(i int) {
+	if m.addcard_count != nil {
+		*m.addcard_count += i
+	} else {
+		m.addcard_count = &i
+	}
+}
+
+// AddedCardCount returns the value that was added to the "card_count" field in this mutation.
+func (m *PracticeRunMutation) AddedCardCount() (r int, exists bool) {
+	v := m.addcard_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCardCount resets all changes to the "card_count" field.
+func (m *PracticeRunMutation) ResetCardCount() {
+	m.card_count = nil
+	m.addcard_count = nil
+}
+
+// SetSeed sets the "seed" field.
+func (m *PracticeRunMutation) SetSeed(i int64) {
+	m.seed = &i
+	m.addseed = nil
+}
+
+// Seed returns the value of the "seed" field in the mutation.
+func (m *PracticeRunMutation) Seed() (r int64, exists bool) {
+	v := m.seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeed returns the old "seed" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldSeed(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
+	}
+	return oldValue.Seed, nil
+}
+
+// AddSeed adds i to the "seed" field.
+func (m *PracticeRunMutation) AddSeed(i int64) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
+}
+
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *PracticeRunMutation) AddedSeed() (r int64, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSeed resets all changes to the "seed" field.
+func (m *PracticeRunMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (m *PracticeRunMutation) SetTotalMs(i int64) {
+	m.total_ms = &i
+	m.addtotal_ms = nil
+}
+
+// TotalMs returns the value of the "total_ms" field in the mutation.
+func (m *PracticeRunMutation) TotalMs() (r int64, exists bool) {
+	v := m.total_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalMs returns the old "total_ms" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldTotalMs(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalMs: %w", err)
+	}
+	return oldValue.TotalMs, nil
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (m *PracticeRunMutation) AddTotalMs(i int64) {
+	if m.addtotal_ms != nil {
+		*m.addtotal_ms += i
+	} else {
+		m.addtotal_ms = &i
+	}
+}
+
+// AddedTotalMs returns the value that was added to the "total_ms" field in this mutation.
+func (m *PracticeRunMutation) AddedTotalMs() (r int64, exists bool) {
+	v := m.addtotal_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalMs resets all changes to the "total_ms" field.
+func (m *PracticeRunMutation) ResetTotalMs() {
+	m.total_ms = nil
+	m.addtotal_ms = nil
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (m *PracticeRunMutation) SetReactionMs(i []int64) {
+	m.reaction_ms = &i
+	m.appendreaction_ms = nil
+}
+
+// ReactionMs returns the value of the "reaction_ms" field in the mutation.
+func (m *PracticeRunMutation) ReactionMs() (r []int64, exists bool) {
+	v := m.reaction_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReactionMs returns the old "reaction_ms" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldReactionMs(ctx context.Context) (v []int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReactionMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReactionMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReactionMs: %w", err)
+	}
+	return oldValue.ReactionMs, nil
+}
+
+// AppendReactionMs adds i to the "reaction_ms" field.
+func (m *PracticeRunMutation) AppendReactionMs(i []int64) {
+	m.appendreaction_ms = append(m.appendreaction_ms, i...)
+}
+
+// AppendedReactionMs returns the list of values that were appended to the "reaction_ms" field in this mutation.
+func (m *PracticeRunMutation) AppendedReactionMs() ([]int64, bool) {
+	if len(m.appendreaction_ms) == 0 {
+		return nil, false
+	}
+	return m.appendreaction_ms, true
+}
+
+// ResetReactionMs resets all changes to the "reaction_ms" field.
+func (m *PracticeRunMutation) ResetReactionMs() {
+	m.reaction_ms = nil
+	m.appendreaction_ms = nil
+}
+
+// SetMistakes sets the "mistakes" field.
+func (m *PracticeRunMutation) SetMistakes(i int) {
+	m.mistakes = &i
+	m.addmistakes = nil
+}
+
+// Mistakes returns the value of the "mistakes" field in the mutation.
+func (m *PracticeRunMutation) Mistakes() (r int, exists bool) {
+	v := m.mistakes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMistakes returns the old "mistakes" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldMistakes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMistakes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMistakes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMistakes: %w", err)
+	}
+	return oldValue.Mistakes, nil
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (m *PracticeRunMutation) AddMistakes(i int) {
+	if m.addmistakes != nil {
+		*m.addmistakes += i
+	} else {
+		m.addmistakes = &i
+	}
+}
+
+// AddedMistakes returns the value that was added to the "mistakes" field in this mutation.
+func (m *PracticeRunMutation) AddedMistakes() (r int, exists bool) {
+	v := m.addmistakes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMistakes resets all changes to the "mistakes" field.
+func (m *PracticeRunMutation) ResetMistakes() {
+	m.mistakes = nil
+	m.addmistakes = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PracticeRunMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PracticeRunMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PracticeRun entity.
+// If the PracticeRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PracticeRunMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PracticeRunMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PracticeRunMutation builder.
+func (m *PracticeRunMutation) Where(ps ...predicate.PracticeRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PracticeRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PracticeRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PracticeRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PracticeRunMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PracticeRunMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PracticeRun).
+func (m *PracticeRunMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PracticeRunMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.player_name != nil {
+		fields = append(fields, practicerun.FieldPlayerName)
+	}
+	if m.card_count != nil {
+		fields = append(fields, practicerun.FieldCardCount)
+	}
+	if m.seed != nil {
+		fields = append(fields, practicerun.FieldSeed)
+	}
+	if m.total_ms != nil {
+		fields = append(fields, practicerun.FieldTotalMs)
+	}
+	if m.reaction_ms != nil {
+		fields = append(fields, practicerun.FieldReactionMs)
+	}
+	if m.mistakes != nil {
+		fields = append(fields, practicerun.FieldMistakes)
+	}
+	if m.created_at != nil {
+		fields = append(fields, practicerun.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PracticeRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case practicerun.FieldPlayerName:
+		return m.PlayerName()
+	case practicerun.FieldCardCount:
+		return m.CardCount()
+	case practicerun.FieldSeed:
+		return m.Seed()
+	case practicerun.FieldTotalMs:
+		return m.TotalMs()
+	case practicerun.FieldReactionMs:
+		return m.ReactionMs()
+	case practicerun.FieldMistakes:
+		return m.Mistakes()
+	case practicerun.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PracticeRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case practicerun.FieldPlayerName:
+		return m.OldPlayerName(ctx)
+	case practicerun.FieldCardCount:
+		return m.OldCardCount(ctx)
+	case practicerun.FieldSeed:
+		return m.OldSeed(ctx)
+	case practicerun.FieldTotalMs:
+		return m.OldTotalMs(ctx)
+	case practicerun.FieldReactionMs:
+		return m.OldReactionMs(ctx)
+	case practicerun.FieldMistakes:
+		return m.OldMistakes(ctx)
+	case practicerun.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PracticeRun field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PracticeRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case practicerun.FieldPlayerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlayerName(v)
+		return nil
+	case practicerun.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardCount(v)
+		return nil
+	case practicerun.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeed(v)
+		return nil
+	case practicerun.FieldTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalMs(v)
+		return nil
+	case practicerun.FieldReactionMs:
+		v, ok := value.([]int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReactionMs(v)
+		return nil
+	case practicerun.FieldMistakes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMistakes(v)
+		return nil
+	case practicerun.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PracticeRun field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PracticeRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addcard_count != nil {
+		fields = append(fields, practicerun.FieldCardCount)
+	}
+	if m.addseed != nil {
+		fields = append(fields, practicerun.FieldSeed)
+	}
+	if m.addtotal_ms != nil {
+		fields = append(fields, practicerun.FieldTotalMs)
+	}
+	if m.addmistakes != nil {
+		fields = append(fields, practicerun.FieldMistakes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PracticeRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case practicerun.FieldCardCount:
+		return m.AddedCardCount()
+	case practicerun.FieldSeed:
+		return m.AddedSeed()
+	case practicerun.FieldTotalMs:
+		return m.AddedTotalMs()
+	case practicerun.FieldMistakes:
+		return m.AddedMistakes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PracticeRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case practicerun.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCardCount(v)
+		return nil
+	case practicerun.FieldSeed:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeed(v)
+		return nil
+	case practicerun.FieldTotalMs:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalMs(v)
+		return nil
+	case practicerun.FieldMistakes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMistakes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PracticeRun numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PracticeRunMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PracticeRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PracticeRunMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PracticeRun nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PracticeRunMutation) ResetField(name string) error {
+	switch name {
+	case practicerun.FieldPlayerName:
+		m.ResetPlayerName()
+		return nil
+	case practicerun.FieldCardCount:
+		m.ResetCardCount()
+		return nil
+	case practicerun.FieldSeed:
+		m.ResetSeed()
+		return nil
+	case practicerun.FieldTotalMs:
+		m.ResetTotalMs()
+		return nil
+	case practicerun.FieldReactionMs:
+		m.ResetReactionMs()
+		return nil
+	case practicerun.FieldMistakes:
+		m.ResetMistakes()
+		return nil
+	case practicerun.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PracticeRun field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PracticeRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PracticeRunMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PracticeRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PracticeRunMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PracticeRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PracticeRunMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PracticeRunMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PracticeRun unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PracticeRunMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PracticeRun edge %s", name)
+}
+
+// TeamMutation represents an operation that mutates the Team nodes in the graph.
+type TeamMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	score          *int
+	addscore       *int
+	clearedFields  map[string]struct{}
+	parent         *int
+	clearedparent  bool
+	members        map[int]struct{}
+	removedmembers map[int]struct{}
+	clearedmembers bool
+	done           bool
+	oldValue       func(context.Context) (*Team, error)
+	predicates     []predicate.Team
+}
+
+var _ ent.Mutation = (*TeamMutation)(nil)
+
+// teamOption allows management of the mutation configuration using functional options.
+type teamOption func(*TeamMutation)
+
+// newTeamMutation creates new mutation for the Team entity.
+func newTeamMutation(c config, op Op, opts ...teamOption) *TeamMutation {
+	m := &TeamMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTeam,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTeamID sets the ID field of the mutation.
+func withTeamID(id int) teamOption {
+	return func(m *TeamMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Team
+		)
+		m.oldValue = func(ctx context.Context) (*Team, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Team.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTeam sets the old Team of the mutation.
+func withTeam(node *Team) teamOption {
+	return func(m *TeamMutation) {
+		m.oldValue = func(context.Context) (*Team, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TeamMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TeamMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TeamMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TeamMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Team.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TeamMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TeamMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TeamMutation) ResetName() {
+	m.name = nil
+}
+
+// SetScore sets the "score" field.
+func (m *TeamMutation) SetScore(i int) {
+	m.score = &i
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *TeamMutation) Score() (r int, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the Team entity.
+// If the Team object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TeamMutation) OldScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds i to the "score" field.
+func (m *TeamMutation) AddScore(i int) {
+	if m.addscore != nil {
+		*m.addscore += i
+	} else {
+		m.addscore = &i
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *TeamMutation) AddedScore() (r int, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *TeamMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+}
+
+// SetParentID sets the "parent" edge to the Game entity by id.
+func (m *TeamMutation) SetParentID(id int) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Game entity.
+func (m *TeamMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Game entity was cleared.
+func (m *TeamMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *TeamMutation) ParentID() (id int, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *TeamMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *TeamMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddMemberIDs adds the "members" edge to the Player entity by ids.
+func (m *TeamMutation) AddMemberIDs(ids ...int) {
+	if m.members == nil {
+		m.members = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the Player entity.
+func (m *TeamMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the Player entity was cleared.
+func (m *TeamMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the Player entity by IDs.
+func (m *TeamMutation) RemoveMemberIDs(ids ...int) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the Player entity.
+func (m *TeamMutation) RemovedMembersIDs() (ids []int) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *TeamMutation) MembersIDs() (ids []int) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *TeamMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// Where appends a list predicates to the TeamMutation builder.
+func (m *TeamMutation) Where(ps ...predicate.Team) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TeamMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TeamMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Team, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TeamMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TeamMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Team).
+func (m *TeamMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TeamMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, team.FieldName)
+	}
+	if m.score != nil {
+		fields = append(fields, team.FieldScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TeamMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldName:
+		return m.Name()
+	case team.FieldScore:
+		return m.Score()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TeamMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case team.FieldName:
+		return m.OldName(ctx)
+	case team.FieldScore:
+		return m.OldScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown Team field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case team.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TeamMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, team.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TeamMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case team.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TeamMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case team.FieldScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Team numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TeamMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TeamMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TeamMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Team nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TeamMutation) ResetField(name string) error {
+	switch name {
+	case team.FieldName:
+		m.ResetName()
+		return nil
+	case team.FieldScore:
+		m.ResetScore()
+		return nil
+	}
+	return fmt.Errorf("unknown Team field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TeamMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.parent != nil {
+		edges = append(edges, team.EdgeParent)
+	}
+	if m.members != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TeamMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TeamMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmembers != nil {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TeamMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case team.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TeamMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedparent {
+		edges = append(edges, team.EdgeParent)
+	}
+	if m.clearedmembers {
+		edges = append(edges, team.EdgeMembers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TeamMutation) EdgeCleared(name string) bool {
+	switch name {
+	case team.EdgeParent:
+		return m.clearedparent
+	case team.EdgeMembers:
+		return m.clearedmembers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TeamMutation) ClearEdge(name string) error {
+	switch name {
+	case team.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Team unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TeamMutation) ResetEdge(name string) error {
+	switch name {
+	case team.EdgeParent:
+		m.ResetParent()
+		return nil
+	case team.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	}
+	return fmt.Errorf("unknown Team edge %s", name)
+}
+
+// TournamentMutation represents an operation that mutates the Tournament nodes in the graph.
+type TournamentMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	format           *tournament.Format
+	status           *tournament.Status
+	mode             *string
+	card_count       *int
+	addcard_count    *int
+	organizer_token  *string
+	champion_seed    *int
+	addchampion_seed *int
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	entrants         map[int]struct{}
+	removedentrants  map[int]struct{}
+	clearedentrants  bool
+	matches          map[int]struct{}
+	removedmatches   map[int]struct{}
+	clearedmatches   bool
+	done             bool
+	oldValue         func(context.Context) (*Tournament, error)
+	predicates       []predicate.Tournament
+}
+
+var _ ent.Mutation = (*TournamentMutation)(nil)
+
+// tournamentOption allows management of the mutation configuration using functional options.
+type tournamentOption func(*TournamentMutation)
+
+// newTournamentMutation creates new mutation for the Tournament entity.
+func newTournamentMutation(c config, op Op, opts ...tournamentOption) *TournamentMutation {
+	m := &TournamentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTournament,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTournamentID sets the ID field of the mutation.
+func withTournamentID(id int) tournamentOption {
+	return func(m *TournamentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tournament
+		)
+		m.oldValue = func(ctx context.Context) (*Tournament, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tournament.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTournament sets the old Tournament of the mutation.
+func withTournament(node *Tournament) tournamentOption {
+	return func(m *TournamentMutation) {
+		m.oldValue = func(context.Context) (*Tournament, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TournamentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TournamentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TournamentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TournamentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tournament.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TournamentMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TournamentMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TournamentMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFormat sets the "format" field.
+func (m *TournamentMutation) SetFormat(t tournament.Format) {
+	m.format = &t
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *TournamentMutation) Format() (r tournament.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldFormat(ctx context.Context) (v tournament.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *TournamentMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TournamentMutation) SetStatus(t tournament.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TournamentMutation) Status() (r tournament.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldStatus(ctx context.Context) (v tournament.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TournamentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *TournamentMutation) SetMode(s string) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *TournamentMutation) Mode() (r string, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *TournamentMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetCardCount sets the "card_count" field.
+func (m *TournamentMutation) SetCardCount(i int) {
+	m.card_count = &i
+	m.addcard_count = nil
+}
+
+// CardCount returns the value of the "card_count" field in the mutation.
+func (m *TournamentMutation) CardCount() (r int, exists bool) {
+	v := m.card_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardCount returns the old "card_count" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldCardCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardCount: %w", err)
+	}
+	return oldValue.CardCount, nil
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (m *TournamentMutation) AddCardCount(i int) {
+	if m.addcard_count != nil {
+		*m.addcard_count += i
+	} else {
+		m.addcard_count = &i
+	}
+}
+
+// AddedCardCount returns the value that was added to the "card_count" field in this mutation.
+func (m *TournamentMutation) AddedCardCount() (r int, exists bool) {
+	v := m.addcard_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCardCount resets all changes to the "card_count" field.
+func (m *TournamentMutation) ResetCardCount() {
+	m.card_count = nil
+	m.addcard_count = nil
+}
+
+// SetOrganizerToken sets the "organizer_token" field.
+func (m *TournamentMutation) SetOrganizerToken(s string) {
+	m.organizer_token = &s
+}
+
+// OrganizerToken returns the value of the "organizer_token" field in the mutation.
+func (m *TournamentMutation) OrganizerToken() (r string, exists bool) {
+	v := m.organizer_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizerToken returns the old "organizer_token" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldOrganizerToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizerToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizerToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizerToken: %w", err)
+	}
+	return oldValue.OrganizerToken, nil
+}
+
+// ResetOrganizerToken resets all changes to the "organizer_token" field.
+func (m *TournamentMutation) ResetOrganizerToken() {
+	m.organizer_token = nil
+}
+
+// SetChampionSeed sets the "champion_seed" field.
+func (m *TournamentMutation) SetChampionSeed(i int) {
+	m.champion_seed = &i
+	m.addchampion_seed = nil
+}
+
+// ChampionSeed returns the value of the "champion_seed" field in the mutation.
+func (m *TournamentMutation) ChampionSeed() (r int, exists bool) {
+	v := m.champion_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChampionSeed returns the old "champion_seed" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldChampionSeed(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChampionSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChampionSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChampionSeed: %w", err)
+	}
+	return oldValue.ChampionSeed, nil
+}
+
+// AddChampionSeed adds i to the "champion_seed" field.
+func (m *TournamentMutation) AddChampionSeed(i int) {
+	if m.addchampion_seed != nil {
+		*m.addchampion_seed += i
+	} else {
+		m.addchampion_seed = &i
+	}
+}
+
+// AddedChampionSeed returns the value that was added to the "champion_seed" field in this mutation.
+func (m *TournamentMutation) AddedChampionSeed() (r int, exists bool) {
+	v := m.addchampion_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearChampionSeed clears the value of the "champion_seed" field.
+func (m *TournamentMutation) ClearChampionSeed() {
+	m.champion_seed = nil
+	m.addchampion_seed = nil
+	m.clearedFields[tournament.FieldChampionSeed] = struct{}{}
+}
+
+// ChampionSeedCleared returns if the "champion_seed" field was cleared in this mutation.
+func (m *TournamentMutation) ChampionSeedCleared() bool {
+	_, ok := m.clearedFields[tournament.FieldChampionSeed]
+	return ok
+}
+
+// ResetChampionSeed resets all changes to the "champion_seed" field.
+func (m *TournamentMutation) ResetChampionSeed() {
+	m.champion_seed = nil
+	m.addchampion_seed = nil
+	delete(m.clearedFields, tournament.FieldChampionSeed)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TournamentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TournamentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tournament entity.
+// If the Tournament object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TournamentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddEntrantIDs adds the "entrants" edge to the TournamentEntrant entity by ids.
+func (m *TournamentMutation) AddEntrantIDs(ids ...int) {
+	if m.entrants == nil {
+		m.entrants = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.entrants[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEntrants clears the "entrants" edge to the TournamentEntrant entity.
+func (m *TournamentMutation) ClearEntrants() {
+	m.clearedentrants = true
+}
+
+// EntrantsCleared reports if the "entrants" edge to the TournamentEntrant entity was cleared.
+func (m *TournamentMutation) EntrantsCleared() bool {
+	return m.clearedentrants
+}
+
+// RemoveEntrantIDs removes the "entrants" edge to the TournamentEntrant entity by IDs.
+func (m *TournamentMutation) RemoveEntrantIDs(ids ...int) {
+	if m.removedentrants == nil {
+		m.removedentrants = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.entrants, ids[i])
+		m.removedentrants[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEntrants returns the removed IDs of the "entrants" edge to the TournamentEntrant entity.
+func (m *TournamentMutation) RemovedEntrantsIDs() (ids []int) {
+	for id := range m.removedentrants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EntrantsIDs returns the "entrants" edge IDs in the mutation.
+func (m *TournamentMutation) EntrantsIDs() (ids []int) {
+	for id := range m.entrants {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEntrants resets all changes to the "entrants" edge.
+func (m *TournamentMutation) ResetEntrants() {
+	m.entrants = nil
+	m.clearedentrants = false
+	m.removedentrants = nil
+}
+
+// AddMatchIDs adds the "matches" edge to the TournamentMatch entity by ids.
+func (m *TournamentMutation) AddMatchIDs(ids ...int) {
+	if m.matches == nil {
+		m.matches = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.matches[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMatches clears the "matches" edge to the TournamentMatch entity.
+func (m *TournamentMutation) ClearMatches() {
+	m.clearedmatches = true
+}
+
+// MatchesCleared reports if the "matches" edge to the TournamentMatch entity was cleared.
+func (m *TournamentMutation) MatchesCleared() bool {
+	return m.clearedmatches
+}
+
+// RemoveMatchIDs removes the "matches" edge to the TournamentMatch entity by IDs.
+func (m *TournamentMutation) RemoveMatchIDs(ids ...int) {
+	if m.removedmatches == nil {
+		m.removedmatches = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.matches, ids[i])
+		m.removedmatches[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMatches returns the removed IDs of the "matches" edge to the TournamentMatch entity.
+func (m *TournamentMutation) RemovedMatchesIDs() (ids []int) {
+	for id := range m.removedmatches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MatchesIDs returns the "matches" edge IDs in the mutation.
+func (m *TournamentMutation) MatchesIDs() (ids []int) {
+	for id := range m.matches {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMatches resets all changes to the "matches" edge.
+func (m *TournamentMutation) ResetMatches() {
+	m.matches = nil
+	m.clearedmatches = false
+	m.removedmatches = nil
+}
+
+// Where appends a list predicates to the TournamentMutation builder.
+func (m *TournamentMutation) Where(ps ...predicate.Tournament) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TournamentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TournamentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tournament, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TournamentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TournamentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tournament).
+func (m *TournamentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TournamentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, tournament.FieldName)
+	}
+	if m.format != nil {
+		fields = append(fields, tournament.FieldFormat)
+	}
+	if m.status != nil {
+		fields = append(fields, tournament.FieldStatus)
+	}
+	if m.mode != nil {
+		fields = append(fields, tournament.FieldMode)
+	}
+	if m.card_count != nil {
+		fields = append(fields, tournament.FieldCardCount)
+	}
+	if m.organizer_token != nil {
+		fields = append(fields, tournament.FieldOrganizerToken)
+	}
+	if m.champion_seed != nil {
+		fields = append(fields, tournament.FieldChampionSeed)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tournament.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TournamentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tournament.FieldName:
+		return m.Name()
+	case tournament.FieldFormat:
+		return m.Format()
+	case tournament.FieldStatus:
+		return m.Status()
+	case tournament.FieldMode:
+		return m.Mode()
+	case tournament.FieldCardCount:
+		return m.CardCount()
+	case tournament.FieldOrganizerToken:
+		return m.OrganizerToken()
+	case tournament.FieldChampionSeed:
+		return m.ChampionSeed()
+	case tournament.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TournamentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tournament.FieldName:
+		return m.OldName(ctx)
+	case tournament.FieldFormat:
+		return m.OldFormat(ctx)
+	case tournament.FieldStatus:
+		return m.OldStatus(ctx)
+	case tournament.FieldMode:
+		return m.OldMode(ctx)
+	case tournament.FieldCardCount:
+		return m.OldCardCount(ctx)
+	case tournament.FieldOrganizerToken:
+		return m.OldOrganizerToken(ctx)
+	case tournament.FieldChampionSeed:
+		return m.OldChampionSeed(ctx)
+	case tournament.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tournament.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tournament.FieldFormat:
+		v, ok := value.(tournament.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case tournament.FieldStatus:
+		v, ok := value.(tournament.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tournament.FieldMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case tournament.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardCount(v)
+		return nil
+	case tournament.FieldOrganizerToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizerToken(v)
+		return nil
+	case tournament.FieldChampionSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChampionSeed(v)
+		return nil
+	case tournament.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TournamentMutation) AddedFields() []string {
+	var fields []string
+	if m.addcard_count != nil {
+		fields = append(fields, tournament.FieldCardCount)
+	}
+	if m.addchampion_seed != nil {
+		fields = append(fields, tournament.FieldChampionSeed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TournamentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tournament.FieldCardCount:
+		return m.AddedCardCount()
+	case tournament.FieldChampionSeed:
+		return m.AddedChampionSeed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TournamentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tournament.FieldCardCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCardCount(v)
+		return nil
+	case tournament.FieldChampionSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChampionSeed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TournamentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tournament.FieldChampionSeed) {
+		fields = append(fields, tournament.FieldChampionSeed)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TournamentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TournamentMutation) ClearField(name string) error {
+	switch name {
+	case tournament.FieldChampionSeed:
+		m.ClearChampionSeed()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TournamentMutation) ResetField(name string) error {
+	switch name {
+	case tournament.FieldName:
+		m.ResetName()
+		return nil
+	case tournament.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case tournament.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tournament.FieldMode:
+		m.ResetMode()
+		return nil
+	case tournament.FieldCardCount:
+		m.ResetCardCount()
+		return nil
+	case tournament.FieldOrganizerToken:
+		m.ResetOrganizerToken()
+		return nil
+	case tournament.FieldChampionSeed:
+		m.ResetChampionSeed()
+		return nil
+	case tournament.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TournamentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.entrants != nil {
+		edges = append(edges, tournament.EdgeEntrants)
+	}
+	if m.matches != nil {
+		edges = append(edges, tournament.EdgeMatches)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TournamentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case card.EdgeItems:
-		m.ResetItems()
-		return nil
+	case tournament.EdgeEntrants:
+		ids := make([]ent.Value, 0, len(m.entrants))
+		for id := range m.entrants {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeMatches:
+		ids := make([]ent.Value, 0, len(m.matches))
+		for id := range m.matches {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return fmt.Errorf("unknown Card edge %s", name)
+	return nil
 }
 
-// GameMutation represents an operation that mutates the Game nodes in the graph.
-type GameMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	name            *string
-	status          *game.Status
-	total_rounds    *int
-	addtotal_rounds *int
-	clearedFields   map[string]struct{}
-	players         map[int]struct{}
-	removedplayers  map[int]struct{}
-	clearedplayers  bool
-	done            bool
-	oldValue        func(context.Context) (*Game, error)
-	predicates      []predicate.Game
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TournamentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedentrants != nil {
+		edges = append(edges, tournament.EdgeEntrants)
+	}
+	if m.removedmatches != nil {
+		edges = append(edges, tournament.EdgeMatches)
+	}
+	return edges
 }
 
-var _ ent.Mutation = (*GameMutation)(nil)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TournamentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tournament.EdgeEntrants:
+		ids := make([]ent.Value, 0, len(m.removedentrants))
+		for id := range m.removedentrants {
+			ids = append(ids, id)
+		}
+		return ids
+	case tournament.EdgeMatches:
+		ids := make([]ent.Value, 0, len(m.removedmatches))
+		for id := range m.removedmatches {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
 
-// gameOption allows management of the mutation configuration using functional options.
-type gameOption func(*GameMutation)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TournamentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedentrants {
+		edges = append(edges, tournament.EdgeEntrants)
+	}
+	if m.clearedmatches {
+		edges = append(edges, tournament.EdgeMatches)
+	}
+	return edges
+}
 
-// newGameMutation creates new mutation for the Game entity.
-func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
-	m := &GameMutation{
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TournamentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tournament.EdgeEntrants:
+		return m.clearedentrants
+	case tournament.EdgeMatches:
+		return m.clearedmatches
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TournamentMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tournament unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TournamentMutation) ResetEdge(name string) error {
+	switch name {
+	case tournament.EdgeEntrants:
+		m.ResetEntrants()
+		return nil
+	case tournament.EdgeMatches:
+		m.ResetMatches()
+		return nil
+	}
+	return fmt.Errorf("unknown Tournament edge %s", name)
+}
+
+// TournamentEntrantMutation represents an operation that mutates the TournamentEntrant nodes in the graph.
+type TournamentEntrantMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	seed              *int
+	addseed           *int
+	entrant_token     *string
+	clearedFields     map[string]struct{}
+	tournament        *int
+	clearedtournament bool
+	done              bool
+	oldValue          func(context.Context) (*TournamentEntrant, error)
+	predicates        []predicate.TournamentEntrant
+}
+
+var _ ent.Mutation = (*TournamentEntrantMutation)(nil)
+
+// tournamententrantOption allows management of the mutation configuration using functional options.
+type tournamententrantOption func(*TournamentEntrantMutation)
+
+// newTournamentEntrantMutation creates new mutation for the TournamentEntrant entity.
+func newTournamentEntrantMutation(c config, op Op, opts ...tournamententrantOption) *TournamentEntrantMutation {
+	m := &TournamentEntrantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeGame,
+		typ:           TypeTournamentEntrant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -427,20 +9199,20 @@ func newGameMutation(c config, op Op, opts ...gameOption) *GameMutation {
 	return m
 }
 
-// withGameID sets the ID field of the mutation.
-func withGameID(id int) gameOption {
-	return func(m *GameMutation) {
+// withTournamentEntrantID sets the ID field of the mutation.
+func withTournamentEntrantID(id int) tournamententrantOption {
+	return func(m *TournamentEntrantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Game
+			value *TournamentEntrant
 		)
-		m.oldValue = func(ctx context.Context) (*Game, error) {
+		m.oldValue = func(ctx context.Context) (*TournamentEntrant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Game.Get(ctx, id)
+					value, err = m.Client().TournamentEntrant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -449,10 +9221,10 @@ func withGameID(id int) gameOption {
 	}
 }
 
-// withGame sets the old Game of the mutation.
-func withGame(node *Game) gameOption {
-	return func(m *GameMutation) {
-		m.oldValue = func(context.Context) (*Game, error) {
+// withTournamentEntrant sets the old TournamentEntrant of the mutation.
+func withTournamentEntrant(node *TournamentEntrant) tournamententrantOption {
+	return func(m *TournamentEntrantMutation) {
+		m.oldValue = func(context.Context) (*TournamentEntrant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -461,7 +9233,7 @@ func withGame(node *Game) gameOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m GameMutation) Client() *Client {
+func (m TournamentEntrantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -469,7 +9241,7 @@ func (m GameMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m GameMutation) Tx() (*Tx, error) {
+func (m TournamentEntrantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -480,7 +9252,7 @@ func (m GameMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *GameMutation) ID() (id int, exists bool) {
+func (m *TournamentEntrantMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -491,7 +9263,7 @@ func (m *GameMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *GameMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TournamentEntrantMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -500,19 +9272,19 @@ func (m *GameMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Game.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TournamentEntrant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *GameMutation) SetName(s string) {
+func (m *TournamentEntrantMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *GameMutation) Name() (r string, exists bool) {
+func (m *TournamentEntrantMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -520,10 +9292,10 @@ func (m *GameMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the TournamentEntrant entity.
+// If the TournamentEntrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TournamentEntrantMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -538,165 +9310,163 @@ func (m *GameMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *GameMutation) ResetName() {
+func (m *TournamentEntrantMutation) ResetName() {
 	m.name = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *GameMutation) SetStatus(ga game.Status) {
-	m.status = &ga
+// SetSeed sets the "seed" field.
+func (m *TournamentEntrantMutation) SetSeed(i int) {
+	m.seed = &i
+	m.addseed = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *GameMutation) Status() (r game.Status, exists bool) {
-	v := m.status
+// Seed returns the value of the "seed" field in the mutation.
+func (m *TournamentEntrantMutation) Seed() (r int, exists bool) {
+	v := m.seed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldSeed returns the old "seed" field's value of the TournamentEntrant entity.
+// If the TournamentEntrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldStatus(ctx context.Context) (v game.Status, err error) {
+func (m *TournamentEntrantMutation) OldSeed(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldSeed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldSeed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldSeed: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Seed, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *GameMutation) ResetStatus() {
-	m.status = nil
+// AddSeed adds i to the "seed" field.
+func (m *TournamentEntrantMutation) AddSeed(i int) {
+	if m.addseed != nil {
+		*m.addseed += i
+	} else {
+		m.addseed = &i
+	}
 }
 
-// SetTotalRounds sets the "total_rounds" field.
-func (m *GameMutation) SetTotalRounds(i int) {
-	m.total_rounds = &i
-	m.addtotal_rounds = nil
+// AddedSeed returns the value that was added to the "seed" field in this mutation.
+func (m *TournamentEntrantMutation) AddedSeed() (r int, exists bool) {
+	v := m.addseed
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// TotalRounds returns the value of the "total_rounds" field in the mutation.
-func (m *GameMutation) TotalRounds() (r int, exists bool) {
-	v := m.total_rounds
+// ResetSeed resets all changes to the "seed" field.
+func (m *TournamentEntrantMutation) ResetSeed() {
+	m.seed = nil
+	m.addseed = nil
+}
+
+// SetEntrantToken sets the "entrant_token" field.
+func (m *TournamentEntrantMutation) SetEntrantToken(s string) {
+	m.entrant_token = &s
+}
+
+// EntrantToken returns the value of the "entrant_token" field in the mutation.
+func (m *TournamentEntrantMutation) EntrantToken() (r string, exists bool) {
+	v := m.entrant_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalRounds returns the old "total_rounds" field's value of the Game entity.
-// If the Game object wasn't provided to the builder, the object is fetched from the database.
+// OldEntrantToken returns the old "entrant_token" field's value of the TournamentEntrant entity.
+// If the TournamentEntrant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *GameMutation) OldTotalRounds(ctx context.Context) (v int, err error) {
+func (m *TournamentEntrantMutation) OldEntrantToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalRounds is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntrantToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalRounds requires an ID field in the mutation")
+		return v, errors.New("OldEntrantToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalRounds: %w", err)
-	}
-	return oldValue.TotalRounds, nil
-}
-
-// AddTotalRounds adds i to the "total_rounds" field.
-func (m *GameMutation) AddTotalRounds(i int) {
-	if m.addtotal_rounds != nil {
-		*m.addtotal_rounds += i
-	} else {
-		m.addtotal_rounds = &i
+		return v, fmt.Errorf("querying old value for OldEntrantToken: %w", err)
 	}
+	return oldValue.EntrantToken, nil
 }
 
-// AddedTotalRounds returns the value that was added to the "total_rounds" field in this mutation.
-func (m *GameMutation) AddedTotalRounds() (r int, exists bool) {
-	v := m.addtotal_rounds
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEntrantToken clears the value of the "entrant_token" field.
+func (m *TournamentEntrantMutation) ClearEntrantToken() {
+	m.entrant_token = nil
+	m.clearedFields[tournamententrant.FieldEntrantToken] = struct{}{}
 }
 
-// ResetTotalRounds resets all changes to the "total_rounds" field.
-func (m *GameMutation) ResetTotalRounds() {
-	m.total_rounds = nil
-	m.addtotal_rounds = nil
+// EntrantTokenCleared returns if the "entrant_token" field was cleared in this mutation.
+func (m *TournamentEntrantMutation) EntrantTokenCleared() bool {
+	_, ok := m.clearedFields[tournamententrant.FieldEntrantToken]
+	return ok
 }
 
-// AddPlayerIDs adds the "players" edge to the Player entity by ids.
-func (m *GameMutation) AddPlayerIDs(ids ...int) {
-	if m.players == nil {
-		m.players = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.players[ids[i]] = struct{}{}
-	}
+// ResetEntrantToken resets all changes to the "entrant_token" field.
+func (m *TournamentEntrantMutation) ResetEntrantToken() {
+	m.entrant_token = nil
+	delete(m.clearedFields, tournamententrant.FieldEntrantToken)
 }
 
-// ClearPlayers clears the "players" edge to the Player entity.
-func (m *GameMutation) ClearPlayers() {
-	m.clearedplayers = true
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *TournamentEntrantMutation) SetTournamentID(id int) {
+	m.tournament = &id
 }
 
-// PlayersCleared reports if the "players" edge to the Player entity was cleared.
-func (m *GameMutation) PlayersCleared() bool {
-	return m.clearedplayers
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *TournamentEntrantMutation) ClearTournament() {
+	m.clearedtournament = true
 }
 
-// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
-func (m *GameMutation) RemovePlayerIDs(ids ...int) {
-	if m.removedplayers == nil {
-		m.removedplayers = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.players, ids[i])
-		m.removedplayers[ids[i]] = struct{}{}
-	}
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *TournamentEntrantMutation) TournamentCleared() bool {
+	return m.clearedtournament
 }
 
-// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
-func (m *GameMutation) RemovedPlayersIDs() (ids []int) {
-	for id := range m.removedplayers {
-		ids = append(ids, id)
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *TournamentEntrantMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
 	}
 	return
 }
 
-// PlayersIDs returns the "players" edge IDs in the mutation.
-func (m *GameMutation) PlayersIDs() (ids []int) {
-	for id := range m.players {
-		ids = append(ids, id)
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *TournamentEntrantMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetPlayers resets all changes to the "players" edge.
-func (m *GameMutation) ResetPlayers() {
-	m.players = nil
-	m.clearedplayers = false
-	m.removedplayers = nil
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *TournamentEntrantMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
 }
 
-// Where appends a list predicates to the GameMutation builder.
-func (m *GameMutation) Where(ps ...predicate.Game) {
+// Where appends a list predicates to the TournamentEntrantMutation builder.
+func (m *TournamentEntrantMutation) Where(ps ...predicate.TournamentEntrant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the GameMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TournamentEntrantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *GameMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Game, len(ps))
+func (m *TournamentEntrantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TournamentEntrant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -704,33 +9474,33 @@ func (m *GameMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *GameMutation) Op() Op {
+func (m *TournamentEntrantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *GameMutation) SetOp(op Op) {
+func (m *TournamentEntrantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Game).
-func (m *GameMutation) Type() string {
+// Type returns the node type of this mutation (TournamentEntrant).
+func (m *TournamentEntrantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *GameMutation) Fields() []string {
+func (m *TournamentEntrantMutation) Fields() []string {
 	fields := make([]string, 0, 3)
 	if m.name != nil {
-		fields = append(fields, game.FieldName)
+		fields = append(fields, tournamententrant.FieldName)
 	}
-	if m.status != nil {
-		fields = append(fields, game.FieldStatus)
+	if m.seed != nil {
+		fields = append(fields, tournamententrant.FieldSeed)
 	}
-	if m.total_rounds != nil {
-		fields = append(fields, game.FieldTotalRounds)
+	if m.entrant_token != nil {
+		fields = append(fields, tournamententrant.FieldEntrantToken)
 	}
 	return fields
 }
@@ -738,14 +9508,14 @@ func (m *GameMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *GameMutation) Field(name string) (ent.Value, bool) {
+func (m *TournamentEntrantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case game.FieldName:
+	case tournamententrant.FieldName:
 		return m.Name()
-	case game.FieldStatus:
-		return m.Status()
-	case game.FieldTotalRounds:
-		return m.TotalRounds()
+	case tournamententrant.FieldSeed:
+		return m.Seed()
+	case tournamententrant.FieldEntrantToken:
+		return m.EntrantToken()
 	}
 	return nil, false
 }
@@ -753,54 +9523,54 @@ func (m *GameMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *GameMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TournamentEntrantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case game.FieldName:
+	case tournamententrant.FieldName:
 		return m.OldName(ctx)
-	case game.FieldStatus:
-		return m.OldStatus(ctx)
-	case game.FieldTotalRounds:
-		return m.OldTotalRounds(ctx)
+	case tournamententrant.FieldSeed:
+		return m.OldSeed(ctx)
+	case tournamententrant.FieldEntrantToken:
+		return m.OldEntrantToken(ctx)
 	}
-	return nil, fmt.Errorf("unknown Game field %s", name)
+	return nil, fmt.Errorf("unknown TournamentEntrant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) SetField(name string, value ent.Value) error {
+func (m *TournamentEntrantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case game.FieldName:
+	case tournamententrant.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case game.FieldStatus:
-		v, ok := value.(game.Status)
+	case tournamententrant.FieldSeed:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetSeed(v)
 		return nil
-	case game.FieldTotalRounds:
-		v, ok := value.(int)
+	case tournamententrant.FieldEntrantToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalRounds(v)
+		m.SetEntrantToken(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown TournamentEntrant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *GameMutation) AddedFields() []string {
+func (m *TournamentEntrantMutation) AddedFields() []string {
 	var fields []string
-	if m.addtotal_rounds != nil {
-		fields = append(fields, game.FieldTotalRounds)
+	if m.addseed != nil {
+		fields = append(fields, tournamententrant.FieldSeed)
 	}
 	return fields
 }
@@ -808,10 +9578,10 @@ func (m *GameMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TournamentEntrantMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case game.FieldTotalRounds:
-		return m.AddedTotalRounds()
+	case tournamententrant.FieldSeed:
+		return m.AddedSeed()
 	}
 	return nil, false
 }
@@ -819,166 +9589,173 @@ func (m *GameMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *GameMutation) AddField(name string, value ent.Value) error {
+func (m *TournamentEntrantMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case game.FieldTotalRounds:
+	case tournamententrant.FieldSeed:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalRounds(v)
+		m.AddSeed(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Game numeric field %s", name)
+	return fmt.Errorf("unknown TournamentEntrant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *GameMutation) ClearedFields() []string {
-	return nil
+func (m *TournamentEntrantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tournamententrant.FieldEntrantToken) {
+		fields = append(fields, tournamententrant.FieldEntrantToken)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *GameMutation) FieldCleared(name string) bool {
+func (m *TournamentEntrantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *GameMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Game nullable field %s", name)
+func (m *TournamentEntrantMutation) ClearField(name string) error {
+	switch name {
+	case tournamententrant.FieldEntrantToken:
+		m.ClearEntrantToken()
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentEntrant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *GameMutation) ResetField(name string) error {
+func (m *TournamentEntrantMutation) ResetField(name string) error {
 	switch name {
-	case game.FieldName:
+	case tournamententrant.FieldName:
 		m.ResetName()
 		return nil
-	case game.FieldStatus:
-		m.ResetStatus()
+	case tournamententrant.FieldSeed:
+		m.ResetSeed()
 		return nil
-	case game.FieldTotalRounds:
-		m.ResetTotalRounds()
+	case tournamententrant.FieldEntrantToken:
+		m.ResetEntrantToken()
 		return nil
 	}
-	return fmt.Errorf("unknown Game field %s", name)
+	return fmt.Errorf("unknown TournamentEntrant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *GameMutation) AddedEdges() []string {
+func (m *TournamentEntrantMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.players != nil {
-		edges = append(edges, game.EdgePlayers)
+	if m.tournament != nil {
+		edges = append(edges, tournamententrant.EdgeTournament)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *GameMutation) AddedIDs(name string) []ent.Value {
+func (m *TournamentEntrantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.players))
-		for id := range m.players {
-			ids = append(ids, id)
+	case tournamententrant.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *GameMutation) RemovedEdges() []string {
+func (m *TournamentEntrantMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedplayers != nil {
-		edges = append(edges, game.EdgePlayers)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *GameMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case game.EdgePlayers:
-		ids := make([]ent.Value, 0, len(m.removedplayers))
-		for id := range m.removedplayers {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TournamentEntrantMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *GameMutation) ClearedEdges() []string {
+func (m *TournamentEntrantMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedplayers {
-		edges = append(edges, game.EdgePlayers)
+	if m.clearedtournament {
+		edges = append(edges, tournamententrant.EdgeTournament)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *GameMutation) EdgeCleared(name string) bool {
+func (m *TournamentEntrantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case game.EdgePlayers:
-		return m.clearedplayers
+	case tournamententrant.EdgeTournament:
+		return m.clearedtournament
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *GameMutation) ClearEdge(name string) error {
+func (m *TournamentEntrantMutation) ClearEdge(name string) error {
 	switch name {
+	case tournamententrant.EdgeTournament:
+		m.ClearTournament()
+		return nil
 	}
-	return fmt.Errorf("unknown Game unique edge %s", name)
+	return fmt.Errorf("unknown TournamentEntrant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *GameMutation) ResetEdge(name string) error {
+func (m *TournamentEntrantMutation) ResetEdge(name string) error {
 	switch name {
-	case game.EdgePlayers:
-		m.ResetPlayers()
+	case tournamententrant.EdgeTournament:
+		m.ResetTournament()
 		return nil
 	}
-	return fmt.Errorf("unknown Game edge %s", name)
+	return fmt.Errorf("unknown TournamentEntrant edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// TournamentMatchMutation represents an operation that mutates the TournamentMatch nodes in the graph.
+type TournamentMatchMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	symbol        *string
-	clearedFields map[string]struct{}
-	parent        map[int]struct{}
-	removedparent map[int]struct{}
-	clearedparent bool
-	done          bool
-	oldValue      func(context.Context) (*Item, error)
-	predicates    []predicate.Item
-}
-
-var _ ent.Mutation = (*ItemMutation)(nil)
-
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
-
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+	op                Op
+	typ               string
+	id                *int
+	number            *int
+	addnumber         *int
+	side              *tournamentmatch.Side
+	round             *int
+	addround          *int
+	status            *tournamentmatch.Status
+	winner_seed       *int
+	addwinner_seed    *int
+	clearedFields     map[string]struct{}
+	tournament        *int
+	clearedtournament bool
+	game              *int
+	clearedgame       bool
+	done              bool
+	oldValue          func(context.Context) (*TournamentMatch, error)
+	predicates        []predicate.TournamentMatch
+}
+
+var _ ent.Mutation = (*TournamentMatchMutation)(nil)
+
+// tournamentmatchOption allows management of the mutation configuration using functional options.
+type tournamentmatchOption func(*TournamentMatchMutation)
+
+// newTournamentMatchMutation creates new mutation for the TournamentMatch entity.
+func newTournamentMatchMutation(c config, op Op, opts ...tournamentmatchOption) *TournamentMatchMutation {
+	m := &TournamentMatchMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeTournamentMatch,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -987,20 +9764,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id int) itemOption {
-	return func(m *ItemMutation) {
+// withTournamentMatchID sets the ID field of the mutation.
+func withTournamentMatchID(id int) tournamentmatchOption {
+	return func(m *TournamentMatchMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *TournamentMatch
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*TournamentMatch, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().TournamentMatch.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1009,10 +9786,10 @@ func withItemID(id int) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withTournamentMatch sets the old TournamentMatch of the mutation.
+func withTournamentMatch(node *TournamentMatch) tournamentmatchOption {
+	return func(m *TournamentMatchMutation) {
+		m.oldValue = func(context.Context) (*TournamentMatch, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1021,7 +9798,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m TournamentMatchMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1029,7 +9806,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m TournamentMatchMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1040,7 +9817,7 @@ func (m ItemMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id int, exists bool) {
+func (m *TournamentMatchMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1051,7 +9828,7 @@ func (m *ItemMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TournamentMatchMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1060,111 +9837,390 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TournamentMatch.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *ItemMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetNumber sets the "number" field.
+func (m *TournamentMatchMutation) SetNumber(i int) {
+	m.number = &i
+	m.addnumber = nil
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *ItemMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// Number returns the value of the "number" field in the mutation.
+func (m *TournamentMatchMutation) Number() (r int, exists bool) {
+	v := m.number
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldNumber returns the old "number" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *TournamentMatchMutation) OldNumber(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldNumber is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldNumber requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldNumber: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.Number, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *ItemMutation) ResetSymbol() {
-	m.symbol = nil
+// AddNumber adds i to the "number" field.
+func (m *TournamentMatchMutation) AddNumber(i int) {
+	if m.addnumber != nil {
+		*m.addnumber += i
+	} else {
+		m.addnumber = &i
+	}
 }
 
-// AddParentIDs adds the "parent" edge to the Card entity by ids.
-func (m *ItemMutation) AddParentIDs(ids ...int) {
-	if m.parent == nil {
-		m.parent = make(map[int]struct{})
+// AddedNumber returns the value that was added to the "number" field in this mutation.
+func (m *TournamentMatchMutation) AddedNumber() (r int, exists bool) {
+	v := m.addnumber
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.parent[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// ResetNumber resets all changes to the "number" field.
+func (m *TournamentMatchMutation) ResetNumber() {
+	m.number = nil
+	m.addnumber = nil
+}
+
+// SetSide sets the "side" field.
+func (m *TournamentMatchMutation) SetSide(t tournamentmatch.Side) {
+	m.side = &t
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *TournamentMatchMutation) Side() (r tournamentmatch.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMatchMutation) OldSide(ctx context.Context) (v tournamentmatch.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *TournamentMatchMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetRound sets the "round" field.
+func (m *TournamentMatchMutation) SetRound(i int) {
+	m.round = &i
+	m.addround = nil
+}
+
+// Round returns the value of the "round" field in the mutation.
+func (m *TournamentMatchMutation) Round() (r int, exists bool) {
+	v := m.round
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRound returns the old "round" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMatchMutation) OldRound(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRound: %w", err)
+	}
+	return oldValue.Round, nil
+}
+
+// AddRound adds i to the "round" field.
+func (m *TournamentMatchMutation) AddRound(i int) {
+	if m.addround != nil {
+		*m.addround += i
+	} else {
+		m.addround = &i
+	}
+}
+
+// AddedRound returns the value that was added to the "round" field in this mutation.
+func (m *TournamentMatchMutation) AddedRound() (r int, exists bool) {
+	v := m.addround
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRound resets all changes to the "round" field.
+func (m *TournamentMatchMutation) ResetRound() {
+	m.round = nil
+	m.addround = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *TournamentMatchMutation) SetStatus(t tournamentmatch.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TournamentMatchMutation) Status() (r tournamentmatch.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMatchMutation) OldStatus(ctx context.Context) (v tournamentmatch.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *TournamentMatchMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetWinnerSeed sets the "winner_seed" field.
+func (m *TournamentMatchMutation) SetWinnerSeed(i int) {
+	m.winner_seed = &i
+	m.addwinner_seed = nil
+}
+
+// WinnerSeed returns the value of the "winner_seed" field in the mutation.
+func (m *TournamentMatchMutation) WinnerSeed() (r int, exists bool) {
+	v := m.winner_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinnerSeed returns the old "winner_seed" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMatchMutation) OldWinnerSeed(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinnerSeed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinnerSeed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinnerSeed: %w", err)
+	}
+	return oldValue.WinnerSeed, nil
+}
+
+// AddWinnerSeed adds i to the "winner_seed" field.
+func (m *TournamentMatchMutation) AddWinnerSeed(i int) {
+	if m.addwinner_seed != nil {
+		*m.addwinner_seed += i
+	} else {
+		m.addwinner_seed = &i
+	}
+}
+
+// AddedWinnerSeed returns the value that was added to the "winner_seed" field in this mutation.
+func (m *TournamentMatchMutation) AddedWinnerSeed() (r int, exists bool) {
+	v := m.addwinner_seed
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWinnerSeed clears the value of the "winner_seed" field.
+func (m *TournamentMatchMutation) ClearWinnerSeed() {
+	m.winner_seed = nil
+	m.addwinner_seed = nil
+	m.clearedFields[tournamentmatch.FieldWinnerSeed] = struct{}{}
+}
+
+// WinnerSeedCleared returns if the "winner_seed" field was cleared in this mutation.
+func (m *TournamentMatchMutation) WinnerSeedCleared() bool {
+	_, ok := m.clearedFields[tournamentmatch.FieldWinnerSeed]
+	return ok
+}
+
+// ResetWinnerSeed resets all changes to the "winner_seed" field.
+func (m *TournamentMatchMutation) ResetWinnerSeed() {
+	m.winner_seed = nil
+	m.addwinner_seed = nil
+	delete(m.clearedFields, tournamentmatch.FieldWinnerSeed)
+}
+
+// SetGameID sets the "game_id" field.
+func (m *TournamentMatchMutation) SetGameID(i int) {
+	m.game = &i
+}
+
+// GameID returns the value of the "game_id" field in the mutation.
+func (m *TournamentMatchMutation) GameID() (r int, exists bool) {
+	v := m.game
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGameID returns the old "game_id" field's value of the TournamentMatch entity.
+// If the TournamentMatch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TournamentMatchMutation) OldGameID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGameID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGameID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGameID: %w", err)
 	}
+	return oldValue.GameID, nil
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (m *TournamentMatchMutation) ClearGameID() {
+	m.game = nil
+	m.clearedFields[tournamentmatch.FieldGameID] = struct{}{}
+}
+
+// GameIDCleared returns if the "game_id" field was cleared in this mutation.
+func (m *TournamentMatchMutation) GameIDCleared() bool {
+	_, ok := m.clearedFields[tournamentmatch.FieldGameID]
+	return ok
+}
+
+// ResetGameID resets all changes to the "game_id" field.
+func (m *TournamentMatchMutation) ResetGameID() {
+	m.game = nil
+	delete(m.clearedFields, tournamentmatch.FieldGameID)
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by id.
+func (m *TournamentMatchMutation) SetTournamentID(id int) {
+	m.tournament = &id
 }
 
-// ClearParent clears the "parent" edge to the Card entity.
-func (m *ItemMutation) ClearParent() {
-	m.clearedparent = true
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (m *TournamentMatchMutation) ClearTournament() {
+	m.clearedtournament = true
 }
 
-// ParentCleared reports if the "parent" edge to the Card entity was cleared.
-func (m *ItemMutation) ParentCleared() bool {
-	return m.clearedparent
+// TournamentCleared reports if the "tournament" edge to the Tournament entity was cleared.
+func (m *TournamentMatchMutation) TournamentCleared() bool {
+	return m.clearedtournament
 }
 
-// RemoveParentIDs removes the "parent" edge to the Card entity by IDs.
-func (m *ItemMutation) RemoveParentIDs(ids ...int) {
-	if m.removedparent == nil {
-		m.removedparent = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.parent, ids[i])
-		m.removedparent[ids[i]] = struct{}{}
+// TournamentID returns the "tournament" edge ID in the mutation.
+func (m *TournamentMatchMutation) TournamentID() (id int, exists bool) {
+	if m.tournament != nil {
+		return *m.tournament, true
 	}
+	return
 }
 
-// RemovedParent returns the removed IDs of the "parent" edge to the Card entity.
-func (m *ItemMutation) RemovedParentIDs() (ids []int) {
-	for id := range m.removedparent {
-		ids = append(ids, id)
+// TournamentIDs returns the "tournament" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TournamentID instead. It exists only for internal usage by the builders.
+func (m *TournamentMatchMutation) TournamentIDs() (ids []int) {
+	if id := m.tournament; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-func (m *ItemMutation) ParentIDs() (ids []int) {
-	for id := range m.parent {
-		ids = append(ids, id)
+// ResetTournament resets all changes to the "tournament" edge.
+func (m *TournamentMatchMutation) ResetTournament() {
+	m.tournament = nil
+	m.clearedtournament = false
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (m *TournamentMatchMutation) ClearGame() {
+	m.clearedgame = true
+	m.clearedFields[tournamentmatch.FieldGameID] = struct{}{}
+}
+
+// GameCleared reports if the "game" edge to the Game entity was cleared.
+func (m *TournamentMatchMutation) GameCleared() bool {
+	return m.GameIDCleared() || m.clearedgame
+}
+
+// GameIDs returns the "game" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GameID instead. It exists only for internal usage by the builders.
+func (m *TournamentMatchMutation) GameIDs() (ids []int) {
+	if id := m.game; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *ItemMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
-	m.removedparent = nil
+// ResetGame resets all changes to the "game" edge.
+func (m *TournamentMatchMutation) ResetGame() {
+	m.game = nil
+	m.clearedgame = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the TournamentMatchMutation builder.
+func (m *TournamentMatchMutation) Where(ps ...predicate.TournamentMatch) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TournamentMatchMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *TournamentMatchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TournamentMatch, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1172,27 +10228,42 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *TournamentMatchMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *TournamentMatchMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (TournamentMatch).
+func (m *TournamentMatchMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.symbol != nil {
-		fields = append(fields, item.FieldSymbol)
+func (m *TournamentMatchMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.number != nil {
+		fields = append(fields, tournamentmatch.FieldNumber)
+	}
+	if m.side != nil {
+		fields = append(fields, tournamentmatch.FieldSide)
+	}
+	if m.round != nil {
+		fields = append(fields, tournamentmatch.FieldRound)
+	}
+	if m.status != nil {
+		fields = append(fields, tournamentmatch.FieldStatus)
+	}
+	if m.winner_seed != nil {
+		fields = append(fields, tournamentmatch.FieldWinnerSeed)
+	}
+	if m.game != nil {
+		fields = append(fields, tournamentmatch.FieldGameID)
 	}
 	return fields
 }
@@ -1200,10 +10271,20 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *TournamentMatchMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldSymbol:
-		return m.Symbol()
+	case tournamentmatch.FieldNumber:
+		return m.Number()
+	case tournamentmatch.FieldSide:
+		return m.Side()
+	case tournamentmatch.FieldRound:
+		return m.Round()
+	case tournamentmatch.FieldStatus:
+		return m.Status()
+	case tournamentmatch.FieldWinnerSeed:
+		return m.WinnerSeed()
+	case tournamentmatch.FieldGameID:
+		return m.GameID()
 	}
 	return nil, false
 }
@@ -1211,195 +10292,321 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TournamentMatchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case item.FieldSymbol:
-		return m.OldSymbol(ctx)
+	case tournamentmatch.FieldNumber:
+		return m.OldNumber(ctx)
+	case tournamentmatch.FieldSide:
+		return m.OldSide(ctx)
+	case tournamentmatch.FieldRound:
+		return m.OldRound(ctx)
+	case tournamentmatch.FieldStatus:
+		return m.OldStatus(ctx)
+	case tournamentmatch.FieldWinnerSeed:
+		return m.OldWinnerSeed(ctx)
+	case tournamentmatch.FieldGameID:
+		return m.OldGameID(ctx)
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return nil, fmt.Errorf("unknown TournamentMatch field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
+func (m *TournamentMatchMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldSymbol:
-		v, ok := value.(string)
+	case tournamentmatch.FieldNumber:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
+		m.SetNumber(v)
+		return nil
+	case tournamentmatch.FieldSide:
+		v, ok := value.(tournamentmatch.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case tournamentmatch.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRound(v)
+		return nil
+	case tournamentmatch.FieldStatus:
+		v, ok := value.(tournamentmatch.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case tournamentmatch.FieldWinnerSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinnerSeed(v)
+		return nil
+	case tournamentmatch.FieldGameID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGameID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown TournamentMatch field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	return nil
+func (m *TournamentMatchMutation) AddedFields() []string {
+	var fields []string
+	if m.addnumber != nil {
+		fields = append(fields, tournamentmatch.FieldNumber)
+	}
+	if m.addround != nil {
+		fields = append(fields, tournamentmatch.FieldRound)
+	}
+	if m.addwinner_seed != nil {
+		fields = append(fields, tournamentmatch.FieldWinnerSeed)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TournamentMatchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tournamentmatch.FieldNumber:
+		return m.AddedNumber()
+	case tournamentmatch.FieldRound:
+		return m.AddedRound()
+	case tournamentmatch.FieldWinnerSeed:
+		return m.AddedWinnerSeed()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *TournamentMatchMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case tournamentmatch.FieldNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNumber(v)
+		return nil
+	case tournamentmatch.FieldRound:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRound(v)
+		return nil
+	case tournamentmatch.FieldWinnerSeed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWinnerSeed(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	return fmt.Errorf("unknown TournamentMatch numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	return nil
+func (m *TournamentMatchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tournamentmatch.FieldWinnerSeed) {
+		fields = append(fields, tournamentmatch.FieldWinnerSeed)
+	}
+	if m.FieldCleared(tournamentmatch.FieldGameID) {
+		fields = append(fields, tournamentmatch.FieldGameID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *TournamentMatchMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Item nullable field %s", name)
+func (m *TournamentMatchMutation) ClearField(name string) error {
+	switch name {
+	case tournamentmatch.FieldWinnerSeed:
+		m.ClearWinnerSeed()
+		return nil
+	case tournamentmatch.FieldGameID:
+		m.ClearGameID()
+		return nil
+	}
+	return fmt.Errorf("unknown TournamentMatch nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *TournamentMatchMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldSymbol:
-		m.ResetSymbol()
+	case tournamentmatch.FieldNumber:
+		m.ResetNumber()
+		return nil
+	case tournamentmatch.FieldSide:
+		m.ResetSide()
+		return nil
+	case tournamentmatch.FieldRound:
+		m.ResetRound()
+		return nil
+	case tournamentmatch.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case tournamentmatch.FieldWinnerSeed:
+		m.ResetWinnerSeed()
+		return nil
+	case tournamentmatch.FieldGameID:
+		m.ResetGameID()
 		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown TournamentMatch field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.parent != nil {
-		edges = append(edges, item.EdgeParent)
+func (m *TournamentMatchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tournament != nil {
+		edges = append(edges, tournamentmatch.EdgeTournament)
+	}
+	if m.game != nil {
+		edges = append(edges, tournamentmatch.EdgeGame)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *TournamentMatchMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.parent))
-		for id := range m.parent {
-			ids = append(ids, id)
+	case tournamentmatch.EdgeTournament:
+		if id := m.tournament; id != nil {
+			return []ent.Value{*id}
+		}
+	case tournamentmatch.EdgeGame:
+		if id := m.game; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedparent != nil {
-		edges = append(edges, item.EdgeParent)
-	}
+func (m *TournamentMatchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeParent:
-		ids := make([]ent.Value, 0, len(m.removedparent))
-		for id := range m.removedparent {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TournamentMatchMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedparent {
-		edges = append(edges, item.EdgeParent)
+func (m *TournamentMatchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtournament {
+		edges = append(edges, tournamentmatch.EdgeTournament)
+	}
+	if m.clearedgame {
+		edges = append(edges, tournamentmatch.EdgeGame)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *TournamentMatchMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgeParent:
-		return m.clearedparent
+	case tournamentmatch.EdgeTournament:
+		return m.clearedtournament
+	case tournamentmatch.EdgeGame:
+		return m.clearedgame
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *TournamentMatchMutation) ClearEdge(name string) error {
 	switch name {
+	case tournamentmatch.EdgeTournament:
+		m.ClearTournament()
+		return nil
+	case tournamentmatch.EdgeGame:
+		m.ClearGame()
+		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown TournamentMatch unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *TournamentMatchMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeParent:
-		m.ResetParent()
+	case tournamentmatch.EdgeTournament:
+		m.ResetTournament()
+		return nil
+	case tournamentmatch.EdgeGame:
+		m.ResetGame()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown TournamentMatch edge %s", name)
 }
 
-// PlayerMutation represents an operation that mutates the Player nodes in the graph.
-type PlayerMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	status        *player.Status
-	score         *int
-	addscore      *int
-	clearedFields map[string]struct{}
-	parent        *int
-	clearedparent bool
-	done          bool
-	oldValue      func(context.Context) (*Player, error)
-	predicates    []predicate.Player
-}
-
-var _ ent.Mutation = (*PlayerMutation)(nil)
-
-// playerOption allows management of the mutation configuration using functional options.
-type playerOption func(*PlayerMutation)
-
-// newPlayerMutation creates new mutation for the Player entity.
-func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
-	m := &PlayerMutation{
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	token          *string
+	rating         *float64
+	addrating      *float64
+	rated_games    *int
+	addrated_games *int
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	players        map[int]struct{}
+	removedplayers map[int]struct{}
+	clearedplayers bool
+	done           bool
+	oldValue       func(context.Context) (*User, error)
+	predicates     []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePlayer,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1408,20 +10615,20 @@ func newPlayerMutation(c config, op Op, opts ...playerOption) *PlayerMutation {
 	return m
 }
 
-// withPlayerID sets the ID field of the mutation.
-func withPlayerID(id int) playerOption {
-	return func(m *PlayerMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Player
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Player, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Player.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1430,10 +10637,10 @@ func withPlayerID(id int) playerOption {
 	}
 }
 
-// withPlayer sets the old Player of the mutation.
-func withPlayer(node *Player) playerOption {
-	return func(m *PlayerMutation) {
-		m.oldValue = func(context.Context) (*Player, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1442,7 +10649,7 @@ func withPlayer(node *Player) playerOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PlayerMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1450,7 +10657,7 @@ func (m PlayerMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PlayerMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1461,7 +10668,7 @@ func (m PlayerMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PlayerMutation) ID() (id int, exists bool) {
+func (m *UserMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1472,7 +10679,7 @@ func (m *PlayerMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1481,188 +10688,295 @@ func (m *PlayerMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Player.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *PlayerMutation) SetName(s string) {
+func (m *UserMutation) SetName(s string) {
 	m.name = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PlayerMutation) Name() (r string, exists bool) {
-	v := m.name
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetToken sets the "token" field.
+func (m *UserMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *UserMutation) Token() (r string, exists bool) {
+	v := m.token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldToken returns the old "token" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Token, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PlayerMutation) ResetName() {
-	m.name = nil
+// ResetToken resets all changes to the "token" field.
+func (m *UserMutation) ResetToken() {
+	m.token = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *PlayerMutation) SetStatus(pl player.Status) {
-	m.status = &pl
+// SetRating sets the "rating" field.
+func (m *UserMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PlayerMutation) Status() (r player.Status, exists bool) {
-	v := m.status
+// Rating returns the value of the "rating" field in the mutation.
+func (m *UserMutation) Rating() (r float64, exists bool) {
+	v := m.rating
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldRating returns the old "rating" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldStatus(ctx context.Context) (v player.Status, err error) {
+func (m *UserMutation) OldRating(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldRating requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Rating, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PlayerMutation) ResetStatus() {
-	m.status = nil
+// AddRating adds f to the "rating" field.
+func (m *UserMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
+	}
 }
 
-// SetScore sets the "score" field.
-func (m *PlayerMutation) SetScore(i int) {
-	m.score = &i
-	m.addscore = nil
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *UserMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Score returns the value of the "score" field in the mutation.
-func (m *PlayerMutation) Score() (r int, exists bool) {
-	v := m.score
+// ResetRating resets all changes to the "rating" field.
+func (m *UserMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (m *UserMutation) SetRatedGames(i int) {
+	m.rated_games = &i
+	m.addrated_games = nil
+}
+
+// RatedGames returns the value of the "rated_games" field in the mutation.
+func (m *UserMutation) RatedGames() (r int, exists bool) {
+	v := m.rated_games
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldScore returns the old "score" field's value of the Player entity.
-// If the Player object wasn't provided to the builder, the object is fetched from the database.
+// OldRatedGames returns the old "rated_games" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PlayerMutation) OldScore(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldRatedGames(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+		return v, errors.New("OldRatedGames is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldScore requires an ID field in the mutation")
+		return v, errors.New("OldRatedGames requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+		return v, fmt.Errorf("querying old value for OldRatedGames: %w", err)
 	}
-	return oldValue.Score, nil
+	return oldValue.RatedGames, nil
 }
 
-// AddScore adds i to the "score" field.
-func (m *PlayerMutation) AddScore(i int) {
-	if m.addscore != nil {
-		*m.addscore += i
+// AddRatedGames adds i to the "rated_games" field.
+func (m *UserMutation) AddRatedGames(i int) {
+	if m.addrated_games != nil {
+		*m.addrated_games += i
 	} else {
-		m.addscore = &i
+		m.addrated_games = &i
 	}
 }
 
-// AddedScore returns the value that was added to the "score" field in this mutation.
-func (m *PlayerMutation) AddedScore() (r int, exists bool) {
-	v := m.addscore
+// AddedRatedGames returns the value that was added to the "rated_games" field in this mutation.
+func (m *UserMutation) AddedRatedGames() (r int, exists bool) {
+	v := m.addrated_games
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetScore resets all changes to the "score" field.
-func (m *PlayerMutation) ResetScore() {
-	m.score = nil
-	m.addscore = nil
+// ResetRatedGames resets all changes to the "rated_games" field.
+func (m *UserMutation) ResetRatedGames() {
+	m.rated_games = nil
+	m.addrated_games = nil
 }
 
-// SetParentID sets the "parent" edge to the Game entity by id.
-func (m *PlayerMutation) SetParentID(id int) {
-	m.parent = &id
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ClearParent clears the "parent" edge to the Game entity.
-func (m *PlayerMutation) ClearParent() {
-	m.clearedparent = true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ParentCleared reports if the "parent" edge to the Game entity was cleared.
-func (m *PlayerMutation) ParentCleared() bool {
-	return m.clearedparent
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ParentID returns the "parent" edge ID in the mutation.
-func (m *PlayerMutation) ParentID() (id int, exists bool) {
-	if m.parent != nil {
-		return *m.parent, true
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by ids.
+func (m *UserMutation) AddPlayerIDs(ids ...int) {
+	if m.players == nil {
+		m.players = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.players[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPlayers clears the "players" edge to the Player entity.
+func (m *UserMutation) ClearPlayers() {
+	m.clearedplayers = true
+}
+
+// PlayersCleared reports if the "players" edge to the Player entity was cleared.
+func (m *UserMutation) PlayersCleared() bool {
+	return m.clearedplayers
+}
+
+// RemovePlayerIDs removes the "players" edge to the Player entity by IDs.
+func (m *UserMutation) RemovePlayerIDs(ids ...int) {
+	if m.removedplayers == nil {
+		m.removedplayers = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.players, ids[i])
+		m.removedplayers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPlayers returns the removed IDs of the "players" edge to the Player entity.
+func (m *UserMutation) RemovedPlayersIDs() (ids []int) {
+	for id := range m.removedplayers {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ParentIDs returns the "parent" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ParentID instead. It exists only for internal usage by the builders.
-func (m *PlayerMutation) ParentIDs() (ids []int) {
-	if id := m.parent; id != nil {
-		ids = append(ids, *id)
+// PlayersIDs returns the "players" edge IDs in the mutation.
+func (m *UserMutation) PlayersIDs() (ids []int) {
+	for id := range m.players {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetParent resets all changes to the "parent" edge.
-func (m *PlayerMutation) ResetParent() {
-	m.parent = nil
-	m.clearedparent = false
+// ResetPlayers resets all changes to the "players" edge.
+func (m *UserMutation) ResetPlayers() {
+	m.players = nil
+	m.clearedplayers = false
+	m.removedplayers = nil
 }
 
-// Where appends a list predicates to the PlayerMutation builder.
-func (m *PlayerMutation) Where(ps ...predicate.Player) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PlayerMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Player, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1670,33 +10984,39 @@ func (m *PlayerMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PlayerMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PlayerMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Player).
-func (m *PlayerMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PlayerMutation) Fields() []string {
-	fields := make([]string, 0, 3)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.name != nil {
-		fields = append(fields, player.FieldName)
+		fields = append(fields, user.FieldName)
 	}
-	if m.status != nil {
-		fields = append(fields, player.FieldStatus)
+	if m.token != nil {
+		fields = append(fields, user.FieldToken)
 	}
-	if m.score != nil {
-		fields = append(fields, player.FieldScore)
+	if m.rating != nil {
+		fields = append(fields, user.FieldRating)
+	}
+	if m.rated_games != nil {
+		fields = append(fields, user.FieldRatedGames)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1704,14 +11024,18 @@ func (m *PlayerMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldName:
+	case user.FieldName:
 		return m.Name()
-	case player.FieldStatus:
-		return m.Status()
-	case player.FieldScore:
-		return m.Score()
+	case user.FieldToken:
+		return m.Token()
+	case user.FieldRating:
+		return m.Rating()
+	case user.FieldRatedGames:
+		return m.RatedGames()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1719,54 +11043,75 @@ func (m *PlayerMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PlayerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case player.FieldName:
+	case user.FieldName:
 		return m.OldName(ctx)
-	case player.FieldStatus:
-		return m.OldStatus(ctx)
-	case player.FieldScore:
-		return m.OldScore(ctx)
+	case user.FieldToken:
+		return m.OldToken(ctx)
+	case user.FieldRating:
+		return m.OldRating(ctx)
+	case user.FieldRatedGames:
+		return m.OldRatedGames(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Player field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldName:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case player.FieldStatus:
-		v, ok := value.(player.Status)
+	case user.FieldToken:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetToken(v)
 		return nil
-	case player.FieldScore:
+	case user.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case user.FieldRatedGames:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetScore(v)
+		m.SetRatedGames(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PlayerMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addscore != nil {
-		fields = append(fields, player.FieldScore)
+	if m.addrating != nil {
+		fields = append(fields, user.FieldRating)
+	}
+	if m.addrated_games != nil {
+		fields = append(fields, user.FieldRatedGames)
 	}
 	return fields
 }
@@ -1774,10 +11119,12 @@ func (m *PlayerMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case player.FieldScore:
-		return m.AddedScore()
+	case user.FieldRating:
+		return m.AddedRating()
+	case user.FieldRatedGames:
+		return m.AddedRatedGames()
 	}
 	return nil, false
 }
@@ -1785,125 +11132,148 @@ func (m *PlayerMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PlayerMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case player.FieldScore:
+	case user.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case user.FieldRatedGames:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddScore(v)
+		m.AddRatedGames(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Player numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PlayerMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PlayerMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PlayerMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Player nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PlayerMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case player.FieldName:
+	case user.FieldName:
 		m.ResetName()
 		return nil
-	case player.FieldStatus:
-		m.ResetStatus()
+	case user.FieldToken:
+		m.ResetToken()
 		return nil
-	case player.FieldScore:
-		m.ResetScore()
+	case user.FieldRating:
+		m.ResetRating()
+		return nil
+	case user.FieldRatedGames:
+		m.ResetRatedGames()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Player field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PlayerMutation) AddedEdges() []string {
+func (m *UserMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.parent != nil {
-		edges = append(edges, player.EdgeParent)
+	if m.players != nil {
+		edges = append(edges, user.EdgePlayers)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PlayerMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case player.EdgeParent:
-		if id := m.parent; id != nil {
-			return []ent.Value{*id}
+	case user.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.players))
+		for id := range m.players {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PlayerMutation) RemovedEdges() []string {
+func (m *UserMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedplayers != nil {
+		edges = append(edges, user.EdgePlayers)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PlayerMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePlayers:
+		ids := make([]ent.Value, 0, len(m.removedplayers))
+		for id := range m.removedplayers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PlayerMutation) ClearedEdges() []string {
+func (m *UserMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedparent {
-		edges = append(edges, player.EdgeParent)
+	if m.clearedplayers {
+		edges = append(edges, user.EdgePlayers)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PlayerMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case player.EdgeParent:
-		return m.clearedparent
+	case user.EdgePlayers:
+		return m.clearedplayers
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PlayerMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case player.EdgeParent:
-		m.ClearParent()
-		return nil
 	}
-	return fmt.Errorf("unknown Player unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PlayerMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case player.EdgeParent:
-		m.ResetParent()
+	case user.EdgePlayers:
+		m.ResetPlayers()
 		return nil
 	}
-	return fmt.Errorf("unknown Player edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -3,8 +3,12 @@
 package ent
 
 import (
+	"encoding/json"
 	"example/ent/game"
 	"example/ent/player"
+	"example/ent/team"
+	"example/ent/user"
+	"example/internal/handicap"
 	"fmt"
 	"strings"
 
@@ -23,6 +27,30 @@ type Player struct {
 	Status player.Status `json:"status,omitempty"`
 	// Score holds the value of the "score" field.
 	Score int `json:"score,omitempty"`
+	// WrongCount holds the value of the "wrong_count" field.
+	WrongCount int `json:"wrong_count,omitempty"`
+	// Streak holds the value of the "streak" field.
+	Streak int `json:"streak,omitempty"`
+	// IsHost holds the value of the "is_host" field.
+	IsHost bool `json:"is_host,omitempty"`
+	// IsBot holds the value of the "is_bot" field.
+	IsBot bool `json:"is_bot,omitempty"`
+	// SeriesWins holds the value of the "series_wins" field.
+	SeriesWins int `json:"series_wins,omitempty"`
+	// SeriesID holds the value of the "series_id" field.
+	SeriesID int `json:"series_id,omitempty"`
+	// ResumeToken holds the value of the "resume_token" field.
+	ResumeToken string `json:"-"`
+	// TeamID holds the value of the "team_id" field.
+	TeamID int `json:"team_id,omitempty"`
+	// Handicap holds the value of the "handicap" field.
+	Handicap handicap.Handicap `json:"handicap,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Muted holds the value of the "muted" field.
+	Muted bool `json:"muted,omitempty"`
+	// TournamentSeed holds the value of the "tournament_seed" field.
+	TournamentSeed int `json:"tournament_seed,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the PlayerQuery when eager-loading is set.
 	Edges         PlayerEdges `json:"edges"`
@@ -34,9 +62,13 @@ type Player struct {
 type PlayerEdges struct {
 	// Parent holds the value of the parent edge.
 	Parent *Game `json:"parent,omitempty"`
+	// Team holds the value of the team edge.
+	Team *Team `json:"team,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [3]bool
 }
 
 // ParentOrErr returns the Parent value or an error if the edge
@@ -50,14 +82,40 @@ func (e PlayerEdges) ParentOrErr() (*Game, error) {
 	return nil, &NotLoadedError{edge: "parent"}
 }
 
+// TeamOrErr returns the Team value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayerEdges) TeamOrErr() (*Team, error) {
+	if e.Team != nil {
+		return e.Team, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: team.Label}
+	}
+	return nil, &NotLoadedError{edge: "team"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PlayerEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Player) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case player.FieldID, player.FieldScore:
+		case player.FieldHandicap:
+			values[i] = new([]byte)
+		case player.FieldIsHost, player.FieldIsBot, player.FieldMuted:
+			values[i] = new(sql.NullBool)
+		case player.FieldID, player.FieldScore, player.FieldWrongCount, player.FieldStreak, player.FieldSeriesWins, player.FieldSeriesID, player.FieldTeamID, player.FieldUserID, player.FieldTournamentSeed:
 			values[i] = new(sql.NullInt64)
-		case player.FieldName, player.FieldStatus:
+		case player.FieldName, player.FieldStatus, player.FieldResumeToken:
 			values[i] = new(sql.NullString)
 		case player.ForeignKeys[0]: // player_parent
 			values[i] = new(sql.NullInt64)
@@ -100,6 +158,80 @@ func (pl *Player) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pl.Score = int(value.Int64)
 			}
+		case player.FieldWrongCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field wrong_count", values[i])
+			} else if value.Valid {
+				pl.WrongCount = int(value.Int64)
+			}
+		case player.FieldStreak:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field streak", values[i])
+			} else if value.Valid {
+				pl.Streak = int(value.Int64)
+			}
+		case player.FieldIsHost:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_host", values[i])
+			} else if value.Valid {
+				pl.IsHost = value.Bool
+			}
+		case player.FieldIsBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_bot", values[i])
+			} else if value.Valid {
+				pl.IsBot = value.Bool
+			}
+		case player.FieldSeriesWins:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field series_wins", values[i])
+			} else if value.Valid {
+				pl.SeriesWins = int(value.Int64)
+			}
+		case player.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				pl.SeriesID = int(value.Int64)
+			}
+		case player.FieldResumeToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field resume_token", values[i])
+			} else if value.Valid {
+				pl.ResumeToken = value.String
+			}
+		case player.FieldTeamID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field team_id", values[i])
+			} else if value.Valid {
+				pl.TeamID = int(value.Int64)
+			}
+		case player.FieldHandicap:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field handicap", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pl.Handicap); err != nil {
+					return fmt.Errorf("unmarshal field handicap: %w", err)
+				}
+			}
+		case player.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pl.UserID = int(value.Int64)
+			}
+		case player.FieldMuted:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field muted", values[i])
+			} else if value.Valid {
+				pl.Muted = value.Bool
+			}
+		case player.FieldTournamentSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tournament_seed", values[i])
+			} else if value.Valid {
+				pl.TournamentSeed = int(value.Int64)
+			}
 		case player.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field player_parent", value)
@@ -125,6 +257,16 @@ func (pl *Player) QueryParent() *GameQuery {
 	return NewPlayerClient(pl.config).QueryParent(pl)
 }
 
+// QueryTeam queries the "team" edge of the Player entity.
+func (pl *Player) QueryTeam() *TeamQuery {
+	return NewPlayerClient(pl.config).QueryTeam(pl)
+}
+
+// QueryUser queries the "user" edge of the Player entity.
+func (pl *Player) QueryUser() *UserQuery {
+	return NewPlayerClient(pl.config).QueryUser(pl)
+}
+
 // Update returns a builder for updating this Player.
 // Note that you need to call Player.Unwrap() before calling this method if this Player
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -156,6 +298,41 @@ func (pl *Player) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("score=")
 	builder.WriteString(fmt.Sprintf("%v", pl.Score))
+	builder.WriteString(", ")
+	builder.WriteString("wrong_count=")
+	builder.WriteString(fmt.Sprintf("%v", pl.WrongCount))
+	builder.WriteString(", ")
+	builder.WriteString("streak=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Streak))
+	builder.WriteString(", ")
+	builder.WriteString("is_host=")
+	builder.WriteString(fmt.Sprintf("%v", pl.IsHost))
+	builder.WriteString(", ")
+	builder.WriteString("is_bot=")
+	builder.WriteString(fmt.Sprintf("%v", pl.IsBot))
+	builder.WriteString(", ")
+	builder.WriteString("series_wins=")
+	builder.WriteString(fmt.Sprintf("%v", pl.SeriesWins))
+	builder.WriteString(", ")
+	builder.WriteString("series_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.SeriesID))
+	builder.WriteString(", ")
+	builder.WriteString("resume_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("team_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.TeamID))
+	builder.WriteString(", ")
+	builder.WriteString("handicap=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Handicap))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pl.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("muted=")
+	builder.WriteString(fmt.Sprintf("%v", pl.Muted))
+	builder.WriteString(", ")
+	builder.WriteString("tournament_seed=")
+	builder.WriteString(fmt.Sprintf("%v", pl.TournamentSeed))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -20,8 +20,36 @@ const (
 	FieldStatus = "status"
 	// FieldScore holds the string denoting the score field in the database.
 	FieldScore = "score"
+	// FieldWrongCount holds the string denoting the wrong_count field in the database.
+	FieldWrongCount = "wrong_count"
+	// FieldStreak holds the string denoting the streak field in the database.
+	FieldStreak = "streak"
+	// FieldIsHost holds the string denoting the is_host field in the database.
+	FieldIsHost = "is_host"
+	// FieldIsBot holds the string denoting the is_bot field in the database.
+	FieldIsBot = "is_bot"
+	// FieldSeriesWins holds the string denoting the series_wins field in the database.
+	FieldSeriesWins = "series_wins"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
+	// FieldResumeToken holds the string denoting the resume_token field in the database.
+	FieldResumeToken = "resume_token"
+	// FieldTeamID holds the string denoting the team_id field in the database.
+	FieldTeamID = "team_id"
+	// FieldHandicap holds the string denoting the handicap field in the database.
+	FieldHandicap = "handicap"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldMuted holds the string denoting the muted field in the database.
+	FieldMuted = "muted"
+	// FieldTournamentSeed holds the string denoting the tournament_seed field in the database.
+	FieldTournamentSeed = "tournament_seed"
 	// EdgeParent holds the string denoting the parent edge name in mutations.
 	EdgeParent = "parent"
+	// EdgeTeam holds the string denoting the team edge name in mutations.
+	EdgeTeam = "team"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
 	// Table holds the table name of the player in the database.
 	Table = "players"
 	// ParentTable is the table that holds the parent relation/edge.
@@ -31,6 +59,20 @@ const (
 	ParentInverseTable = "games"
 	// ParentColumn is the table column denoting the parent relation/edge.
 	ParentColumn = "player_parent"
+	// TeamTable is the table that holds the team relation/edge.
+	TeamTable = "players"
+	// TeamInverseTable is the table name for the Team entity.
+	// It exists in this package in order to avoid circular dependency with the "team" package.
+	TeamInverseTable = "teams"
+	// TeamColumn is the table column denoting the team relation/edge.
+	TeamColumn = "team_id"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "players"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
 )
 
 // Columns holds all SQL columns for player fields.
@@ -39,6 +81,18 @@ var Columns = []string{
 	FieldName,
 	FieldStatus,
 	FieldScore,
+	FieldWrongCount,
+	FieldStreak,
+	FieldIsHost,
+	FieldIsBot,
+	FieldSeriesWins,
+	FieldSeriesID,
+	FieldResumeToken,
+	FieldTeamID,
+	FieldHandicap,
+	FieldUserID,
+	FieldMuted,
+	FieldTournamentSeed,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "players"
@@ -67,6 +121,18 @@ var (
 	NameValidator func(string) error
 	// DefaultScore holds the default value on creation for the "score" field.
 	DefaultScore int
+	// DefaultWrongCount holds the default value on creation for the "wrong_count" field.
+	DefaultWrongCount int
+	// DefaultStreak holds the default value on creation for the "streak" field.
+	DefaultStreak int
+	// DefaultIsHost holds the default value on creation for the "is_host" field.
+	DefaultIsHost bool
+	// DefaultIsBot holds the default value on creation for the "is_bot" field.
+	DefaultIsBot bool
+	// DefaultSeriesWins holds the default value on creation for the "series_wins" field.
+	DefaultSeriesWins int
+	// DefaultMuted holds the default value on creation for the "muted" field.
+	DefaultMuted bool
 )
 
 // Status defines the type for the "status" enum field.
@@ -77,11 +143,13 @@ const DefaultStatus = StatusJOINING
 
 // Status values.
 const (
-	StatusJOINING  Status = "JOINING"
-	StatusSTARTED  Status = "STARTED"
-	StatusREADY    Status = "READY"
-	StatusPLAYING  Status = "PLAYING"
-	StatusFINISHED Status = "FINISHED"
+	StatusJOINING      Status = "JOINING"
+	StatusSTARTED      Status = "STARTED"
+	StatusREADY        Status = "READY"
+	StatusPLAYING      Status = "PLAYING"
+	StatusFINISHED     Status = "FINISHED"
+	StatusELIMINATED   Status = "ELIMINATED"
+	StatusDISCONNECTED Status = "DISCONNECTED"
 )
 
 func (s Status) String() string {
@@ -91,7 +159,7 @@ func (s Status) String() string {
 // StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
 func StatusValidator(s Status) error {
 	switch s {
-	case StatusJOINING, StatusSTARTED, StatusREADY, StatusPLAYING, StatusFINISHED:
+	case StatusJOINING, StatusSTARTED, StatusREADY, StatusPLAYING, StatusFINISHED, StatusELIMINATED, StatusDISCONNECTED:
 		return nil
 	default:
 		return fmt.Errorf("player: invalid enum value for status field: %q", s)
@@ -121,12 +189,81 @@ func ByScore(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldScore, opts...).ToFunc()
 }
 
+// ByWrongCount orders the results by the wrong_count field.
+func ByWrongCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWrongCount, opts...).ToFunc()
+}
+
+// ByStreak orders the results by the streak field.
+func ByStreak(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStreak, opts...).ToFunc()
+}
+
+// ByIsHost orders the results by the is_host field.
+func ByIsHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsHost, opts...).ToFunc()
+}
+
+// ByIsBot orders the results by the is_bot field.
+func ByIsBot(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsBot, opts...).ToFunc()
+}
+
+// BySeriesWins orders the results by the series_wins field.
+func BySeriesWins(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesWins, opts...).ToFunc()
+}
+
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
+// ByResumeToken orders the results by the resume_token field.
+func ByResumeToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResumeToken, opts...).ToFunc()
+}
+
+// ByTeamID orders the results by the team_id field.
+func ByTeamID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTeamID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByMuted orders the results by the muted field.
+func ByMuted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMuted, opts...).ToFunc()
+}
+
+// ByTournamentSeed orders the results by the tournament_seed field.
+func ByTournamentSeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTournamentSeed, opts...).ToFunc()
+}
+
 // ByParentField orders the results by parent field.
 func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
 		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByTeamField orders the results by team field.
+func ByTeamField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTeamStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newParentStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -134,3 +271,17 @@ func newParentStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, ParentTable, ParentColumn),
 	)
 }
+func newTeamStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TeamInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TeamTable, TeamColumn),
+	)
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+	)
+}
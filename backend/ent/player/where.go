@@ -64,6 +64,61 @@ func Score(v int) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldScore, v))
 }
 
+// WrongCount applies equality check predicate on the "wrong_count" field. It's identical to WrongCountEQ.
+func WrongCount(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldWrongCount, v))
+}
+
+// Streak applies equality check predicate on the "streak" field. It's identical to StreakEQ.
+func Streak(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldStreak, v))
+}
+
+// IsHost applies equality check predicate on the "is_host" field. It's identical to IsHostEQ.
+func IsHost(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIsHost, v))
+}
+
+// IsBot applies equality check predicate on the "is_bot" field. It's identical to IsBotEQ.
+func IsBot(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIsBot, v))
+}
+
+// SeriesWins applies equality check predicate on the "series_wins" field. It's identical to SeriesWinsEQ.
+func SeriesWins(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeriesWins, v))
+}
+
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// ResumeToken applies equality check predicate on the "resume_token" field. It's identical to ResumeTokenEQ.
+func ResumeToken(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldResumeToken, v))
+}
+
+// TeamID applies equality check predicate on the "team_id" field. It's identical to TeamIDEQ.
+func TeamID(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldTeamID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldUserID, v))
+}
+
+// Muted applies equality check predicate on the "muted" field. It's identical to MutedEQ.
+func Muted(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldMuted, v))
+}
+
+// TournamentSeed applies equality check predicate on the "tournament_seed" field. It's identical to TournamentSeedEQ.
+func TournamentSeed(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldTournamentSeed, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Player {
 	return predicate.Player(sql.FieldEQ(FieldName, v))
@@ -189,6 +244,401 @@ func ScoreLTE(v int) predicate.Player {
 	return predicate.Player(sql.FieldLTE(FieldScore, v))
 }
 
+// WrongCountEQ applies the EQ predicate on the "wrong_count" field.
+func WrongCountEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldWrongCount, v))
+}
+
+// WrongCountNEQ applies the NEQ predicate on the "wrong_count" field.
+func WrongCountNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldWrongCount, v))
+}
+
+// WrongCountIn applies the In predicate on the "wrong_count" field.
+func WrongCountIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldWrongCount, vs...))
+}
+
+// WrongCountNotIn applies the NotIn predicate on the "wrong_count" field.
+func WrongCountNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldWrongCount, vs...))
+}
+
+// WrongCountGT applies the GT predicate on the "wrong_count" field.
+func WrongCountGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldWrongCount, v))
+}
+
+// WrongCountGTE applies the GTE predicate on the "wrong_count" field.
+func WrongCountGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldWrongCount, v))
+}
+
+// WrongCountLT applies the LT predicate on the "wrong_count" field.
+func WrongCountLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldWrongCount, v))
+}
+
+// WrongCountLTE applies the LTE predicate on the "wrong_count" field.
+func WrongCountLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldWrongCount, v))
+}
+
+// StreakEQ applies the EQ predicate on the "streak" field.
+func StreakEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldStreak, v))
+}
+
+// StreakNEQ applies the NEQ predicate on the "streak" field.
+func StreakNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldStreak, v))
+}
+
+// StreakIn applies the In predicate on the "streak" field.
+func StreakIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldStreak, vs...))
+}
+
+// StreakNotIn applies the NotIn predicate on the "streak" field.
+func StreakNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldStreak, vs...))
+}
+
+// StreakGT applies the GT predicate on the "streak" field.
+func StreakGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldStreak, v))
+}
+
+// StreakGTE applies the GTE predicate on the "streak" field.
+func StreakGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldStreak, v))
+}
+
+// StreakLT applies the LT predicate on the "streak" field.
+func StreakLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldStreak, v))
+}
+
+// StreakLTE applies the LTE predicate on the "streak" field.
+func StreakLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldStreak, v))
+}
+
+// IsHostEQ applies the EQ predicate on the "is_host" field.
+func IsHostEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIsHost, v))
+}
+
+// IsHostNEQ applies the NEQ predicate on the "is_host" field.
+func IsHostNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldIsHost, v))
+}
+
+// IsBotEQ applies the EQ predicate on the "is_bot" field.
+func IsBotEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldIsBot, v))
+}
+
+// IsBotNEQ applies the NEQ predicate on the "is_bot" field.
+func IsBotNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldIsBot, v))
+}
+
+// SeriesWinsEQ applies the EQ predicate on the "series_wins" field.
+func SeriesWinsEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeriesWins, v))
+}
+
+// SeriesWinsNEQ applies the NEQ predicate on the "series_wins" field.
+func SeriesWinsNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldSeriesWins, v))
+}
+
+// SeriesWinsIn applies the In predicate on the "series_wins" field.
+func SeriesWinsIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldSeriesWins, vs...))
+}
+
+// SeriesWinsNotIn applies the NotIn predicate on the "series_wins" field.
+func SeriesWinsNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldSeriesWins, vs...))
+}
+
+// SeriesWinsGT applies the GT predicate on the "series_wins" field.
+func SeriesWinsGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldSeriesWins, v))
+}
+
+// SeriesWinsGTE applies the GTE predicate on the "series_wins" field.
+func SeriesWinsGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldSeriesWins, v))
+}
+
+// SeriesWinsLT applies the LT predicate on the "series_wins" field.
+func SeriesWinsLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldSeriesWins, v))
+}
+
+// SeriesWinsLTE applies the LTE predicate on the "series_wins" field.
+func SeriesWinsLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldSeriesWins, v))
+}
+
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDGT applies the GT predicate on the "series_id" field.
+func SeriesIDGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldSeriesID, v))
+}
+
+// SeriesIDGTE applies the GTE predicate on the "series_id" field.
+func SeriesIDGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldSeriesID, v))
+}
+
+// SeriesIDLT applies the LT predicate on the "series_id" field.
+func SeriesIDLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldSeriesID, v))
+}
+
+// SeriesIDLTE applies the LTE predicate on the "series_id" field.
+func SeriesIDLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldSeriesID, v))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldSeriesID))
+}
+
+// ResumeTokenEQ applies the EQ predicate on the "resume_token" field.
+func ResumeTokenEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldResumeToken, v))
+}
+
+// ResumeTokenNEQ applies the NEQ predicate on the "resume_token" field.
+func ResumeTokenNEQ(v string) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldResumeToken, v))
+}
+
+// ResumeTokenIn applies the In predicate on the "resume_token" field.
+func ResumeTokenIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldResumeToken, vs...))
+}
+
+// ResumeTokenNotIn applies the NotIn predicate on the "resume_token" field.
+func ResumeTokenNotIn(vs ...string) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldResumeToken, vs...))
+}
+
+// ResumeTokenGT applies the GT predicate on the "resume_token" field.
+func ResumeTokenGT(v string) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldResumeToken, v))
+}
+
+// ResumeTokenGTE applies the GTE predicate on the "resume_token" field.
+func ResumeTokenGTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldResumeToken, v))
+}
+
+// ResumeTokenLT applies the LT predicate on the "resume_token" field.
+func ResumeTokenLT(v string) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldResumeToken, v))
+}
+
+// ResumeTokenLTE applies the LTE predicate on the "resume_token" field.
+func ResumeTokenLTE(v string) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldResumeToken, v))
+}
+
+// ResumeTokenContains applies the Contains predicate on the "resume_token" field.
+func ResumeTokenContains(v string) predicate.Player {
+	return predicate.Player(sql.FieldContains(FieldResumeToken, v))
+}
+
+// ResumeTokenHasPrefix applies the HasPrefix predicate on the "resume_token" field.
+func ResumeTokenHasPrefix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasPrefix(FieldResumeToken, v))
+}
+
+// ResumeTokenHasSuffix applies the HasSuffix predicate on the "resume_token" field.
+func ResumeTokenHasSuffix(v string) predicate.Player {
+	return predicate.Player(sql.FieldHasSuffix(FieldResumeToken, v))
+}
+
+// ResumeTokenIsNil applies the IsNil predicate on the "resume_token" field.
+func ResumeTokenIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldResumeToken))
+}
+
+// ResumeTokenNotNil applies the NotNil predicate on the "resume_token" field.
+func ResumeTokenNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldResumeToken))
+}
+
+// ResumeTokenEqualFold applies the EqualFold predicate on the "resume_token" field.
+func ResumeTokenEqualFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldEqualFold(FieldResumeToken, v))
+}
+
+// ResumeTokenContainsFold applies the ContainsFold predicate on the "resume_token" field.
+func ResumeTokenContainsFold(v string) predicate.Player {
+	return predicate.Player(sql.FieldContainsFold(FieldResumeToken, v))
+}
+
+// TeamIDEQ applies the EQ predicate on the "team_id" field.
+func TeamIDEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldTeamID, v))
+}
+
+// TeamIDNEQ applies the NEQ predicate on the "team_id" field.
+func TeamIDNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldTeamID, v))
+}
+
+// TeamIDIn applies the In predicate on the "team_id" field.
+func TeamIDIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldTeamID, vs...))
+}
+
+// TeamIDNotIn applies the NotIn predicate on the "team_id" field.
+func TeamIDNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldTeamID, vs...))
+}
+
+// TeamIDIsNil applies the IsNil predicate on the "team_id" field.
+func TeamIDIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldTeamID))
+}
+
+// TeamIDNotNil applies the NotNil predicate on the "team_id" field.
+func TeamIDNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldTeamID))
+}
+
+// HandicapIsNil applies the IsNil predicate on the "handicap" field.
+func HandicapIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldHandicap))
+}
+
+// HandicapNotNil applies the NotNil predicate on the "handicap" field.
+func HandicapNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldHandicap))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldUserID))
+}
+
+// MutedEQ applies the EQ predicate on the "muted" field.
+func MutedEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldMuted, v))
+}
+
+// MutedNEQ applies the NEQ predicate on the "muted" field.
+func MutedNEQ(v bool) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldMuted, v))
+}
+
+// TournamentSeedEQ applies the EQ predicate on the "tournament_seed" field.
+func TournamentSeedEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldEQ(FieldTournamentSeed, v))
+}
+
+// TournamentSeedNEQ applies the NEQ predicate on the "tournament_seed" field.
+func TournamentSeedNEQ(v int) predicate.Player {
+	return predicate.Player(sql.FieldNEQ(FieldTournamentSeed, v))
+}
+
+// TournamentSeedIn applies the In predicate on the "tournament_seed" field.
+func TournamentSeedIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldIn(FieldTournamentSeed, vs...))
+}
+
+// TournamentSeedNotIn applies the NotIn predicate on the "tournament_seed" field.
+func TournamentSeedNotIn(vs ...int) predicate.Player {
+	return predicate.Player(sql.FieldNotIn(FieldTournamentSeed, vs...))
+}
+
+// TournamentSeedGT applies the GT predicate on the "tournament_seed" field.
+func TournamentSeedGT(v int) predicate.Player {
+	return predicate.Player(sql.FieldGT(FieldTournamentSeed, v))
+}
+
+// TournamentSeedGTE applies the GTE predicate on the "tournament_seed" field.
+func TournamentSeedGTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldGTE(FieldTournamentSeed, v))
+}
+
+// TournamentSeedLT applies the LT predicate on the "tournament_seed" field.
+func TournamentSeedLT(v int) predicate.Player {
+	return predicate.Player(sql.FieldLT(FieldTournamentSeed, v))
+}
+
+// TournamentSeedLTE applies the LTE predicate on the "tournament_seed" field.
+func TournamentSeedLTE(v int) predicate.Player {
+	return predicate.Player(sql.FieldLTE(FieldTournamentSeed, v))
+}
+
+// TournamentSeedIsNil applies the IsNil predicate on the "tournament_seed" field.
+func TournamentSeedIsNil() predicate.Player {
+	return predicate.Player(sql.FieldIsNull(FieldTournamentSeed))
+}
+
+// TournamentSeedNotNil applies the NotNil predicate on the "tournament_seed" field.
+func TournamentSeedNotNil() predicate.Player {
+	return predicate.Player(sql.FieldNotNull(FieldTournamentSeed))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.Player {
 	return predicate.Player(func(s *sql.Selector) {
@@ -212,6 +662,52 @@ func HasParentWith(preds ...predicate.Game) predicate.Player {
 	})
 }
 
+// HasTeam applies the HasEdge predicate on the "team" edge.
+func HasTeam() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TeamTable, TeamColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTeamWith applies the HasEdge predicate on the "team" edge with a given conditions (other predicates).
+func HasTeamWith(preds ...predicate.Team) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newTeamStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Player {
+	return predicate.Player(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Player) predicate.Player {
 	return predicate.Player(sql.AndPredicates(predicates...))
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"example/ent/game"
 	"example/ent/player"
+	"example/ent/team"
+	"example/ent/user"
+	"example/internal/handicap"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -54,6 +57,174 @@ func (pc *PlayerCreate) SetNillableScore(i *int) *PlayerCreate {
 	return pc
 }
 
+// SetWrongCount sets the "wrong_count" field.
+func (pc *PlayerCreate) SetWrongCount(i int) *PlayerCreate {
+	pc.mutation.SetWrongCount(i)
+	return pc
+}
+
+// SetNillableWrongCount sets the "wrong_count" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableWrongCount(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetWrongCount(*i)
+	}
+	return pc
+}
+
+// SetStreak sets the "streak" field.
+func (pc *PlayerCreate) SetStreak(i int) *PlayerCreate {
+	pc.mutation.SetStreak(i)
+	return pc
+}
+
+// SetNillableStreak sets the "streak" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableStreak(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetStreak(*i)
+	}
+	return pc
+}
+
+// SetIsHost sets the "is_host" field.
+func (pc *PlayerCreate) SetIsHost(b bool) *PlayerCreate {
+	pc.mutation.SetIsHost(b)
+	return pc
+}
+
+// SetNillableIsHost sets the "is_host" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableIsHost(b *bool) *PlayerCreate {
+	if b != nil {
+		pc.SetIsHost(*b)
+	}
+	return pc
+}
+
+// SetIsBot sets the "is_bot" field.
+func (pc *PlayerCreate) SetIsBot(b bool) *PlayerCreate {
+	pc.mutation.SetIsBot(b)
+	return pc
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableIsBot(b *bool) *PlayerCreate {
+	if b != nil {
+		pc.SetIsBot(*b)
+	}
+	return pc
+}
+
+// SetSeriesWins sets the "series_wins" field.
+func (pc *PlayerCreate) SetSeriesWins(i int) *PlayerCreate {
+	pc.mutation.SetSeriesWins(i)
+	return pc
+}
+
+// SetNillableSeriesWins sets the "series_wins" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableSeriesWins(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetSeriesWins(*i)
+	}
+	return pc
+}
+
+// SetSeriesID sets the "series_id" field.
+func (pc *PlayerCreate) SetSeriesID(i int) *PlayerCreate {
+	pc.mutation.SetSeriesID(i)
+	return pc
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableSeriesID(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetSeriesID(*i)
+	}
+	return pc
+}
+
+// SetResumeToken sets the "resume_token" field.
+func (pc *PlayerCreate) SetResumeToken(s string) *PlayerCreate {
+	pc.mutation.SetResumeToken(s)
+	return pc
+}
+
+// SetNillableResumeToken sets the "resume_token" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableResumeToken(s *string) *PlayerCreate {
+	if s != nil {
+		pc.SetResumeToken(*s)
+	}
+	return pc
+}
+
+// SetTeamID sets the "team_id" field.
+func (pc *PlayerCreate) SetTeamID(i int) *PlayerCreate {
+	pc.mutation.SetTeamID(i)
+	return pc
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableTeamID(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetTeamID(*i)
+	}
+	return pc
+}
+
+// SetHandicap sets the "handicap" field.
+func (pc *PlayerCreate) SetHandicap(h handicap.Handicap) *PlayerCreate {
+	pc.mutation.SetHandicap(h)
+	return pc
+}
+
+// SetNillableHandicap sets the "handicap" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableHandicap(h *handicap.Handicap) *PlayerCreate {
+	if h != nil {
+		pc.SetHandicap(*h)
+	}
+	return pc
+}
+
+// SetUserID sets the "user_id" field.
+func (pc *PlayerCreate) SetUserID(i int) *PlayerCreate {
+	pc.mutation.SetUserID(i)
+	return pc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableUserID(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetUserID(*i)
+	}
+	return pc
+}
+
+// SetMuted sets the "muted" field.
+func (pc *PlayerCreate) SetMuted(b bool) *PlayerCreate {
+	pc.mutation.SetMuted(b)
+	return pc
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableMuted(b *bool) *PlayerCreate {
+	if b != nil {
+		pc.SetMuted(*b)
+	}
+	return pc
+}
+
+// SetTournamentSeed sets the "tournament_seed" field.
+func (pc *PlayerCreate) SetTournamentSeed(i int) *PlayerCreate {
+	pc.mutation.SetTournamentSeed(i)
+	return pc
+}
+
+// SetNillableTournamentSeed sets the "tournament_seed" field if the given value is not nil.
+func (pc *PlayerCreate) SetNillableTournamentSeed(i *int) *PlayerCreate {
+	if i != nil {
+		pc.SetTournamentSeed(*i)
+	}
+	return pc
+}
+
 // SetParentID sets the "parent" edge to the Game entity by ID.
 func (pc *PlayerCreate) SetParentID(id int) *PlayerCreate {
 	pc.mutation.SetParentID(id)
@@ -73,6 +244,16 @@ func (pc *PlayerCreate) SetParent(g *Game) *PlayerCreate {
 	return pc.SetParentID(g.ID)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (pc *PlayerCreate) SetTeam(t *Team) *PlayerCreate {
+	return pc.SetTeamID(t.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pc *PlayerCreate) SetUser(u *User) *PlayerCreate {
+	return pc.SetUserID(u.ID)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pc *PlayerCreate) Mutation() *PlayerMutation {
 	return pc.mutation
@@ -116,6 +297,30 @@ func (pc *PlayerCreate) defaults() {
 		v := player.DefaultScore
 		pc.mutation.SetScore(v)
 	}
+	if _, ok := pc.mutation.WrongCount(); !ok {
+		v := player.DefaultWrongCount
+		pc.mutation.SetWrongCount(v)
+	}
+	if _, ok := pc.mutation.Streak(); !ok {
+		v := player.DefaultStreak
+		pc.mutation.SetStreak(v)
+	}
+	if _, ok := pc.mutation.IsHost(); !ok {
+		v := player.DefaultIsHost
+		pc.mutation.SetIsHost(v)
+	}
+	if _, ok := pc.mutation.IsBot(); !ok {
+		v := player.DefaultIsBot
+		pc.mutation.SetIsBot(v)
+	}
+	if _, ok := pc.mutation.SeriesWins(); !ok {
+		v := player.DefaultSeriesWins
+		pc.mutation.SetSeriesWins(v)
+	}
+	if _, ok := pc.mutation.Muted(); !ok {
+		v := player.DefaultMuted
+		pc.mutation.SetMuted(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -139,6 +344,29 @@ func (pc *PlayerCreate) check() error {
 	if _, ok := pc.mutation.Score(); !ok {
 		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "Player.score"`)}
 	}
+	if _, ok := pc.mutation.WrongCount(); !ok {
+		return &ValidationError{Name: "wrong_count", err: errors.New(`ent: missing required field "Player.wrong_count"`)}
+	}
+	if _, ok := pc.mutation.Streak(); !ok {
+		return &ValidationError{Name: "streak", err: errors.New(`ent: missing required field "Player.streak"`)}
+	}
+	if _, ok := pc.mutation.IsHost(); !ok {
+		return &ValidationError{Name: "is_host", err: errors.New(`ent: missing required field "Player.is_host"`)}
+	}
+	if _, ok := pc.mutation.IsBot(); !ok {
+		return &ValidationError{Name: "is_bot", err: errors.New(`ent: missing required field "Player.is_bot"`)}
+	}
+	if _, ok := pc.mutation.SeriesWins(); !ok {
+		return &ValidationError{Name: "series_wins", err: errors.New(`ent: missing required field "Player.series_wins"`)}
+	}
+	if v, ok := pc.mutation.Handicap(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "handicap", err: fmt.Errorf(`ent: validator failed for field "Player.handicap": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Muted(); !ok {
+		return &ValidationError{Name: "muted", err: errors.New(`ent: missing required field "Player.muted"`)}
+	}
 	return nil
 }
 
@@ -177,6 +405,46 @@ func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		_spec.SetField(player.FieldScore, field.TypeInt, value)
 		_node.Score = value
 	}
+	if value, ok := pc.mutation.WrongCount(); ok {
+		_spec.SetField(player.FieldWrongCount, field.TypeInt, value)
+		_node.WrongCount = value
+	}
+	if value, ok := pc.mutation.Streak(); ok {
+		_spec.SetField(player.FieldStreak, field.TypeInt, value)
+		_node.Streak = value
+	}
+	if value, ok := pc.mutation.IsHost(); ok {
+		_spec.SetField(player.FieldIsHost, field.TypeBool, value)
+		_node.IsHost = value
+	}
+	if value, ok := pc.mutation.IsBot(); ok {
+		_spec.SetField(player.FieldIsBot, field.TypeBool, value)
+		_node.IsBot = value
+	}
+	if value, ok := pc.mutation.SeriesWins(); ok {
+		_spec.SetField(player.FieldSeriesWins, field.TypeInt, value)
+		_node.SeriesWins = value
+	}
+	if value, ok := pc.mutation.SeriesID(); ok {
+		_spec.SetField(player.FieldSeriesID, field.TypeInt, value)
+		_node.SeriesID = value
+	}
+	if value, ok := pc.mutation.ResumeToken(); ok {
+		_spec.SetField(player.FieldResumeToken, field.TypeString, value)
+		_node.ResumeToken = value
+	}
+	if value, ok := pc.mutation.Handicap(); ok {
+		_spec.SetField(player.FieldHandicap, field.TypeJSON, value)
+		_node.Handicap = value
+	}
+	if value, ok := pc.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+		_node.Muted = value
+	}
+	if value, ok := pc.mutation.TournamentSeed(); ok {
+		_spec.SetField(player.FieldTournamentSeed, field.TypeInt, value)
+		_node.TournamentSeed = value
+	}
 	if nodes := pc.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -194,6 +462,40 @@ func (pc *PlayerCreate) createSpec() (*Player, *sqlgraph.CreateSpec) {
 		_node.player_parent = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TeamID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.UserTable,
+			Columns: []string{player.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,6 +8,9 @@ import (
 	"example/ent/game"
 	"example/ent/player"
 	"example/ent/predicate"
+	"example/ent/team"
+	"example/ent/user"
+	"example/internal/handicap"
 	"fmt"
 
 	"entgo.io/ent/dialect/sql"
@@ -77,6 +80,245 @@ func (pu *PlayerUpdate) AddScore(i int) *PlayerUpdate {
 	return pu
 }
 
+// SetWrongCount sets the "wrong_count" field.
+func (pu *PlayerUpdate) SetWrongCount(i int) *PlayerUpdate {
+	pu.mutation.ResetWrongCount()
+	pu.mutation.SetWrongCount(i)
+	return pu
+}
+
+// SetNillableWrongCount sets the "wrong_count" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableWrongCount(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetWrongCount(*i)
+	}
+	return pu
+}
+
+// AddWrongCount adds i to the "wrong_count" field.
+func (pu *PlayerUpdate) AddWrongCount(i int) *PlayerUpdate {
+	pu.mutation.AddWrongCount(i)
+	return pu
+}
+
+// SetStreak sets the "streak" field.
+func (pu *PlayerUpdate) SetStreak(i int) *PlayerUpdate {
+	pu.mutation.ResetStreak()
+	pu.mutation.SetStreak(i)
+	return pu
+}
+
+// SetNillableStreak sets the "streak" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableStreak(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetStreak(*i)
+	}
+	return pu
+}
+
+// AddStreak adds i to the "streak" field.
+func (pu *PlayerUpdate) AddStreak(i int) *PlayerUpdate {
+	pu.mutation.AddStreak(i)
+	return pu
+}
+
+// SetIsHost sets the "is_host" field.
+func (pu *PlayerUpdate) SetIsHost(b bool) *PlayerUpdate {
+	pu.mutation.SetIsHost(b)
+	return pu
+}
+
+// SetNillableIsHost sets the "is_host" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableIsHost(b *bool) *PlayerUpdate {
+	if b != nil {
+		pu.SetIsHost(*b)
+	}
+	return pu
+}
+
+// SetIsBot sets the "is_bot" field.
+func (pu *PlayerUpdate) SetIsBot(b bool) *PlayerUpdate {
+	pu.mutation.SetIsBot(b)
+	return pu
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableIsBot(b *bool) *PlayerUpdate {
+	if b != nil {
+		pu.SetIsBot(*b)
+	}
+	return pu
+}
+
+// SetSeriesWins sets the "series_wins" field.
+func (pu *PlayerUpdate) SetSeriesWins(i int) *PlayerUpdate {
+	pu.mutation.ResetSeriesWins()
+	pu.mutation.SetSeriesWins(i)
+	return pu
+}
+
+// SetNillableSeriesWins sets the "series_wins" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableSeriesWins(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetSeriesWins(*i)
+	}
+	return pu
+}
+
+// AddSeriesWins adds i to the "series_wins" field.
+func (pu *PlayerUpdate) AddSeriesWins(i int) *PlayerUpdate {
+	pu.mutation.AddSeriesWins(i)
+	return pu
+}
+
+// SetSeriesID sets the "series_id" field.
+func (pu *PlayerUpdate) SetSeriesID(i int) *PlayerUpdate {
+	pu.mutation.ResetSeriesID()
+	pu.mutation.SetSeriesID(i)
+	return pu
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableSeriesID(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetSeriesID(*i)
+	}
+	return pu
+}
+
+// AddSeriesID adds i to the "series_id" field.
+func (pu *PlayerUpdate) AddSeriesID(i int) *PlayerUpdate {
+	pu.mutation.AddSeriesID(i)
+	return pu
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (pu *PlayerUpdate) ClearSeriesID() *PlayerUpdate {
+	pu.mutation.ClearSeriesID()
+	return pu
+}
+
+// SetResumeToken sets the "resume_token" field.
+func (pu *PlayerUpdate) SetResumeToken(s string) *PlayerUpdate {
+	pu.mutation.SetResumeToken(s)
+	return pu
+}
+
+// SetNillableResumeToken sets the "resume_token" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableResumeToken(s *string) *PlayerUpdate {
+	if s != nil {
+		pu.SetResumeToken(*s)
+	}
+	return pu
+}
+
+// ClearResumeToken clears the value of the "resume_token" field.
+func (pu *PlayerUpdate) ClearResumeToken() *PlayerUpdate {
+	pu.mutation.ClearResumeToken()
+	return pu
+}
+
+// SetTeamID sets the "team_id" field.
+func (pu *PlayerUpdate) SetTeamID(i int) *PlayerUpdate {
+	pu.mutation.SetTeamID(i)
+	return pu
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableTeamID(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetTeamID(*i)
+	}
+	return pu
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (pu *PlayerUpdate) ClearTeamID() *PlayerUpdate {
+	pu.mutation.ClearTeamID()
+	return pu
+}
+
+// SetHandicap sets the "handicap" field.
+func (pu *PlayerUpdate) SetHandicap(h handicap.Handicap) *PlayerUpdate {
+	pu.mutation.SetHandicap(h)
+	return pu
+}
+
+// SetNillableHandicap sets the "handicap" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableHandicap(h *handicap.Handicap) *PlayerUpdate {
+	if h != nil {
+		pu.SetHandicap(*h)
+	}
+	return pu
+}
+
+// ClearHandicap clears the value of the "handicap" field.
+func (pu *PlayerUpdate) ClearHandicap() *PlayerUpdate {
+	pu.mutation.ClearHandicap()
+	return pu
+}
+
+// SetUserID sets the "user_id" field.
+func (pu *PlayerUpdate) SetUserID(i int) *PlayerUpdate {
+	pu.mutation.SetUserID(i)
+	return pu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableUserID(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetUserID(*i)
+	}
+	return pu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (pu *PlayerUpdate) ClearUserID() *PlayerUpdate {
+	pu.mutation.ClearUserID()
+	return pu
+}
+
+// SetMuted sets the "muted" field.
+func (pu *PlayerUpdate) SetMuted(b bool) *PlayerUpdate {
+	pu.mutation.SetMuted(b)
+	return pu
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableMuted(b *bool) *PlayerUpdate {
+	if b != nil {
+		pu.SetMuted(*b)
+	}
+	return pu
+}
+
+// SetTournamentSeed sets the "tournament_seed" field.
+func (pu *PlayerUpdate) SetTournamentSeed(i int) *PlayerUpdate {
+	pu.mutation.ResetTournamentSeed()
+	pu.mutation.SetTournamentSeed(i)
+	return pu
+}
+
+// SetNillableTournamentSeed sets the "tournament_seed" field if the given value is not nil.
+func (pu *PlayerUpdate) SetNillableTournamentSeed(i *int) *PlayerUpdate {
+	if i != nil {
+		pu.SetTournamentSeed(*i)
+	}
+	return pu
+}
+
+// AddTournamentSeed adds i to the "tournament_seed" field.
+func (pu *PlayerUpdate) AddTournamentSeed(i int) *PlayerUpdate {
+	pu.mutation.AddTournamentSeed(i)
+	return pu
+}
+
+// ClearTournamentSeed clears the value of the "tournament_seed" field.
+func (pu *PlayerUpdate) ClearTournamentSeed() *PlayerUpdate {
+	pu.mutation.ClearTournamentSeed()
+	return pu
+}
+
 // SetParentID sets the "parent" edge to the Game entity by ID.
 func (pu *PlayerUpdate) SetParentID(id int) *PlayerUpdate {
 	pu.mutation.SetParentID(id)
@@ -96,6 +338,16 @@ func (pu *PlayerUpdate) SetParent(g *Game) *PlayerUpdate {
 	return pu.SetParentID(g.ID)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (pu *PlayerUpdate) SetTeam(t *Team) *PlayerUpdate {
+	return pu.SetTeamID(t.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (pu *PlayerUpdate) SetUser(u *User) *PlayerUpdate {
+	return pu.SetUserID(u.ID)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (pu *PlayerUpdate) Mutation() *PlayerMutation {
 	return pu.mutation
@@ -107,6 +359,18 @@ func (pu *PlayerUpdate) ClearParent() *PlayerUpdate {
 	return pu
 }
 
+// ClearTeam clears the "team" edge to the Team entity.
+func (pu *PlayerUpdate) ClearTeam() *PlayerUpdate {
+	pu.mutation.ClearTeam()
+	return pu
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (pu *PlayerUpdate) ClearUser() *PlayerUpdate {
+	pu.mutation.ClearUser()
+	return pu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *PlayerUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
@@ -146,6 +410,11 @@ func (pu *PlayerUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Player.status": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Handicap(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "handicap", err: fmt.Errorf(`ent: validator failed for field "Player.handicap": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -173,6 +442,63 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.AddedScore(); ok {
 		_spec.AddField(player.FieldScore, field.TypeInt, value)
 	}
+	if value, ok := pu.mutation.WrongCount(); ok {
+		_spec.SetField(player.FieldWrongCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedWrongCount(); ok {
+		_spec.AddField(player.FieldWrongCount, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.Streak(); ok {
+		_spec.SetField(player.FieldStreak, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedStreak(); ok {
+		_spec.AddField(player.FieldStreak, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.IsHost(); ok {
+		_spec.SetField(player.FieldIsHost, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.IsBot(); ok {
+		_spec.SetField(player.FieldIsBot, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.SeriesWins(); ok {
+		_spec.SetField(player.FieldSeriesWins, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedSeriesWins(); ok {
+		_spec.AddField(player.FieldSeriesWins, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.SeriesID(); ok {
+		_spec.SetField(player.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedSeriesID(); ok {
+		_spec.AddField(player.FieldSeriesID, field.TypeInt, value)
+	}
+	if pu.mutation.SeriesIDCleared() {
+		_spec.ClearField(player.FieldSeriesID, field.TypeInt)
+	}
+	if value, ok := pu.mutation.ResumeToken(); ok {
+		_spec.SetField(player.FieldResumeToken, field.TypeString, value)
+	}
+	if pu.mutation.ResumeTokenCleared() {
+		_spec.ClearField(player.FieldResumeToken, field.TypeString)
+	}
+	if value, ok := pu.mutation.Handicap(); ok {
+		_spec.SetField(player.FieldHandicap, field.TypeJSON, value)
+	}
+	if pu.mutation.HandicapCleared() {
+		_spec.ClearField(player.FieldHandicap, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+	}
+	if value, ok := pu.mutation.TournamentSeed(); ok {
+		_spec.SetField(player.FieldTournamentSeed, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedTournamentSeed(); ok {
+		_spec.AddField(player.FieldTournamentSeed, field.TypeInt, value)
+	}
+	if pu.mutation.TournamentSeedCleared() {
+		_spec.ClearField(player.FieldTournamentSeed, field.TypeInt)
+	}
 	if pu.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -202,6 +528,64 @@ func (pu *PlayerUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.UserTable,
+			Columns: []string{player.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.UserTable,
+			Columns: []string{player.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{player.Label}
@@ -271,6 +655,245 @@ func (puo *PlayerUpdateOne) AddScore(i int) *PlayerUpdateOne {
 	return puo
 }
 
+// SetWrongCount sets the "wrong_count" field.
+func (puo *PlayerUpdateOne) SetWrongCount(i int) *PlayerUpdateOne {
+	puo.mutation.ResetWrongCount()
+	puo.mutation.SetWrongCount(i)
+	return puo
+}
+
+// SetNillableWrongCount sets the "wrong_count" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableWrongCount(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetWrongCount(*i)
+	}
+	return puo
+}
+
+// AddWrongCount adds i to the "wrong_count" field.
+func (puo *PlayerUpdateOne) AddWrongCount(i int) *PlayerUpdateOne {
+	puo.mutation.AddWrongCount(i)
+	return puo
+}
+
+// SetStreak sets the "streak" field.
+func (puo *PlayerUpdateOne) SetStreak(i int) *PlayerUpdateOne {
+	puo.mutation.ResetStreak()
+	puo.mutation.SetStreak(i)
+	return puo
+}
+
+// SetNillableStreak sets the "streak" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableStreak(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetStreak(*i)
+	}
+	return puo
+}
+
+// AddStreak adds i to the "streak" field.
+func (puo *PlayerUpdateOne) AddStreak(i int) *PlayerUpdateOne {
+	puo.mutation.AddStreak(i)
+	return puo
+}
+
+// SetIsHost sets the "is_host" field.
+func (puo *PlayerUpdateOne) SetIsHost(b bool) *PlayerUpdateOne {
+	puo.mutation.SetIsHost(b)
+	return puo
+}
+
+// SetNillableIsHost sets the "is_host" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableIsHost(b *bool) *PlayerUpdateOne {
+	if b != nil {
+		puo.SetIsHost(*b)
+	}
+	return puo
+}
+
+// SetIsBot sets the "is_bot" field.
+func (puo *PlayerUpdateOne) SetIsBot(b bool) *PlayerUpdateOne {
+	puo.mutation.SetIsBot(b)
+	return puo
+}
+
+// SetNillableIsBot sets the "is_bot" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableIsBot(b *bool) *PlayerUpdateOne {
+	if b != nil {
+		puo.SetIsBot(*b)
+	}
+	return puo
+}
+
+// SetSeriesWins sets the "series_wins" field.
+func (puo *PlayerUpdateOne) SetSeriesWins(i int) *PlayerUpdateOne {
+	puo.mutation.ResetSeriesWins()
+	puo.mutation.SetSeriesWins(i)
+	return puo
+}
+
+// SetNillableSeriesWins sets the "series_wins" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableSeriesWins(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetSeriesWins(*i)
+	}
+	return puo
+}
+
+// AddSeriesWins adds i to the "series_wins" field.
+func (puo *PlayerUpdateOne) AddSeriesWins(i int) *PlayerUpdateOne {
+	puo.mutation.AddSeriesWins(i)
+	return puo
+}
+
+// SetSeriesID sets the "series_id" field.
+func (puo *PlayerUpdateOne) SetSeriesID(i int) *PlayerUpdateOne {
+	puo.mutation.ResetSeriesID()
+	puo.mutation.SetSeriesID(i)
+	return puo
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableSeriesID(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetSeriesID(*i)
+	}
+	return puo
+}
+
+// AddSeriesID adds i to the "series_id" field.
+func (puo *PlayerUpdateOne) AddSeriesID(i int) *PlayerUpdateOne {
+	puo.mutation.AddSeriesID(i)
+	return puo
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (puo *PlayerUpdateOne) ClearSeriesID() *PlayerUpdateOne {
+	puo.mutation.ClearSeriesID()
+	return puo
+}
+
+// SetResumeToken sets the "resume_token" field.
+func (puo *PlayerUpdateOne) SetResumeToken(s string) *PlayerUpdateOne {
+	puo.mutation.SetResumeToken(s)
+	return puo
+}
+
+// SetNillableResumeToken sets the "resume_token" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableResumeToken(s *string) *PlayerUpdateOne {
+	if s != nil {
+		puo.SetResumeToken(*s)
+	}
+	return puo
+}
+
+// ClearResumeToken clears the value of the "resume_token" field.
+func (puo *PlayerUpdateOne) ClearResumeToken() *PlayerUpdateOne {
+	puo.mutation.ClearResumeToken()
+	return puo
+}
+
+// SetTeamID sets the "team_id" field.
+func (puo *PlayerUpdateOne) SetTeamID(i int) *PlayerUpdateOne {
+	puo.mutation.SetTeamID(i)
+	return puo
+}
+
+// SetNillableTeamID sets the "team_id" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableTeamID(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetTeamID(*i)
+	}
+	return puo
+}
+
+// ClearTeamID clears the value of the "team_id" field.
+func (puo *PlayerUpdateOne) ClearTeamID() *PlayerUpdateOne {
+	puo.mutation.ClearTeamID()
+	return puo
+}
+
+// SetHandicap sets the "handicap" field.
+func (puo *PlayerUpdateOne) SetHandicap(h handicap.Handicap) *PlayerUpdateOne {
+	puo.mutation.SetHandicap(h)
+	return puo
+}
+
+// SetNillableHandicap sets the "handicap" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableHandicap(h *handicap.Handicap) *PlayerUpdateOne {
+	if h != nil {
+		puo.SetHandicap(*h)
+	}
+	return puo
+}
+
+// ClearHandicap clears the value of the "handicap" field.
+func (puo *PlayerUpdateOne) ClearHandicap() *PlayerUpdateOne {
+	puo.mutation.ClearHandicap()
+	return puo
+}
+
+// SetUserID sets the "user_id" field.
+func (puo *PlayerUpdateOne) SetUserID(i int) *PlayerUpdateOne {
+	puo.mutation.SetUserID(i)
+	return puo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableUserID(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetUserID(*i)
+	}
+	return puo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (puo *PlayerUpdateOne) ClearUserID() *PlayerUpdateOne {
+	puo.mutation.ClearUserID()
+	return puo
+}
+
+// SetMuted sets the "muted" field.
+func (puo *PlayerUpdateOne) SetMuted(b bool) *PlayerUpdateOne {
+	puo.mutation.SetMuted(b)
+	return puo
+}
+
+// SetNillableMuted sets the "muted" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableMuted(b *bool) *PlayerUpdateOne {
+	if b != nil {
+		puo.SetMuted(*b)
+	}
+	return puo
+}
+
+// SetTournamentSeed sets the "tournament_seed" field.
+func (puo *PlayerUpdateOne) SetTournamentSeed(i int) *PlayerUpdateOne {
+	puo.mutation.ResetTournamentSeed()
+	puo.mutation.SetTournamentSeed(i)
+	return puo
+}
+
+// SetNillableTournamentSeed sets the "tournament_seed" field if the given value is not nil.
+func (puo *PlayerUpdateOne) SetNillableTournamentSeed(i *int) *PlayerUpdateOne {
+	if i != nil {
+		puo.SetTournamentSeed(*i)
+	}
+	return puo
+}
+
+// AddTournamentSeed adds i to the "tournament_seed" field.
+func (puo *PlayerUpdateOne) AddTournamentSeed(i int) *PlayerUpdateOne {
+	puo.mutation.AddTournamentSeed(i)
+	return puo
+}
+
+// ClearTournamentSeed clears the value of the "tournament_seed" field.
+func (puo *PlayerUpdateOne) ClearTournamentSeed() *PlayerUpdateOne {
+	puo.mutation.ClearTournamentSeed()
+	return puo
+}
+
 // SetParentID sets the "parent" edge to the Game entity by ID.
 func (puo *PlayerUpdateOne) SetParentID(id int) *PlayerUpdateOne {
 	puo.mutation.SetParentID(id)
@@ -290,6 +913,16 @@ func (puo *PlayerUpdateOne) SetParent(g *Game) *PlayerUpdateOne {
 	return puo.SetParentID(g.ID)
 }
 
+// SetTeam sets the "team" edge to the Team entity.
+func (puo *PlayerUpdateOne) SetTeam(t *Team) *PlayerUpdateOne {
+	return puo.SetTeamID(t.ID)
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (puo *PlayerUpdateOne) SetUser(u *User) *PlayerUpdateOne {
+	return puo.SetUserID(u.ID)
+}
+
 // Mutation returns the PlayerMutation object of the builder.
 func (puo *PlayerUpdateOne) Mutation() *PlayerMutation {
 	return puo.mutation
@@ -301,6 +934,18 @@ func (puo *PlayerUpdateOne) ClearParent() *PlayerUpdateOne {
 	return puo
 }
 
+// ClearTeam clears the "team" edge to the Team entity.
+func (puo *PlayerUpdateOne) ClearTeam() *PlayerUpdateOne {
+	puo.mutation.ClearTeam()
+	return puo
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (puo *PlayerUpdateOne) ClearUser() *PlayerUpdateOne {
+	puo.mutation.ClearUser()
+	return puo
+}
+
 // Where appends a list predicates to the PlayerUpdate builder.
 func (puo *PlayerUpdateOne) Where(ps ...predicate.Player) *PlayerUpdateOne {
 	puo.mutation.Where(ps...)
@@ -353,6 +998,11 @@ func (puo *PlayerUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Player.status": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Handicap(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "handicap", err: fmt.Errorf(`ent: validator failed for field "Player.handicap": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -397,6 +1047,63 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 	if value, ok := puo.mutation.AddedScore(); ok {
 		_spec.AddField(player.FieldScore, field.TypeInt, value)
 	}
+	if value, ok := puo.mutation.WrongCount(); ok {
+		_spec.SetField(player.FieldWrongCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedWrongCount(); ok {
+		_spec.AddField(player.FieldWrongCount, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.Streak(); ok {
+		_spec.SetField(player.FieldStreak, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedStreak(); ok {
+		_spec.AddField(player.FieldStreak, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.IsHost(); ok {
+		_spec.SetField(player.FieldIsHost, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.IsBot(); ok {
+		_spec.SetField(player.FieldIsBot, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.SeriesWins(); ok {
+		_spec.SetField(player.FieldSeriesWins, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedSeriesWins(); ok {
+		_spec.AddField(player.FieldSeriesWins, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.SeriesID(); ok {
+		_spec.SetField(player.FieldSeriesID, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedSeriesID(); ok {
+		_spec.AddField(player.FieldSeriesID, field.TypeInt, value)
+	}
+	if puo.mutation.SeriesIDCleared() {
+		_spec.ClearField(player.FieldSeriesID, field.TypeInt)
+	}
+	if value, ok := puo.mutation.ResumeToken(); ok {
+		_spec.SetField(player.FieldResumeToken, field.TypeString, value)
+	}
+	if puo.mutation.ResumeTokenCleared() {
+		_spec.ClearField(player.FieldResumeToken, field.TypeString)
+	}
+	if value, ok := puo.mutation.Handicap(); ok {
+		_spec.SetField(player.FieldHandicap, field.TypeJSON, value)
+	}
+	if puo.mutation.HandicapCleared() {
+		_spec.ClearField(player.FieldHandicap, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Muted(); ok {
+		_spec.SetField(player.FieldMuted, field.TypeBool, value)
+	}
+	if value, ok := puo.mutation.TournamentSeed(); ok {
+		_spec.SetField(player.FieldTournamentSeed, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedTournamentSeed(); ok {
+		_spec.AddField(player.FieldTournamentSeed, field.TypeInt, value)
+	}
+	if puo.mutation.TournamentSeedCleared() {
+		_spec.ClearField(player.FieldTournamentSeed, field.TypeInt)
+	}
 	if puo.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -426,6 +1133,64 @@ func (puo *PlayerUpdateOne) sqlSave(ctx context.Context) (_node *Player, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.TeamCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.TeamIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.TeamTable,
+			Columns: []string{player.TeamColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(team.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.UserTable,
+			Columns: []string{player.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   player.UserTable,
+			Columns: []string{player.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Player{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,177 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"example/ent/practicerun"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PracticeRun is the model entity for the PracticeRun schema.
+type PracticeRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// PlayerName holds the value of the "player_name" field.
+	PlayerName string `json:"player_name,omitempty"`
+	// CardCount holds the value of the "card_count" field.
+	CardCount int `json:"card_count,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed int64 `json:"seed,omitempty"`
+	// TotalMs holds the value of the "total_ms" field.
+	TotalMs int64 `json:"total_ms,omitempty"`
+	// ReactionMs holds the value of the "reaction_ms" field.
+	ReactionMs []int64 `json:"reaction_ms,omitempty"`
+	// Mistakes holds the value of the "mistakes" field.
+	Mistakes int `json:"mistakes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PracticeRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case practicerun.FieldReactionMs:
+			values[i] = new([]byte)
+		case practicerun.FieldID, practicerun.FieldCardCount, practicerun.FieldSeed, practicerun.FieldTotalMs, practicerun.FieldMistakes:
+			values[i] = new(sql.NullInt64)
+		case practicerun.FieldPlayerName:
+			values[i] = new(sql.NullString)
+		case practicerun.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PracticeRun fields.
+func (pr *PracticeRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case practicerun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pr.ID = int(value.Int64)
+		case practicerun.FieldPlayerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field player_name", values[i])
+			} else if value.Valid {
+				pr.PlayerName = value.String
+			}
+		case practicerun.FieldCardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card_count", values[i])
+			} else if value.Valid {
+				pr.CardCount = int(value.Int64)
+			}
+		case practicerun.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				pr.Seed = value.Int64
+			}
+		case practicerun.FieldTotalMs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_ms", values[i])
+			} else if value.Valid {
+				pr.TotalMs = value.Int64
+			}
+		case practicerun.FieldReactionMs:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field reaction_ms", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pr.ReactionMs); err != nil {
+					return fmt.Errorf("unmarshal field reaction_ms: %w", err)
+				}
+			}
+		case practicerun.FieldMistakes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field mistakes", values[i])
+			} else if value.Valid {
+				pr.Mistakes = int(value.Int64)
+			}
+		case practicerun.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pr.CreatedAt = value.Time
+			}
+		default:
+			pr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PracticeRun.
+// This includes values selected through modifiers, order, etc.
+func (pr *PracticeRun) Value(name string) (ent.Value, error) {
+	return pr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PracticeRun.
+// Note that you need to call PracticeRun.Unwrap() before calling this method if this PracticeRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pr *PracticeRun) Update() *PracticeRunUpdateOne {
+	return NewPracticeRunClient(pr.config).UpdateOne(pr)
+}
+
+// Unwrap unwraps the PracticeRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pr *PracticeRun) Unwrap() *PracticeRun {
+	_tx, ok := pr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PracticeRun is not a transactional entity")
+	}
+	pr.config.driver = _tx.drv
+	return pr
+}
+
+// String implements the fmt.Stringer.
+func (pr *PracticeRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("PracticeRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pr.ID))
+	builder.WriteString("player_name=")
+	builder.WriteString(pr.PlayerName)
+	builder.WriteString(", ")
+	builder.WriteString("card_count=")
+	builder.WriteString(fmt.Sprintf("%v", pr.CardCount))
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("total_ms=")
+	builder.WriteString(fmt.Sprintf("%v", pr.TotalMs))
+	builder.WriteString(", ")
+	builder.WriteString("reaction_ms=")
+	builder.WriteString(fmt.Sprintf("%v", pr.ReactionMs))
+	builder.WriteString(", ")
+	builder.WriteString("mistakes=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Mistakes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PracticeRuns is a parsable slice of PracticeRun.
+type PracticeRuns []*PracticeRun
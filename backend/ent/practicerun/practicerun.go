@@ -0,0 +1,101 @@
+// Code generated by ent, DO NOT EDIT.
+
+package practicerun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the practicerun type in the database.
+	Label = "practice_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPlayerName holds the string denoting the player_name field in the database.
+	FieldPlayerName = "player_name"
+	// FieldCardCount holds the string denoting the card_count field in the database.
+	FieldCardCount = "card_count"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldTotalMs holds the string denoting the total_ms field in the database.
+	FieldTotalMs = "total_ms"
+	// FieldReactionMs holds the string denoting the reaction_ms field in the database.
+	FieldReactionMs = "reaction_ms"
+	// FieldMistakes holds the string denoting the mistakes field in the database.
+	FieldMistakes = "mistakes"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the practicerun in the database.
+	Table = "practice_runs"
+)
+
+// Columns holds all SQL columns for practicerun fields.
+var Columns = []string{
+	FieldID,
+	FieldPlayerName,
+	FieldCardCount,
+	FieldSeed,
+	FieldTotalMs,
+	FieldReactionMs,
+	FieldMistakes,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PlayerNameValidator is a validator for the "player_name" field. It is called by the builders before save.
+	PlayerNameValidator func(string) error
+	// DefaultMistakes holds the default value on creation for the "mistakes" field.
+	DefaultMistakes int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PracticeRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPlayerName orders the results by the player_name field.
+func ByPlayerName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlayerName, opts...).ToFunc()
+}
+
+// ByCardCount orders the results by the card_count field.
+func ByCardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardCount, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByTotalMs orders the results by the total_ms field.
+func ByTotalMs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalMs, opts...).ToFunc()
+}
+
+// ByMistakes orders the results by the mistakes field.
+func ByMistakes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMistakes, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
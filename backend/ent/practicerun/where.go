@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package practicerun
+
+import (
+	"example/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldID, id))
+}
+
+// PlayerName applies equality check predicate on the "player_name" field. It's identical to PlayerNameEQ.
+func PlayerName(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldPlayerName, v))
+}
+
+// CardCount applies equality check predicate on the "card_count" field. It's identical to CardCountEQ.
+func CardCount(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldCardCount, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldSeed, v))
+}
+
+// TotalMs applies equality check predicate on the "total_ms" field. It's identical to TotalMsEQ.
+func TotalMs(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldTotalMs, v))
+}
+
+// Mistakes applies equality check predicate on the "mistakes" field. It's identical to MistakesEQ.
+func Mistakes(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldMistakes, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PlayerNameEQ applies the EQ predicate on the "player_name" field.
+func PlayerNameEQ(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldPlayerName, v))
+}
+
+// PlayerNameNEQ applies the NEQ predicate on the "player_name" field.
+func PlayerNameNEQ(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldPlayerName, v))
+}
+
+// PlayerNameIn applies the In predicate on the "player_name" field.
+func PlayerNameIn(vs ...string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldPlayerName, vs...))
+}
+
+// PlayerNameNotIn applies the NotIn predicate on the "player_name" field.
+func PlayerNameNotIn(vs ...string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldPlayerName, vs...))
+}
+
+// PlayerNameGT applies the GT predicate on the "player_name" field.
+func PlayerNameGT(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldPlayerName, v))
+}
+
+// PlayerNameGTE applies the GTE predicate on the "player_name" field.
+func PlayerNameGTE(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldPlayerName, v))
+}
+
+// PlayerNameLT applies the LT predicate on the "player_name" field.
+func PlayerNameLT(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldPlayerName, v))
+}
+
+// PlayerNameLTE applies the LTE predicate on the "player_name" field.
+func PlayerNameLTE(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldPlayerName, v))
+}
+
+// PlayerNameContains applies the Contains predicate on the "player_name" field.
+func PlayerNameContains(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldContains(FieldPlayerName, v))
+}
+
+// PlayerNameHasPrefix applies the HasPrefix predicate on the "player_name" field.
+func PlayerNameHasPrefix(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldHasPrefix(FieldPlayerName, v))
+}
+
+// PlayerNameHasSuffix applies the HasSuffix predicate on the "player_name" field.
+func PlayerNameHasSuffix(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldHasSuffix(FieldPlayerName, v))
+}
+
+// PlayerNameEqualFold applies the EqualFold predicate on the "player_name" field.
+func PlayerNameEqualFold(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEqualFold(FieldPlayerName, v))
+}
+
+// PlayerNameContainsFold applies the ContainsFold predicate on the "player_name" field.
+func PlayerNameContainsFold(v string) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldContainsFold(FieldPlayerName, v))
+}
+
+// CardCountEQ applies the EQ predicate on the "card_count" field.
+func CardCountEQ(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldCardCount, v))
+}
+
+// CardCountNEQ applies the NEQ predicate on the "card_count" field.
+func CardCountNEQ(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldCardCount, v))
+}
+
+// CardCountIn applies the In predicate on the "card_count" field.
+func CardCountIn(vs ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldCardCount, vs...))
+}
+
+// CardCountNotIn applies the NotIn predicate on the "card_count" field.
+func CardCountNotIn(vs ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldCardCount, vs...))
+}
+
+// CardCountGT applies the GT predicate on the "card_count" field.
+func CardCountGT(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldCardCount, v))
+}
+
+// CardCountGTE applies the GTE predicate on the "card_count" field.
+func CardCountGTE(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldCardCount, v))
+}
+
+// CardCountLT applies the LT predicate on the "card_count" field.
+func CardCountLT(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldCardCount, v))
+}
+
+// CardCountLTE applies the LTE predicate on the "card_count" field.
+func CardCountLTE(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldCardCount, v))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldSeed, v))
+}
+
+// TotalMsEQ applies the EQ predicate on the "total_ms" field.
+func TotalMsEQ(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldTotalMs, v))
+}
+
+// TotalMsNEQ applies the NEQ predicate on the "total_ms" field.
+func TotalMsNEQ(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldTotalMs, v))
+}
+
+// TotalMsIn applies the In predicate on the "total_ms" field.
+func TotalMsIn(vs ...int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldTotalMs, vs...))
+}
+
+// TotalMsNotIn applies the NotIn predicate on the "total_ms" field.
+func TotalMsNotIn(vs ...int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldTotalMs, vs...))
+}
+
+// TotalMsGT applies the GT predicate on the "total_ms" field.
+func TotalMsGT(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldTotalMs, v))
+}
+
+// TotalMsGTE applies the GTE predicate on the "total_ms" field.
+func TotalMsGTE(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldTotalMs, v))
+}
+
+// TotalMsLT applies the LT predicate on the "total_ms" field.
+func TotalMsLT(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldTotalMs, v))
+}
+
+// TotalMsLTE applies the LTE predicate on the "total_ms" field.
+func TotalMsLTE(v int64) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldTotalMs, v))
+}
+
+// MistakesEQ applies the EQ predicate on the "mistakes" field.
+func MistakesEQ(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldMistakes, v))
+}
+
+// MistakesNEQ applies the NEQ predicate on the "mistakes" field.
+func MistakesNEQ(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldMistakes, v))
+}
+
+// MistakesIn applies the In predicate on the "mistakes" field.
+func MistakesIn(vs ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldMistakes, vs...))
+}
+
+// MistakesNotIn applies the NotIn predicate on the "mistakes" field.
+func MistakesNotIn(vs ...int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldMistakes, vs...))
+}
+
+// MistakesGT applies the GT predicate on the "mistakes" field.
+func MistakesGT(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldMistakes, v))
+}
+
+// MistakesGTE applies the GTE predicate on the "mistakes" field.
+func MistakesGTE(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldMistakes, v))
+}
+
+// MistakesLT applies the LT predicate on the "mistakes" field.
+func MistakesLT(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldMistakes, v))
+}
+
+// MistakesLTE applies the LTE predicate on the "mistakes" field.
+func MistakesLTE(v int) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldMistakes, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PracticeRun) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PracticeRun) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PracticeRun) predicate.PracticeRun {
+	return predicate.PracticeRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,297 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/practicerun"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PracticeRunCreate is the builder for creating a PracticeRun entity.
+type PracticeRunCreate struct {
+	config
+	mutation *PracticeRunMutation
+	hooks    []Hook
+}
+
+// SetPlayerName sets the "player_name" field.
+func (prc *PracticeRunCreate) SetPlayerName(s string) *PracticeRunCreate {
+	prc.mutation.SetPlayerName(s)
+	return prc
+}
+
+// SetCardCount sets the "card_count" field.
+func (prc *PracticeRunCreate) SetCardCount(i int) *PracticeRunCreate {
+	prc.mutation.SetCardCount(i)
+	return prc
+}
+
+// SetSeed sets the "seed" field.
+func (prc *PracticeRunCreate) SetSeed(i int64) *PracticeRunCreate {
+	prc.mutation.SetSeed(i)
+	return prc
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (prc *PracticeRunCreate) SetTotalMs(i int64) *PracticeRunCreate {
+	prc.mutation.SetTotalMs(i)
+	return prc
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (prc *PracticeRunCreate) SetReactionMs(i []int64) *PracticeRunCreate {
+	prc.mutation.SetReactionMs(i)
+	return prc
+}
+
+// SetMistakes sets the "mistakes" field.
+func (prc *PracticeRunCreate) SetMistakes(i int) *PracticeRunCreate {
+	prc.mutation.SetMistakes(i)
+	return prc
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (prc *PracticeRunCreate) SetNillableMistakes(i *int) *PracticeRunCreate {
+	if i != nil {
+		prc.SetMistakes(*i)
+	}
+	return prc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (prc *PracticeRunCreate) SetCreatedAt(t time.Time) *PracticeRunCreate {
+	prc.mutation.SetCreatedAt(t)
+	return prc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (prc *PracticeRunCreate) SetNillableCreatedAt(t *time.Time) *PracticeRunCreate {
+	if t != nil {
+		prc.SetCreatedAt(*t)
+	}
+	return prc
+}
+
+// Mutation returns the PracticeRunMutation object of the builder.
+func (prc *PracticeRunCreate) Mutation() *PracticeRunMutation {
+	return prc.mutation
+}
+
+// Save creates the PracticeRun in the database.
+func (prc *PracticeRunCreate) Save(ctx context.Context) (*PracticeRun, error) {
+	prc.defaults()
+	return withHooks(ctx, prc.sqlSave, prc.mutation, prc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (prc *PracticeRunCreate) SaveX(ctx context.Context) *PracticeRun {
+	v, err := prc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prc *PracticeRunCreate) Exec(ctx context.Context) error {
+	_, err := prc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prc *PracticeRunCreate) ExecX(ctx context.Context) {
+	if err := prc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (prc *PracticeRunCreate) defaults() {
+	if _, ok := prc.mutation.Mistakes(); !ok {
+		v := practicerun.DefaultMistakes
+		prc.mutation.SetMistakes(v)
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		v := practicerun.DefaultCreatedAt()
+		prc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (prc *PracticeRunCreate) check() error {
+	if _, ok := prc.mutation.PlayerName(); !ok {
+		return &ValidationError{Name: "player_name", err: errors.New(`ent: missing required field "PracticeRun.player_name"`)}
+	}
+	if v, ok := prc.mutation.PlayerName(); ok {
+		if err := practicerun.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "PracticeRun.player_name": %w`, err)}
+		}
+	}
+	if _, ok := prc.mutation.CardCount(); !ok {
+		return &ValidationError{Name: "card_count", err: errors.New(`ent: missing required field "PracticeRun.card_count"`)}
+	}
+	if _, ok := prc.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "PracticeRun.seed"`)}
+	}
+	if _, ok := prc.mutation.TotalMs(); !ok {
+		return &ValidationError{Name: "total_ms", err: errors.New(`ent: missing required field "PracticeRun.total_ms"`)}
+	}
+	if _, ok := prc.mutation.ReactionMs(); !ok {
+		return &ValidationError{Name: "reaction_ms", err: errors.New(`ent: missing required field "PracticeRun.reaction_ms"`)}
+	}
+	if _, ok := prc.mutation.Mistakes(); !ok {
+		return &ValidationError{Name: "mistakes", err: errors.New(`ent: missing required field "PracticeRun.mistakes"`)}
+	}
+	if _, ok := prc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PracticeRun.created_at"`)}
+	}
+	return nil
+}
+
+func (prc *PracticeRunCreate) sqlSave(ctx context.Context) (*PracticeRun, error) {
+	if err := prc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := prc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, prc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	prc.mutation.id = &_node.ID
+	prc.mutation.done = true
+	return _node, nil
+}
+
+func (prc *PracticeRunCreate) createSpec() (*PracticeRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PracticeRun{config: prc.config}
+		_spec = sqlgraph.NewCreateSpec(practicerun.Table, sqlgraph.NewFieldSpec(practicerun.FieldID, field.TypeInt))
+	)
+	if value, ok := prc.mutation.PlayerName(); ok {
+		_spec.SetField(practicerun.FieldPlayerName, field.TypeString, value)
+		_node.PlayerName = value
+	}
+	if value, ok := prc.mutation.CardCount(); ok {
+		_spec.SetField(practicerun.FieldCardCount, field.TypeInt, value)
+		_node.CardCount = value
+	}
+	if value, ok := prc.mutation.Seed(); ok {
+		_spec.SetField(practicerun.FieldSeed, field.TypeInt64, value)
+		_node.Seed = value
+	}
+	if value, ok := prc.mutation.TotalMs(); ok {
+		_spec.SetField(practicerun.FieldTotalMs, field.TypeInt64, value)
+		_node.TotalMs = value
+	}
+	if value, ok := prc.mutation.ReactionMs(); ok {
+		_spec.SetField(practicerun.FieldReactionMs, field.TypeJSON, value)
+		_node.ReactionMs = value
+	}
+	if value, ok := prc.mutation.Mistakes(); ok {
+		_spec.SetField(practicerun.FieldMistakes, field.TypeInt, value)
+		_node.Mistakes = value
+	}
+	if value, ok := prc.mutation.CreatedAt(); ok {
+		_spec.SetField(practicerun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// PracticeRunCreateBulk is the builder for creating many PracticeRun entities in bulk.
+type PracticeRunCreateBulk struct {
+	config
+	err      error
+	builders []*PracticeRunCreate
+}
+
+// Save creates the PracticeRun entities in the database.
+func (prcb *PracticeRunCreateBulk) Save(ctx context.Context) ([]*PracticeRun, error) {
+	if prcb.err != nil {
+		return nil, prcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(prcb.builders))
+	nodes := make([]*PracticeRun, len(prcb.builders))
+	mutators := make([]Mutator, len(prcb.builders))
+	for i := range prcb.builders {
+		func(i int, root context.Context) {
+			builder := prcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PracticeRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, prcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, prcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, prcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (prcb *PracticeRunCreateBulk) SaveX(ctx context.Context) []*PracticeRun {
+	v, err := prcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (prcb *PracticeRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := prcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (prcb *PracticeRunCreateBulk) ExecX(ctx context.Context) {
+	if err := prcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,492 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/practicerun"
+	"example/ent/predicate"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// PracticeRunUpdate is the builder for updating PracticeRun entities.
+type PracticeRunUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PracticeRunMutation
+}
+
+// Where appends a list predicates to the PracticeRunUpdate builder.
+func (pru *PracticeRunUpdate) Where(ps ...predicate.PracticeRun) *PracticeRunUpdate {
+	pru.mutation.Where(ps...)
+	return pru
+}
+
+// SetPlayerName sets the "player_name" field.
+func (pru *PracticeRunUpdate) SetPlayerName(s string) *PracticeRunUpdate {
+	pru.mutation.SetPlayerName(s)
+	return pru
+}
+
+// SetNillablePlayerName sets the "player_name" field if the given value is not nil.
+func (pru *PracticeRunUpdate) SetNillablePlayerName(s *string) *PracticeRunUpdate {
+	if s != nil {
+		pru.SetPlayerName(*s)
+	}
+	return pru
+}
+
+// SetCardCount sets the "card_count" field.
+func (pru *PracticeRunUpdate) SetCardCount(i int) *PracticeRunUpdate {
+	pru.mutation.ResetCardCount()
+	pru.mutation.SetCardCount(i)
+	return pru
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (pru *PracticeRunUpdate) SetNillableCardCount(i *int) *PracticeRunUpdate {
+	if i != nil {
+		pru.SetCardCount(*i)
+	}
+	return pru
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (pru *PracticeRunUpdate) AddCardCount(i int) *PracticeRunUpdate {
+	pru.mutation.AddCardCount(i)
+	return pru
+}
+
+// SetSeed sets the "seed" field.
+func (pru *PracticeRunUpdate) SetSeed(i int64) *PracticeRunUpdate {
+	pru.mutation.ResetSeed()
+	pru.mutation.SetSeed(i)
+	return pru
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (pru *PracticeRunUpdate) SetNillableSeed(i *int64) *PracticeRunUpdate {
+	if i != nil {
+		pru.SetSeed(*i)
+	}
+	return pru
+}
+
+// AddSeed adds i to the "seed" field.
+func (pru *PracticeRunUpdate) AddSeed(i int64) *PracticeRunUpdate {
+	pru.mutation.AddSeed(i)
+	return pru
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (pru *PracticeRunUpdate) SetTotalMs(i int64) *PracticeRunUpdate {
+	pru.mutation.ResetTotalMs()
+	pru.mutation.SetTotalMs(i)
+	return pru
+}
+
+// SetNillableTotalMs sets the "total_ms" field if the given value is not nil.
+func (pru *PracticeRunUpdate) SetNillableTotalMs(i *int64) *PracticeRunUpdate {
+	if i != nil {
+		pru.SetTotalMs(*i)
+	}
+	return pru
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (pru *PracticeRunUpdate) AddTotalMs(i int64) *PracticeRunUpdate {
+	pru.mutation.AddTotalMs(i)
+	return pru
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (pru *PracticeRunUpdate) SetReactionMs(i []int64) *PracticeRunUpdate {
+	pru.mutation.SetReactionMs(i)
+	return pru
+}
+
+// AppendReactionMs appends i to the "reaction_ms" field.
+func (pru *PracticeRunUpdate) AppendReactionMs(i []int64) *PracticeRunUpdate {
+	pru.mutation.AppendReactionMs(i)
+	return pru
+}
+
+// SetMistakes sets the "mistakes" field.
+func (pru *PracticeRunUpdate) SetMistakes(i int) *PracticeRunUpdate {
+	pru.mutation.ResetMistakes()
+	pru.mutation.SetMistakes(i)
+	return pru
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (pru *PracticeRunUpdate) SetNillableMistakes(i *int) *PracticeRunUpdate {
+	if i != nil {
+		pru.SetMistakes(*i)
+	}
+	return pru
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (pru *PracticeRunUpdate) AddMistakes(i int) *PracticeRunUpdate {
+	pru.mutation.AddMistakes(i)
+	return pru
+}
+
+// Mutation returns the PracticeRunMutation object of the builder.
+func (pru *PracticeRunUpdate) Mutation() *PracticeRunMutation {
+	return pru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pru *PracticeRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pru.sqlSave, pru.mutation, pru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pru *PracticeRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := pru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pru *PracticeRunUpdate) Exec(ctx context.Context) error {
+	_, err := pru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pru *PracticeRunUpdate) ExecX(ctx context.Context) {
+	if err := pru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pru *PracticeRunUpdate) check() error {
+	if v, ok := pru.mutation.PlayerName(); ok {
+		if err := practicerun.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "PracticeRun.player_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pru *PracticeRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(practicerun.Table, practicerun.Columns, sqlgraph.NewFieldSpec(practicerun.FieldID, field.TypeInt))
+	if ps := pru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pru.mutation.PlayerName(); ok {
+		_spec.SetField(practicerun.FieldPlayerName, field.TypeString, value)
+	}
+	if value, ok := pru.mutation.CardCount(); ok {
+		_spec.SetField(practicerun.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedCardCount(); ok {
+		_spec.AddField(practicerun.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.Seed(); ok {
+		_spec.SetField(practicerun.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.AddedSeed(); ok {
+		_spec.AddField(practicerun.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.TotalMs(); ok {
+		_spec.SetField(practicerun.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.AddedTotalMs(); ok {
+		_spec.AddField(practicerun.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := pru.mutation.ReactionMs(); ok {
+		_spec.SetField(practicerun.FieldReactionMs, field.TypeJSON, value)
+	}
+	if value, ok := pru.mutation.AppendedReactionMs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, practicerun.FieldReactionMs, value)
+		})
+	}
+	if value, ok := pru.mutation.Mistakes(); ok {
+		_spec.SetField(practicerun.FieldMistakes, field.TypeInt, value)
+	}
+	if value, ok := pru.mutation.AddedMistakes(); ok {
+		_spec.AddField(practicerun.FieldMistakes, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{practicerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pru.mutation.done = true
+	return n, nil
+}
+
+// PracticeRunUpdateOne is the builder for updating a single PracticeRun entity.
+type PracticeRunUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PracticeRunMutation
+}
+
+// SetPlayerName sets the "player_name" field.
+func (pruo *PracticeRunUpdateOne) SetPlayerName(s string) *PracticeRunUpdateOne {
+	pruo.mutation.SetPlayerName(s)
+	return pruo
+}
+
+// SetNillablePlayerName sets the "player_name" field if the given value is not nil.
+func (pruo *PracticeRunUpdateOne) SetNillablePlayerName(s *string) *PracticeRunUpdateOne {
+	if s != nil {
+		pruo.SetPlayerName(*s)
+	}
+	return pruo
+}
+
+// SetCardCount sets the "card_count" field.
+func (pruo *PracticeRunUpdateOne) SetCardCount(i int) *PracticeRunUpdateOne {
+	pruo.mutation.ResetCardCount()
+	pruo.mutation.SetCardCount(i)
+	return pruo
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (pruo *PracticeRunUpdateOne) SetNillableCardCount(i *int) *PracticeRunUpdateOne {
+	if i != nil {
+		pruo.SetCardCount(*i)
+	}
+	return pruo
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (pruo *PracticeRunUpdateOne) AddCardCount(i int) *PracticeRunUpdateOne {
+	pruo.mutation.AddCardCount(i)
+	return pruo
+}
+
+// SetSeed sets the "seed" field.
+func (pruo *PracticeRunUpdateOne) SetSeed(i int64) *PracticeRunUpdateOne {
+	pruo.mutation.ResetSeed()
+	pruo.mutation.SetSeed(i)
+	return pruo
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (pruo *PracticeRunUpdateOne) SetNillableSeed(i *int64) *PracticeRunUpdateOne {
+	if i != nil {
+		pruo.SetSeed(*i)
+	}
+	return pruo
+}
+
+// AddSeed adds i to the "seed" field.
+func (pruo *PracticeRunUpdateOne) AddSeed(i int64) *PracticeRunUpdateOne {
+	pruo.mutation.AddSeed(i)
+	return pruo
+}
+
+// SetTotalMs sets the "total_ms" field.
+func (pruo *PracticeRunUpdateOne) SetTotalMs(i int64) *PracticeRunUpdateOne {
+	pruo.mutation.ResetTotalMs()
+	pruo.mutation.SetTotalMs(i)
+	return pruo
+}
+
+// SetNillableTotalMs sets the "total_ms" field if the given value is not nil.
+func (pruo *PracticeRunUpdateOne) SetNillableTotalMs(i *int64) *PracticeRunUpdateOne {
+	if i != nil {
+		pruo.SetTotalMs(*i)
+	}
+	return pruo
+}
+
+// AddTotalMs adds i to the "total_ms" field.
+func (pruo *PracticeRunUpdateOne) AddTotalMs(i int64) *PracticeRunUpdateOne {
+	pruo.mutation.AddTotalMs(i)
+	return pruo
+}
+
+// SetReactionMs sets the "reaction_ms" field.
+func (pruo *PracticeRunUpdateOne) SetReactionMs(i []int64) *PracticeRunUpdateOne {
+	pruo.mutation.SetReactionMs(i)
+	return pruo
+}
+
+// AppendReactionMs appends i to the "reaction_ms" field.
+func (pruo *PracticeRunUpdateOne) AppendReactionMs(i []int64) *PracticeRunUpdateOne {
+	pruo.mutation.AppendReactionMs(i)
+	return pruo
+}
+
+// SetMistakes sets the "mistakes" field.
+func (pruo *PracticeRunUpdateOne) SetMistakes(i int) *PracticeRunUpdateOne {
+	pruo.mutation.ResetMistakes()
+	pruo.mutation.SetMistakes(i)
+	return pruo
+}
+
+// SetNillableMistakes sets the "mistakes" field if the given value is not nil.
+func (pruo *PracticeRunUpdateOne) SetNillableMistakes(i *int) *PracticeRunUpdateOne {
+	if i != nil {
+		pruo.SetMistakes(*i)
+	}
+	return pruo
+}
+
+// AddMistakes adds i to the "mistakes" field.
+func (pruo *PracticeRunUpdateOne) AddMistakes(i int) *PracticeRunUpdateOne {
+	pruo.mutation.AddMistakes(i)
+	return pruo
+}
+
+// Mutation returns the PracticeRunMutation object of the builder.
+func (pruo *PracticeRunUpdateOne) Mutation() *PracticeRunMutation {
+	return pruo.mutation
+}
+
+// Where appends a list predicates to the PracticeRunUpdate builder.
+func (pruo *PracticeRunUpdateOne) Where(ps ...predicate.PracticeRun) *PracticeRunUpdateOne {
+	pruo.mutation.Where(ps...)
+	return pruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pruo *PracticeRunUpdateOne) Select(field string, fields ...string) *PracticeRunUpdateOne {
+	pruo.fields = append([]string{field}, fields...)
+	return pruo
+}
+
+// Save executes the query and returns the updated PracticeRun entity.
+func (pruo *PracticeRunUpdateOne) Save(ctx context.Context) (*PracticeRun, error) {
+	return withHooks(ctx, pruo.sqlSave, pruo.mutation, pruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pruo *PracticeRunUpdateOne) SaveX(ctx context.Context) *PracticeRun {
+	node, err := pruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pruo *PracticeRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := pruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pruo *PracticeRunUpdateOne) ExecX(ctx context.Context) {
+	if err := pruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pruo *PracticeRunUpdateOne) check() error {
+	if v, ok := pruo.mutation.PlayerName(); ok {
+		if err := practicerun.PlayerNameValidator(v); err != nil {
+			return &ValidationError{Name: "player_name", err: fmt.Errorf(`ent: validator failed for field "PracticeRun.player_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pruo *PracticeRunUpdateOne) sqlSave(ctx context.Context) (_node *PracticeRun, err error) {
+	if err := pruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(practicerun.Table, practicerun.Columns, sqlgraph.NewFieldSpec(practicerun.FieldID, field.TypeInt))
+	id, ok := pruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PracticeRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, practicerun.FieldID)
+		for _, f := range fields {
+			if !practicerun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != practicerun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pruo.mutation.PlayerName(); ok {
+		_spec.SetField(practicerun.FieldPlayerName, field.TypeString, value)
+	}
+	if value, ok := pruo.mutation.CardCount(); ok {
+		_spec.SetField(practicerun.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedCardCount(); ok {
+		_spec.AddField(practicerun.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.Seed(); ok {
+		_spec.SetField(practicerun.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.AddedSeed(); ok {
+		_spec.AddField(practicerun.FieldSeed, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.TotalMs(); ok {
+		_spec.SetField(practicerun.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.AddedTotalMs(); ok {
+		_spec.AddField(practicerun.FieldTotalMs, field.TypeInt64, value)
+	}
+	if value, ok := pruo.mutation.ReactionMs(); ok {
+		_spec.SetField(practicerun.FieldReactionMs, field.TypeJSON, value)
+	}
+	if value, ok := pruo.mutation.AppendedReactionMs(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, practicerun.FieldReactionMs, value)
+		})
+	}
+	if value, ok := pruo.mutation.Mistakes(); ok {
+		_spec.SetField(practicerun.FieldMistakes, field.TypeInt, value)
+	}
+	if value, ok := pruo.mutation.AddedMistakes(); ok {
+		_spec.AddField(practicerun.FieldMistakes, field.TypeInt, value)
+	}
+	_node = &PracticeRun{config: pruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{practicerun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pruo.mutation.done = true
+	return _node, nil
+}
@@ -6,14 +6,41 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Ban is the predicate function for ban builders.
+type Ban func(*sql.Selector)
+
 // Card is the predicate function for card builders.
 type Card func(*sql.Selector)
 
+// DailyChallenge is the predicate function for dailychallenge builders.
+type DailyChallenge func(*sql.Selector)
+
 // Game is the predicate function for game builders.
 type Game func(*sql.Selector)
 
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// Match is the predicate function for match builders.
+type Match func(*sql.Selector)
+
 // Player is the predicate function for player builders.
 type Player func(*sql.Selector)
+
+// PracticeRun is the predicate function for practicerun builders.
+type PracticeRun func(*sql.Selector)
+
+// Team is the predicate function for team builders.
+type Team func(*sql.Selector)
+
+// Tournament is the predicate function for tournament builders.
+type Tournament func(*sql.Selector)
+
+// TournamentEntrant is the predicate function for tournamententrant builders.
+type TournamentEntrant func(*sql.Selector)
+
+// TournamentMatch is the predicate function for tournamentmatch builders.
+type TournamentMatch func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
@@ -3,15 +3,49 @@
 package ent
 
 import (
+	"example/ent/ban"
+	"example/ent/dailychallenge"
 	"example/ent/game"
+	"example/ent/match"
 	"example/ent/player"
+	"example/ent/practicerun"
 	"example/ent/schema"
+	"example/ent/team"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/user"
+	"example/internal/scoring"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	banFields := schema.Ban{}.Fields()
+	_ = banFields
+	// banDescName is the schema descriptor for name field.
+	banDescName := banFields[0].Descriptor()
+	// ban.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ban.NameValidator = banDescName.Validators[0].(func(string) error)
+	// banDescCreatedAt is the schema descriptor for created_at field.
+	banDescCreatedAt := banFields[2].Descriptor()
+	// ban.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ban.DefaultCreatedAt = banDescCreatedAt.Default.(func() time.Time)
+	dailychallengeFields := schema.DailyChallenge{}.Fields()
+	_ = dailychallengeFields
+	// dailychallengeDescPlayerName is the schema descriptor for player_name field.
+	dailychallengeDescPlayerName := dailychallengeFields[1].Descriptor()
+	// dailychallenge.PlayerNameValidator is a validator for the "player_name" field. It is called by the builders before save.
+	dailychallenge.PlayerNameValidator = dailychallengeDescPlayerName.Validators[0].(func(string) error)
+	// dailychallengeDescMistakes is the schema descriptor for mistakes field.
+	dailychallengeDescMistakes := dailychallengeFields[7].Descriptor()
+	// dailychallenge.DefaultMistakes holds the default value on creation for the mistakes field.
+	dailychallenge.DefaultMistakes = dailychallengeDescMistakes.Default.(int)
+	// dailychallengeDescStartedAt is the schema descriptor for started_at field.
+	dailychallengeDescStartedAt := dailychallengeFields[8].Descriptor()
+	// dailychallenge.DefaultStartedAt holds the default value on creation for the started_at field.
+	dailychallenge.DefaultStartedAt = dailychallengeDescStartedAt.Default.(func() time.Time)
 	gameFields := schema.Game{}.Fields()
 	_ = gameFields
 	// gameDescName is the schema descriptor for name field.
@@ -22,6 +56,52 @@ func init() {
 	gameDescTotalRounds := gameFields[2].Descriptor()
 	// game.DefaultTotalRounds holds the default value on creation for the total_rounds field.
 	game.DefaultTotalRounds = gameDescTotalRounds.Default.(int)
+	// gameDescMode is the schema descriptor for mode field.
+	gameDescMode := gameFields[3].Descriptor()
+	// game.DefaultMode holds the default value on creation for the mode field.
+	game.DefaultMode = gameDescMode.Default.(string)
+	// gameDescTeamScore is the schema descriptor for team_score field.
+	gameDescTeamScore := gameFields[4].Descriptor()
+	// game.DefaultTeamScore holds the default value on creation for the team_score field.
+	game.DefaultTeamScore = gameDescTeamScore.Default.(int)
+	// gameDescEliminationInterval is the schema descriptor for elimination_interval field.
+	gameDescEliminationInterval := gameFields[5].Descriptor()
+	// game.DefaultEliminationInterval holds the default value on creation for the elimination_interval field.
+	game.DefaultEliminationInterval = gameDescEliminationInterval.Default.(int)
+	// gameDescCenterCount is the schema descriptor for center_count field.
+	gameDescCenterCount := gameFields[6].Descriptor()
+	// game.DefaultCenterCount holds the default value on creation for the center_count field.
+	game.DefaultCenterCount = gameDescCenterCount.Default.(int)
+	// gameDescScoring is the schema descriptor for scoring field.
+	gameDescScoring := gameFields[8].Descriptor()
+	// game.DefaultScoring holds the default value on creation for the scoring field.
+	game.DefaultScoring = gameDescScoring.Default.(scoring.Rules)
+	// gameDescMaxPlayers is the schema descriptor for max_players field.
+	gameDescMaxPlayers := gameFields[9].Descriptor()
+	// game.DefaultMaxPlayers holds the default value on creation for the max_players field.
+	game.DefaultMaxPlayers = gameDescMaxPlayers.Default.(int)
+	// gameDescMinPlayers is the schema descriptor for min_players field.
+	gameDescMinPlayers := gameFields[10].Descriptor()
+	// game.DefaultMinPlayers holds the default value on creation for the min_players field.
+	game.DefaultMinPlayers = gameDescMinPlayers.Default.(int)
+	// gameDescAutoStart is the schema descriptor for auto_start field.
+	gameDescAutoStart := gameFields[15].Descriptor()
+	// game.DefaultAutoStart holds the default value on creation for the auto_start field.
+	game.DefaultAutoStart = gameDescAutoStart.Default.(bool)
+	// gameDescCardCount is the schema descriptor for card_count field.
+	gameDescCardCount := gameFields[16].Descriptor()
+	// game.DefaultCardCount holds the default value on creation for the card_count field.
+	game.DefaultCardCount = gameDescCardCount.Default.(int)
+	// gameDescAutoBalance is the schema descriptor for auto_balance field.
+	gameDescAutoBalance := gameFields[18].Descriptor()
+	// game.DefaultAutoBalance holds the default value on creation for the auto_balance field.
+	game.DefaultAutoBalance = gameDescAutoBalance.Default.(bool)
+	matchFields := schema.Match{}.Fields()
+	_ = matchFields
+	// matchDescCreatedAt is the schema descriptor for created_at field.
+	matchDescCreatedAt := matchFields[4].Descriptor()
+	// match.DefaultCreatedAt holds the default value on creation for the created_at field.
+	match.DefaultCreatedAt = matchDescCreatedAt.Default.(func() time.Time)
 	playerFields := schema.Player{}.Fields()
 	_ = playerFields
 	// playerDescName is the schema descriptor for name field.
@@ -32,4 +112,96 @@ func init() {
 	playerDescScore := playerFields[2].Descriptor()
 	// player.DefaultScore holds the default value on creation for the score field.
 	player.DefaultScore = playerDescScore.Default.(int)
+	// playerDescWrongCount is the schema descriptor for wrong_count field.
+	playerDescWrongCount := playerFields[3].Descriptor()
+	// player.DefaultWrongCount holds the default value on creation for the wrong_count field.
+	player.DefaultWrongCount = playerDescWrongCount.Default.(int)
+	// playerDescStreak is the schema descriptor for streak field.
+	playerDescStreak := playerFields[4].Descriptor()
+	// player.DefaultStreak holds the default value on creation for the streak field.
+	player.DefaultStreak = playerDescStreak.Default.(int)
+	// playerDescIsHost is the schema descriptor for is_host field.
+	playerDescIsHost := playerFields[5].Descriptor()
+	// player.DefaultIsHost holds the default value on creation for the is_host field.
+	player.DefaultIsHost = playerDescIsHost.Default.(bool)
+	// playerDescIsBot is the schema descriptor for is_bot field.
+	playerDescIsBot := playerFields[6].Descriptor()
+	// player.DefaultIsBot holds the default value on creation for the is_bot field.
+	player.DefaultIsBot = playerDescIsBot.Default.(bool)
+	// playerDescSeriesWins is the schema descriptor for series_wins field.
+	playerDescSeriesWins := playerFields[7].Descriptor()
+	// player.DefaultSeriesWins holds the default value on creation for the series_wins field.
+	player.DefaultSeriesWins = playerDescSeriesWins.Default.(int)
+	// playerDescMuted is the schema descriptor for muted field.
+	playerDescMuted := playerFields[13].Descriptor()
+	// player.DefaultMuted holds the default value on creation for the muted field.
+	player.DefaultMuted = playerDescMuted.Default.(bool)
+	practicerunFields := schema.PracticeRun{}.Fields()
+	_ = practicerunFields
+	// practicerunDescPlayerName is the schema descriptor for player_name field.
+	practicerunDescPlayerName := practicerunFields[0].Descriptor()
+	// practicerun.PlayerNameValidator is a validator for the "player_name" field. It is called by the builders before save.
+	practicerun.PlayerNameValidator = practicerunDescPlayerName.Validators[0].(func(string) error)
+	// practicerunDescMistakes is the schema descriptor for mistakes field.
+	practicerunDescMistakes := practicerunFields[5].Descriptor()
+	// practicerun.DefaultMistakes holds the default value on creation for the mistakes field.
+	practicerun.DefaultMistakes = practicerunDescMistakes.Default.(int)
+	// practicerunDescCreatedAt is the schema descriptor for created_at field.
+	practicerunDescCreatedAt := practicerunFields[6].Descriptor()
+	// practicerun.DefaultCreatedAt holds the default value on creation for the created_at field.
+	practicerun.DefaultCreatedAt = practicerunDescCreatedAt.Default.(func() time.Time)
+	teamFields := schema.Team{}.Fields()
+	_ = teamFields
+	// teamDescName is the schema descriptor for name field.
+	teamDescName := teamFields[0].Descriptor()
+	// team.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	team.NameValidator = teamDescName.Validators[0].(func(string) error)
+	// teamDescScore is the schema descriptor for score field.
+	teamDescScore := teamFields[1].Descriptor()
+	// team.DefaultScore holds the default value on creation for the score field.
+	team.DefaultScore = teamDescScore.Default.(int)
+	tournamentFields := schema.Tournament{}.Fields()
+	_ = tournamentFields
+	// tournamentDescName is the schema descriptor for name field.
+	tournamentDescName := tournamentFields[0].Descriptor()
+	// tournament.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tournament.NameValidator = tournamentDescName.Validators[0].(func(string) error)
+	// tournamentDescMode is the schema descriptor for mode field.
+	tournamentDescMode := tournamentFields[3].Descriptor()
+	// tournament.DefaultMode holds the default value on creation for the mode field.
+	tournament.DefaultMode = tournamentDescMode.Default.(string)
+	// tournamentDescCardCount is the schema descriptor for card_count field.
+	tournamentDescCardCount := tournamentFields[4].Descriptor()
+	// tournament.DefaultCardCount holds the default value on creation for the card_count field.
+	tournament.DefaultCardCount = tournamentDescCardCount.Default.(int)
+	// tournamentDescCreatedAt is the schema descriptor for created_at field.
+	tournamentDescCreatedAt := tournamentFields[7].Descriptor()
+	// tournament.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tournament.DefaultCreatedAt = tournamentDescCreatedAt.Default.(func() time.Time)
+	tournamententrantFields := schema.TournamentEntrant{}.Fields()
+	_ = tournamententrantFields
+	// tournamententrantDescName is the schema descriptor for name field.
+	tournamententrantDescName := tournamententrantFields[0].Descriptor()
+	// tournamententrant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tournamententrant.NameValidator = tournamententrantDescName.Validators[0].(func(string) error)
+	tournamentmatchFields := schema.TournamentMatch{}.Fields()
+	_ = tournamentmatchFields
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescName is the schema descriptor for name field.
+	userDescName := userFields[0].Descriptor()
+	// user.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	user.NameValidator = userDescName.Validators[0].(func(string) error)
+	// userDescRating is the schema descriptor for rating field.
+	userDescRating := userFields[2].Descriptor()
+	// user.DefaultRating holds the default value on creation for the rating field.
+	user.DefaultRating = userDescRating.Default.(float64)
+	// userDescRatedGames is the schema descriptor for rated_games field.
+	userDescRatedGames := userFields[3].Descriptor()
+	// user.DefaultRatedGames holds the default value on creation for the rated_games field.
+	user.DefaultRatedGames = userDescRatedGames.Default.(int)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 }
@@ -1,9 +1,16 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"example/internal/handicap"
+	"example/internal/rating"
+	"example/internal/scoring"
 )
 
 /*********
@@ -19,10 +26,67 @@ func (Game) Fields() []ent.Field {
 	return []ent.Field{
 		field.Text("name").NotEmpty(),
 		field.Enum("status").
-			Values("CREATED", "STARTED", "FINISHED").
+			Values("CREATED", "STARTED", "PAUSED", "FINISHED").
 			Default("CREATED"),
 		field.Int("total_rounds").
 			Default(0),
+		// ゲームモード名（internal/gamemodeに登録されたもの）
+		field.String("mode").
+			Default("NORMAL"),
+		// 協力モードのチームスコア
+		field.Int("team_score").
+			Default(0),
+		// バトルロイヤルモードで脱落者を決めるラウンド間隔
+		field.Int("elimination_interval").
+			Default(3),
+		// スピードモードで同時に場に出すカード枚数
+		field.Int("center_count").
+			Default(3),
+		// デッキ終了時に同点だった場合のサドンデスの方式
+		field.Enum("tie_break").
+			Values("NONE", "REDRAW", "FRESH_DECK").
+			Default("NONE"),
+		// 得点ルール（結果を後から説明できるようにゲームごとに保存）
+		field.JSON("scoring", scoring.Rules{}).
+			Default(scoring.Default()),
+		// 参加人数の上限（0は無制限）
+		field.Int("max_players").
+			Default(0),
+		// 開始に必要な人数
+		field.Int("min_players").
+			Default(1),
+		// PRIVATEのゲームはロビーの一覧に表示しない
+		field.Enum("visibility").
+			Values("PUBLIC", "PRIVATE").
+			Default("PUBLIC"),
+		// 招待コード。PRIVATEやパスワード付きのゲームはこれかパスワードがないと参加できない
+		field.String("invite_code").
+			Optional().
+			Sensitive(),
+		// 参加に必要なパスワードのハッシュ（未設定ならパスワードなし）
+		field.String("password_hash").
+			Optional().
+			Sensitive(),
+		// 作成者に渡すトークン。JoinGameでこれを示したプレイヤーがホストになる
+		field.String("host_token").
+			Optional().
+			Sensitive(),
+		// 参加人数が揃ったら自動で開始する
+		field.Bool("auto_start").
+			Default(false),
+		// 使用したデッキの枚数（再戦で同じ枚数のデッキを作る）
+		field.Int("card_count").
+			Default(0),
+		// 勝者のプレイヤーID（チーム戦や勝者なしの場合は未設定）
+		field.Int("winner_id").
+			Optional().
+			Nillable(),
+		// チーム対抗モードで人数が揃うようにチームを自動で振り分ける
+		field.Bool("auto_balance").
+			Default(false),
+		// 複数ゲームの対戦（シリーズ）の一部であればその対戦
+		field.Int("match_id").
+			Optional(),
 	}
 }
 
@@ -30,6 +94,60 @@ func (Game) Fields() []ent.Field {
 func (Game) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("players", Player.Type).Ref("parent"),
+		edge.From("teams", Team.Type).Ref("parent"),
+		// トーナメントの試合として作られたゲームの試合
+		edge.From("tournament_matches", TournamentMatch.Type).Ref("game"),
+		edge.To("match", Match.Type).
+			Field("match_id").
+			Unique(),
+		// 再戦で作られた次のゲーム（previousは元のゲーム）
+		edge.To("rematch", Game.Type).
+			Unique().
+			From("previous").
+			Unique(),
+	}
+}
+
+// Indexes of the Game.
+func (Game) Indexes() []ent.Index {
+	return []ent.Index{
+		// 招待コードだけで参加するゲームを探す
+		index.Fields("invite_code"),
+	}
+}
+
+/*********
+  Match
+*********/
+// Match holds the schema definition for a series of consecutive games among the same players.
+type Match struct {
+	ent.Schema
+}
+
+// Fields of the Match.
+func (Match) Fields() []ent.Field {
+	return []ent.Field{
+		// BEST_OF: length試合のうち過半数を先に勝った方、FIRST_TO: 先にlength勝した方が勝ち
+		field.Enum("format").
+			Values("BEST_OF", "FIRST_TO"),
+		field.Int("length"),
+		field.Enum("status").
+			Values("PLAYING", "FINISHED").
+			Default("PLAYING"),
+		// 対戦の勝者の名前（決まるまでは未設定）
+		field.Text("winner_name").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Match.
+func (Match) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("games", Game.Type).Ref("match"),
 	}
 }
 
@@ -46,10 +164,47 @@ func (Player) Fields() []ent.Field {
 	return []ent.Field{
 		field.Text("name").NotEmpty(),
 		field.Enum("status").
-			Values("JOINING", "STARTED", "READY", "PLAYING", "FINISHED").
+			Values("JOINING", "STARTED", "READY", "PLAYING", "FINISHED", "ELIMINATED", "DISCONNECTED").
 			Default("JOINING"),
 		field.Int("score").
 			Default(0),
+		// 脱落判定のタイブレークに使う不正解数
+		field.Int("wrong_count").
+			Default(0),
+		// 連続正解数
+		field.Int("streak").
+			Default(0),
+		// ゲームを開始・設定変更・キックできるホスト（作成時のホスト用トークンで参加した作成者。抜けると他のプレイヤーに移る）
+		field.Bool("is_host").
+			Default(false),
+		// サーバー内で動くボット
+		field.Bool("is_bot").
+			Default(false),
+		// 再戦を続けたシリーズでの勝利数（このゲームの勝利を含む）。シリーズの勝利数はこれだけで数える
+		field.Int("series_wins").
+			Default(0),
+		// 再戦を続けたシリーズを通したプレイヤーの識別子（シリーズ最初のゲームでのplayer_id。最初のゲームでは0）
+		field.Int("series_id").
+			Optional(),
+		// 切断後に再接続するときの本人確認用トークン
+		field.String("resume_token").
+			Optional().
+			Sensitive(),
+		// チーム対抗モードで所属するチーム（未所属は0）
+		field.Int("team_id").
+			Optional(),
+		// ホストが設定したハンデ
+		field.JSON("handicap", handicap.Handicap{}).
+			Optional(),
+		// 登録ユーザーとして参加した場合のユーザー（レーティングの対象）
+		field.Int("user_id").
+			Optional(),
+		// ホストにチャットを禁止されている
+		field.Bool("muted").
+			Default(false),
+		// トーナメントの試合に参加した参加者のシード（トーナメント外は0）
+		field.Int("tournament_seed").
+			Optional(),
 	}
 }
 
@@ -57,6 +212,109 @@ func (Player) Fields() []ent.Field {
 func (Player) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("parent", Game.Type).Unique(),
+		edge.To("team", Team.Type).
+			Field("team_id").
+			Unique(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique(),
+	}
+}
+
+/*********
+  Ban
+*********/
+// Ban holds the schema definition for a player the host banned from rejoining a game.
+type Ban struct {
+	ent.Schema
+}
+
+// Fields of the Ban.
+func (Ban) Fields() []ent.Field {
+	return []ent.Field{
+		// 同じ名前での再参加を拒否する
+		field.Text("name").NotEmpty(),
+		// 登録ユーザーだった場合は名前を変えても再参加を拒否する
+		field.Int("user_id").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Ban.
+func (Ban) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("game", Game.Type).
+			Unique().
+			Required(),
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique(),
+	}
+}
+
+/*********
+  User
+*********/
+// User holds the schema definition for a registered player kept across games.
+type User struct {
+	ent.Schema
+}
+
+// Fields of the User.
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("name").
+			NotEmpty().
+			Unique(),
+		// ゲームやキューに参加するときの本人確認用トークン
+		field.String("token").
+			Unique().
+			Sensitive(),
+		// ゲームの順位から更新するレーティング（Elo）
+		field.Float("rating").
+			Default(rating.Initial),
+		// レーティングの対象になったゲーム数
+		field.Int("rated_games").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the User.
+func (User) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("players", Player.Type).Ref("user"),
+	}
+}
+
+/*********
+  Team
+*********/
+// Team holds the schema definition for a team in a teams mode game.
+type Team struct {
+	ent.Schema
+}
+
+// Fields of the Team.
+func (Team) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("name").NotEmpty(),
+		// メンバーの得点の合計
+		field.Int("score").
+			Default(0),
+	}
+}
+
+// Edges of the Team.
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("parent", Game.Type).Unique(),
+		edge.From("members", Player.Type).Ref("team"),
 	}
 }
 
@@ -101,6 +359,219 @@ func (Item) Edges() []ent.Edge {
 	}
 }
 
+/*********
+  PracticeRun
+*********/
+
+// PracticeRun holds the schema definition for a finished solo time-attack run.
+type PracticeRun struct {
+	ent.Schema
+}
+
+// Fields of the PracticeRun.
+func (PracticeRun) Fields() []ent.Field {
+	return []ent.Field{
+		// 自己ベストはプレイヤー名とデッキサイズごとに記録する
+		field.Text("player_name").NotEmpty(),
+		field.Int("card_count"),
+		// デッキの並びを再現するためのシード
+		field.Int64("seed"),
+		field.Int64("total_ms"),
+		// カードごとの反応時間（ミリ秒）
+		field.JSON("reaction_ms", []int64{}),
+		field.Int("mistakes").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the PracticeRun.
+func (PracticeRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("player_name", "card_count", "total_ms"),
+	}
+}
+
+/*********
+  DailyChallenge
+*********/
+
+// DailyChallenge holds the schema definition for a ranked attempt at the daily challenge.
+type DailyChallenge struct {
+	ent.Schema
+}
+
+// Fields of the DailyChallenge.
+func (DailyChallenge) Fields() []ent.Field {
+	return []ent.Field{
+		// 日付（YYYY-MM-DD）
+		field.String("day"),
+		field.Text("player_name").NotEmpty(),
+		// 挑戦した登録ユーザー。ランキングはユーザーごとに1日1回
+		field.Int("user_id").
+			Optional(),
+		field.Int64("seed"),
+		field.Int("card_count"),
+		// 最後までプレイしていなければ未設定
+		field.Int64("total_ms").
+			Optional().
+			Nillable(),
+		field.JSON("reaction_ms", []int64{}).
+			Optional(),
+		field.Int("mistakes").
+			Default(0),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the DailyChallenge.
+func (DailyChallenge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes of the DailyChallenge.
+func (DailyChallenge) Indexes() []ent.Index {
+	return []ent.Index{
+		// ランキング対象の挑戦は1日1人1回
+		index.Fields("day", "user_id").Unique(),
+	}
+}
+
+/*********
+  Tournament
+*********/
+
+// Tournament holds the schema definition for a bracket spanning multiple games.
+type Tournament struct {
+	ent.Schema
+}
+
+// Fields of the Tournament.
+func (Tournament) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("name").NotEmpty(),
+		field.Enum("format").
+			Values("SINGLE_ELIMINATION", "DOUBLE_ELIMINATION").
+			Default("SINGLE_ELIMINATION"),
+		field.Enum("status").
+			Values("REGISTERING", "STARTED", "FINISHED").
+			Default("REGISTERING"),
+		// 各試合のゲームモードとデッキの枚数
+		field.String("mode").
+			Default("NORMAL"),
+		field.Int("card_count").
+			Default(0),
+		// 参加者の登録と開始ができる主催者の確認用トークン
+		field.String("organizer_token").
+			Sensitive(),
+		// 優勝者のシード（決まるまでは未設定）
+		field.Int("champion_seed").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Tournament.
+func (Tournament) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("entrants", TournamentEntrant.Type).Ref("tournament"),
+		edge.From("matches", TournamentMatch.Type).Ref("tournament"),
+	}
+}
+
+/*********
+  TournamentEntrant
+*********/
+
+// TournamentEntrant holds the schema definition for a registered player of a tournament.
+type TournamentEntrant struct {
+	ent.Schema
+}
+
+// Fields of the TournamentEntrant.
+func (TournamentEntrant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Text("name").NotEmpty(),
+		// 登録順のシード（1が第1シード）
+		field.Int("seed"),
+		// 試合のゲームに参加するときの本人確認用トークン
+		field.String("entrant_token").
+			Optional().
+			Sensitive(),
+	}
+}
+
+// Edges of the TournamentEntrant.
+func (TournamentEntrant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tournament", Tournament.Type).
+			Unique().
+			Required(),
+	}
+}
+
+/*********
+  TournamentMatch
+*********/
+
+// TournamentMatch holds the schema definition for a match of a tournament bracket.
+type TournamentMatch struct {
+	ent.Schema
+}
+
+// Fields of the TournamentMatch.
+func (TournamentMatch) Fields() []ent.Field {
+	return []ent.Field{
+		// ブラケット内の試合番号（internal/tournamentの並び）
+		field.Int("number"),
+		field.Enum("side").
+			Values("WINNERS", "LOSERS", "GRAND_FINAL"),
+		field.Int("round"),
+		field.Enum("status").
+			Values("PENDING", "PLAYING", "FINISHED").
+			Default("PENDING"),
+		// 勝者のシード（不戦勝を含む。両者不在の試合は0）
+		field.Int("winner_seed").
+			Optional().
+			Nillable(),
+		// 試合のゲーム（不戦勝の試合にはない）
+		field.Int("game_id").
+			Optional(),
+	}
+}
+
+// Edges of the TournamentMatch.
+func (TournamentMatch) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("tournament", Tournament.Type).
+			Unique().
+			Required(),
+		edge.To("game", Game.Type).
+			Field("game_id").
+			Unique(),
+	}
+}
+
+// Indexes of the TournamentMatch.
+func (TournamentMatch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("number").
+			Edges("tournament").
+			Unique(),
+	}
+}
+
 /* sample
 func (Todo) Fields() []ent.Field {
 	return []ent.Field{
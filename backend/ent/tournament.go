@@ -0,0 +1,227 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"example/ent/tournament"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Tournament is the model entity for the Tournament schema.
+type Tournament struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Format holds the value of the "format" field.
+	Format tournament.Format `json:"format,omitempty"`
+	// Status holds the value of the "status" field.
+	Status tournament.Status `json:"status,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode string `json:"mode,omitempty"`
+	// CardCount holds the value of the "card_count" field.
+	CardCount int `json:"card_count,omitempty"`
+	// OrganizerToken holds the value of the "organizer_token" field.
+	OrganizerToken string `json:"-"`
+	// ChampionSeed holds the value of the "champion_seed" field.
+	ChampionSeed *int `json:"champion_seed,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TournamentQuery when eager-loading is set.
+	Edges        TournamentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TournamentEdges holds the relations/edges for other nodes in the graph.
+type TournamentEdges struct {
+	// Entrants holds the value of the entrants edge.
+	Entrants []*TournamentEntrant `json:"entrants,omitempty"`
+	// Matches holds the value of the matches edge.
+	Matches []*TournamentMatch `json:"matches,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// EntrantsOrErr returns the Entrants value or an error if the edge
+// was not loaded in eager-loading.
+func (e TournamentEdges) EntrantsOrErr() ([]*TournamentEntrant, error) {
+	if e.loadedTypes[0] {
+		return e.Entrants, nil
+	}
+	return nil, &NotLoadedError{edge: "entrants"}
+}
+
+// MatchesOrErr returns the Matches value or an error if the edge
+// was not loaded in eager-loading.
+func (e TournamentEdges) MatchesOrErr() ([]*TournamentMatch, error) {
+	if e.loadedTypes[1] {
+		return e.Matches, nil
+	}
+	return nil, &NotLoadedError{edge: "matches"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Tournament) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tournament.FieldID, tournament.FieldCardCount, tournament.FieldChampionSeed:
+			values[i] = new(sql.NullInt64)
+		case tournament.FieldName, tournament.FieldFormat, tournament.FieldStatus, tournament.FieldMode, tournament.FieldOrganizerToken:
+			values[i] = new(sql.NullString)
+		case tournament.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Tournament fields.
+func (t *Tournament) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tournament.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			t.ID = int(value.Int64)
+		case tournament.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				t.Name = value.String
+			}
+		case tournament.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				t.Format = tournament.Format(value.String)
+			}
+		case tournament.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				t.Status = tournament.Status(value.String)
+			}
+		case tournament.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				t.Mode = value.String
+			}
+		case tournament.FieldCardCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field card_count", values[i])
+			} else if value.Valid {
+				t.CardCount = int(value.Int64)
+			}
+		case tournament.FieldOrganizerToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field organizer_token", values[i])
+			} else if value.Valid {
+				t.OrganizerToken = value.String
+			}
+		case tournament.FieldChampionSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field champion_seed", values[i])
+			} else if value.Valid {
+				t.ChampionSeed = new(int)
+				*t.ChampionSeed = int(value.Int64)
+			}
+		case tournament.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				t.CreatedAt = value.Time
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Tournament.
+// This includes values selected through modifiers, order, etc.
+func (t *Tournament) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// QueryEntrants queries the "entrants" edge of the Tournament entity.
+func (t *Tournament) QueryEntrants() *TournamentEntrantQuery {
+	return NewTournamentClient(t.config).QueryEntrants(t)
+}
+
+// QueryMatches queries the "matches" edge of the Tournament entity.
+func (t *Tournament) QueryMatches() *TournamentMatchQuery {
+	return NewTournamentClient(t.config).QueryMatches(t)
+}
+
+// Update returns a builder for updating this Tournament.
+// Note that you need to call Tournament.Unwrap() before calling this method if this Tournament
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Tournament) Update() *TournamentUpdateOne {
+	return NewTournamentClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Tournament entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Tournament) Unwrap() *Tournament {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Tournament is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Tournament) String() string {
+	var builder strings.Builder
+	builder.WriteString("Tournament(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("name=")
+	builder.WriteString(t.Name)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", t.Format))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", t.Status))
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(t.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("card_count=")
+	builder.WriteString(fmt.Sprintf("%v", t.CardCount))
+	builder.WriteString(", ")
+	builder.WriteString("organizer_token=<sensitive>")
+	builder.WriteString(", ")
+	if v := t.ChampionSeed; v != nil {
+		builder.WriteString("champion_seed=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(t.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tournaments is a parsable slice of Tournament.
+type Tournaments []*Tournament
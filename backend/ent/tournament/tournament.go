@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournament
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the tournament type in the database.
+	Label = "tournament"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldCardCount holds the string denoting the card_count field in the database.
+	FieldCardCount = "card_count"
+	// FieldOrganizerToken holds the string denoting the organizer_token field in the database.
+	FieldOrganizerToken = "organizer_token"
+	// FieldChampionSeed holds the string denoting the champion_seed field in the database.
+	FieldChampionSeed = "champion_seed"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeEntrants holds the string denoting the entrants edge name in mutations.
+	EdgeEntrants = "entrants"
+	// EdgeMatches holds the string denoting the matches edge name in mutations.
+	EdgeMatches = "matches"
+	// Table holds the table name of the tournament in the database.
+	Table = "tournaments"
+	// EntrantsTable is the table that holds the entrants relation/edge.
+	EntrantsTable = "tournament_entrants"
+	// EntrantsInverseTable is the table name for the TournamentEntrant entity.
+	// It exists in this package in order to avoid circular dependency with the "tournamententrant" package.
+	EntrantsInverseTable = "tournament_entrants"
+	// EntrantsColumn is the table column denoting the entrants relation/edge.
+	EntrantsColumn = "tournament_entrant_tournament"
+	// MatchesTable is the table that holds the matches relation/edge.
+	MatchesTable = "tournament_matches"
+	// MatchesInverseTable is the table name for the TournamentMatch entity.
+	// It exists in this package in order to avoid circular dependency with the "tournamentmatch" package.
+	MatchesInverseTable = "tournament_matches"
+	// MatchesColumn is the table column denoting the matches relation/edge.
+	MatchesColumn = "tournament_match_tournament"
+)
+
+// Columns holds all SQL columns for tournament fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldFormat,
+	FieldStatus,
+	FieldMode,
+	FieldCardCount,
+	FieldOrganizerToken,
+	FieldChampionSeed,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode string
+	// DefaultCardCount holds the default value on creation for the "card_count" field.
+	DefaultCardCount int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// FormatSINGLE_ELIMINATION is the default value of the Format enum.
+const DefaultFormat = FormatSINGLE_ELIMINATION
+
+// Format values.
+const (
+	FormatSINGLE_ELIMINATION Format = "SINGLE_ELIMINATION"
+	FormatDOUBLE_ELIMINATION Format = "DOUBLE_ELIMINATION"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatSINGLE_ELIMINATION, FormatDOUBLE_ELIMINATION:
+		return nil
+	default:
+		return fmt.Errorf("tournament: invalid enum value for format field: %q", f)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusREGISTERING is the default value of the Status enum.
+const DefaultStatus = StatusREGISTERING
+
+// Status values.
+const (
+	StatusREGISTERING Status = "REGISTERING"
+	StatusSTARTED     Status = "STARTED"
+	StatusFINISHED    Status = "FINISHED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusREGISTERING, StatusSTARTED, StatusFINISHED:
+		return nil
+	default:
+		return fmt.Errorf("tournament: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Tournament queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByCardCount orders the results by the card_count field.
+func ByCardCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCardCount, opts...).ToFunc()
+}
+
+// ByOrganizerToken orders the results by the organizer_token field.
+func ByOrganizerToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizerToken, opts...).ToFunc()
+}
+
+// ByChampionSeed orders the results by the champion_seed field.
+func ByChampionSeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChampionSeed, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByEntrantsCount orders the results by entrants count.
+func ByEntrantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEntrantsStep(), opts...)
+	}
+}
+
+// ByEntrants orders the results by entrants terms.
+func ByEntrants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEntrantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMatchesCount orders the results by matches count.
+func ByMatchesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMatchesStep(), opts...)
+	}
+}
+
+// ByMatches orders the results by matches terms.
+func ByMatches(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newEntrantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EntrantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, EntrantsTable, EntrantsColumn),
+	)
+}
+func newMatchesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MatchesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, MatchesTable, MatchesColumn),
+	)
+}
@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournament
+
+import (
+	"example/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldName, v))
+}
+
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldMode, v))
+}
+
+// CardCount applies equality check predicate on the "card_count" field. It's identical to CardCountEQ.
+func CardCount(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldCardCount, v))
+}
+
+// OrganizerToken applies equality check predicate on the "organizer_token" field. It's identical to OrganizerTokenEQ.
+func OrganizerToken(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldOrganizerToken, v))
+}
+
+// ChampionSeed applies equality check predicate on the "champion_seed" field. It's identical to ChampionSeedEQ.
+func ChampionSeed(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldChampionSeed, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContainsFold(FieldName, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContainsFold(FieldMode, v))
+}
+
+// CardCountEQ applies the EQ predicate on the "card_count" field.
+func CardCountEQ(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldCardCount, v))
+}
+
+// CardCountNEQ applies the NEQ predicate on the "card_count" field.
+func CardCountNEQ(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldCardCount, v))
+}
+
+// CardCountIn applies the In predicate on the "card_count" field.
+func CardCountIn(vs ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldCardCount, vs...))
+}
+
+// CardCountNotIn applies the NotIn predicate on the "card_count" field.
+func CardCountNotIn(vs ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldCardCount, vs...))
+}
+
+// CardCountGT applies the GT predicate on the "card_count" field.
+func CardCountGT(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldCardCount, v))
+}
+
+// CardCountGTE applies the GTE predicate on the "card_count" field.
+func CardCountGTE(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldCardCount, v))
+}
+
+// CardCountLT applies the LT predicate on the "card_count" field.
+func CardCountLT(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldCardCount, v))
+}
+
+// CardCountLTE applies the LTE predicate on the "card_count" field.
+func CardCountLTE(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldCardCount, v))
+}
+
+// OrganizerTokenEQ applies the EQ predicate on the "organizer_token" field.
+func OrganizerTokenEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenNEQ applies the NEQ predicate on the "organizer_token" field.
+func OrganizerTokenNEQ(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenIn applies the In predicate on the "organizer_token" field.
+func OrganizerTokenIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldOrganizerToken, vs...))
+}
+
+// OrganizerTokenNotIn applies the NotIn predicate on the "organizer_token" field.
+func OrganizerTokenNotIn(vs ...string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldOrganizerToken, vs...))
+}
+
+// OrganizerTokenGT applies the GT predicate on the "organizer_token" field.
+func OrganizerTokenGT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenGTE applies the GTE predicate on the "organizer_token" field.
+func OrganizerTokenGTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenLT applies the LT predicate on the "organizer_token" field.
+func OrganizerTokenLT(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenLTE applies the LTE predicate on the "organizer_token" field.
+func OrganizerTokenLTE(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenContains applies the Contains predicate on the "organizer_token" field.
+func OrganizerTokenContains(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContains(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenHasPrefix applies the HasPrefix predicate on the "organizer_token" field.
+func OrganizerTokenHasPrefix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasPrefix(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenHasSuffix applies the HasSuffix predicate on the "organizer_token" field.
+func OrganizerTokenHasSuffix(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldHasSuffix(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenEqualFold applies the EqualFold predicate on the "organizer_token" field.
+func OrganizerTokenEqualFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEqualFold(FieldOrganizerToken, v))
+}
+
+// OrganizerTokenContainsFold applies the ContainsFold predicate on the "organizer_token" field.
+func OrganizerTokenContainsFold(v string) predicate.Tournament {
+	return predicate.Tournament(sql.FieldContainsFold(FieldOrganizerToken, v))
+}
+
+// ChampionSeedEQ applies the EQ predicate on the "champion_seed" field.
+func ChampionSeedEQ(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldChampionSeed, v))
+}
+
+// ChampionSeedNEQ applies the NEQ predicate on the "champion_seed" field.
+func ChampionSeedNEQ(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldChampionSeed, v))
+}
+
+// ChampionSeedIn applies the In predicate on the "champion_seed" field.
+func ChampionSeedIn(vs ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldChampionSeed, vs...))
+}
+
+// ChampionSeedNotIn applies the NotIn predicate on the "champion_seed" field.
+func ChampionSeedNotIn(vs ...int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldChampionSeed, vs...))
+}
+
+// ChampionSeedGT applies the GT predicate on the "champion_seed" field.
+func ChampionSeedGT(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldChampionSeed, v))
+}
+
+// ChampionSeedGTE applies the GTE predicate on the "champion_seed" field.
+func ChampionSeedGTE(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldChampionSeed, v))
+}
+
+// ChampionSeedLT applies the LT predicate on the "champion_seed" field.
+func ChampionSeedLT(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldChampionSeed, v))
+}
+
+// ChampionSeedLTE applies the LTE predicate on the "champion_seed" field.
+func ChampionSeedLTE(v int) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldChampionSeed, v))
+}
+
+// ChampionSeedIsNil applies the IsNil predicate on the "champion_seed" field.
+func ChampionSeedIsNil() predicate.Tournament {
+	return predicate.Tournament(sql.FieldIsNull(FieldChampionSeed))
+}
+
+// ChampionSeedNotNil applies the NotNil predicate on the "champion_seed" field.
+func ChampionSeedNotNil() predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotNull(FieldChampionSeed))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Tournament {
+	return predicate.Tournament(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasEntrants applies the HasEdge predicate on the "entrants" edge.
+func HasEntrants() predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, EntrantsTable, EntrantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEntrantsWith applies the HasEdge predicate on the "entrants" edge with a given conditions (other predicates).
+func HasEntrantsWith(preds ...predicate.TournamentEntrant) predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := newEntrantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatches applies the HasEdge predicate on the "matches" edge.
+func HasMatches() predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, MatchesTable, MatchesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchesWith applies the HasEdge predicate on the "matches" edge with a given conditions (other predicates).
+func HasMatchesWith(preds ...predicate.TournamentMatch) predicate.Tournament {
+	return predicate.Tournament(func(s *sql.Selector) {
+		step := newMatchesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Tournament) predicate.Tournament {
+	return predicate.Tournament(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Tournament) predicate.Tournament {
+	return predicate.Tournament(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Tournament) predicate.Tournament {
+	return predicate.Tournament(sql.NotPredicates(p))
+}
@@ -0,0 +1,425 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentCreate is the builder for creating a Tournament entity.
+type TournamentCreate struct {
+	config
+	mutation *TournamentMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (tc *TournamentCreate) SetName(s string) *TournamentCreate {
+	tc.mutation.SetName(s)
+	return tc
+}
+
+// SetFormat sets the "format" field.
+func (tc *TournamentCreate) SetFormat(t tournament.Format) *TournamentCreate {
+	tc.mutation.SetFormat(t)
+	return tc
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableFormat(t *tournament.Format) *TournamentCreate {
+	if t != nil {
+		tc.SetFormat(*t)
+	}
+	return tc
+}
+
+// SetStatus sets the "status" field.
+func (tc *TournamentCreate) SetStatus(t tournament.Status) *TournamentCreate {
+	tc.mutation.SetStatus(t)
+	return tc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableStatus(t *tournament.Status) *TournamentCreate {
+	if t != nil {
+		tc.SetStatus(*t)
+	}
+	return tc
+}
+
+// SetMode sets the "mode" field.
+func (tc *TournamentCreate) SetMode(s string) *TournamentCreate {
+	tc.mutation.SetMode(s)
+	return tc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableMode(s *string) *TournamentCreate {
+	if s != nil {
+		tc.SetMode(*s)
+	}
+	return tc
+}
+
+// SetCardCount sets the "card_count" field.
+func (tc *TournamentCreate) SetCardCount(i int) *TournamentCreate {
+	tc.mutation.SetCardCount(i)
+	return tc
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableCardCount(i *int) *TournamentCreate {
+	if i != nil {
+		tc.SetCardCount(*i)
+	}
+	return tc
+}
+
+// SetOrganizerToken sets the "organizer_token" field.
+func (tc *TournamentCreate) SetOrganizerToken(s string) *TournamentCreate {
+	tc.mutation.SetOrganizerToken(s)
+	return tc
+}
+
+// SetChampionSeed sets the "champion_seed" field.
+func (tc *TournamentCreate) SetChampionSeed(i int) *TournamentCreate {
+	tc.mutation.SetChampionSeed(i)
+	return tc
+}
+
+// SetNillableChampionSeed sets the "champion_seed" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableChampionSeed(i *int) *TournamentCreate {
+	if i != nil {
+		tc.SetChampionSeed(*i)
+	}
+	return tc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tc *TournamentCreate) SetCreatedAt(t time.Time) *TournamentCreate {
+	tc.mutation.SetCreatedAt(t)
+	return tc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tc *TournamentCreate) SetNillableCreatedAt(t *time.Time) *TournamentCreate {
+	if t != nil {
+		tc.SetCreatedAt(*t)
+	}
+	return tc
+}
+
+// AddEntrantIDs adds the "entrants" edge to the TournamentEntrant entity by IDs.
+func (tc *TournamentCreate) AddEntrantIDs(ids ...int) *TournamentCreate {
+	tc.mutation.AddEntrantIDs(ids...)
+	return tc
+}
+
+// AddEntrants adds the "entrants" edges to the TournamentEntrant entity.
+func (tc *TournamentCreate) AddEntrants(t ...*TournamentEntrant) *TournamentCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddEntrantIDs(ids...)
+}
+
+// AddMatchIDs adds the "matches" edge to the TournamentMatch entity by IDs.
+func (tc *TournamentCreate) AddMatchIDs(ids ...int) *TournamentCreate {
+	tc.mutation.AddMatchIDs(ids...)
+	return tc
+}
+
+// AddMatches adds the "matches" edges to the TournamentMatch entity.
+func (tc *TournamentCreate) AddMatches(t ...*TournamentMatch) *TournamentCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tc.AddMatchIDs(ids...)
+}
+
+// Mutation returns the TournamentMutation object of the builder.
+func (tc *TournamentCreate) Mutation() *TournamentMutation {
+	return tc.mutation
+}
+
+// Save creates the Tournament in the database.
+func (tc *TournamentCreate) Save(ctx context.Context) (*Tournament, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TournamentCreate) SaveX(ctx context.Context) *Tournament {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TournamentCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TournamentCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TournamentCreate) defaults() {
+	if _, ok := tc.mutation.Format(); !ok {
+		v := tournament.DefaultFormat
+		tc.mutation.SetFormat(v)
+	}
+	if _, ok := tc.mutation.Status(); !ok {
+		v := tournament.DefaultStatus
+		tc.mutation.SetStatus(v)
+	}
+	if _, ok := tc.mutation.Mode(); !ok {
+		v := tournament.DefaultMode
+		tc.mutation.SetMode(v)
+	}
+	if _, ok := tc.mutation.CardCount(); !ok {
+		v := tournament.DefaultCardCount
+		tc.mutation.SetCardCount(v)
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		v := tournament.DefaultCreatedAt()
+		tc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TournamentCreate) check() error {
+	if _, ok := tc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Tournament.name"`)}
+	}
+	if v, ok := tc.mutation.Name(); ok {
+		if err := tournament.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tournament.name": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Tournament.format"`)}
+	}
+	if v, ok := tc.mutation.Format(); ok {
+		if err := tournament.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Tournament.format": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Tournament.status"`)}
+	}
+	if v, ok := tc.mutation.Status(); ok {
+		if err := tournament.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Tournament.status": %w`, err)}
+		}
+	}
+	if _, ok := tc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Tournament.mode"`)}
+	}
+	if _, ok := tc.mutation.CardCount(); !ok {
+		return &ValidationError{Name: "card_count", err: errors.New(`ent: missing required field "Tournament.card_count"`)}
+	}
+	if _, ok := tc.mutation.OrganizerToken(); !ok {
+		return &ValidationError{Name: "organizer_token", err: errors.New(`ent: missing required field "Tournament.organizer_token"`)}
+	}
+	if _, ok := tc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Tournament.created_at"`)}
+	}
+	return nil
+}
+
+func (tc *TournamentCreate) sqlSave(ctx context.Context) (*Tournament, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TournamentCreate) createSpec() (*Tournament, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Tournament{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(tournament.Table, sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt))
+	)
+	if value, ok := tc.mutation.Name(); ok {
+		_spec.SetField(tournament.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tc.mutation.Format(); ok {
+		_spec.SetField(tournament.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := tc.mutation.Status(); ok {
+		_spec.SetField(tournament.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tc.mutation.Mode(); ok {
+		_spec.SetField(tournament.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := tc.mutation.CardCount(); ok {
+		_spec.SetField(tournament.FieldCardCount, field.TypeInt, value)
+		_node.CardCount = value
+	}
+	if value, ok := tc.mutation.OrganizerToken(); ok {
+		_spec.SetField(tournament.FieldOrganizerToken, field.TypeString, value)
+		_node.OrganizerToken = value
+	}
+	if value, ok := tc.mutation.ChampionSeed(); ok {
+		_spec.SetField(tournament.FieldChampionSeed, field.TypeInt, value)
+		_node.ChampionSeed = &value
+	}
+	if value, ok := tc.mutation.CreatedAt(); ok {
+		_spec.SetField(tournament.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tc.mutation.EntrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tc.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TournamentCreateBulk is the builder for creating many Tournament entities in bulk.
+type TournamentCreateBulk struct {
+	config
+	err      error
+	builders []*TournamentCreate
+}
+
+// Save creates the Tournament entities in the database.
+func (tcb *TournamentCreateBulk) Save(ctx context.Context) ([]*Tournament, error) {
+	if tcb.err != nil {
+		return nil, tcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Tournament, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TournamentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TournamentCreateBulk) SaveX(ctx context.Context) []*Tournament {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TournamentCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TournamentCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,843 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/predicate"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"example/ent/tournamentmatch"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentUpdate is the builder for updating Tournament entities.
+type TournamentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TournamentMutation
+}
+
+// Where appends a list predicates to the TournamentUpdate builder.
+func (tu *TournamentUpdate) Where(ps ...predicate.Tournament) *TournamentUpdate {
+	tu.mutation.Where(ps...)
+	return tu
+}
+
+// SetName sets the "name" field.
+func (tu *TournamentUpdate) SetName(s string) *TournamentUpdate {
+	tu.mutation.SetName(s)
+	return tu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableName(s *string) *TournamentUpdate {
+	if s != nil {
+		tu.SetName(*s)
+	}
+	return tu
+}
+
+// SetFormat sets the "format" field.
+func (tu *TournamentUpdate) SetFormat(t tournament.Format) *TournamentUpdate {
+	tu.mutation.SetFormat(t)
+	return tu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableFormat(t *tournament.Format) *TournamentUpdate {
+	if t != nil {
+		tu.SetFormat(*t)
+	}
+	return tu
+}
+
+// SetStatus sets the "status" field.
+func (tu *TournamentUpdate) SetStatus(t tournament.Status) *TournamentUpdate {
+	tu.mutation.SetStatus(t)
+	return tu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableStatus(t *tournament.Status) *TournamentUpdate {
+	if t != nil {
+		tu.SetStatus(*t)
+	}
+	return tu
+}
+
+// SetMode sets the "mode" field.
+func (tu *TournamentUpdate) SetMode(s string) *TournamentUpdate {
+	tu.mutation.SetMode(s)
+	return tu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableMode(s *string) *TournamentUpdate {
+	if s != nil {
+		tu.SetMode(*s)
+	}
+	return tu
+}
+
+// SetCardCount sets the "card_count" field.
+func (tu *TournamentUpdate) SetCardCount(i int) *TournamentUpdate {
+	tu.mutation.ResetCardCount()
+	tu.mutation.SetCardCount(i)
+	return tu
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableCardCount(i *int) *TournamentUpdate {
+	if i != nil {
+		tu.SetCardCount(*i)
+	}
+	return tu
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (tu *TournamentUpdate) AddCardCount(i int) *TournamentUpdate {
+	tu.mutation.AddCardCount(i)
+	return tu
+}
+
+// SetOrganizerToken sets the "organizer_token" field.
+func (tu *TournamentUpdate) SetOrganizerToken(s string) *TournamentUpdate {
+	tu.mutation.SetOrganizerToken(s)
+	return tu
+}
+
+// SetNillableOrganizerToken sets the "organizer_token" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableOrganizerToken(s *string) *TournamentUpdate {
+	if s != nil {
+		tu.SetOrganizerToken(*s)
+	}
+	return tu
+}
+
+// SetChampionSeed sets the "champion_seed" field.
+func (tu *TournamentUpdate) SetChampionSeed(i int) *TournamentUpdate {
+	tu.mutation.ResetChampionSeed()
+	tu.mutation.SetChampionSeed(i)
+	return tu
+}
+
+// SetNillableChampionSeed sets the "champion_seed" field if the given value is not nil.
+func (tu *TournamentUpdate) SetNillableChampionSeed(i *int) *TournamentUpdate {
+	if i != nil {
+		tu.SetChampionSeed(*i)
+	}
+	return tu
+}
+
+// AddChampionSeed adds i to the "champion_seed" field.
+func (tu *TournamentUpdate) AddChampionSeed(i int) *TournamentUpdate {
+	tu.mutation.AddChampionSeed(i)
+	return tu
+}
+
+// ClearChampionSeed clears the value of the "champion_seed" field.
+func (tu *TournamentUpdate) ClearChampionSeed() *TournamentUpdate {
+	tu.mutation.ClearChampionSeed()
+	return tu
+}
+
+// AddEntrantIDs adds the "entrants" edge to the TournamentEntrant entity by IDs.
+func (tu *TournamentUpdate) AddEntrantIDs(ids ...int) *TournamentUpdate {
+	tu.mutation.AddEntrantIDs(ids...)
+	return tu
+}
+
+// AddEntrants adds the "entrants" edges to the TournamentEntrant entity.
+func (tu *TournamentUpdate) AddEntrants(t ...*TournamentEntrant) *TournamentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddEntrantIDs(ids...)
+}
+
+// AddMatchIDs adds the "matches" edge to the TournamentMatch entity by IDs.
+func (tu *TournamentUpdate) AddMatchIDs(ids ...int) *TournamentUpdate {
+	tu.mutation.AddMatchIDs(ids...)
+	return tu
+}
+
+// AddMatches adds the "matches" edges to the TournamentMatch entity.
+func (tu *TournamentUpdate) AddMatches(t ...*TournamentMatch) *TournamentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.AddMatchIDs(ids...)
+}
+
+// Mutation returns the TournamentMutation object of the builder.
+func (tu *TournamentUpdate) Mutation() *TournamentMutation {
+	return tu.mutation
+}
+
+// ClearEntrants clears all "entrants" edges to the TournamentEntrant entity.
+func (tu *TournamentUpdate) ClearEntrants() *TournamentUpdate {
+	tu.mutation.ClearEntrants()
+	return tu
+}
+
+// RemoveEntrantIDs removes the "entrants" edge to TournamentEntrant entities by IDs.
+func (tu *TournamentUpdate) RemoveEntrantIDs(ids ...int) *TournamentUpdate {
+	tu.mutation.RemoveEntrantIDs(ids...)
+	return tu
+}
+
+// RemoveEntrants removes "entrants" edges to TournamentEntrant entities.
+func (tu *TournamentUpdate) RemoveEntrants(t ...*TournamentEntrant) *TournamentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveEntrantIDs(ids...)
+}
+
+// ClearMatches clears all "matches" edges to the TournamentMatch entity.
+func (tu *TournamentUpdate) ClearMatches() *TournamentUpdate {
+	tu.mutation.ClearMatches()
+	return tu
+}
+
+// RemoveMatchIDs removes the "matches" edge to TournamentMatch entities by IDs.
+func (tu *TournamentUpdate) RemoveMatchIDs(ids ...int) *TournamentUpdate {
+	tu.mutation.RemoveMatchIDs(ids...)
+	return tu
+}
+
+// RemoveMatches removes "matches" edges to TournamentMatch entities.
+func (tu *TournamentUpdate) RemoveMatches(t ...*TournamentMatch) *TournamentUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tu.RemoveMatchIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tu *TournamentUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tu.sqlSave, tu.mutation, tu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *TournamentUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *TournamentUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *TournamentUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tu *TournamentUpdate) check() error {
+	if v, ok := tu.mutation.Name(); ok {
+		if err := tournament.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tournament.name": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Format(); ok {
+		if err := tournament.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Tournament.format": %w`, err)}
+		}
+	}
+	if v, ok := tu.mutation.Status(); ok {
+		if err := tournament.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Tournament.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tu *TournamentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournament.Table, tournament.Columns, sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt))
+	if ps := tu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Name(); ok {
+		_spec.SetField(tournament.FieldName, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.Format(); ok {
+		_spec.SetField(tournament.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.Status(); ok {
+		_spec.SetField(tournament.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tu.mutation.Mode(); ok {
+		_spec.SetField(tournament.FieldMode, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.CardCount(); ok {
+		_spec.SetField(tournament.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedCardCount(); ok {
+		_spec.AddField(tournament.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.OrganizerToken(); ok {
+		_spec.SetField(tournament.FieldOrganizerToken, field.TypeString, value)
+	}
+	if value, ok := tu.mutation.ChampionSeed(); ok {
+		_spec.SetField(tournament.FieldChampionSeed, field.TypeInt, value)
+	}
+	if value, ok := tu.mutation.AddedChampionSeed(); ok {
+		_spec.AddField(tournament.FieldChampionSeed, field.TypeInt, value)
+	}
+	if tu.mutation.ChampionSeedCleared() {
+		_spec.ClearField(tournament.FieldChampionSeed, field.TypeInt)
+	}
+	if tu.mutation.EntrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedEntrantsIDs(); len(nodes) > 0 && !tu.mutation.EntrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.EntrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tu.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.RemovedMatchesIDs(); len(nodes) > 0 && !tu.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournament.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tu.mutation.done = true
+	return n, nil
+}
+
+// TournamentUpdateOne is the builder for updating a single Tournament entity.
+type TournamentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TournamentMutation
+}
+
+// SetName sets the "name" field.
+func (tuo *TournamentUpdateOne) SetName(s string) *TournamentUpdateOne {
+	tuo.mutation.SetName(s)
+	return tuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableName(s *string) *TournamentUpdateOne {
+	if s != nil {
+		tuo.SetName(*s)
+	}
+	return tuo
+}
+
+// SetFormat sets the "format" field.
+func (tuo *TournamentUpdateOne) SetFormat(t tournament.Format) *TournamentUpdateOne {
+	tuo.mutation.SetFormat(t)
+	return tuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableFormat(t *tournament.Format) *TournamentUpdateOne {
+	if t != nil {
+		tuo.SetFormat(*t)
+	}
+	return tuo
+}
+
+// SetStatus sets the "status" field.
+func (tuo *TournamentUpdateOne) SetStatus(t tournament.Status) *TournamentUpdateOne {
+	tuo.mutation.SetStatus(t)
+	return tuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableStatus(t *tournament.Status) *TournamentUpdateOne {
+	if t != nil {
+		tuo.SetStatus(*t)
+	}
+	return tuo
+}
+
+// SetMode sets the "mode" field.
+func (tuo *TournamentUpdateOne) SetMode(s string) *TournamentUpdateOne {
+	tuo.mutation.SetMode(s)
+	return tuo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableMode(s *string) *TournamentUpdateOne {
+	if s != nil {
+		tuo.SetMode(*s)
+	}
+	return tuo
+}
+
+// SetCardCount sets the "card_count" field.
+func (tuo *TournamentUpdateOne) SetCardCount(i int) *TournamentUpdateOne {
+	tuo.mutation.ResetCardCount()
+	tuo.mutation.SetCardCount(i)
+	return tuo
+}
+
+// SetNillableCardCount sets the "card_count" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableCardCount(i *int) *TournamentUpdateOne {
+	if i != nil {
+		tuo.SetCardCount(*i)
+	}
+	return tuo
+}
+
+// AddCardCount adds i to the "card_count" field.
+func (tuo *TournamentUpdateOne) AddCardCount(i int) *TournamentUpdateOne {
+	tuo.mutation.AddCardCount(i)
+	return tuo
+}
+
+// SetOrganizerToken sets the "organizer_token" field.
+func (tuo *TournamentUpdateOne) SetOrganizerToken(s string) *TournamentUpdateOne {
+	tuo.mutation.SetOrganizerToken(s)
+	return tuo
+}
+
+// SetNillableOrganizerToken sets the "organizer_token" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableOrganizerToken(s *string) *TournamentUpdateOne {
+	if s != nil {
+		tuo.SetOrganizerToken(*s)
+	}
+	return tuo
+}
+
+// SetChampionSeed sets the "champion_seed" field.
+func (tuo *TournamentUpdateOne) SetChampionSeed(i int) *TournamentUpdateOne {
+	tuo.mutation.ResetChampionSeed()
+	tuo.mutation.SetChampionSeed(i)
+	return tuo
+}
+
+// SetNillableChampionSeed sets the "champion_seed" field if the given value is not nil.
+func (tuo *TournamentUpdateOne) SetNillableChampionSeed(i *int) *TournamentUpdateOne {
+	if i != nil {
+		tuo.SetChampionSeed(*i)
+	}
+	return tuo
+}
+
+// AddChampionSeed adds i to the "champion_seed" field.
+func (tuo *TournamentUpdateOne) AddChampionSeed(i int) *TournamentUpdateOne {
+	tuo.mutation.AddChampionSeed(i)
+	return tuo
+}
+
+// ClearChampionSeed clears the value of the "champion_seed" field.
+func (tuo *TournamentUpdateOne) ClearChampionSeed() *TournamentUpdateOne {
+	tuo.mutation.ClearChampionSeed()
+	return tuo
+}
+
+// AddEntrantIDs adds the "entrants" edge to the TournamentEntrant entity by IDs.
+func (tuo *TournamentUpdateOne) AddEntrantIDs(ids ...int) *TournamentUpdateOne {
+	tuo.mutation.AddEntrantIDs(ids...)
+	return tuo
+}
+
+// AddEntrants adds the "entrants" edges to the TournamentEntrant entity.
+func (tuo *TournamentUpdateOne) AddEntrants(t ...*TournamentEntrant) *TournamentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddEntrantIDs(ids...)
+}
+
+// AddMatchIDs adds the "matches" edge to the TournamentMatch entity by IDs.
+func (tuo *TournamentUpdateOne) AddMatchIDs(ids ...int) *TournamentUpdateOne {
+	tuo.mutation.AddMatchIDs(ids...)
+	return tuo
+}
+
+// AddMatches adds the "matches" edges to the TournamentMatch entity.
+func (tuo *TournamentUpdateOne) AddMatches(t ...*TournamentMatch) *TournamentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.AddMatchIDs(ids...)
+}
+
+// Mutation returns the TournamentMutation object of the builder.
+func (tuo *TournamentUpdateOne) Mutation() *TournamentMutation {
+	return tuo.mutation
+}
+
+// ClearEntrants clears all "entrants" edges to the TournamentEntrant entity.
+func (tuo *TournamentUpdateOne) ClearEntrants() *TournamentUpdateOne {
+	tuo.mutation.ClearEntrants()
+	return tuo
+}
+
+// RemoveEntrantIDs removes the "entrants" edge to TournamentEntrant entities by IDs.
+func (tuo *TournamentUpdateOne) RemoveEntrantIDs(ids ...int) *TournamentUpdateOne {
+	tuo.mutation.RemoveEntrantIDs(ids...)
+	return tuo
+}
+
+// RemoveEntrants removes "entrants" edges to TournamentEntrant entities.
+func (tuo *TournamentUpdateOne) RemoveEntrants(t ...*TournamentEntrant) *TournamentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveEntrantIDs(ids...)
+}
+
+// ClearMatches clears all "matches" edges to the TournamentMatch entity.
+func (tuo *TournamentUpdateOne) ClearMatches() *TournamentUpdateOne {
+	tuo.mutation.ClearMatches()
+	return tuo
+}
+
+// RemoveMatchIDs removes the "matches" edge to TournamentMatch entities by IDs.
+func (tuo *TournamentUpdateOne) RemoveMatchIDs(ids ...int) *TournamentUpdateOne {
+	tuo.mutation.RemoveMatchIDs(ids...)
+	return tuo
+}
+
+// RemoveMatches removes "matches" edges to TournamentMatch entities.
+func (tuo *TournamentUpdateOne) RemoveMatches(t ...*TournamentMatch) *TournamentUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return tuo.RemoveMatchIDs(ids...)
+}
+
+// Where appends a list predicates to the TournamentUpdate builder.
+func (tuo *TournamentUpdateOne) Where(ps ...predicate.Tournament) *TournamentUpdateOne {
+	tuo.mutation.Where(ps...)
+	return tuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tuo *TournamentUpdateOne) Select(field string, fields ...string) *TournamentUpdateOne {
+	tuo.fields = append([]string{field}, fields...)
+	return tuo
+}
+
+// Save executes the query and returns the updated Tournament entity.
+func (tuo *TournamentUpdateOne) Save(ctx context.Context) (*Tournament, error) {
+	return withHooks(ctx, tuo.sqlSave, tuo.mutation, tuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *TournamentUpdateOne) SaveX(ctx context.Context) *Tournament {
+	node, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tuo *TournamentUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *TournamentUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tuo *TournamentUpdateOne) check() error {
+	if v, ok := tuo.mutation.Name(); ok {
+		if err := tournament.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tournament.name": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Format(); ok {
+		if err := tournament.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Tournament.format": %w`, err)}
+		}
+	}
+	if v, ok := tuo.mutation.Status(); ok {
+		if err := tournament.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Tournament.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (tuo *TournamentUpdateOne) sqlSave(ctx context.Context) (_node *Tournament, err error) {
+	if err := tuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournament.Table, tournament.Columns, sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt))
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tournament.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tournament.FieldID)
+		for _, f := range fields {
+			if !tournament.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tournament.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tuo.mutation.Name(); ok {
+		_spec.SetField(tournament.FieldName, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.Format(); ok {
+		_spec.SetField(tournament.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.Status(); ok {
+		_spec.SetField(tournament.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tuo.mutation.Mode(); ok {
+		_spec.SetField(tournament.FieldMode, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.CardCount(); ok {
+		_spec.SetField(tournament.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedCardCount(); ok {
+		_spec.AddField(tournament.FieldCardCount, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.OrganizerToken(); ok {
+		_spec.SetField(tournament.FieldOrganizerToken, field.TypeString, value)
+	}
+	if value, ok := tuo.mutation.ChampionSeed(); ok {
+		_spec.SetField(tournament.FieldChampionSeed, field.TypeInt, value)
+	}
+	if value, ok := tuo.mutation.AddedChampionSeed(); ok {
+		_spec.AddField(tournament.FieldChampionSeed, field.TypeInt, value)
+	}
+	if tuo.mutation.ChampionSeedCleared() {
+		_spec.ClearField(tournament.FieldChampionSeed, field.TypeInt)
+	}
+	if tuo.mutation.EntrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedEntrantsIDs(); len(nodes) > 0 && !tuo.mutation.EntrantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.EntrantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.EntrantsTable,
+			Columns: []string{tournament.EntrantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tuo.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.RemovedMatchesIDs(); len(nodes) > 0 && !tuo.mutation.MatchesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.MatchesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   tournament.MatchesTable,
+			Columns: []string{tournament.MatchesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Tournament{config: tuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournament.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tuo.mutation.done = true
+	return _node, nil
+}
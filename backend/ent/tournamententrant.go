@@ -0,0 +1,163 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TournamentEntrant is the model entity for the TournamentEntrant schema.
+type TournamentEntrant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Seed holds the value of the "seed" field.
+	Seed int `json:"seed,omitempty"`
+	// EntrantToken holds the value of the "entrant_token" field.
+	EntrantToken string `json:"-"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TournamentEntrantQuery when eager-loading is set.
+	Edges                         TournamentEntrantEdges `json:"edges"`
+	tournament_entrant_tournament *int
+	selectValues                  sql.SelectValues
+}
+
+// TournamentEntrantEdges holds the relations/edges for other nodes in the graph.
+type TournamentEntrantEdges struct {
+	// Tournament holds the value of the tournament edge.
+	Tournament *Tournament `json:"tournament,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// TournamentOrErr returns the Tournament value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TournamentEntrantEdges) TournamentOrErr() (*Tournament, error) {
+	if e.Tournament != nil {
+		return e.Tournament, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tournament.Label}
+	}
+	return nil, &NotLoadedError{edge: "tournament"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TournamentEntrant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tournamententrant.FieldID, tournamententrant.FieldSeed:
+			values[i] = new(sql.NullInt64)
+		case tournamententrant.FieldName, tournamententrant.FieldEntrantToken:
+			values[i] = new(sql.NullString)
+		case tournamententrant.ForeignKeys[0]: // tournament_entrant_tournament
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TournamentEntrant fields.
+func (te *TournamentEntrant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tournamententrant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			te.ID = int(value.Int64)
+		case tournamententrant.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				te.Name = value.String
+			}
+		case tournamententrant.FieldSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field seed", values[i])
+			} else if value.Valid {
+				te.Seed = int(value.Int64)
+			}
+		case tournamententrant.FieldEntrantToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entrant_token", values[i])
+			} else if value.Valid {
+				te.EntrantToken = value.String
+			}
+		case tournamententrant.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tournament_entrant_tournament", value)
+			} else if value.Valid {
+				te.tournament_entrant_tournament = new(int)
+				*te.tournament_entrant_tournament = int(value.Int64)
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TournamentEntrant.
+// This includes values selected through modifiers, order, etc.
+func (te *TournamentEntrant) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// QueryTournament queries the "tournament" edge of the TournamentEntrant entity.
+func (te *TournamentEntrant) QueryTournament() *TournamentQuery {
+	return NewTournamentEntrantClient(te.config).QueryTournament(te)
+}
+
+// Update returns a builder for updating this TournamentEntrant.
+// Note that you need to call TournamentEntrant.Unwrap() before calling this method if this TournamentEntrant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TournamentEntrant) Update() *TournamentEntrantUpdateOne {
+	return NewTournamentEntrantClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TournamentEntrant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TournamentEntrant) Unwrap() *TournamentEntrant {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TournamentEntrant is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TournamentEntrant) String() string {
+	var builder strings.Builder
+	builder.WriteString("TournamentEntrant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("name=")
+	builder.WriteString(te.Name)
+	builder.WriteString(", ")
+	builder.WriteString("seed=")
+	builder.WriteString(fmt.Sprintf("%v", te.Seed))
+	builder.WriteString(", ")
+	builder.WriteString("entrant_token=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TournamentEntrants is a parsable slice of TournamentEntrant.
+type TournamentEntrants []*TournamentEntrant
@@ -0,0 +1,103 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournamententrant
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the tournamententrant type in the database.
+	Label = "tournament_entrant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSeed holds the string denoting the seed field in the database.
+	FieldSeed = "seed"
+	// FieldEntrantToken holds the string denoting the entrant_token field in the database.
+	FieldEntrantToken = "entrant_token"
+	// EdgeTournament holds the string denoting the tournament edge name in mutations.
+	EdgeTournament = "tournament"
+	// Table holds the table name of the tournamententrant in the database.
+	Table = "tournament_entrants"
+	// TournamentTable is the table that holds the tournament relation/edge.
+	TournamentTable = "tournament_entrants"
+	// TournamentInverseTable is the table name for the Tournament entity.
+	// It exists in this package in order to avoid circular dependency with the "tournament" package.
+	TournamentInverseTable = "tournaments"
+	// TournamentColumn is the table column denoting the tournament relation/edge.
+	TournamentColumn = "tournament_entrant_tournament"
+)
+
+// Columns holds all SQL columns for tournamententrant fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldSeed,
+	FieldEntrantToken,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tournament_entrants"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tournament_entrant_tournament",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the TournamentEntrant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySeed orders the results by the seed field.
+func BySeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeed, opts...).ToFunc()
+}
+
+// ByEntrantToken orders the results by the entrant_token field.
+func ByEntrantToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntrantToken, opts...).ToFunc()
+}
+
+// ByTournamentField orders the results by tournament field.
+func ByTournamentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTournamentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTournamentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TournamentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TournamentTable, TournamentColumn),
+	)
+}
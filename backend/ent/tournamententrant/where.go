@@ -0,0 +1,288 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournamententrant
+
+import (
+	"example/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldName, v))
+}
+
+// Seed applies equality check predicate on the "seed" field. It's identical to SeedEQ.
+func Seed(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldSeed, v))
+}
+
+// EntrantToken applies equality check predicate on the "entrant_token" field. It's identical to EntrantTokenEQ.
+func EntrantToken(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldEntrantToken, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldContainsFold(FieldName, v))
+}
+
+// SeedEQ applies the EQ predicate on the "seed" field.
+func SeedEQ(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldSeed, v))
+}
+
+// SeedNEQ applies the NEQ predicate on the "seed" field.
+func SeedNEQ(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNEQ(FieldSeed, v))
+}
+
+// SeedIn applies the In predicate on the "seed" field.
+func SeedIn(vs ...int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldIn(FieldSeed, vs...))
+}
+
+// SeedNotIn applies the NotIn predicate on the "seed" field.
+func SeedNotIn(vs ...int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNotIn(FieldSeed, vs...))
+}
+
+// SeedGT applies the GT predicate on the "seed" field.
+func SeedGT(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGT(FieldSeed, v))
+}
+
+// SeedGTE applies the GTE predicate on the "seed" field.
+func SeedGTE(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGTE(FieldSeed, v))
+}
+
+// SeedLT applies the LT predicate on the "seed" field.
+func SeedLT(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLT(FieldSeed, v))
+}
+
+// SeedLTE applies the LTE predicate on the "seed" field.
+func SeedLTE(v int) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLTE(FieldSeed, v))
+}
+
+// EntrantTokenEQ applies the EQ predicate on the "entrant_token" field.
+func EntrantTokenEQ(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEQ(FieldEntrantToken, v))
+}
+
+// EntrantTokenNEQ applies the NEQ predicate on the "entrant_token" field.
+func EntrantTokenNEQ(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNEQ(FieldEntrantToken, v))
+}
+
+// EntrantTokenIn applies the In predicate on the "entrant_token" field.
+func EntrantTokenIn(vs ...string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldIn(FieldEntrantToken, vs...))
+}
+
+// EntrantTokenNotIn applies the NotIn predicate on the "entrant_token" field.
+func EntrantTokenNotIn(vs ...string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNotIn(FieldEntrantToken, vs...))
+}
+
+// EntrantTokenGT applies the GT predicate on the "entrant_token" field.
+func EntrantTokenGT(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGT(FieldEntrantToken, v))
+}
+
+// EntrantTokenGTE applies the GTE predicate on the "entrant_token" field.
+func EntrantTokenGTE(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldGTE(FieldEntrantToken, v))
+}
+
+// EntrantTokenLT applies the LT predicate on the "entrant_token" field.
+func EntrantTokenLT(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLT(FieldEntrantToken, v))
+}
+
+// EntrantTokenLTE applies the LTE predicate on the "entrant_token" field.
+func EntrantTokenLTE(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldLTE(FieldEntrantToken, v))
+}
+
+// EntrantTokenContains applies the Contains predicate on the "entrant_token" field.
+func EntrantTokenContains(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldContains(FieldEntrantToken, v))
+}
+
+// EntrantTokenHasPrefix applies the HasPrefix predicate on the "entrant_token" field.
+func EntrantTokenHasPrefix(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldHasPrefix(FieldEntrantToken, v))
+}
+
+// EntrantTokenHasSuffix applies the HasSuffix predicate on the "entrant_token" field.
+func EntrantTokenHasSuffix(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldHasSuffix(FieldEntrantToken, v))
+}
+
+// EntrantTokenIsNil applies the IsNil predicate on the "entrant_token" field.
+func EntrantTokenIsNil() predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldIsNull(FieldEntrantToken))
+}
+
+// EntrantTokenNotNil applies the NotNil predicate on the "entrant_token" field.
+func EntrantTokenNotNil() predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldNotNull(FieldEntrantToken))
+}
+
+// EntrantTokenEqualFold applies the EqualFold predicate on the "entrant_token" field.
+func EntrantTokenEqualFold(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldEqualFold(FieldEntrantToken, v))
+}
+
+// EntrantTokenContainsFold applies the ContainsFold predicate on the "entrant_token" field.
+func EntrantTokenContainsFold(v string) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.FieldContainsFold(FieldEntrantToken, v))
+}
+
+// HasTournament applies the HasEdge predicate on the "tournament" edge.
+func HasTournament() predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TournamentTable, TournamentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTournamentWith applies the HasEdge predicate on the "tournament" edge with a given conditions (other predicates).
+func HasTournamentWith(preds ...predicate.Tournament) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(func(s *sql.Selector) {
+		step := newTournamentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TournamentEntrant) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TournamentEntrant) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TournamentEntrant) predicate.TournamentEntrant {
+	return predicate.TournamentEntrant(sql.NotPredicates(p))
+}
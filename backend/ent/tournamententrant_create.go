@@ -0,0 +1,251 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentEntrantCreate is the builder for creating a TournamentEntrant entity.
+type TournamentEntrantCreate struct {
+	config
+	mutation *TournamentEntrantMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (tec *TournamentEntrantCreate) SetName(s string) *TournamentEntrantCreate {
+	tec.mutation.SetName(s)
+	return tec
+}
+
+// SetSeed sets the "seed" field.
+func (tec *TournamentEntrantCreate) SetSeed(i int) *TournamentEntrantCreate {
+	tec.mutation.SetSeed(i)
+	return tec
+}
+
+// SetEntrantToken sets the "entrant_token" field.
+func (tec *TournamentEntrantCreate) SetEntrantToken(s string) *TournamentEntrantCreate {
+	tec.mutation.SetEntrantToken(s)
+	return tec
+}
+
+// SetNillableEntrantToken sets the "entrant_token" field if the given value is not nil.
+func (tec *TournamentEntrantCreate) SetNillableEntrantToken(s *string) *TournamentEntrantCreate {
+	if s != nil {
+		tec.SetEntrantToken(*s)
+	}
+	return tec
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (tec *TournamentEntrantCreate) SetTournamentID(id int) *TournamentEntrantCreate {
+	tec.mutation.SetTournamentID(id)
+	return tec
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (tec *TournamentEntrantCreate) SetTournament(t *Tournament) *TournamentEntrantCreate {
+	return tec.SetTournamentID(t.ID)
+}
+
+// Mutation returns the TournamentEntrantMutation object of the builder.
+func (tec *TournamentEntrantCreate) Mutation() *TournamentEntrantMutation {
+	return tec.mutation
+}
+
+// Save creates the TournamentEntrant in the database.
+func (tec *TournamentEntrantCreate) Save(ctx context.Context) (*TournamentEntrant, error) {
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TournamentEntrantCreate) SaveX(ctx context.Context) *TournamentEntrant {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TournamentEntrantCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TournamentEntrantCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TournamentEntrantCreate) check() error {
+	if _, ok := tec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TournamentEntrant.name"`)}
+	}
+	if v, ok := tec.mutation.Name(); ok {
+		if err := tournamententrant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TournamentEntrant.name": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Seed(); !ok {
+		return &ValidationError{Name: "seed", err: errors.New(`ent: missing required field "TournamentEntrant.seed"`)}
+	}
+	if len(tec.mutation.TournamentIDs()) == 0 {
+		return &ValidationError{Name: "tournament", err: errors.New(`ent: missing required edge "TournamentEntrant.tournament"`)}
+	}
+	return nil
+}
+
+func (tec *TournamentEntrantCreate) sqlSave(ctx context.Context) (*TournamentEntrant, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TournamentEntrantCreate) createSpec() (*TournamentEntrant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TournamentEntrant{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(tournamententrant.Table, sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt))
+	)
+	if value, ok := tec.mutation.Name(); ok {
+		_spec.SetField(tournamententrant.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tec.mutation.Seed(); ok {
+		_spec.SetField(tournamententrant.FieldSeed, field.TypeInt, value)
+		_node.Seed = value
+	}
+	if value, ok := tec.mutation.EntrantToken(); ok {
+		_spec.SetField(tournamententrant.FieldEntrantToken, field.TypeString, value)
+		_node.EntrantToken = value
+	}
+	if nodes := tec.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamententrant.TournamentTable,
+			Columns: []string{tournamententrant.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tournament_entrant_tournament = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TournamentEntrantCreateBulk is the builder for creating many TournamentEntrant entities in bulk.
+type TournamentEntrantCreateBulk struct {
+	config
+	err      error
+	builders []*TournamentEntrantCreate
+}
+
+// Save creates the TournamentEntrant entities in the database.
+func (tecb *TournamentEntrantCreateBulk) Save(ctx context.Context) ([]*TournamentEntrant, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TournamentEntrant, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TournamentEntrantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TournamentEntrantCreateBulk) SaveX(ctx context.Context) []*TournamentEntrant {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TournamentEntrantCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TournamentEntrantCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
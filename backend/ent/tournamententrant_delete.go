@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"example/ent/predicate"
+	"example/ent/tournamententrant"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentEntrantDelete is the builder for deleting a TournamentEntrant entity.
+type TournamentEntrantDelete struct {
+	config
+	hooks    []Hook
+	mutation *TournamentEntrantMutation
+}
+
+// Where appends a list predicates to the TournamentEntrantDelete builder.
+func (ted *TournamentEntrantDelete) Where(ps ...predicate.TournamentEntrant) *TournamentEntrantDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TournamentEntrantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ted.sqlExec, ted.mutation, ted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TournamentEntrantDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TournamentEntrantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tournamententrant.Table, sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt))
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ted.mutation.done = true
+	return affected, err
+}
+
+// TournamentEntrantDeleteOne is the builder for deleting a single TournamentEntrant entity.
+type TournamentEntrantDeleteOne struct {
+	ted *TournamentEntrantDelete
+}
+
+// Where appends a list predicates to the TournamentEntrantDelete builder.
+func (tedo *TournamentEntrantDeleteOne) Where(ps ...predicate.TournamentEntrant) *TournamentEntrantDeleteOne {
+	tedo.ted.mutation.Where(ps...)
+	return tedo
+}
+
+// Exec executes the deletion query.
+func (tedo *TournamentEntrantDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tournamententrant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TournamentEntrantDeleteOne) ExecX(ctx context.Context) {
+	if err := tedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/predicate"
+	"example/ent/tournament"
+	"example/ent/tournamententrant"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentEntrantUpdate is the builder for updating TournamentEntrant entities.
+type TournamentEntrantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TournamentEntrantMutation
+}
+
+// Where appends a list predicates to the TournamentEntrantUpdate builder.
+func (teu *TournamentEntrantUpdate) Where(ps ...predicate.TournamentEntrant) *TournamentEntrantUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetName sets the "name" field.
+func (teu *TournamentEntrantUpdate) SetName(s string) *TournamentEntrantUpdate {
+	teu.mutation.SetName(s)
+	return teu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (teu *TournamentEntrantUpdate) SetNillableName(s *string) *TournamentEntrantUpdate {
+	if s != nil {
+		teu.SetName(*s)
+	}
+	return teu
+}
+
+// SetSeed sets the "seed" field.
+func (teu *TournamentEntrantUpdate) SetSeed(i int) *TournamentEntrantUpdate {
+	teu.mutation.ResetSeed()
+	teu.mutation.SetSeed(i)
+	return teu
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (teu *TournamentEntrantUpdate) SetNillableSeed(i *int) *TournamentEntrantUpdate {
+	if i != nil {
+		teu.SetSeed(*i)
+	}
+	return teu
+}
+
+// AddSeed adds i to the "seed" field.
+func (teu *TournamentEntrantUpdate) AddSeed(i int) *TournamentEntrantUpdate {
+	teu.mutation.AddSeed(i)
+	return teu
+}
+
+// SetEntrantToken sets the "entrant_token" field.
+func (teu *TournamentEntrantUpdate) SetEntrantToken(s string) *TournamentEntrantUpdate {
+	teu.mutation.SetEntrantToken(s)
+	return teu
+}
+
+// SetNillableEntrantToken sets the "entrant_token" field if the given value is not nil.
+func (teu *TournamentEntrantUpdate) SetNillableEntrantToken(s *string) *TournamentEntrantUpdate {
+	if s != nil {
+		teu.SetEntrantToken(*s)
+	}
+	return teu
+}
+
+// ClearEntrantToken clears the value of the "entrant_token" field.
+func (teu *TournamentEntrantUpdate) ClearEntrantToken() *TournamentEntrantUpdate {
+	teu.mutation.ClearEntrantToken()
+	return teu
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (teu *TournamentEntrantUpdate) SetTournamentID(id int) *TournamentEntrantUpdate {
+	teu.mutation.SetTournamentID(id)
+	return teu
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (teu *TournamentEntrantUpdate) SetTournament(t *Tournament) *TournamentEntrantUpdate {
+	return teu.SetTournamentID(t.ID)
+}
+
+// Mutation returns the TournamentEntrantMutation object of the builder.
+func (teu *TournamentEntrantUpdate) Mutation() *TournamentEntrantMutation {
+	return teu.mutation
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (teu *TournamentEntrantUpdate) ClearTournament() *TournamentEntrantUpdate {
+	teu.mutation.ClearTournament()
+	return teu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TournamentEntrantUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TournamentEntrantUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TournamentEntrantUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TournamentEntrantUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TournamentEntrantUpdate) check() error {
+	if v, ok := teu.mutation.Name(); ok {
+		if err := tournamententrant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TournamentEntrant.name": %w`, err)}
+		}
+	}
+	if teu.mutation.TournamentCleared() && len(teu.mutation.TournamentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TournamentEntrant.tournament"`)
+	}
+	return nil
+}
+
+func (teu *TournamentEntrantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := teu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournamententrant.Table, tournamententrant.Columns, sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.Name(); ok {
+		_spec.SetField(tournamententrant.FieldName, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.Seed(); ok {
+		_spec.SetField(tournamententrant.FieldSeed, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.AddedSeed(); ok {
+		_spec.AddField(tournamententrant.FieldSeed, field.TypeInt, value)
+	}
+	if value, ok := teu.mutation.EntrantToken(); ok {
+		_spec.SetField(tournamententrant.FieldEntrantToken, field.TypeString, value)
+	}
+	if teu.mutation.EntrantTokenCleared() {
+		_spec.ClearField(tournamententrant.FieldEntrantToken, field.TypeString)
+	}
+	if teu.mutation.TournamentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamententrant.TournamentTable,
+			Columns: []string{tournamententrant.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teu.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamententrant.TournamentTable,
+			Columns: []string{tournamententrant.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournamententrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TournamentEntrantUpdateOne is the builder for updating a single TournamentEntrant entity.
+type TournamentEntrantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TournamentEntrantMutation
+}
+
+// SetName sets the "name" field.
+func (teuo *TournamentEntrantUpdateOne) SetName(s string) *TournamentEntrantUpdateOne {
+	teuo.mutation.SetName(s)
+	return teuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (teuo *TournamentEntrantUpdateOne) SetNillableName(s *string) *TournamentEntrantUpdateOne {
+	if s != nil {
+		teuo.SetName(*s)
+	}
+	return teuo
+}
+
+// SetSeed sets the "seed" field.
+func (teuo *TournamentEntrantUpdateOne) SetSeed(i int) *TournamentEntrantUpdateOne {
+	teuo.mutation.ResetSeed()
+	teuo.mutation.SetSeed(i)
+	return teuo
+}
+
+// SetNillableSeed sets the "seed" field if the given value is not nil.
+func (teuo *TournamentEntrantUpdateOne) SetNillableSeed(i *int) *TournamentEntrantUpdateOne {
+	if i != nil {
+		teuo.SetSeed(*i)
+	}
+	return teuo
+}
+
+// AddSeed adds i to the "seed" field.
+func (teuo *TournamentEntrantUpdateOne) AddSeed(i int) *TournamentEntrantUpdateOne {
+	teuo.mutation.AddSeed(i)
+	return teuo
+}
+
+// SetEntrantToken sets the "entrant_token" field.
+func (teuo *TournamentEntrantUpdateOne) SetEntrantToken(s string) *TournamentEntrantUpdateOne {
+	teuo.mutation.SetEntrantToken(s)
+	return teuo
+}
+
+// SetNillableEntrantToken sets the "entrant_token" field if the given value is not nil.
+func (teuo *TournamentEntrantUpdateOne) SetNillableEntrantToken(s *string) *TournamentEntrantUpdateOne {
+	if s != nil {
+		teuo.SetEntrantToken(*s)
+	}
+	return teuo
+}
+
+// ClearEntrantToken clears the value of the "entrant_token" field.
+func (teuo *TournamentEntrantUpdateOne) ClearEntrantToken() *TournamentEntrantUpdateOne {
+	teuo.mutation.ClearEntrantToken()
+	return teuo
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (teuo *TournamentEntrantUpdateOne) SetTournamentID(id int) *TournamentEntrantUpdateOne {
+	teuo.mutation.SetTournamentID(id)
+	return teuo
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (teuo *TournamentEntrantUpdateOne) SetTournament(t *Tournament) *TournamentEntrantUpdateOne {
+	return teuo.SetTournamentID(t.ID)
+}
+
+// Mutation returns the TournamentEntrantMutation object of the builder.
+func (teuo *TournamentEntrantUpdateOne) Mutation() *TournamentEntrantMutation {
+	return teuo.mutation
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (teuo *TournamentEntrantUpdateOne) ClearTournament() *TournamentEntrantUpdateOne {
+	teuo.mutation.ClearTournament()
+	return teuo
+}
+
+// Where appends a list predicates to the TournamentEntrantUpdate builder.
+func (teuo *TournamentEntrantUpdateOne) Where(ps ...predicate.TournamentEntrant) *TournamentEntrantUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TournamentEntrantUpdateOne) Select(field string, fields ...string) *TournamentEntrantUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TournamentEntrant entity.
+func (teuo *TournamentEntrantUpdateOne) Save(ctx context.Context) (*TournamentEntrant, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TournamentEntrantUpdateOne) SaveX(ctx context.Context) *TournamentEntrant {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TournamentEntrantUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TournamentEntrantUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TournamentEntrantUpdateOne) check() error {
+	if v, ok := teuo.mutation.Name(); ok {
+		if err := tournamententrant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TournamentEntrant.name": %w`, err)}
+		}
+	}
+	if teuo.mutation.TournamentCleared() && len(teuo.mutation.TournamentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TournamentEntrant.tournament"`)
+	}
+	return nil
+}
+
+func (teuo *TournamentEntrantUpdateOne) sqlSave(ctx context.Context) (_node *TournamentEntrant, err error) {
+	if err := teuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournamententrant.Table, tournamententrant.Columns, sqlgraph.NewFieldSpec(tournamententrant.FieldID, field.TypeInt))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TournamentEntrant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tournamententrant.FieldID)
+		for _, f := range fields {
+			if !tournamententrant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tournamententrant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.Name(); ok {
+		_spec.SetField(tournamententrant.FieldName, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.Seed(); ok {
+		_spec.SetField(tournamententrant.FieldSeed, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.AddedSeed(); ok {
+		_spec.AddField(tournamententrant.FieldSeed, field.TypeInt, value)
+	}
+	if value, ok := teuo.mutation.EntrantToken(); ok {
+		_spec.SetField(tournamententrant.FieldEntrantToken, field.TypeString, value)
+	}
+	if teuo.mutation.EntrantTokenCleared() {
+		_spec.ClearField(tournamententrant.FieldEntrantToken, field.TypeString)
+	}
+	if teuo.mutation.TournamentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamententrant.TournamentTable,
+			Columns: []string{tournamententrant.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teuo.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamententrant.TournamentTable,
+			Columns: []string{tournamententrant.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TournamentEntrant{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournamententrant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"example/ent/game"
+	"example/ent/tournament"
+	"example/ent/tournamentmatch"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TournamentMatch is the model entity for the TournamentMatch schema.
+type TournamentMatch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Number holds the value of the "number" field.
+	Number int `json:"number,omitempty"`
+	// Side holds the value of the "side" field.
+	Side tournamentmatch.Side `json:"side,omitempty"`
+	// Round holds the value of the "round" field.
+	Round int `json:"round,omitempty"`
+	// Status holds the value of the "status" field.
+	Status tournamentmatch.Status `json:"status,omitempty"`
+	// WinnerSeed holds the value of the "winner_seed" field.
+	WinnerSeed *int `json:"winner_seed,omitempty"`
+	// GameID holds the value of the "game_id" field.
+	GameID int `json:"game_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TournamentMatchQuery when eager-loading is set.
+	Edges                       TournamentMatchEdges `json:"edges"`
+	tournament_match_tournament *int
+	selectValues                sql.SelectValues
+}
+
+// TournamentMatchEdges holds the relations/edges for other nodes in the graph.
+type TournamentMatchEdges struct {
+	// Tournament holds the value of the tournament edge.
+	Tournament *Tournament `json:"tournament,omitempty"`
+	// Game holds the value of the game edge.
+	Game *Game `json:"game,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TournamentOrErr returns the Tournament value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TournamentMatchEdges) TournamentOrErr() (*Tournament, error) {
+	if e.Tournament != nil {
+		return e.Tournament, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tournament.Label}
+	}
+	return nil, &NotLoadedError{edge: "tournament"}
+}
+
+// GameOrErr returns the Game value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TournamentMatchEdges) GameOrErr() (*Game, error) {
+	if e.Game != nil {
+		return e.Game, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: game.Label}
+	}
+	return nil, &NotLoadedError{edge: "game"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TournamentMatch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tournamentmatch.FieldID, tournamentmatch.FieldNumber, tournamentmatch.FieldRound, tournamentmatch.FieldWinnerSeed, tournamentmatch.FieldGameID:
+			values[i] = new(sql.NullInt64)
+		case tournamentmatch.FieldSide, tournamentmatch.FieldStatus:
+			values[i] = new(sql.NullString)
+		case tournamentmatch.ForeignKeys[0]: // tournament_match_tournament
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TournamentMatch fields.
+func (tm *TournamentMatch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tournamentmatch.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tm.ID = int(value.Int64)
+		case tournamentmatch.FieldNumber:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field number", values[i])
+			} else if value.Valid {
+				tm.Number = int(value.Int64)
+			}
+		case tournamentmatch.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				tm.Side = tournamentmatch.Side(value.String)
+			}
+		case tournamentmatch.FieldRound:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field round", values[i])
+			} else if value.Valid {
+				tm.Round = int(value.Int64)
+			}
+		case tournamentmatch.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				tm.Status = tournamentmatch.Status(value.String)
+			}
+		case tournamentmatch.FieldWinnerSeed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field winner_seed", values[i])
+			} else if value.Valid {
+				tm.WinnerSeed = new(int)
+				*tm.WinnerSeed = int(value.Int64)
+			}
+		case tournamentmatch.FieldGameID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field game_id", values[i])
+			} else if value.Valid {
+				tm.GameID = int(value.Int64)
+			}
+		case tournamentmatch.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field tournament_match_tournament", value)
+			} else if value.Valid {
+				tm.tournament_match_tournament = new(int)
+				*tm.tournament_match_tournament = int(value.Int64)
+			}
+		default:
+			tm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TournamentMatch.
+// This includes values selected through modifiers, order, etc.
+func (tm *TournamentMatch) Value(name string) (ent.Value, error) {
+	return tm.selectValues.Get(name)
+}
+
+// QueryTournament queries the "tournament" edge of the TournamentMatch entity.
+func (tm *TournamentMatch) QueryTournament() *TournamentQuery {
+	return NewTournamentMatchClient(tm.config).QueryTournament(tm)
+}
+
+// QueryGame queries the "game" edge of the TournamentMatch entity.
+func (tm *TournamentMatch) QueryGame() *GameQuery {
+	return NewTournamentMatchClient(tm.config).QueryGame(tm)
+}
+
+// Update returns a builder for updating this TournamentMatch.
+// Note that you need to call TournamentMatch.Unwrap() before calling this method if this TournamentMatch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tm *TournamentMatch) Update() *TournamentMatchUpdateOne {
+	return NewTournamentMatchClient(tm.config).UpdateOne(tm)
+}
+
+// Unwrap unwraps the TournamentMatch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tm *TournamentMatch) Unwrap() *TournamentMatch {
+	_tx, ok := tm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TournamentMatch is not a transactional entity")
+	}
+	tm.config.driver = _tx.drv
+	return tm
+}
+
+// String implements the fmt.Stringer.
+func (tm *TournamentMatch) String() string {
+	var builder strings.Builder
+	builder.WriteString("TournamentMatch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tm.ID))
+	builder.WriteString("number=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Number))
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Side))
+	builder.WriteString(", ")
+	builder.WriteString("round=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Round))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", tm.Status))
+	builder.WriteString(", ")
+	if v := tm.WinnerSeed; v != nil {
+		builder.WriteString("winner_seed=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("game_id=")
+	builder.WriteString(fmt.Sprintf("%v", tm.GameID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TournamentMatches is a parsable slice of TournamentMatch.
+type TournamentMatches []*TournamentMatch
@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournamentmatch
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the tournamentmatch type in the database.
+	Label = "tournament_match"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldNumber holds the string denoting the number field in the database.
+	FieldNumber = "number"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldRound holds the string denoting the round field in the database.
+	FieldRound = "round"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldWinnerSeed holds the string denoting the winner_seed field in the database.
+	FieldWinnerSeed = "winner_seed"
+	// FieldGameID holds the string denoting the game_id field in the database.
+	FieldGameID = "game_id"
+	// EdgeTournament holds the string denoting the tournament edge name in mutations.
+	EdgeTournament = "tournament"
+	// EdgeGame holds the string denoting the game edge name in mutations.
+	EdgeGame = "game"
+	// Table holds the table name of the tournamentmatch in the database.
+	Table = "tournament_matches"
+	// TournamentTable is the table that holds the tournament relation/edge.
+	TournamentTable = "tournament_matches"
+	// TournamentInverseTable is the table name for the Tournament entity.
+	// It exists in this package in order to avoid circular dependency with the "tournament" package.
+	TournamentInverseTable = "tournaments"
+	// TournamentColumn is the table column denoting the tournament relation/edge.
+	TournamentColumn = "tournament_match_tournament"
+	// GameTable is the table that holds the game relation/edge.
+	GameTable = "tournament_matches"
+	// GameInverseTable is the table name for the Game entity.
+	// It exists in this package in order to avoid circular dependency with the "game" package.
+	GameInverseTable = "games"
+	// GameColumn is the table column denoting the game relation/edge.
+	GameColumn = "game_id"
+)
+
+// Columns holds all SQL columns for tournamentmatch fields.
+var Columns = []string{
+	FieldID,
+	FieldNumber,
+	FieldSide,
+	FieldRound,
+	FieldStatus,
+	FieldWinnerSeed,
+	FieldGameID,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "tournament_matches"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"tournament_match_tournament",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Side defines the type for the "side" enum field.
+type Side string
+
+// Side values.
+const (
+	SideWINNERS     Side = "WINNERS"
+	SideLOSERS      Side = "LOSERS"
+	SideGRAND_FINAL Side = "GRAND_FINAL"
+)
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// SideValidator is a validator for the "side" field enum values. It is called by the builders before save.
+func SideValidator(s Side) error {
+	switch s {
+	case SideWINNERS, SideLOSERS, SideGRAND_FINAL:
+		return nil
+	default:
+		return fmt.Errorf("tournamentmatch: invalid enum value for side field: %q", s)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPENDING is the default value of the Status enum.
+const DefaultStatus = StatusPENDING
+
+// Status values.
+const (
+	StatusPENDING  Status = "PENDING"
+	StatusPLAYING  Status = "PLAYING"
+	StatusFINISHED Status = "FINISHED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPENDING, StatusPLAYING, StatusFINISHED:
+		return nil
+	default:
+		return fmt.Errorf("tournamentmatch: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the TournamentMatch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByNumber orders the results by the number field.
+func ByNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNumber, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByRound orders the results by the round field.
+func ByRound(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRound, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByWinnerSeed orders the results by the winner_seed field.
+func ByWinnerSeed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWinnerSeed, opts...).ToFunc()
+}
+
+// ByGameID orders the results by the game_id field.
+func ByGameID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGameID, opts...).ToFunc()
+}
+
+// ByTournamentField orders the results by tournament field.
+func ByTournamentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTournamentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGameField orders the results by game field.
+func ByGameField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGameStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newTournamentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TournamentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, TournamentTable, TournamentColumn),
+	)
+}
+func newGameStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GameInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, GameTable, GameColumn),
+	)
+}
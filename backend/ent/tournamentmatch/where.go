@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tournamentmatch
+
+import (
+	"example/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLTE(FieldID, id))
+}
+
+// Number applies equality check predicate on the "number" field. It's identical to NumberEQ.
+func Number(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldNumber, v))
+}
+
+// Round applies equality check predicate on the "round" field. It's identical to RoundEQ.
+func Round(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldRound, v))
+}
+
+// WinnerSeed applies equality check predicate on the "winner_seed" field. It's identical to WinnerSeedEQ.
+func WinnerSeed(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldWinnerSeed, v))
+}
+
+// GameID applies equality check predicate on the "game_id" field. It's identical to GameIDEQ.
+func GameID(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldGameID, v))
+}
+
+// NumberEQ applies the EQ predicate on the "number" field.
+func NumberEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldNumber, v))
+}
+
+// NumberNEQ applies the NEQ predicate on the "number" field.
+func NumberNEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldNumber, v))
+}
+
+// NumberIn applies the In predicate on the "number" field.
+func NumberIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldNumber, vs...))
+}
+
+// NumberNotIn applies the NotIn predicate on the "number" field.
+func NumberNotIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldNumber, vs...))
+}
+
+// NumberGT applies the GT predicate on the "number" field.
+func NumberGT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGT(FieldNumber, v))
+}
+
+// NumberGTE applies the GTE predicate on the "number" field.
+func NumberGTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGTE(FieldNumber, v))
+}
+
+// NumberLT applies the LT predicate on the "number" field.
+func NumberLT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLT(FieldNumber, v))
+}
+
+// NumberLTE applies the LTE predicate on the "number" field.
+func NumberLTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLTE(FieldNumber, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// RoundEQ applies the EQ predicate on the "round" field.
+func RoundEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldRound, v))
+}
+
+// RoundNEQ applies the NEQ predicate on the "round" field.
+func RoundNEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldRound, v))
+}
+
+// RoundIn applies the In predicate on the "round" field.
+func RoundIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldRound, vs...))
+}
+
+// RoundNotIn applies the NotIn predicate on the "round" field.
+func RoundNotIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldRound, vs...))
+}
+
+// RoundGT applies the GT predicate on the "round" field.
+func RoundGT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGT(FieldRound, v))
+}
+
+// RoundGTE applies the GTE predicate on the "round" field.
+func RoundGTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGTE(FieldRound, v))
+}
+
+// RoundLT applies the LT predicate on the "round" field.
+func RoundLT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLT(FieldRound, v))
+}
+
+// RoundLTE applies the LTE predicate on the "round" field.
+func RoundLTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLTE(FieldRound, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// WinnerSeedEQ applies the EQ predicate on the "winner_seed" field.
+func WinnerSeedEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldWinnerSeed, v))
+}
+
+// WinnerSeedNEQ applies the NEQ predicate on the "winner_seed" field.
+func WinnerSeedNEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldWinnerSeed, v))
+}
+
+// WinnerSeedIn applies the In predicate on the "winner_seed" field.
+func WinnerSeedIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldWinnerSeed, vs...))
+}
+
+// WinnerSeedNotIn applies the NotIn predicate on the "winner_seed" field.
+func WinnerSeedNotIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldWinnerSeed, vs...))
+}
+
+// WinnerSeedGT applies the GT predicate on the "winner_seed" field.
+func WinnerSeedGT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGT(FieldWinnerSeed, v))
+}
+
+// WinnerSeedGTE applies the GTE predicate on the "winner_seed" field.
+func WinnerSeedGTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldGTE(FieldWinnerSeed, v))
+}
+
+// WinnerSeedLT applies the LT predicate on the "winner_seed" field.
+func WinnerSeedLT(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLT(FieldWinnerSeed, v))
+}
+
+// WinnerSeedLTE applies the LTE predicate on the "winner_seed" field.
+func WinnerSeedLTE(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldLTE(FieldWinnerSeed, v))
+}
+
+// WinnerSeedIsNil applies the IsNil predicate on the "winner_seed" field.
+func WinnerSeedIsNil() predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIsNull(FieldWinnerSeed))
+}
+
+// WinnerSeedNotNil applies the NotNil predicate on the "winner_seed" field.
+func WinnerSeedNotNil() predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotNull(FieldWinnerSeed))
+}
+
+// GameIDEQ applies the EQ predicate on the "game_id" field.
+func GameIDEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldEQ(FieldGameID, v))
+}
+
+// GameIDNEQ applies the NEQ predicate on the "game_id" field.
+func GameIDNEQ(v int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNEQ(FieldGameID, v))
+}
+
+// GameIDIn applies the In predicate on the "game_id" field.
+func GameIDIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIn(FieldGameID, vs...))
+}
+
+// GameIDNotIn applies the NotIn predicate on the "game_id" field.
+func GameIDNotIn(vs ...int) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotIn(FieldGameID, vs...))
+}
+
+// GameIDIsNil applies the IsNil predicate on the "game_id" field.
+func GameIDIsNil() predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldIsNull(FieldGameID))
+}
+
+// GameIDNotNil applies the NotNil predicate on the "game_id" field.
+func GameIDNotNil() predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.FieldNotNull(FieldGameID))
+}
+
+// HasTournament applies the HasEdge predicate on the "tournament" edge.
+func HasTournament() predicate.TournamentMatch {
+	return predicate.TournamentMatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, TournamentTable, TournamentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTournamentWith applies the HasEdge predicate on the "tournament" edge with a given conditions (other predicates).
+func HasTournamentWith(preds ...predicate.Tournament) predicate.TournamentMatch {
+	return predicate.TournamentMatch(func(s *sql.Selector) {
+		step := newTournamentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGame applies the HasEdge predicate on the "game" edge.
+func HasGame() predicate.TournamentMatch {
+	return predicate.TournamentMatch(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, GameTable, GameColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGameWith applies the HasEdge predicate on the "game" edge with a given conditions (other predicates).
+func HasGameWith(preds ...predicate.Game) predicate.TournamentMatch {
+	return predicate.TournamentMatch(func(s *sql.Selector) {
+		step := newGameStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TournamentMatch) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TournamentMatch) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TournamentMatch) predicate.TournamentMatch {
+	return predicate.TournamentMatch(sql.NotPredicates(p))
+}
@@ -0,0 +1,337 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/game"
+	"example/ent/tournament"
+	"example/ent/tournamentmatch"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentMatchCreate is the builder for creating a TournamentMatch entity.
+type TournamentMatchCreate struct {
+	config
+	mutation *TournamentMatchMutation
+	hooks    []Hook
+}
+
+// SetNumber sets the "number" field.
+func (tmc *TournamentMatchCreate) SetNumber(i int) *TournamentMatchCreate {
+	tmc.mutation.SetNumber(i)
+	return tmc
+}
+
+// SetSide sets the "side" field.
+func (tmc *TournamentMatchCreate) SetSide(t tournamentmatch.Side) *TournamentMatchCreate {
+	tmc.mutation.SetSide(t)
+	return tmc
+}
+
+// SetRound sets the "round" field.
+func (tmc *TournamentMatchCreate) SetRound(i int) *TournamentMatchCreate {
+	tmc.mutation.SetRound(i)
+	return tmc
+}
+
+// SetStatus sets the "status" field.
+func (tmc *TournamentMatchCreate) SetStatus(t tournamentmatch.Status) *TournamentMatchCreate {
+	tmc.mutation.SetStatus(t)
+	return tmc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmc *TournamentMatchCreate) SetNillableStatus(t *tournamentmatch.Status) *TournamentMatchCreate {
+	if t != nil {
+		tmc.SetStatus(*t)
+	}
+	return tmc
+}
+
+// SetWinnerSeed sets the "winner_seed" field.
+func (tmc *TournamentMatchCreate) SetWinnerSeed(i int) *TournamentMatchCreate {
+	tmc.mutation.SetWinnerSeed(i)
+	return tmc
+}
+
+// SetNillableWinnerSeed sets the "winner_seed" field if the given value is not nil.
+func (tmc *TournamentMatchCreate) SetNillableWinnerSeed(i *int) *TournamentMatchCreate {
+	if i != nil {
+		tmc.SetWinnerSeed(*i)
+	}
+	return tmc
+}
+
+// SetGameID sets the "game_id" field.
+func (tmc *TournamentMatchCreate) SetGameID(i int) *TournamentMatchCreate {
+	tmc.mutation.SetGameID(i)
+	return tmc
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (tmc *TournamentMatchCreate) SetNillableGameID(i *int) *TournamentMatchCreate {
+	if i != nil {
+		tmc.SetGameID(*i)
+	}
+	return tmc
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (tmc *TournamentMatchCreate) SetTournamentID(id int) *TournamentMatchCreate {
+	tmc.mutation.SetTournamentID(id)
+	return tmc
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (tmc *TournamentMatchCreate) SetTournament(t *Tournament) *TournamentMatchCreate {
+	return tmc.SetTournamentID(t.ID)
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (tmc *TournamentMatchCreate) SetGame(g *Game) *TournamentMatchCreate {
+	return tmc.SetGameID(g.ID)
+}
+
+// Mutation returns the TournamentMatchMutation object of the builder.
+func (tmc *TournamentMatchCreate) Mutation() *TournamentMatchMutation {
+	return tmc.mutation
+}
+
+// Save creates the TournamentMatch in the database.
+func (tmc *TournamentMatchCreate) Save(ctx context.Context) (*TournamentMatch, error) {
+	tmc.defaults()
+	return withHooks(ctx, tmc.sqlSave, tmc.mutation, tmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tmc *TournamentMatchCreate) SaveX(ctx context.Context) *TournamentMatch {
+	v, err := tmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmc *TournamentMatchCreate) Exec(ctx context.Context) error {
+	_, err := tmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmc *TournamentMatchCreate) ExecX(ctx context.Context) {
+	if err := tmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tmc *TournamentMatchCreate) defaults() {
+	if _, ok := tmc.mutation.Status(); !ok {
+		v := tournamentmatch.DefaultStatus
+		tmc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmc *TournamentMatchCreate) check() error {
+	if _, ok := tmc.mutation.Number(); !ok {
+		return &ValidationError{Name: "number", err: errors.New(`ent: missing required field "TournamentMatch.number"`)}
+	}
+	if _, ok := tmc.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "TournamentMatch.side"`)}
+	}
+	if v, ok := tmc.mutation.Side(); ok {
+		if err := tournamentmatch.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.side": %w`, err)}
+		}
+	}
+	if _, ok := tmc.mutation.Round(); !ok {
+		return &ValidationError{Name: "round", err: errors.New(`ent: missing required field "TournamentMatch.round"`)}
+	}
+	if _, ok := tmc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TournamentMatch.status"`)}
+	}
+	if v, ok := tmc.mutation.Status(); ok {
+		if err := tournamentmatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.status": %w`, err)}
+		}
+	}
+	if len(tmc.mutation.TournamentIDs()) == 0 {
+		return &ValidationError{Name: "tournament", err: errors.New(`ent: missing required edge "TournamentMatch.tournament"`)}
+	}
+	return nil
+}
+
+func (tmc *TournamentMatchCreate) sqlSave(ctx context.Context) (*TournamentMatch, error) {
+	if err := tmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tmc.mutation.id = &_node.ID
+	tmc.mutation.done = true
+	return _node, nil
+}
+
+func (tmc *TournamentMatchCreate) createSpec() (*TournamentMatch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TournamentMatch{config: tmc.config}
+		_spec = sqlgraph.NewCreateSpec(tournamentmatch.Table, sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt))
+	)
+	if value, ok := tmc.mutation.Number(); ok {
+		_spec.SetField(tournamentmatch.FieldNumber, field.TypeInt, value)
+		_node.Number = value
+	}
+	if value, ok := tmc.mutation.Side(); ok {
+		_spec.SetField(tournamentmatch.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := tmc.mutation.Round(); ok {
+		_spec.SetField(tournamentmatch.FieldRound, field.TypeInt, value)
+		_node.Round = value
+	}
+	if value, ok := tmc.mutation.Status(); ok {
+		_spec.SetField(tournamentmatch.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tmc.mutation.WinnerSeed(); ok {
+		_spec.SetField(tournamentmatch.FieldWinnerSeed, field.TypeInt, value)
+		_node.WinnerSeed = &value
+	}
+	if nodes := tmc.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.TournamentTable,
+			Columns: []string{tournamentmatch.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.tournament_match_tournament = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tmc.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.GameTable,
+			Columns: []string{tournamentmatch.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.GameID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TournamentMatchCreateBulk is the builder for creating many TournamentMatch entities in bulk.
+type TournamentMatchCreateBulk struct {
+	config
+	err      error
+	builders []*TournamentMatchCreate
+}
+
+// Save creates the TournamentMatch entities in the database.
+func (tmcb *TournamentMatchCreateBulk) Save(ctx context.Context) ([]*TournamentMatch, error) {
+	if tmcb.err != nil {
+		return nil, tmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tmcb.builders))
+	nodes := make([]*TournamentMatch, len(tmcb.builders))
+	mutators := make([]Mutator, len(tmcb.builders))
+	for i := range tmcb.builders {
+		func(i int, root context.Context) {
+			builder := tmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TournamentMatchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmcb *TournamentMatchCreateBulk) SaveX(ctx context.Context) []*TournamentMatch {
+	v, err := tmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tmcb *TournamentMatchCreateBulk) Exec(ctx context.Context) error {
+	_, err := tmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmcb *TournamentMatchCreateBulk) ExecX(ctx context.Context) {
+	if err := tmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"example/ent/predicate"
+	"example/ent/tournamentmatch"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentMatchDelete is the builder for deleting a TournamentMatch entity.
+type TournamentMatchDelete struct {
+	config
+	hooks    []Hook
+	mutation *TournamentMatchMutation
+}
+
+// Where appends a list predicates to the TournamentMatchDelete builder.
+func (tmd *TournamentMatchDelete) Where(ps ...predicate.TournamentMatch) *TournamentMatchDelete {
+	tmd.mutation.Where(ps...)
+	return tmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tmd *TournamentMatchDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tmd.sqlExec, tmd.mutation, tmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmd *TournamentMatchDelete) ExecX(ctx context.Context) int {
+	n, err := tmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tmd *TournamentMatchDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tournamentmatch.Table, sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt))
+	if ps := tmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tmd.mutation.done = true
+	return affected, err
+}
+
+// TournamentMatchDeleteOne is the builder for deleting a single TournamentMatch entity.
+type TournamentMatchDeleteOne struct {
+	tmd *TournamentMatchDelete
+}
+
+// Where appends a list predicates to the TournamentMatchDelete builder.
+func (tmdo *TournamentMatchDeleteOne) Where(ps ...predicate.TournamentMatch) *TournamentMatchDeleteOne {
+	tmdo.tmd.mutation.Where(ps...)
+	return tmdo
+}
+
+// Exec executes the deletion query.
+func (tmdo *TournamentMatchDeleteOne) Exec(ctx context.Context) error {
+	n, err := tmdo.tmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tournamentmatch.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmdo *TournamentMatchDeleteOne) ExecX(ctx context.Context) {
+	if err := tmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"example/ent/game"
+	"example/ent/predicate"
+	"example/ent/tournament"
+	"example/ent/tournamentmatch"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentMatchQuery is the builder for querying TournamentMatch entities.
+type TournamentMatchQuery struct {
+	config
+	ctx            *QueryContext
+	order          []tournamentmatch.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.TournamentMatch
+	withTournament *TournamentQuery
+	withGame       *GameQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TournamentMatchQuery builder.
+func (tmq *TournamentMatchQuery) Where(ps ...predicate.TournamentMatch) *TournamentMatchQuery {
+	tmq.predicates = append(tmq.predicates, ps...)
+	return tmq
+}
+
+// Limit the number of records to be returned by this query.
+func (tmq *TournamentMatchQuery) Limit(limit int) *TournamentMatchQuery {
+	tmq.ctx.Limit = &limit
+	return tmq
+}
+
+// Offset to start from.
+func (tmq *TournamentMatchQuery) Offset(offset int) *TournamentMatchQuery {
+	tmq.ctx.Offset = &offset
+	return tmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tmq *TournamentMatchQuery) Unique(unique bool) *TournamentMatchQuery {
+	tmq.ctx.Unique = &unique
+	return tmq
+}
+
+// Order specifies how the records should be ordered.
+func (tmq *TournamentMatchQuery) Order(o ...tournamentmatch.OrderOption) *TournamentMatchQuery {
+	tmq.order = append(tmq.order, o...)
+	return tmq
+}
+
+// QueryTournament chains the current query on the "tournament" edge.
+func (tmq *TournamentMatchQuery) QueryTournament() *TournamentQuery {
+	query := (&TournamentClient{config: tmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournamentmatch.Table, tournamentmatch.FieldID, selector),
+			sqlgraph.To(tournament.Table, tournament.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tournamentmatch.TournamentTable, tournamentmatch.TournamentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGame chains the current query on the "game" edge.
+func (tmq *TournamentMatchQuery) QueryGame() *GameQuery {
+	query := (&GameClient{config: tmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tournamentmatch.Table, tournamentmatch.FieldID, selector),
+			sqlgraph.To(game.Table, game.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, tournamentmatch.GameTable, tournamentmatch.GameColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TournamentMatch entity from the query.
+// Returns a *NotFoundError when no TournamentMatch was found.
+func (tmq *TournamentMatchQuery) First(ctx context.Context) (*TournamentMatch, error) {
+	nodes, err := tmq.Limit(1).All(setContextOp(ctx, tmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{tournamentmatch.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) FirstX(ctx context.Context) *TournamentMatch {
+	node, err := tmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TournamentMatch ID from the query.
+// Returns a *NotFoundError when no TournamentMatch ID was found.
+func (tmq *TournamentMatchQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tmq.Limit(1).IDs(setContextOp(ctx, tmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{tournamentmatch.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) FirstIDX(ctx context.Context) int {
+	id, err := tmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TournamentMatch entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TournamentMatch entity is found.
+// Returns a *NotFoundError when no TournamentMatch entities are found.
+func (tmq *TournamentMatchQuery) Only(ctx context.Context) (*TournamentMatch, error) {
+	nodes, err := tmq.Limit(2).All(setContextOp(ctx, tmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{tournamentmatch.Label}
+	default:
+		return nil, &NotSingularError{tournamentmatch.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) OnlyX(ctx context.Context) *TournamentMatch {
+	node, err := tmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TournamentMatch ID in the query.
+// Returns a *NotSingularError when more than one TournamentMatch ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tmq *TournamentMatchQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tmq.Limit(2).IDs(setContextOp(ctx, tmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{tournamentmatch.Label}
+	default:
+		err = &NotSingularError{tournamentmatch.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TournamentMatches.
+func (tmq *TournamentMatchQuery) All(ctx context.Context) ([]*TournamentMatch, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryAll)
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TournamentMatch, *TournamentMatchQuery]()
+	return withInterceptors[[]*TournamentMatch](ctx, tmq, qr, tmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) AllX(ctx context.Context) []*TournamentMatch {
+	nodes, err := tmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TournamentMatch IDs.
+func (tmq *TournamentMatchQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tmq.ctx.Unique == nil && tmq.path != nil {
+		tmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryIDs)
+	if err = tmq.Select(tournamentmatch.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) IDsX(ctx context.Context) []int {
+	ids, err := tmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tmq *TournamentMatchQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryCount)
+	if err := tmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tmq, querierCount[*TournamentMatchQuery](), tmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) CountX(ctx context.Context) int {
+	count, err := tmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tmq *TournamentMatchQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tmq.ctx, ent.OpQueryExist)
+	switch _, err := tmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tmq *TournamentMatchQuery) ExistX(ctx context.Context) bool {
+	exist, err := tmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TournamentMatchQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tmq *TournamentMatchQuery) Clone() *TournamentMatchQuery {
+	if tmq == nil {
+		return nil
+	}
+	return &TournamentMatchQuery{
+		config:         tmq.config,
+		ctx:            tmq.ctx.Clone(),
+		order:          append([]tournamentmatch.OrderOption{}, tmq.order...),
+		inters:         append([]Interceptor{}, tmq.inters...),
+		predicates:     append([]predicate.TournamentMatch{}, tmq.predicates...),
+		withTournament: tmq.withTournament.Clone(),
+		withGame:       tmq.withGame.Clone(),
+		// clone intermediate query.
+		sql:  tmq.sql.Clone(),
+		path: tmq.path,
+	}
+}
+
+// WithTournament tells the query-builder to eager-load the nodes that are connected to
+// the "tournament" edge. The optional arguments are used to configure the query builder of the edge.
+func (tmq *TournamentMatchQuery) WithTournament(opts ...func(*TournamentQuery)) *TournamentMatchQuery {
+	query := (&TournamentClient{config: tmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tmq.withTournament = query
+	return tmq
+}
+
+// WithGame tells the query-builder to eager-load the nodes that are connected to
+// the "game" edge. The optional arguments are used to configure the query builder of the edge.
+func (tmq *TournamentMatchQuery) WithGame(opts ...func(*GameQuery)) *TournamentMatchQuery {
+	query := (&GameClient{config: tmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tmq.withGame = query
+	return tmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Number int `json:"number,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TournamentMatch.Query().
+//		GroupBy(tournamentmatch.FieldNumber).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tmq *TournamentMatchQuery) GroupBy(field string, fields ...string) *TournamentMatchGroupBy {
+	tmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TournamentMatchGroupBy{build: tmq}
+	grbuild.flds = &tmq.ctx.Fields
+	grbuild.label = tournamentmatch.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Number int `json:"number,omitempty"`
+//	}
+//
+//	client.TournamentMatch.Query().
+//		Select(tournamentmatch.FieldNumber).
+//		Scan(ctx, &v)
+func (tmq *TournamentMatchQuery) Select(fields ...string) *TournamentMatchSelect {
+	tmq.ctx.Fields = append(tmq.ctx.Fields, fields...)
+	sbuild := &TournamentMatchSelect{TournamentMatchQuery: tmq}
+	sbuild.label = tournamentmatch.Label
+	sbuild.flds, sbuild.scan = &tmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TournamentMatchSelect configured with the given aggregations.
+func (tmq *TournamentMatchQuery) Aggregate(fns ...AggregateFunc) *TournamentMatchSelect {
+	return tmq.Select().Aggregate(fns...)
+}
+
+func (tmq *TournamentMatchQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tmq.ctx.Fields {
+		if !tournamentmatch.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tmq.path != nil {
+		prev, err := tmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tmq.sql = prev
+	}
+	return nil
+}
+
+func (tmq *TournamentMatchQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TournamentMatch, error) {
+	var (
+		nodes       = []*TournamentMatch{}
+		withFKs     = tmq.withFKs
+		_spec       = tmq.querySpec()
+		loadedTypes = [2]bool{
+			tmq.withTournament != nil,
+			tmq.withGame != nil,
+		}
+	)
+	if tmq.withTournament != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, tournamentmatch.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TournamentMatch).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TournamentMatch{config: tmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tmq.withTournament; query != nil {
+		if err := tmq.loadTournament(ctx, query, nodes, nil,
+			func(n *TournamentMatch, e *Tournament) { n.Edges.Tournament = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tmq.withGame; query != nil {
+		if err := tmq.loadGame(ctx, query, nodes, nil,
+			func(n *TournamentMatch, e *Game) { n.Edges.Game = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tmq *TournamentMatchQuery) loadTournament(ctx context.Context, query *TournamentQuery, nodes []*TournamentMatch, init func(*TournamentMatch), assign func(*TournamentMatch, *Tournament)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TournamentMatch)
+	for i := range nodes {
+		if nodes[i].tournament_match_tournament == nil {
+			continue
+		}
+		fk := *nodes[i].tournament_match_tournament
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(tournament.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "tournament_match_tournament" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tmq *TournamentMatchQuery) loadGame(ctx context.Context, query *GameQuery, nodes []*TournamentMatch, init func(*TournamentMatch), assign func(*TournamentMatch, *Game)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TournamentMatch)
+	for i := range nodes {
+		fk := nodes[i].GameID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(game.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "game_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (tmq *TournamentMatchQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tmq.querySpec()
+	_spec.Node.Columns = tmq.ctx.Fields
+	if len(tmq.ctx.Fields) > 0 {
+		_spec.Unique = tmq.ctx.Unique != nil && *tmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tmq.driver, _spec)
+}
+
+func (tmq *TournamentMatchQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(tournamentmatch.Table, tournamentmatch.Columns, sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt))
+	_spec.From = tmq.sql
+	if unique := tmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tournamentmatch.FieldID)
+		for i := range fields {
+			if fields[i] != tournamentmatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tmq.withGame != nil {
+			_spec.Node.AddColumnOnce(tournamentmatch.FieldGameID)
+		}
+	}
+	if ps := tmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tmq *TournamentMatchQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tmq.driver.Dialect())
+	t1 := builder.Table(tournamentmatch.Table)
+	columns := tmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = tournamentmatch.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tmq.sql != nil {
+		selector = tmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tmq.ctx.Unique != nil && *tmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tmq.predicates {
+		p(selector)
+	}
+	for _, p := range tmq.order {
+		p(selector)
+	}
+	if offset := tmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TournamentMatchGroupBy is the group-by builder for TournamentMatch entities.
+type TournamentMatchGroupBy struct {
+	selector
+	build *TournamentMatchQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tmgb *TournamentMatchGroupBy) Aggregate(fns ...AggregateFunc) *TournamentMatchGroupBy {
+	tmgb.fns = append(tmgb.fns, fns...)
+	return tmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tmgb *TournamentMatchGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := tmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TournamentMatchQuery, *TournamentMatchGroupBy](ctx, tmgb.build, tmgb, tmgb.build.inters, v)
+}
+
+func (tmgb *TournamentMatchGroupBy) sqlScan(ctx context.Context, root *TournamentMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tmgb.fns))
+	for _, fn := range tmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tmgb.flds)+len(tmgb.fns))
+		for _, f := range *tmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TournamentMatchSelect is the builder for selecting fields of TournamentMatch entities.
+type TournamentMatchSelect struct {
+	*TournamentMatchQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tms *TournamentMatchSelect) Aggregate(fns ...AggregateFunc) *TournamentMatchSelect {
+	tms.fns = append(tms.fns, fns...)
+	return tms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tms *TournamentMatchSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tms.ctx, ent.OpQuerySelect)
+	if err := tms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TournamentMatchQuery, *TournamentMatchSelect](ctx, tms.TournamentMatchQuery, tms, tms.inters, v)
+}
+
+func (tms *TournamentMatchSelect) sqlScan(ctx context.Context, root *TournamentMatchQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tms.fns))
+	for _, fn := range tms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/game"
+	"example/ent/predicate"
+	"example/ent/tournament"
+	"example/ent/tournamentmatch"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TournamentMatchUpdate is the builder for updating TournamentMatch entities.
+type TournamentMatchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TournamentMatchMutation
+}
+
+// Where appends a list predicates to the TournamentMatchUpdate builder.
+func (tmu *TournamentMatchUpdate) Where(ps ...predicate.TournamentMatch) *TournamentMatchUpdate {
+	tmu.mutation.Where(ps...)
+	return tmu
+}
+
+// SetNumber sets the "number" field.
+func (tmu *TournamentMatchUpdate) SetNumber(i int) *TournamentMatchUpdate {
+	tmu.mutation.ResetNumber()
+	tmu.mutation.SetNumber(i)
+	return tmu
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableNumber(i *int) *TournamentMatchUpdate {
+	if i != nil {
+		tmu.SetNumber(*i)
+	}
+	return tmu
+}
+
+// AddNumber adds i to the "number" field.
+func (tmu *TournamentMatchUpdate) AddNumber(i int) *TournamentMatchUpdate {
+	tmu.mutation.AddNumber(i)
+	return tmu
+}
+
+// SetSide sets the "side" field.
+func (tmu *TournamentMatchUpdate) SetSide(t tournamentmatch.Side) *TournamentMatchUpdate {
+	tmu.mutation.SetSide(t)
+	return tmu
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableSide(t *tournamentmatch.Side) *TournamentMatchUpdate {
+	if t != nil {
+		tmu.SetSide(*t)
+	}
+	return tmu
+}
+
+// SetRound sets the "round" field.
+func (tmu *TournamentMatchUpdate) SetRound(i int) *TournamentMatchUpdate {
+	tmu.mutation.ResetRound()
+	tmu.mutation.SetRound(i)
+	return tmu
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableRound(i *int) *TournamentMatchUpdate {
+	if i != nil {
+		tmu.SetRound(*i)
+	}
+	return tmu
+}
+
+// AddRound adds i to the "round" field.
+func (tmu *TournamentMatchUpdate) AddRound(i int) *TournamentMatchUpdate {
+	tmu.mutation.AddRound(i)
+	return tmu
+}
+
+// SetStatus sets the "status" field.
+func (tmu *TournamentMatchUpdate) SetStatus(t tournamentmatch.Status) *TournamentMatchUpdate {
+	tmu.mutation.SetStatus(t)
+	return tmu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableStatus(t *tournamentmatch.Status) *TournamentMatchUpdate {
+	if t != nil {
+		tmu.SetStatus(*t)
+	}
+	return tmu
+}
+
+// SetWinnerSeed sets the "winner_seed" field.
+func (tmu *TournamentMatchUpdate) SetWinnerSeed(i int) *TournamentMatchUpdate {
+	tmu.mutation.ResetWinnerSeed()
+	tmu.mutation.SetWinnerSeed(i)
+	return tmu
+}
+
+// SetNillableWinnerSeed sets the "winner_seed" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableWinnerSeed(i *int) *TournamentMatchUpdate {
+	if i != nil {
+		tmu.SetWinnerSeed(*i)
+	}
+	return tmu
+}
+
+// AddWinnerSeed adds i to the "winner_seed" field.
+func (tmu *TournamentMatchUpdate) AddWinnerSeed(i int) *TournamentMatchUpdate {
+	tmu.mutation.AddWinnerSeed(i)
+	return tmu
+}
+
+// ClearWinnerSeed clears the value of the "winner_seed" field.
+func (tmu *TournamentMatchUpdate) ClearWinnerSeed() *TournamentMatchUpdate {
+	tmu.mutation.ClearWinnerSeed()
+	return tmu
+}
+
+// SetGameID sets the "game_id" field.
+func (tmu *TournamentMatchUpdate) SetGameID(i int) *TournamentMatchUpdate {
+	tmu.mutation.SetGameID(i)
+	return tmu
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (tmu *TournamentMatchUpdate) SetNillableGameID(i *int) *TournamentMatchUpdate {
+	if i != nil {
+		tmu.SetGameID(*i)
+	}
+	return tmu
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (tmu *TournamentMatchUpdate) ClearGameID() *TournamentMatchUpdate {
+	tmu.mutation.ClearGameID()
+	return tmu
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (tmu *TournamentMatchUpdate) SetTournamentID(id int) *TournamentMatchUpdate {
+	tmu.mutation.SetTournamentID(id)
+	return tmu
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (tmu *TournamentMatchUpdate) SetTournament(t *Tournament) *TournamentMatchUpdate {
+	return tmu.SetTournamentID(t.ID)
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (tmu *TournamentMatchUpdate) SetGame(g *Game) *TournamentMatchUpdate {
+	return tmu.SetGameID(g.ID)
+}
+
+// Mutation returns the TournamentMatchMutation object of the builder.
+func (tmu *TournamentMatchUpdate) Mutation() *TournamentMatchMutation {
+	return tmu.mutation
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (tmu *TournamentMatchUpdate) ClearTournament() *TournamentMatchUpdate {
+	tmu.mutation.ClearTournament()
+	return tmu
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (tmu *TournamentMatchUpdate) ClearGame() *TournamentMatchUpdate {
+	tmu.mutation.ClearGame()
+	return tmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tmu *TournamentMatchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, tmu.sqlSave, tmu.mutation, tmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmu *TournamentMatchUpdate) SaveX(ctx context.Context) int {
+	affected, err := tmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tmu *TournamentMatchUpdate) Exec(ctx context.Context) error {
+	_, err := tmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmu *TournamentMatchUpdate) ExecX(ctx context.Context) {
+	if err := tmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmu *TournamentMatchUpdate) check() error {
+	if v, ok := tmu.mutation.Side(); ok {
+		if err := tournamentmatch.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.side": %w`, err)}
+		}
+	}
+	if v, ok := tmu.mutation.Status(); ok {
+		if err := tournamentmatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.status": %w`, err)}
+		}
+	}
+	if tmu.mutation.TournamentCleared() && len(tmu.mutation.TournamentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TournamentMatch.tournament"`)
+	}
+	return nil
+}
+
+func (tmu *TournamentMatchUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournamentmatch.Table, tournamentmatch.Columns, sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt))
+	if ps := tmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmu.mutation.Number(); ok {
+		_spec.SetField(tournamentmatch.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tmu.mutation.AddedNumber(); ok {
+		_spec.AddField(tournamentmatch.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tmu.mutation.Side(); ok {
+		_spec.SetField(tournamentmatch.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := tmu.mutation.Round(); ok {
+		_spec.SetField(tournamentmatch.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := tmu.mutation.AddedRound(); ok {
+		_spec.AddField(tournamentmatch.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := tmu.mutation.Status(); ok {
+		_spec.SetField(tournamentmatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tmu.mutation.WinnerSeed(); ok {
+		_spec.SetField(tournamentmatch.FieldWinnerSeed, field.TypeInt, value)
+	}
+	if value, ok := tmu.mutation.AddedWinnerSeed(); ok {
+		_spec.AddField(tournamentmatch.FieldWinnerSeed, field.TypeInt, value)
+	}
+	if tmu.mutation.WinnerSeedCleared() {
+		_spec.ClearField(tournamentmatch.FieldWinnerSeed, field.TypeInt)
+	}
+	if tmu.mutation.TournamentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.TournamentTable,
+			Columns: []string{tournamentmatch.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.TournamentTable,
+			Columns: []string{tournamentmatch.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmu.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.GameTable,
+			Columns: []string{tournamentmatch.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmu.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.GameTable,
+			Columns: []string{tournamentmatch.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournamentmatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tmu.mutation.done = true
+	return n, nil
+}
+
+// TournamentMatchUpdateOne is the builder for updating a single TournamentMatch entity.
+type TournamentMatchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TournamentMatchMutation
+}
+
+// SetNumber sets the "number" field.
+func (tmuo *TournamentMatchUpdateOne) SetNumber(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.ResetNumber()
+	tmuo.mutation.SetNumber(i)
+	return tmuo
+}
+
+// SetNillableNumber sets the "number" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableNumber(i *int) *TournamentMatchUpdateOne {
+	if i != nil {
+		tmuo.SetNumber(*i)
+	}
+	return tmuo
+}
+
+// AddNumber adds i to the "number" field.
+func (tmuo *TournamentMatchUpdateOne) AddNumber(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.AddNumber(i)
+	return tmuo
+}
+
+// SetSide sets the "side" field.
+func (tmuo *TournamentMatchUpdateOne) SetSide(t tournamentmatch.Side) *TournamentMatchUpdateOne {
+	tmuo.mutation.SetSide(t)
+	return tmuo
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableSide(t *tournamentmatch.Side) *TournamentMatchUpdateOne {
+	if t != nil {
+		tmuo.SetSide(*t)
+	}
+	return tmuo
+}
+
+// SetRound sets the "round" field.
+func (tmuo *TournamentMatchUpdateOne) SetRound(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.ResetRound()
+	tmuo.mutation.SetRound(i)
+	return tmuo
+}
+
+// SetNillableRound sets the "round" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableRound(i *int) *TournamentMatchUpdateOne {
+	if i != nil {
+		tmuo.SetRound(*i)
+	}
+	return tmuo
+}
+
+// AddRound adds i to the "round" field.
+func (tmuo *TournamentMatchUpdateOne) AddRound(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.AddRound(i)
+	return tmuo
+}
+
+// SetStatus sets the "status" field.
+func (tmuo *TournamentMatchUpdateOne) SetStatus(t tournamentmatch.Status) *TournamentMatchUpdateOne {
+	tmuo.mutation.SetStatus(t)
+	return tmuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableStatus(t *tournamentmatch.Status) *TournamentMatchUpdateOne {
+	if t != nil {
+		tmuo.SetStatus(*t)
+	}
+	return tmuo
+}
+
+// SetWinnerSeed sets the "winner_seed" field.
+func (tmuo *TournamentMatchUpdateOne) SetWinnerSeed(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.ResetWinnerSeed()
+	tmuo.mutation.SetWinnerSeed(i)
+	return tmuo
+}
+
+// SetNillableWinnerSeed sets the "winner_seed" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableWinnerSeed(i *int) *TournamentMatchUpdateOne {
+	if i != nil {
+		tmuo.SetWinnerSeed(*i)
+	}
+	return tmuo
+}
+
+// AddWinnerSeed adds i to the "winner_seed" field.
+func (tmuo *TournamentMatchUpdateOne) AddWinnerSeed(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.AddWinnerSeed(i)
+	return tmuo
+}
+
+// ClearWinnerSeed clears the value of the "winner_seed" field.
+func (tmuo *TournamentMatchUpdateOne) ClearWinnerSeed() *TournamentMatchUpdateOne {
+	tmuo.mutation.ClearWinnerSeed()
+	return tmuo
+}
+
+// SetGameID sets the "game_id" field.
+func (tmuo *TournamentMatchUpdateOne) SetGameID(i int) *TournamentMatchUpdateOne {
+	tmuo.mutation.SetGameID(i)
+	return tmuo
+}
+
+// SetNillableGameID sets the "game_id" field if the given value is not nil.
+func (tmuo *TournamentMatchUpdateOne) SetNillableGameID(i *int) *TournamentMatchUpdateOne {
+	if i != nil {
+		tmuo.SetGameID(*i)
+	}
+	return tmuo
+}
+
+// ClearGameID clears the value of the "game_id" field.
+func (tmuo *TournamentMatchUpdateOne) ClearGameID() *TournamentMatchUpdateOne {
+	tmuo.mutation.ClearGameID()
+	return tmuo
+}
+
+// SetTournamentID sets the "tournament" edge to the Tournament entity by ID.
+func (tmuo *TournamentMatchUpdateOne) SetTournamentID(id int) *TournamentMatchUpdateOne {
+	tmuo.mutation.SetTournamentID(id)
+	return tmuo
+}
+
+// SetTournament sets the "tournament" edge to the Tournament entity.
+func (tmuo *TournamentMatchUpdateOne) SetTournament(t *Tournament) *TournamentMatchUpdateOne {
+	return tmuo.SetTournamentID(t.ID)
+}
+
+// SetGame sets the "game" edge to the Game entity.
+func (tmuo *TournamentMatchUpdateOne) SetGame(g *Game) *TournamentMatchUpdateOne {
+	return tmuo.SetGameID(g.ID)
+}
+
+// Mutation returns the TournamentMatchMutation object of the builder.
+func (tmuo *TournamentMatchUpdateOne) Mutation() *TournamentMatchMutation {
+	return tmuo.mutation
+}
+
+// ClearTournament clears the "tournament" edge to the Tournament entity.
+func (tmuo *TournamentMatchUpdateOne) ClearTournament() *TournamentMatchUpdateOne {
+	tmuo.mutation.ClearTournament()
+	return tmuo
+}
+
+// ClearGame clears the "game" edge to the Game entity.
+func (tmuo *TournamentMatchUpdateOne) ClearGame() *TournamentMatchUpdateOne {
+	tmuo.mutation.ClearGame()
+	return tmuo
+}
+
+// Where appends a list predicates to the TournamentMatchUpdate builder.
+func (tmuo *TournamentMatchUpdateOne) Where(ps ...predicate.TournamentMatch) *TournamentMatchUpdateOne {
+	tmuo.mutation.Where(ps...)
+	return tmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tmuo *TournamentMatchUpdateOne) Select(field string, fields ...string) *TournamentMatchUpdateOne {
+	tmuo.fields = append([]string{field}, fields...)
+	return tmuo
+}
+
+// Save executes the query and returns the updated TournamentMatch entity.
+func (tmuo *TournamentMatchUpdateOne) Save(ctx context.Context) (*TournamentMatch, error) {
+	return withHooks(ctx, tmuo.sqlSave, tmuo.mutation, tmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tmuo *TournamentMatchUpdateOne) SaveX(ctx context.Context) *TournamentMatch {
+	node, err := tmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tmuo *TournamentMatchUpdateOne) Exec(ctx context.Context) error {
+	_, err := tmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tmuo *TournamentMatchUpdateOne) ExecX(ctx context.Context) {
+	if err := tmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tmuo *TournamentMatchUpdateOne) check() error {
+	if v, ok := tmuo.mutation.Side(); ok {
+		if err := tournamentmatch.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.side": %w`, err)}
+		}
+	}
+	if v, ok := tmuo.mutation.Status(); ok {
+		if err := tournamentmatch.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TournamentMatch.status": %w`, err)}
+		}
+	}
+	if tmuo.mutation.TournamentCleared() && len(tmuo.mutation.TournamentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "TournamentMatch.tournament"`)
+	}
+	return nil
+}
+
+func (tmuo *TournamentMatchUpdateOne) sqlSave(ctx context.Context) (_node *TournamentMatch, err error) {
+	if err := tmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tournamentmatch.Table, tournamentmatch.Columns, sqlgraph.NewFieldSpec(tournamentmatch.FieldID, field.TypeInt))
+	id, ok := tmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TournamentMatch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tournamentmatch.FieldID)
+		for _, f := range fields {
+			if !tournamentmatch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tournamentmatch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tmuo.mutation.Number(); ok {
+		_spec.SetField(tournamentmatch.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tmuo.mutation.AddedNumber(); ok {
+		_spec.AddField(tournamentmatch.FieldNumber, field.TypeInt, value)
+	}
+	if value, ok := tmuo.mutation.Side(); ok {
+		_spec.SetField(tournamentmatch.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := tmuo.mutation.Round(); ok {
+		_spec.SetField(tournamentmatch.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := tmuo.mutation.AddedRound(); ok {
+		_spec.AddField(tournamentmatch.FieldRound, field.TypeInt, value)
+	}
+	if value, ok := tmuo.mutation.Status(); ok {
+		_spec.SetField(tournamentmatch.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := tmuo.mutation.WinnerSeed(); ok {
+		_spec.SetField(tournamentmatch.FieldWinnerSeed, field.TypeInt, value)
+	}
+	if value, ok := tmuo.mutation.AddedWinnerSeed(); ok {
+		_spec.AddField(tournamentmatch.FieldWinnerSeed, field.TypeInt, value)
+	}
+	if tmuo.mutation.WinnerSeedCleared() {
+		_spec.ClearField(tournamentmatch.FieldWinnerSeed, field.TypeInt)
+	}
+	if tmuo.mutation.TournamentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.TournamentTable,
+			Columns: []string{tournamentmatch.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.TournamentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.TournamentTable,
+			Columns: []string{tournamentmatch.TournamentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tournament.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tmuo.mutation.GameCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.GameTable,
+			Columns: []string{tournamentmatch.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tmuo.mutation.GameIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   tournamentmatch.GameTable,
+			Columns: []string{tournamentmatch.GameColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(game.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TournamentMatch{config: tmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tournamentmatch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tmuo.mutation.done = true
+	return _node, nil
+}
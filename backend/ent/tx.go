@@ -12,14 +12,32 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// Card is the client for interacting with the Card builders.
 	Card *CardClient
+	// DailyChallenge is the client for interacting with the DailyChallenge builders.
+	DailyChallenge *DailyChallengeClient
 	// Game is the client for interacting with the Game builders.
 	Game *GameClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// Match is the client for interacting with the Match builders.
+	Match *MatchClient
 	// Player is the client for interacting with the Player builders.
 	Player *PlayerClient
+	// PracticeRun is the client for interacting with the PracticeRun builders.
+	PracticeRun *PracticeRunClient
+	// Team is the client for interacting with the Team builders.
+	Team *TeamClient
+	// Tournament is the client for interacting with the Tournament builders.
+	Tournament *TournamentClient
+	// TournamentEntrant is the client for interacting with the TournamentEntrant builders.
+	TournamentEntrant *TournamentEntrantClient
+	// TournamentMatch is the client for interacting with the TournamentMatch builders.
+	TournamentMatch *TournamentMatchClient
+	// User is the client for interacting with the User builders.
+	User *UserClient
 
 	// lazily loaded.
 	client     *Client
@@ -151,10 +169,19 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Ban = NewBanClient(tx.config)
 	tx.Card = NewCardClient(tx.config)
+	tx.DailyChallenge = NewDailyChallengeClient(tx.config)
 	tx.Game = NewGameClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.Match = NewMatchClient(tx.config)
 	tx.Player = NewPlayerClient(tx.config)
+	tx.PracticeRun = NewPracticeRunClient(tx.config)
+	tx.Team = NewTeamClient(tx.config)
+	tx.Tournament = NewTournamentClient(tx.config)
+	tx.TournamentEntrant = NewTournamentEntrantClient(tx.config)
+	tx.TournamentMatch = NewTournamentMatchClient(tx.config)
+	tx.User = NewUserClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -164,7 +191,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Card.QueryXXX(), the query will be executed
+// applies a query, for example: Ban.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,540 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"example/ent/player"
+	"example/ent/predicate"
+	"example/ent/user"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserUpdate is the builder for updating User entities.
+type UserUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uu *UserUpdate) Where(ps ...predicate.User) *UserUpdate {
+	uu.mutation.Where(ps...)
+	return uu
+}
+
+// SetName sets the "name" field.
+func (uu *UserUpdate) SetName(s string) *UserUpdate {
+	uu.mutation.SetName(s)
+	return uu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableName(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetName(*s)
+	}
+	return uu
+}
+
+// SetToken sets the "token" field.
+func (uu *UserUpdate) SetToken(s string) *UserUpdate {
+	uu.mutation.SetToken(s)
+	return uu
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableToken(s *string) *UserUpdate {
+	if s != nil {
+		uu.SetToken(*s)
+	}
+	return uu
+}
+
+// SetRating sets the "rating" field.
+func (uu *UserUpdate) SetRating(f float64) *UserUpdate {
+	uu.mutation.ResetRating()
+	uu.mutation.SetRating(f)
+	return uu
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRating(f *float64) *UserUpdate {
+	if f != nil {
+		uu.SetRating(*f)
+	}
+	return uu
+}
+
+// AddRating adds f to the "rating" field.
+func (uu *UserUpdate) AddRating(f float64) *UserUpdate {
+	uu.mutation.AddRating(f)
+	return uu
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (uu *UserUpdate) SetRatedGames(i int) *UserUpdate {
+	uu.mutation.ResetRatedGames()
+	uu.mutation.SetRatedGames(i)
+	return uu
+}
+
+// SetNillableRatedGames sets the "rated_games" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableRatedGames(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetRatedGames(*i)
+	}
+	return uu
+}
+
+// AddRatedGames adds i to the "rated_games" field.
+func (uu *UserUpdate) AddRatedGames(i int) *UserUpdate {
+	uu.mutation.AddRatedGames(i)
+	return uu
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (uu *UserUpdate) AddPlayerIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddPlayerIDs(ids...)
+	return uu
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (uu *UserUpdate) AddPlayers(p ...*Player) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.AddPlayerIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uu *UserUpdate) Mutation() *UserMutation {
+	return uu.mutation
+}
+
+// ClearPlayers clears all "players" edges to the Player entity.
+func (uu *UserUpdate) ClearPlayers() *UserUpdate {
+	uu.mutation.ClearPlayers()
+	return uu
+}
+
+// RemovePlayerIDs removes the "players" edge to Player entities by IDs.
+func (uu *UserUpdate) RemovePlayerIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemovePlayerIDs(ids...)
+	return uu
+}
+
+// RemovePlayers removes "players" edges to Player entities.
+func (uu *UserUpdate) RemovePlayers(p ...*Player) *UserUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uu.RemovePlayerIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, uu.sqlSave, uu.mutation, uu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uu *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := uu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (uu *UserUpdate) Exec(ctx context.Context) error {
+	_, err := uu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uu *UserUpdate) ExecX(ctx context.Context) {
+	if err := uu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uu *UserUpdate) check() error {
+	if v, ok := uu.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := uu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	if ps := uu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uu.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.Token(); ok {
+		_spec.SetField(user.FieldToken, field.TypeString, value)
+	}
+	if value, ok := uu.mutation.Rating(); ok {
+		_spec.SetField(user.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := uu.mutation.AddedRating(); ok {
+		_spec.AddField(user.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := uu.mutation.RatedGames(); ok {
+		_spec.SetField(user.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedRatedGames(); ok {
+		_spec.AddField(user.FieldRatedGames, field.TypeInt, value)
+	}
+	if uu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !uu.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.PlayersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	uu.mutation.done = true
+	return n, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetName sets the "name" field.
+func (uuo *UserUpdateOne) SetName(s string) *UserUpdateOne {
+	uuo.mutation.SetName(s)
+	return uuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableName(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetName(*s)
+	}
+	return uuo
+}
+
+// SetToken sets the "token" field.
+func (uuo *UserUpdateOne) SetToken(s string) *UserUpdateOne {
+	uuo.mutation.SetToken(s)
+	return uuo
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableToken(s *string) *UserUpdateOne {
+	if s != nil {
+		uuo.SetToken(*s)
+	}
+	return uuo
+}
+
+// SetRating sets the "rating" field.
+func (uuo *UserUpdateOne) SetRating(f float64) *UserUpdateOne {
+	uuo.mutation.ResetRating()
+	uuo.mutation.SetRating(f)
+	return uuo
+}
+
+// SetNillableRating sets the "rating" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRating(f *float64) *UserUpdateOne {
+	if f != nil {
+		uuo.SetRating(*f)
+	}
+	return uuo
+}
+
+// AddRating adds f to the "rating" field.
+func (uuo *UserUpdateOne) AddRating(f float64) *UserUpdateOne {
+	uuo.mutation.AddRating(f)
+	return uuo
+}
+
+// SetRatedGames sets the "rated_games" field.
+func (uuo *UserUpdateOne) SetRatedGames(i int) *UserUpdateOne {
+	uuo.mutation.ResetRatedGames()
+	uuo.mutation.SetRatedGames(i)
+	return uuo
+}
+
+// SetNillableRatedGames sets the "rated_games" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableRatedGames(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetRatedGames(*i)
+	}
+	return uuo
+}
+
+// AddRatedGames adds i to the "rated_games" field.
+func (uuo *UserUpdateOne) AddRatedGames(i int) *UserUpdateOne {
+	uuo.mutation.AddRatedGames(i)
+	return uuo
+}
+
+// AddPlayerIDs adds the "players" edge to the Player entity by IDs.
+func (uuo *UserUpdateOne) AddPlayerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddPlayerIDs(ids...)
+	return uuo
+}
+
+// AddPlayers adds the "players" edges to the Player entity.
+func (uuo *UserUpdateOne) AddPlayers(p ...*Player) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.AddPlayerIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (uuo *UserUpdateOne) Mutation() *UserMutation {
+	return uuo.mutation
+}
+
+// ClearPlayers clears all "players" edges to the Player entity.
+func (uuo *UserUpdateOne) ClearPlayers() *UserUpdateOne {
+	uuo.mutation.ClearPlayers()
+	return uuo
+}
+
+// RemovePlayerIDs removes the "players" edge to Player entities by IDs.
+func (uuo *UserUpdateOne) RemovePlayerIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemovePlayerIDs(ids...)
+	return uuo
+}
+
+// RemovePlayers removes "players" edges to Player entities.
+func (uuo *UserUpdateOne) RemovePlayers(p ...*Player) *UserUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return uuo.RemovePlayerIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (uuo *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	uuo.mutation.Where(ps...)
+	return uuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (uuo *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	uuo.fields = append([]string{field}, fields...)
+	return uuo
+}
+
+// Save executes the query and returns the updated User entity.
+func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	return withHooks(ctx, uuo.sqlSave, uuo.mutation, uuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (uuo *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := uuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (uuo *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := uuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (uuo *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := uuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (uuo *UserUpdateOne) check() error {
+	if v, ok := uuo.mutation.Name(); ok {
+		if err := user.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "User.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := uuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt))
+	id, ok := uuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := uuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := uuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := uuo.mutation.Name(); ok {
+		_spec.SetField(user.FieldName, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Token(); ok {
+		_spec.SetField(user.FieldToken, field.TypeString, value)
+	}
+	if value, ok := uuo.mutation.Rating(); ok {
+		_spec.SetField(user.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := uuo.mutation.AddedRating(); ok {
+		_spec.AddField(user.FieldRating, field.TypeFloat64, value)
+	}
+	if value, ok := uuo.mutation.RatedGames(); ok {
+		_spec.SetField(user.FieldRatedGames, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedRatedGames(); ok {
+		_spec.AddField(user.FieldRatedGames, field.TypeInt, value)
+	}
+	if uuo.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.RemovedPlayersIDs(); len(nodes) > 0 && !uuo.mutation.PlayersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.PlayersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   user.PlayersTable,
+			Columns: []string{user.PlayersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(player.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &User{config: uuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, uuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	uuo.mutation.done = true
+	return _node, nil
+}
@@ -27,7 +27,11 @@ type Player struct {
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	GameId        int32                  `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"` // プレイヤーのスコア
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`                             // プレイヤーのスコア
+	IsHost        bool                   `protobuf:"varint,5,opt,name=is_host,json=isHost,proto3" json:"is_host,omitempty"`             // ゲームを開始・設定変更・キックできるホストか
+	SeriesWins    int32                  `protobuf:"varint,6,opt,name=series_wins,json=seriesWins,proto3" json:"series_wins,omitempty"` // 再戦を続けたシリーズでの勝利数
+	IsBot         bool                   `protobuf:"varint,7,opt,name=is_bot,json=isBot,proto3" json:"is_bot,omitempty"`                // サーバー内で動くボットか
+	TeamId        int32                  `protobuf:"varint,8,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`             // TEAMS: 所属するチーム（未所属は0）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,29 +94,4493 @@ func (x *Player) GetScore() int32 {
 	return 0
 }
 
-type CreateGameRequest struct {
+func (x *Player) GetIsHost() bool {
+	if x != nil {
+		return x.IsHost
+	}
+	return false
+}
+
+func (x *Player) GetSeriesWins() int32 {
+	if x != nil {
+		return x.SeriesWins
+	}
+	return 0
+}
+
+func (x *Player) GetIsBot() bool {
+	if x != nil {
+		return x.IsBot
+	}
+	return false
+}
+
+func (x *Player) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+type Handicap struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ExtraSymbols      int32                  `protobuf:"varint,1,opt,name=extra_symbols,json=extraSymbols,proto3" json:"extra_symbols,omitempty"`                // このプレイヤーに見えるカードに足すおとりのシンボル数（0〜3、SPEED以外）
+	AnswerDelayMs     int32                  `protobuf:"varint,2,opt,name=answer_delay_ms,json=answerDelayMs,proto3" json:"answer_delay_ms,omitempty"`           // カードが配られてから回答を受け付けるまでの時間
+	MultiplierPercent int32                  `protobuf:"varint,3,opt,name=multiplier_percent,json=multiplierPercent,proto3" json:"multiplier_percent,omitempty"` // 正解時の得点の倍率（10〜300%、0は100%）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Handicap) Reset() {
+	*x = Handicap{}
+	mi := &file_game_v1_game_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Handicap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handicap) ProtoMessage() {}
+
+func (x *Handicap) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handicap.ProtoReflect.Descriptor instead.
+func (*Handicap) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Handicap) GetExtraSymbols() int32 {
+	if x != nil {
+		return x.ExtraSymbols
+	}
+	return 0
+}
+
+func (x *Handicap) GetAnswerDelayMs() int32 {
+	if x != nil {
+		return x.AnswerDelayMs
+	}
+	return 0
+}
+
+func (x *Handicap) GetMultiplierPercent() int32 {
+	if x != nil {
+		return x.MultiplierPercent
+	}
+	return 0
+}
+
+type Team struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameName      string                 `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
-	CardCount     int32                  `protobuf:"varint,2,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Score         int32                  `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"` // メンバーの得点の合計
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_game_v1_game_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type CreateGameRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	GameName            string                 `protobuf:"bytes,1,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
+	CardCount           int32                  `protobuf:"varint,2,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	Mode                string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`                                                           // GetGameModesで取得できるモード名（未指定はNORMAL）
+	EliminationInterval int32                  `protobuf:"varint,4,opt,name=elimination_interval,json=eliminationInterval,proto3" json:"elimination_interval,omitempty"` // BATTLE_ROYALE: 何ラウンドごとに最下位を脱落させるか（未指定は3）
+	CenterCount         int32                  `protobuf:"varint,5,opt,name=center_count,json=centerCount,proto3" json:"center_count,omitempty"`                         // SPEED: 場に同時に出すカード枚数（3以上、未指定は3）
+	TieBreak            string                 `protobuf:"bytes,6,opt,name=tie_break,json=tieBreak,proto3" json:"tie_break,omitempty"`                                   // 同点時のサドンデス: NONE, REDRAW（同じデッキを引き直す）, FRESH_DECK（新しいデッキ）
+	Scoring             *ScoringRules          `protobuf:"bytes,7,opt,name=scoring,proto3" json:"scoring,omitempty"`                                                     // 未指定は正解+1、不正解-1
+	Settings            *GameSettings          `protobuf:"bytes,8,opt,name=settings,proto3" json:"settings,omitempty"`
+	TeamCount           int32                  `protobuf:"varint,9,opt,name=team_count,json=teamCount,proto3" json:"team_count,omitempty"`        // TEAMS: チーム数（2以上、未指定は2）
+	TeamNames           []string               `protobuf:"bytes,10,rep,name=team_names,json=teamNames,proto3" json:"team_names,omitempty"`        // TEAMS: チーム名（未指定は「チーム1」など）
+	AutoBalance         bool                   `protobuf:"varint,11,opt,name=auto_balance,json=autoBalance,proto3" json:"auto_balance,omitempty"` // TEAMS: 人数が揃うようにチームを自動で振り分ける
+	MatchFormat         string                 `protobuf:"bytes,12,opt,name=match_format,json=matchFormat,proto3" json:"match_format,omitempty"`  // 複数ゲームの対戦にする場合: BEST_OF, FIRST_TO（未指定は1ゲームのみ）
+	MatchLength         int32                  `protobuf:"varint,13,opt,name=match_length,json=matchLength,proto3" json:"match_length,omitempty"` // BEST_OF: 最大ゲーム数（奇数）、FIRST_TO: 必要な勝利数
+	Password            string                 `protobuf:"bytes,14,opt,name=password,proto3" json:"password,omitempty"`                           // 参加に必要なパスワード（未指定はなし）
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *CreateGameRequest) Reset() {
 	*x = CreateGameRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[1]
+	mi := &file_game_v1_game_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameRequest) ProtoMessage() {}
+
+func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateGameRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetEliminationInterval() int32 {
+	if x != nil {
+		return x.EliminationInterval
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetCenterCount() int32 {
+	if x != nil {
+		return x.CenterCount
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetTieBreak() string {
+	if x != nil {
+		return x.TieBreak
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetScoring() *ScoringRules {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetTeamCount() int32 {
+	if x != nil {
+		return x.TeamCount
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetTeamNames() []string {
+	if x != nil {
+		return x.TeamNames
+	}
+	return nil
+}
+
+func (x *CreateGameRequest) GetAutoBalance() bool {
+	if x != nil {
+		return x.AutoBalance
+	}
+	return false
+}
+
+func (x *CreateGameRequest) GetMatchFormat() string {
+	if x != nil {
+		return x.MatchFormat
+	}
+	return ""
+}
+
+func (x *CreateGameRequest) GetMatchLength() int32 {
+	if x != nil {
+		return x.MatchLength
+	}
+	return 0
+}
+
+func (x *CreateGameRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type GameSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxPlayers    int32                  `protobuf:"varint,1,opt,name=max_players,json=maxPlayers,proto3" json:"max_players,omitempty"` // 参加人数の上限（0は無制限）
+	MinPlayers    int32                  `protobuf:"varint,2,opt,name=min_players,json=minPlayers,proto3" json:"min_players,omitempty"` // 開始に必要な人数（未指定は1）
+	Visibility    string                 `protobuf:"bytes,3,opt,name=visibility,proto3" json:"visibility,omitempty"`                    // PUBLIC（ロビーに表示）, PRIVATE（ロビーに表示せず、招待コードかパスワードで参加）。未指定はPUBLIC
+	AutoStart     bool                   `protobuf:"varint,4,opt,name=auto_start,json=autoStart,proto3" json:"auto_start,omitempty"`    // 参加人数がmax_players（無制限ならmin_players）に達したら自動で開始
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSettings) Reset() {
+	*x = GameSettings{}
+	mi := &file_game_v1_game_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSettings) ProtoMessage() {}
+
+func (x *GameSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSettings.ProtoReflect.Descriptor instead.
+func (*GameSettings) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameSettings) GetMaxPlayers() int32 {
+	if x != nil {
+		return x.MaxPlayers
+	}
+	return 0
+}
+
+func (x *GameSettings) GetMinPlayers() int32 {
+	if x != nil {
+		return x.MinPlayers
+	}
+	return 0
+}
+
+func (x *GameSettings) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *GameSettings) GetAutoStart() bool {
+	if x != nil {
+		return x.AutoStart
+	}
+	return false
+}
+
+type ScoringRules struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CorrectPoints      int32                  `protobuf:"varint,1,opt,name=correct_points,json=correctPoints,proto3" json:"correct_points,omitempty"`            // 正解時の得点（1以上）
+	WrongPenalty       int32                  `protobuf:"varint,2,opt,name=wrong_penalty,json=wrongPenalty,proto3" json:"wrong_penalty,omitempty"`               // 不正解時の減点
+	LockoutSeconds     int32                  `protobuf:"varint,3,opt,name=lockout_seconds,json=lockoutSeconds,proto3" json:"lockout_seconds,omitempty"`         // 1以上なら不正解時は減点の代わりにこの秒数回答できなくなる
+	SpeedBonusPoints   int32                  `protobuf:"varint,4,opt,name=speed_bonus_points,json=speedBonusPoints,proto3" json:"speed_bonus_points,omitempty"` // 即答時のボーナス（speed_bonus_window_msかけて0まで減る）
+	SpeedBonusWindowMs int32                  `protobuf:"varint,5,opt,name=speed_bonus_window_ms,json=speedBonusWindowMs,proto3" json:"speed_bonus_window_ms,omitempty"`
+	StreakBonusPercent int32                  `protobuf:"varint,6,opt,name=streak_bonus_percent,json=streakBonusPercent,proto3" json:"streak_bonus_percent,omitempty"` // 連続正解1回ごとに得点に加算する割合（%）
+	MaxStreak          int32                  `protobuf:"varint,7,opt,name=max_streak,json=maxStreak,proto3" json:"max_streak,omitempty"`                              // 連続正解ボーナスの上限回数（0は上限なし）
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ScoringRules) Reset() {
+	*x = ScoringRules{}
+	mi := &file_game_v1_game_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoringRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoringRules) ProtoMessage() {}
+
+func (x *ScoringRules) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoringRules.ProtoReflect.Descriptor instead.
+func (*ScoringRules) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScoringRules) GetCorrectPoints() int32 {
+	if x != nil {
+		return x.CorrectPoints
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetWrongPenalty() int32 {
+	if x != nil {
+		return x.WrongPenalty
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetLockoutSeconds() int32 {
+	if x != nil {
+		return x.LockoutSeconds
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetSpeedBonusPoints() int32 {
+	if x != nil {
+		return x.SpeedBonusPoints
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetSpeedBonusWindowMs() int32 {
+	if x != nil {
+		return x.SpeedBonusWindowMs
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetStreakBonusPercent() int32 {
+	if x != nil {
+		return x.StreakBonusPercent
+	}
+	return 0
+}
+
+func (x *ScoringRules) GetMaxStreak() int32 {
+	if x != nil {
+		return x.MaxStreak
+	}
+	return 0
+}
+
+type CreateGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	InviteCode    string                 `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // 友達に共有する招待コード（PRIVATEやパスワード付きのゲームに参加するときに使う）
+	HostToken     string                 `protobuf:"bytes,3,opt,name=host_token,json=hostToken,proto3" json:"host_token,omitempty"`    // 作成者がJoinGameのhost_tokenに指定するとホストとして参加する（共有しない）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameResponse) Reset() {
+	*x = CreateGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameResponse) ProtoMessage() {}
+
+func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateGameResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *CreateGameResponse) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *CreateGameResponse) GetHostToken() string {
+	if x != nil {
+		return x.HostToken
+	}
+	return ""
+}
+
+// Get games
+type GetGamesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesRequest) Reset() {
+	*x = GetGamesRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesRequest) ProtoMessage() {}
+
+func (x *GetGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesRequest.ProtoReflect.Descriptor instead.
+func (*GetGamesRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+type Game struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	PlayerCount    int32                  `protobuf:"varint,4,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	TotalRounds    int32                  `protobuf:"varint,5,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
+	Mode           string                 `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	TeamScore      int32                  `protobuf:"varint,7,opt,name=team_score,json=teamScore,proto3" json:"team_score,omitempty"` // 協力モードのチームスコア
+	Scoring        *ScoringRules          `protobuf:"bytes,8,opt,name=scoring,proto3" json:"scoring,omitempty"`
+	Settings       *GameSettings          `protobuf:"bytes,9,opt,name=settings,proto3" json:"settings,omitempty"`
+	HostId         int32                  `protobuf:"varint,10,opt,name=host_id,json=hostId,proto3" json:"host_id,omitempty"`                           // ホストのプレイヤーID（参加者がいなければ0）
+	PreviousGameId int32                  `protobuf:"varint,11,opt,name=previous_game_id,json=previousGameId,proto3" json:"previous_game_id,omitempty"` // 再戦元のゲームID（再戦でなければ0）
+	SpectatorCount int32                  `protobuf:"varint,12,opt,name=spectator_count,json=spectatorCount,proto3" json:"spectator_count,omitempty"`   // 観戦中の接続数
+	Teams          []*Team                `protobuf:"bytes,13,rep,name=teams,proto3" json:"teams,omitempty"`                                            // TEAMS: チームと合計得点
+	AutoBalance    bool                   `protobuf:"varint,14,opt,name=auto_balance,json=autoBalance,proto3" json:"auto_balance,omitempty"`
+	MatchId        int32                  `protobuf:"varint,15,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`             // 複数ゲームの対戦の一部であればその対戦（単発は0）
+	HasPassword    bool                   `protobuf:"varint,16,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"` // 参加にパスワード（または招待コード）が必要か
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	mi := &file_game_v1_game_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Game) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Game) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Game) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Game) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Game) GetTotalRounds() int32 {
+	if x != nil {
+		return x.TotalRounds
+	}
+	return 0
+}
+
+func (x *Game) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Game) GetTeamScore() int32 {
+	if x != nil {
+		return x.TeamScore
+	}
+	return 0
+}
+
+func (x *Game) GetScoring() *ScoringRules {
+	if x != nil {
+		return x.Scoring
+	}
+	return nil
+}
+
+func (x *Game) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *Game) GetHostId() int32 {
+	if x != nil {
+		return x.HostId
+	}
+	return 0
+}
+
+func (x *Game) GetPreviousGameId() int32 {
+	if x != nil {
+		return x.PreviousGameId
+	}
+	return 0
+}
+
+func (x *Game) GetSpectatorCount() int32 {
+	if x != nil {
+		return x.SpectatorCount
+	}
+	return 0
+}
+
+func (x *Game) GetTeams() []*Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *Game) GetAutoBalance() bool {
+	if x != nil {
+		return x.AutoBalance
+	}
+	return false
+}
+
+func (x *Game) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *Game) GetHasPassword() bool {
+	if x != nil {
+		return x.HasPassword
+	}
+	return false
+}
+
+type GetGamesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGamesResponse) Reset() {
+	*x = GetGamesResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGamesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGamesResponse) ProtoMessage() {}
+
+func (x *GetGamesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGamesResponse.ProtoReflect.Descriptor instead.
+func (*GetGamesResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetGamesResponse) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+// Join game
+type JoinGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	TeamId        int32                  `protobuf:"varint,3,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`                  // TEAMS: 参加するチーム（未指定で自動振り分けなら人数の少ないチーム）
+	UserToken     string                 `protobuf:"bytes,4,opt,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`          // 登録ユーザーとして参加するときのトークン（ゲームの順位でレーティングが変わる）
+	InviteCode    string                 `protobuf:"bytes,5,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`       // PRIVATEやパスワード付きのゲームの招待コード。game_idを省略するとコードからゲームを探す
+	Password      string                 `protobuf:"bytes,6,opt,name=password,proto3" json:"password,omitempty"`                             // パスワード付きのゲームのパスワード
+	HostToken     string                 `protobuf:"bytes,7,opt,name=host_token,json=hostToken,proto3" json:"host_token,omitempty"`          // CreateGameで受け取ったトークン。ゲームの作成者がホストとして参加する
+	EntrantToken  string                 `protobuf:"bytes,8,opt,name=entrant_token,json=entrantToken,proto3" json:"entrant_token,omitempty"` // トーナメントの試合に参加するときの参加者のトークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameRequest) Reset() {
+	*x = JoinGameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameRequest) ProtoMessage() {}
+
+func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
+func (*JoinGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *JoinGameRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *JoinGameRequest) GetUserToken() string {
+	if x != nil {
+		return x.UserToken
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetHostToken() string {
+	if x != nil {
+		return x.HostToken
+	}
+	return ""
+}
+
+func (x *JoinGameRequest) GetEntrantToken() string {
+	if x != nil {
+		return x.EntrantToken
+	}
+	return ""
+}
+
+type JoinGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,2,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 本人確認用トークン。WebSocketの初回メッセージで毎回送る（切断後の再接続にも使う）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinGameResponse) Reset() {
+	*x = JoinGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinGameResponse) ProtoMessage() {}
+
+func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
+func (*JoinGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *JoinGameResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *JoinGameResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Start game
+type StartGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 開始するホストのプレイヤーID
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン（JoinGameで受け取ったもの）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StartGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type StartGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameResponse) Reset() {
+	*x = StartGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameResponse) ProtoMessage() {}
+
+func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
+func (*StartGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+// Update game settings
+type UpdateGameSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ホストのプレイヤーID
+	Settings      *GameSettings          `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameSettingsRequest) Reset() {
+	*x = UpdateGameSettingsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateGameSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateGameSettingsRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *UpdateGameSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateGameSettingsRequest) GetSettings() *GameSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateGameSettingsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type UpdateGameSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameSettingsResponse) Reset() {
+	*x = UpdateGameSettingsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateGameSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{15}
+}
+
+// Kick player
+type KickPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストのプレイヤーID
+	PlayerId      string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`          // キックするプレイヤーID
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickPlayerRequest) Reset() {
+	*x = KickPlayerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerRequest) ProtoMessage() {}
+
+func (x *KickPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerRequest.ProtoReflect.Descriptor instead.
+func (*KickPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *KickPlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *KickPlayerRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type KickPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickPlayerResponse) Reset() {
+	*x = KickPlayerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickPlayerResponse) ProtoMessage() {}
+
+func (x *KickPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickPlayerResponse.ProtoReflect.Descriptor instead.
+func (*KickPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{17}
+}
+
+// Ban player
+type BanPlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストのプレイヤーID
+	PlayerId      string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`          // キックして再参加を禁止するプレイヤーID
+	ResumeToken   string                 `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPlayerRequest) Reset() {
+	*x = BanPlayerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPlayerRequest) ProtoMessage() {}
+
+func (x *BanPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPlayerRequest.ProtoReflect.Descriptor instead.
+func (*BanPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BanPlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *BanPlayerRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type BanPlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BanPlayerResponse) Reset() {
+	*x = BanPlayerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BanPlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BanPlayerResponse) ProtoMessage() {}
+
+func (x *BanPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BanPlayerResponse.ProtoReflect.Descriptor instead.
+func (*BanPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{19}
+}
+
+// Mute player
+type MutePlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ホストのプレイヤーID
+	PlayerId      string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Muted         bool                   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"`                               // falseでミュートを解除する
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutePlayerRequest) Reset() {
+	*x = MutePlayerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutePlayerRequest) ProtoMessage() {}
+
+func (x *MutePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutePlayerRequest.ProtoReflect.Descriptor instead.
+func (*MutePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MutePlayerRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *MutePlayerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MutePlayerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *MutePlayerRequest) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+func (x *MutePlayerRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type MutePlayerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MutePlayerResponse) Reset() {
+	*x = MutePlayerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MutePlayerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MutePlayerResponse) ProtoMessage() {}
+
+func (x *MutePlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MutePlayerResponse.ProtoReflect.Descriptor instead.
+func (*MutePlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{21}
+}
+
+// Chat
+type SendChatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // JoinGameで受け取った本人確認用トークン
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`                                  // 200文字まで
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatRequest) Reset() {
+	*x = SendChatRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatRequest) ProtoMessage() {}
+
+func (x *SendChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatRequest.ProtoReflect.Descriptor instead.
+func (*SendChatRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SendChatRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SendChatRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SendChatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendChatResponse) Reset() {
+	*x = SendChatResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendChatResponse) ProtoMessage() {}
+
+func (x *SendChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendChatResponse.ProtoReflect.Descriptor instead.
+func (*SendChatResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{23}
+}
+
+// Rotate invite code
+type RotateInviteCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストのプレイヤーID
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateInviteCodeRequest) Reset() {
+	*x = RotateInviteCodeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateInviteCodeRequest) ProtoMessage() {}
+
+func (x *RotateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*RotateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RotateInviteCodeRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RotateInviteCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RotateInviteCodeRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type RotateInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InviteCode    string                 `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"` // 新しい招待コード（古いコードは使えなくなる）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateInviteCodeResponse) Reset() {
+	*x = RotateInviteCodeResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateInviteCodeResponse) ProtoMessage() {}
+
+func (x *RotateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*RotateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RotateInviteCodeResponse) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+// Change team
+type ChangeTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 本人またはホストのプレイヤーID
+	PlayerId      string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"` // チームを変えるプレイヤーID
+	TeamId        int32                  `protobuf:"varint,4,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // user_idのプレイヤーの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTeamRequest) Reset() {
+	*x = ChangeTeamRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTeamRequest) ProtoMessage() {}
+
+func (x *ChangeTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTeamRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTeamRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ChangeTeamRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ChangeTeamRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeTeamRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ChangeTeamRequest) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *ChangeTeamRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ChangeTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTeamResponse) Reset() {
+	*x = ChangeTeamResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTeamResponse) ProtoMessage() {}
+
+func (x *ChangeTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTeamResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTeamResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{27}
+}
+
+// Set handicap
+type SetHandicapRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストのプレイヤーID
+	PlayerId      string                 `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`          // ハンデを設定するプレイヤーID
+	Handicap      *Handicap              `protobuf:"bytes,4,opt,name=handicap,proto3" json:"handicap,omitempty"`                          // 未設定ならハンデを外す
+	ResumeToken   string                 `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHandicapRequest) Reset() {
+	*x = SetHandicapRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHandicapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHandicapRequest) ProtoMessage() {}
+
+func (x *SetHandicapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHandicapRequest.ProtoReflect.Descriptor instead.
+func (*SetHandicapRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetHandicapRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *SetHandicapRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetHandicapRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetHandicapRequest) GetHandicap() *Handicap {
+	if x != nil {
+		return x.Handicap
+	}
+	return nil
+}
+
+func (x *SetHandicapRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type SetHandicapResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetHandicapResponse) Reset() {
+	*x = SetHandicapResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetHandicapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetHandicapResponse) ProtoMessage() {}
+
+func (x *SetHandicapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetHandicapResponse.ProtoReflect.Descriptor instead.
+func (*SetHandicapResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{29}
+}
+
+// Pause game
+type PauseGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストなら即座に一時停止、それ以外は投票として数える
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 投票するプレイヤーの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameRequest) ProtoMessage() {}
+
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PauseGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type PauseGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`                          // 一時停止が決まったか
+	Votes         int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`                                // 現在の賛成票
+	VotesNeeded   int32                  `protobuf:"varint,3,opt,name=votes_needed,json=votesNeeded,proto3" json:"votes_needed,omitempty"` // 過半数に必要な票数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseGameResponse) Reset() {
+	*x = PauseGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameResponse) ProtoMessage() {}
+
+func (x *PauseGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameResponse.ProtoReflect.Descriptor instead.
+func (*PauseGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PauseGameResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *PauseGameResponse) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *PauseGameResponse) GetVotesNeeded() int32 {
+	if x != nil {
+		return x.VotesNeeded
+	}
+	return 0
+}
+
+// Resume game
+type ResumeGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // ホストなら即座に再開、それ以外は投票として数える
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 投票するプレイヤーの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGameRequest) ProtoMessage() {}
+
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ResumeGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ResumeGameRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumeGameRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type ResumeGameResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"` // 再開が決まったか（カウントダウン後に再開する）
+	Votes         int32                  `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty"`
+	VotesNeeded   int32                  `protobuf:"varint,3,opt,name=votes_needed,json=votesNeeded,proto3" json:"votes_needed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeGameResponse) Reset() {
+	*x = ResumeGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGameResponse) ProtoMessage() {}
+
+func (x *ResumeGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGameResponse.ProtoReflect.Descriptor instead.
+func (*ResumeGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ResumeGameResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *ResumeGameResponse) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ResumeGameResponse) GetVotesNeeded() int32 {
+	if x != nil {
+		return x.VotesNeeded
+	}
+	return 0
+}
+
+// Request rematch
+type RequestRematchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                // 終了したゲームのID
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`          // 再戦に賛成するプレイヤーID
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // 投票するプレイヤーの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRematchRequest) Reset() {
+	*x = RequestRematchRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRematchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRematchRequest) ProtoMessage() {}
+
+func (x *RequestRematchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRematchRequest.ProtoReflect.Descriptor instead.
+func (*RequestRematchRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RequestRematchRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *RequestRematchRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *RequestRematchRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type RequestRematchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       bool                   `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"` // 再戦のゲームが作られたか
+	NewGameId     int32                  `protobuf:"varint,2,opt,name=new_game_id,json=newGameId,proto3" json:"new_game_id,omitempty"`
+	Votes         int32                  `protobuf:"varint,3,opt,name=votes,proto3" json:"votes,omitempty"`   // 現在の賛成票
+	Voters        int32                  `protobuf:"varint,4,opt,name=voters,proto3" json:"voters,omitempty"` // 接続中で投票できるプレイヤー数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRematchResponse) Reset() {
+	*x = RequestRematchResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRematchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRematchResponse) ProtoMessage() {}
+
+func (x *RequestRematchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRematchResponse.ProtoReflect.Descriptor instead.
+func (*RequestRematchResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RequestRematchResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *RequestRematchResponse) GetNewGameId() int32 {
+	if x != nil {
+		return x.NewGameId
+	}
+	return 0
+}
+
+func (x *RequestRematchResponse) GetVotes() int32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *RequestRematchResponse) GetVoters() int32 {
+	if x != nil {
+		return x.Voters
+	}
+	return 0
+}
+
+// Add bot
+type BotProfile struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	AccuracyPercent int32                  `protobuf:"varint,1,opt,name=accuracy_percent,json=accuracyPercent,proto3" json:"accuracy_percent,omitempty"` // 正しいシンボルを答える確率（0〜100）
+	MinReactionMs   int32                  `protobuf:"varint,2,opt,name=min_reaction_ms,json=minReactionMs,proto3" json:"min_reaction_ms,omitempty"`     // カードが揃ってから回答するまでの最短時間
+	MaxReactionMs   int32                  `protobuf:"varint,3,opt,name=max_reaction_ms,json=maxReactionMs,proto3" json:"max_reaction_ms,omitempty"`     // カードが揃ってから回答するまでの最長時間
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BotProfile) Reset() {
+	*x = BotProfile{}
+	mi := &file_game_v1_game_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BotProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BotProfile) ProtoMessage() {}
+
+func (x *BotProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BotProfile.ProtoReflect.Descriptor instead.
+func (*BotProfile) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BotProfile) GetAccuracyPercent() int32 {
+	if x != nil {
+		return x.AccuracyPercent
+	}
+	return 0
+}
+
+func (x *BotProfile) GetMinReactionMs() int32 {
+	if x != nil {
+		return x.MinReactionMs
+	}
+	return 0
+}
+
+func (x *BotProfile) GetMaxReactionMs() int32 {
+	if x != nil {
+		return x.MaxReactionMs
+	}
+	return 0
+}
+
+type AddBotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // ホストのプレイヤーID
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                   // 未指定は「BOT」
+	Level         string                 `protobuf:"bytes,4,opt,name=level,proto3" json:"level,omitempty"`                 // EASY, NORMAL, HARD（未指定はNORMAL、profile指定時は無視）
+	Profile       *BotProfile            `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	ResumeToken   string                 `protobuf:"bytes,6,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"` // ホストの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotRequest) Reset() {
+	*x = AddBotRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotRequest) ProtoMessage() {}
+
+func (x *AddBotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotRequest.ProtoReflect.Descriptor instead.
+func (*AddBotRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddBotRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *AddBotRequest) GetProfile() *BotProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *AddBotRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type AddBotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBotResponse) Reset() {
+	*x = AddBotResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBotResponse) ProtoMessage() {}
+
+func (x *AddBotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBotResponse.ProtoReflect.Descriptor instead.
+func (*AddBotResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddBotResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+// Report ready
+type ReportReadyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportReadyRequest) Reset() {
+	*x = ReportReadyRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReadyRequest) ProtoMessage() {}
+
+func (x *ReportReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReadyRequest.ProtoReflect.Descriptor instead.
+func (*ReportReadyRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReportReadyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ReportReadyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportReadyResponse) Reset() {
+	*x = ReportReadyResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportReadyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportReadyResponse) ProtoMessage() {}
+
+func (x *ReportReadyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportReadyResponse.ProtoReflect.Descriptor instead.
+func (*ReportReadyResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{40}
+}
+
+// Submit Answer
+type Card struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	mi := &file_game_v1_game_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Card) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Card) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type SubmitAnswerRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// SPEEDモードでは場のカードのうち共通シンボルを見つけた2枚を指定する
+	Card1         *Card  `protobuf:"bytes,2,opt,name=card1,proto3" json:"card1,omitempty"`
+	Card2         *Card  `protobuf:"bytes,3,opt,name=card2,proto3" json:"card2,omitempty"`
+	Answer        string `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerRequest) Reset() {
+	*x = SubmitAnswerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SubmitAnswerRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SubmitAnswerRequest) GetCard1() *Card {
+	if x != nil {
+		return x.Card1
+	}
+	return nil
+}
+
+func (x *SubmitAnswerRequest) GetCard2() *Card {
+	if x != nil {
+		return x.Card2
+	}
+	return nil
+}
+
+func (x *SubmitAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+type SubmitAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsCorrect     string                 `protobuf:"bytes,1,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAnswerResponse) Reset() {
+	*x = SubmitAnswerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SubmitAnswerResponse) GetIsCorrect() string {
+	if x != nil {
+		return x.IsCorrect
+	}
+	return ""
+}
+
+// Solo practice (time attack)
+type StartPracticeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // 自己ベストを記録する名前
+	CardCount     int32                  `protobuf:"varint,2,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`   // デッキのカード枚数（0は全カード）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartPracticeRequest) Reset() {
+	*x = StartPracticeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPracticeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPracticeRequest) ProtoMessage() {}
+
+func (x *StartPracticeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPracticeRequest.ProtoReflect.Descriptor instead.
+func (*StartPracticeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *StartPracticeRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *StartPracticeRequest) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+type StartPracticeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PracticeId     string                 `protobuf:"bytes,1,opt,name=practice_id,json=practiceId,proto3" json:"practice_id,omitempty"`
+	Cards          []*Card                `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"` // 最初に場に出る2枚
+	CardCount      int32                  `protobuf:"varint,3,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	PersonalBestMs int64                  `protobuf:"varint,4,opt,name=personal_best_ms,json=personalBestMs,proto3" json:"personal_best_ms,omitempty"` // このデッキサイズの自己ベスト（記録がなければ0）
+	PracticeToken  string                 `protobuf:"bytes,5,opt,name=practice_token,json=practiceToken,proto3" json:"practice_token,omitempty"`       // 回答するときの本人確認用トークン
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartPracticeResponse) Reset() {
+	*x = StartPracticeResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartPracticeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartPracticeResponse) ProtoMessage() {}
+
+func (x *StartPracticeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartPracticeResponse.ProtoReflect.Descriptor instead.
+func (*StartPracticeResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *StartPracticeResponse) GetPracticeId() string {
+	if x != nil {
+		return x.PracticeId
+	}
+	return ""
+}
+
+func (x *StartPracticeResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *StartPracticeResponse) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *StartPracticeResponse) GetPersonalBestMs() int64 {
+	if x != nil {
+		return x.PersonalBestMs
+	}
+	return 0
+}
+
+func (x *StartPracticeResponse) GetPracticeToken() string {
+	if x != nil {
+		return x.PracticeToken
+	}
+	return ""
+}
+
+type SubmitPracticeAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PracticeId    string                 `protobuf:"bytes,1,opt,name=practice_id,json=practiceId,proto3" json:"practice_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`                                    // 場の2枚の共通シンボル
+	PracticeToken string                 `protobuf:"bytes,3,opt,name=practice_token,json=practiceToken,proto3" json:"practice_token,omitempty"` // StartPractice・StartDailyChallengeで受け取ったトークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitPracticeAnswerRequest) Reset() {
+	*x = SubmitPracticeAnswerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPracticeAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPracticeAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitPracticeAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPracticeAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitPracticeAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SubmitPracticeAnswerRequest) GetPracticeId() string {
+	if x != nil {
+		return x.PracticeId
+	}
+	return ""
+}
+
+func (x *SubmitPracticeAnswerRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *SubmitPracticeAnswerRequest) GetPracticeToken() string {
+	if x != nil {
+		return x.PracticeToken
+	}
+	return ""
+}
+
+type SubmitPracticeAnswerResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Correct    bool                   `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	NextCard   *Card                  `protobuf:"bytes,2,opt,name=next_card,json=nextCard,proto3" json:"next_card,omitempty"`        // 正解したときに配られる次のカード
+	ReactionMs int64                  `protobuf:"varint,3,opt,name=reaction_ms,json=reactionMs,proto3" json:"reaction_ms,omitempty"` // 正解したカードの反応時間
+	Remaining  int32                  `protobuf:"varint,4,opt,name=remaining,proto3" json:"remaining,omitempty"`                     // 残りのカード枚数
+	Finished   bool                   `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	// 以下は終了時のみ
+	TotalMs         int64   `protobuf:"varint,6,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	ReactionTimesMs []int64 `protobuf:"varint,7,rep,packed,name=reaction_times_ms,json=reactionTimesMs,proto3" json:"reaction_times_ms,omitempty"`
+	Mistakes        int32   `protobuf:"varint,8,opt,name=mistakes,proto3" json:"mistakes,omitempty"`
+	PersonalBest    bool    `protobuf:"varint,9,opt,name=personal_best,json=personalBest,proto3" json:"personal_best,omitempty"` // 自己ベストを更新したか
+	PersonalBestMs  int64   `protobuf:"varint,10,opt,name=personal_best_ms,json=personalBestMs,proto3" json:"personal_best_ms,omitempty"`
+	DailyRank       int32   `protobuf:"varint,11,opt,name=daily_rank,json=dailyRank,proto3" json:"daily_rank,omitempty"` // デイリーチャレンジのランキング対象の挑戦なら順位
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SubmitPracticeAnswerResponse) Reset() {
+	*x = SubmitPracticeAnswerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitPracticeAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitPracticeAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitPracticeAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitPracticeAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitPracticeAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SubmitPracticeAnswerResponse) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *SubmitPracticeAnswerResponse) GetNextCard() *Card {
+	if x != nil {
+		return x.NextCard
+	}
+	return nil
+}
+
+func (x *SubmitPracticeAnswerResponse) GetReactionMs() int64 {
+	if x != nil {
+		return x.ReactionMs
+	}
+	return 0
+}
+
+func (x *SubmitPracticeAnswerResponse) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *SubmitPracticeAnswerResponse) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+func (x *SubmitPracticeAnswerResponse) GetTotalMs() int64 {
+	if x != nil {
+		return x.TotalMs
+	}
+	return 0
+}
+
+func (x *SubmitPracticeAnswerResponse) GetReactionTimesMs() []int64 {
+	if x != nil {
+		return x.ReactionTimesMs
+	}
+	return nil
+}
+
+func (x *SubmitPracticeAnswerResponse) GetMistakes() int32 {
+	if x != nil {
+		return x.Mistakes
+	}
+	return 0
+}
+
+func (x *SubmitPracticeAnswerResponse) GetPersonalBest() bool {
+	if x != nil {
+		return x.PersonalBest
+	}
+	return false
+}
+
+func (x *SubmitPracticeAnswerResponse) GetPersonalBestMs() int64 {
+	if x != nil {
+		return x.PersonalBestMs
+	}
+	return 0
+}
+
+func (x *SubmitPracticeAnswerResponse) GetDailyRank() int32 {
+	if x != nil {
+		return x.DailyRank
+	}
+	return 0
+}
+
+type GetPersonalBestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonalBestsRequest) Reset() {
+	*x = GetPersonalBestsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonalBestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonalBestsRequest) ProtoMessage() {}
+
+func (x *GetPersonalBestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonalBestsRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonalBestsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetPersonalBestsRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type PersonalBest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CardCount       int32                  `protobuf:"varint,1,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	TotalMs         int64                  `protobuf:"varint,2,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	RunId           int32                  `protobuf:"varint,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	ReactionTimesMs []int64                `protobuf:"varint,4,rep,packed,name=reaction_times_ms,json=reactionTimesMs,proto3" json:"reaction_times_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PersonalBest) Reset() {
+	*x = PersonalBest{}
+	mi := &file_game_v1_game_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalBest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalBest) ProtoMessage() {}
+
+func (x *PersonalBest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalBest.ProtoReflect.Descriptor instead.
+func (*PersonalBest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *PersonalBest) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetTotalMs() int64 {
+	if x != nil {
+		return x.TotalMs
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetRunId() int32 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *PersonalBest) GetReactionTimesMs() []int64 {
+	if x != nil {
+		return x.ReactionTimesMs
+	}
+	return nil
+}
+
+type GetPersonalBestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bests         []*PersonalBest        `protobuf:"bytes,1,rep,name=bests,proto3" json:"bests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPersonalBestsResponse) Reset() {
+	*x = GetPersonalBestsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPersonalBestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonalBestsResponse) ProtoMessage() {}
+
+func (x *GetPersonalBestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonalBestsResponse.ProtoReflect.Descriptor instead.
+func (*GetPersonalBestsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetPersonalBestsResponse) GetBests() []*PersonalBest {
+	if x != nil {
+		return x.Bests
+	}
+	return nil
+}
+
+// Ghost race
+type StartGhostRaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	RunId         int32                  `protobuf:"varint,2,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // 対戦する練習の記録（自分や他のプレイヤーの記録）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGhostRaceRequest) Reset() {
+	*x = StartGhostRaceRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGhostRaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGhostRaceRequest) ProtoMessage() {}
+
+func (x *StartGhostRaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGhostRaceRequest.ProtoReflect.Descriptor instead.
+func (*StartGhostRaceRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *StartGhostRaceRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *StartGhostRaceRequest) GetRunId() int32 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+type StartGhostRaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player        *Player                `protobuf:"bytes,2,opt,name=player,proto3" json:"player,omitempty"` // 自分
+	ResumeToken   string                 `protobuf:"bytes,3,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+	Ghost         *Player                `protobuf:"bytes,4,opt,name=ghost,proto3" json:"ghost,omitempty"`                                      // 記録を再生する相手
+	GhostTotalMs  int64                  `protobuf:"varint,5,opt,name=ghost_total_ms,json=ghostTotalMs,proto3" json:"ghost_total_ms,omitempty"` // 記録のタイム
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGhostRaceResponse) Reset() {
+	*x = StartGhostRaceResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGhostRaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGhostRaceResponse) ProtoMessage() {}
+
+func (x *StartGhostRaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGhostRaceResponse.ProtoReflect.Descriptor instead.
+func (*StartGhostRaceResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StartGhostRaceResponse) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *StartGhostRaceResponse) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *StartGhostRaceResponse) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *StartGhostRaceResponse) GetGhost() *Player {
+	if x != nil {
+		return x.Ghost
+	}
+	return nil
+}
+
+func (x *StartGhostRaceResponse) GetGhostTotalMs() int64 {
+	if x != nil {
+		return x.GhostTotalMs
+	}
+	return 0
+}
+
+// Daily challenge
+type StartDailyChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"` // 未登録で挑戦するときの名前（ランキングの対象外）
+	UserToken     string                 `protobuf:"bytes,2,opt,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`    // 登録ユーザーのトークン。ランキングの対象は登録ユーザーの1日1回目の挑戦のみ
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDailyChallengeRequest) Reset() {
+	*x = StartDailyChallengeRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDailyChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDailyChallengeRequest) ProtoMessage() {}
+
+func (x *StartDailyChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyChallengeRequest.ProtoReflect.Descriptor instead.
+func (*StartDailyChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StartDailyChallengeRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *StartDailyChallengeRequest) GetUserToken() string {
+	if x != nil {
+		return x.UserToken
+	}
+	return ""
+}
+
+type StartDailyChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PracticeId    string                 `protobuf:"bytes,1,opt,name=practice_id,json=practiceId,proto3" json:"practice_id,omitempty"` // 回答はSubmitPracticeAnswerで送る
+	Cards         []*Card                `protobuf:"bytes,2,rep,name=cards,proto3" json:"cards,omitempty"`
+	CardCount     int32                  `protobuf:"varint,3,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	Day           string                 `protobuf:"bytes,4,opt,name=day,proto3" json:"day,omitempty"`                                          // YYYY-MM-DD
+	Ranked        bool                   `protobuf:"varint,5,opt,name=ranked,proto3" json:"ranked,omitempty"`                                   // 今日の最初の挑戦ならランキング対象
+	PracticeToken string                 `protobuf:"bytes,6,opt,name=practice_token,json=practiceToken,proto3" json:"practice_token,omitempty"` // 回答するときの本人確認用トークン
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartDailyChallengeResponse) Reset() {
+	*x = StartDailyChallengeResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartDailyChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartDailyChallengeResponse) ProtoMessage() {}
+
+func (x *StartDailyChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartDailyChallengeResponse.ProtoReflect.Descriptor instead.
+func (*StartDailyChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StartDailyChallengeResponse) GetPracticeId() string {
+	if x != nil {
+		return x.PracticeId
+	}
+	return ""
+}
+
+func (x *StartDailyChallengeResponse) GetCards() []*Card {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *StartDailyChallengeResponse) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *StartDailyChallengeResponse) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *StartDailyChallengeResponse) GetRanked() bool {
+	if x != nil {
+		return x.Ranked
+	}
+	return false
+}
+
+func (x *StartDailyChallengeResponse) GetPracticeToken() string {
+	if x != nil {
+		return x.PracticeToken
+	}
+	return ""
+}
+
+type GetDailyLeaderboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Day           string                 `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`      // 未指定は今日
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // 未指定は100件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardRequest) Reset() {
+	*x = GetDailyLeaderboardRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetDailyLeaderboardRequest) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type DailyLeaderboardEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	PlayerName    string                 `protobuf:"bytes,2,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	TotalMs       int64                  `protobuf:"varint,3,opt,name=total_ms,json=totalMs,proto3" json:"total_ms,omitempty"`
+	Mistakes      int32                  `protobuf:"varint,4,opt,name=mistakes,proto3" json:"mistakes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DailyLeaderboardEntry) Reset() {
+	*x = DailyLeaderboardEntry{}
+	mi := &file_game_v1_game_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DailyLeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyLeaderboardEntry) ProtoMessage() {}
+
+func (x *DailyLeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyLeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*DailyLeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DailyLeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DailyLeaderboardEntry) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *DailyLeaderboardEntry) GetTotalMs() int64 {
+	if x != nil {
+		return x.TotalMs
+	}
+	return 0
+}
+
+func (x *DailyLeaderboardEntry) GetMistakes() int32 {
+	if x != nil {
+		return x.Mistakes
+	}
+	return 0
+}
+
+type GetDailyLeaderboardResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Day           string                   `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	Entries       []*DailyLeaderboardEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyLeaderboardResponse) Reset() {
+	*x = GetDailyLeaderboardResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetDailyLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetDailyLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDailyLeaderboardResponse) GetDay() string {
+	if x != nil {
+		return x.Day
+	}
+	return ""
+}
+
+func (x *GetDailyLeaderboardResponse) GetEntries() []*DailyLeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Matchmaking queue
+type JoinQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Mode          string                 `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`                             // 希望するゲームモード（未指定はどれでもよい）
+	CardCount     int32                  `protobuf:"varint,3,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"` // 希望するデッキの枚数（0はどれでもよい）
+	UserToken     string                 `protobuf:"bytes,4,opt,name=user_token,json=userToken,proto3" json:"user_token,omitempty"`  // 登録ユーザーならレーティングの近いプレイヤーと組む
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueRequest) Reset() {
+	*x = JoinQueueRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueRequest) ProtoMessage() {}
+
+func (x *JoinQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueRequest.ProtoReflect.Descriptor instead.
+func (*JoinQueueRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *JoinQueueRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *JoinQueueRequest) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *JoinQueueRequest) GetUserToken() string {
+	if x != nil {
+		return x.UserToken
+	}
+	return ""
+}
+
+type JoinQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`    // ロビーのWebSocketで{"ticket": ...}を送ると、ゲームが決まったときにMATCHEDが届く
+	Waiting       int32                  `protobuf:"varint,2,opt,name=waiting,proto3" json:"waiting,omitempty"` // 待っている人数（自分を含む）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinQueueResponse) Reset() {
+	*x = JoinQueueResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinQueueResponse) ProtoMessage() {}
+
+func (x *JoinQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinQueueResponse.ProtoReflect.Descriptor instead.
+func (*JoinQueueResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *JoinQueueResponse) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *JoinQueueResponse) GetWaiting() int32 {
+	if x != nil {
+		return x.Waiting
+	}
+	return 0
+}
+
+type LeaveQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveQueueRequest) Reset() {
+	*x = LeaveQueueRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueueRequest) ProtoMessage() {}
+
+func (x *LeaveQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueueRequest.ProtoReflect.Descriptor instead.
+func (*LeaveQueueRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LeaveQueueRequest) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+type LeaveQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveQueueResponse) Reset() {
+	*x = LeaveQueueResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveQueueResponse) ProtoMessage() {}
+
+func (x *LeaveQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveQueueResponse.ProtoReflect.Descriptor instead.
+func (*LeaveQueueResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{61}
+}
+
+// Ratings
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Rating        float64                `protobuf:"fixed64,3,opt,name=rating,proto3" json:"rating,omitempty"`                          // Elo レーティング（初期値1500）
+	RatedGames    int32                  `protobuf:"varint,4,opt,name=rated_games,json=ratedGames,proto3" json:"rated_games,omitempty"` // レーティングの対象になったゲーム数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_game_v1_game_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *User) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *User) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *User) GetRatedGames() int32 {
+	if x != nil {
+		return x.RatedGames
+	}
+	return 0
+}
+
+type RegisterUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserRequest) Reset() {
+	*x = RegisterUserRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserRequest) ProtoMessage() {}
+
+func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
+func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RegisterUserRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RegisterUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"` // JoinGame・JoinQueueのuser_tokenに指定する
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterUserResponse) Reset() {
+	*x = RegisterUserResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterUserResponse) ProtoMessage() {}
+
+func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterUserResponse.ProtoReflect.Descriptor instead.
+func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RegisterUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RegisterUserResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetRatingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // 上位何人を返すか（0は10人）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsRequest) Reset() {
+	*x = GetRatingsRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsRequest) ProtoMessage() {}
+
+func (x *GetRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetRatingsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetRatingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"` // レーティングの高い順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRatingsResponse) Reset() {
+	*x = GetRatingsResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingsResponse) ProtoMessage() {}
+
+func (x *GetRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingsResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetRatingsResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Match series
+type MatchStanding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Wins          int32                  `protobuf:"varint,2,opt,name=wins,proto3" json:"wins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchStanding) Reset() {
+	*x = MatchStanding{}
+	mi := &file_game_v1_game_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStanding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStanding) ProtoMessage() {}
+
+func (x *MatchStanding) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStanding.ProtoReflect.Descriptor instead.
+func (*MatchStanding) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MatchStanding) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *MatchStanding) GetWins() int32 {
+	if x != nil {
+		return x.Wins
+	}
+	return 0
+}
+
+type MatchGameScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchGameScore) Reset() {
+	*x = MatchGameScore{}
+	mi := &file_game_v1_game_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchGameScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchGameScore) ProtoMessage() {}
+
+func (x *MatchGameScore) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchGameScore.ProtoReflect.Descriptor instead.
+func (*MatchGameScore) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MatchGameScore) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *MatchGameScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type MatchGame struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	WinnerName    string                 `protobuf:"bytes,3,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"` // 終了していなければ空
+	Scores        []*MatchGameScore      `protobuf:"bytes,4,rep,name=scores,proto3" json:"scores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchGame) Reset() {
+	*x = MatchGame{}
+	mi := &file_game_v1_game_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchGame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchGame) ProtoMessage() {}
+
+func (x *MatchGame) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchGame.ProtoReflect.Descriptor instead.
+func (*MatchGame) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *MatchGame) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+func (x *MatchGame) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MatchGame) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+func (x *MatchGame) GetScores() []*MatchGameScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+type Match struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // BEST_OF, FIRST_TO
+	Length        int32                  `protobuf:"varint,3,opt,name=length,proto3" json:"length,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // PLAYING, FINISHED
+	WinnerName    string                 `protobuf:"bytes,5,opt,name=winner_name,json=winnerName,proto3" json:"winner_name,omitempty"`
+	Standings     []*MatchStanding       `protobuf:"bytes,6,rep,name=standings,proto3" json:"standings,omitempty"` // 勝利数の多い順
+	Games         []*MatchGame           `protobuf:"bytes,7,rep,name=games,proto3" json:"games,omitempty"`         // 行った順
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Match) Reset() {
+	*x = Match{}
+	mi := &file_game_v1_game_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Match) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Match) ProtoMessage() {}
+
+func (x *Match) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Match.ProtoReflect.Descriptor instead.
+func (*Match) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *Match) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Match) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Match) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Match) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Match) GetWinnerName() string {
+	if x != nil {
+		return x.WinnerName
+	}
+	return ""
+}
+
+func (x *Match) GetStandings() []*MatchStanding {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *Match) GetGames() []*MatchGame {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type GetMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       int32                  `protobuf:"varint,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchRequest) Reset() {
+	*x = GetMatchRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchRequest) ProtoMessage() {}
+
+func (x *GetMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetMatchRequest) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+type GetMatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Match         *Match                 `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchResponse) Reset() {
+	*x = GetMatchResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchResponse) ProtoMessage() {}
+
+func (x *GetMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchResponse.ProtoReflect.Descriptor instead.
+func (*GetMatchResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetMatchResponse) GetMatch() *Match {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+// Tournament
+type TournamentEntrant struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Seed          int32                  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"` // 登録順（1が第1シード）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentEntrant) Reset() {
+	*x = TournamentEntrant{}
+	mi := &file_game_v1_game_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TournamentEntrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TournamentEntrant) ProtoMessage() {}
+
+func (x *TournamentEntrant) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TournamentEntrant.ProtoReflect.Descriptor instead.
+func (*TournamentEntrant) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TournamentEntrant) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TournamentEntrant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TournamentEntrant) GetSeed() int32 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+type TournamentMatch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Number        int32                  `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"` // ブラケット内の試合番号
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`      // WINNERS, LOSERS, GRAND_FINAL
+	Round         int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`     // PENDING, PLAYING, FINISHED
+	Entrant1      *TournamentEntrant     `protobuf:"bytes,5,opt,name=entrant1,proto3" json:"entrant1,omitempty"` // 決まっていなければ未設定
+	Entrant2      *TournamentEntrant     `protobuf:"bytes,6,opt,name=entrant2,proto3" json:"entrant2,omitempty"`
+	WinnerSeed    int32                  `protobuf:"varint,7,opt,name=winner_seed,json=winnerSeed,proto3" json:"winner_seed,omitempty"` // 決まっていなければ0
+	GameId        int32                  `protobuf:"varint,8,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`             // 試合中・終了した試合のゲーム（不戦勝は0）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TournamentMatch) Reset() {
+	*x = TournamentMatch{}
+	mi := &file_game_v1_game_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameRequest) String() string {
+func (x *TournamentMatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameRequest) ProtoMessage() {}
+func (*TournamentMatch) ProtoMessage() {}
 
-func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[1]
+func (x *TournamentMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -123,47 +4591,97 @@ func (x *CreateGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use TournamentMatch.ProtoReflect.Descriptor instead.
+func (*TournamentMatch) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *CreateGameRequest) GetGameName() string {
+func (x *TournamentMatch) GetNumber() int32 {
 	if x != nil {
-		return x.GameName
+		return x.Number
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetSide() string {
+	if x != nil {
+		return x.Side
 	}
 	return ""
 }
 
-func (x *CreateGameRequest) GetCardCount() int32 {
+func (x *TournamentMatch) GetRound() int32 {
 	if x != nil {
-		return x.CardCount
+		return x.Round
 	}
 	return 0
 }
 
-type CreateGameResponse struct {
+func (x *TournamentMatch) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TournamentMatch) GetEntrant1() *TournamentEntrant {
+	if x != nil {
+		return x.Entrant1
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetEntrant2() *TournamentEntrant {
+	if x != nil {
+		return x.Entrant2
+	}
+	return nil
+}
+
+func (x *TournamentMatch) GetWinnerSeed() int32 {
+	if x != nil {
+		return x.WinnerSeed
+	}
+	return 0
+}
+
+func (x *TournamentMatch) GetGameId() int32 {
+	if x != nil {
+		return x.GameId
+	}
+	return 0
+}
+
+type Tournament struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        int32                  `protobuf:"varint,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"` // SINGLE_ELIMINATION, DOUBLE_ELIMINATION
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // REGISTERING, STARTED, FINISHED
+	Mode          string                 `protobuf:"bytes,5,opt,name=mode,proto3" json:"mode,omitempty"`
+	CardCount     int32                  `protobuf:"varint,6,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	Entrants      []*TournamentEntrant   `protobuf:"bytes,7,rep,name=entrants,proto3" json:"entrants,omitempty"`
+	Matches       []*TournamentMatch     `protobuf:"bytes,8,rep,name=matches,proto3" json:"matches,omitempty"` // 開始後に作られる
+	Champion      *TournamentEntrant     `protobuf:"bytes,9,opt,name=champion,proto3" json:"champion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameResponse) Reset() {
-	*x = CreateGameResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[2]
+func (x *Tournament) Reset() {
+	*x = Tournament{}
+	mi := &file_game_v1_game_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameResponse) String() string {
+func (x *Tournament) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameResponse) ProtoMessage() {}
+func (*Tournament) ProtoMessage() {}
 
-func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[2]
+func (x *Tournament) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -174,40 +4692,100 @@ func (x *CreateGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use Tournament.ProtoReflect.Descriptor instead.
+func (*Tournament) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *CreateGameResponse) GetGameId() int32 {
+func (x *Tournament) GetId() int32 {
 	if x != nil {
-		return x.GameId
+		return x.Id
 	}
 	return 0
 }
 
-// Get games
-type GetGamesRequest struct {
+func (x *Tournament) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Tournament) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Tournament) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Tournament) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Tournament) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *Tournament) GetEntrants() []*TournamentEntrant {
+	if x != nil {
+		return x.Entrants
+	}
+	return nil
+}
+
+func (x *Tournament) GetMatches() []*TournamentMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+func (x *Tournament) GetChampion() *TournamentEntrant {
+	if x != nil {
+		return x.Champion
+	}
+	return nil
+}
+
+type CreateTournamentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // 未指定はSINGLE_ELIMINATION
+	Mode          string                 `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`     // 各試合のゲームモード（勝者が決まるモードのみ）
+	CardCount     int32                  `protobuf:"varint,4,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	PlayerNames   []string               `protobuf:"bytes,5,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"` // 作成と同時に登録するプレイヤー
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesRequest) Reset() {
-	*x = GetGamesRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[3]
+func (x *CreateTournamentRequest) Reset() {
+	*x = CreateTournamentRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesRequest) String() string {
+func (x *CreateTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesRequest) ProtoMessage() {}
+func (*CreateTournamentRequest) ProtoMessage() {}
 
-func (x *GetGamesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[3]
+func (x *CreateTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,37 +4796,70 @@ func (x *GetGamesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesRequest.ProtoReflect.Descriptor instead.
-func (*GetGamesRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateTournamentRequest.ProtoReflect.Descriptor instead.
+func (*CreateTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{76}
 }
 
-type Game struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	PlayerCount   int32                  `protobuf:"varint,4,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
-	TotalRounds   int32                  `protobuf:"varint,5,opt,name=total_rounds,json=totalRounds,proto3" json:"total_rounds,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateTournamentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Game) Reset() {
-	*x = Game{}
-	mi := &file_game_v1_game_proto_msgTypes[4]
+func (x *CreateTournamentRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *CreateTournamentRequest) GetCardCount() int32 {
+	if x != nil {
+		return x.CardCount
+	}
+	return 0
+}
+
+func (x *CreateTournamentRequest) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+type CreateTournamentResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Tournament     *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
+	OrganizerToken string                 `protobuf:"bytes,2,opt,name=organizer_token,json=organizerToken,proto3" json:"organizer_token,omitempty"` // 登録と開始に使う
+	EntrantTokens  []string               `protobuf:"bytes,3,rep,name=entrant_tokens,json=entrantTokens,proto3" json:"entrant_tokens,omitempty"`    // 参加者ごとの試合参加用トークン（シード順）。主催者が各参加者に渡す
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTournamentResponse) Reset() {
+	*x = CreateTournamentResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Game) String() string {
+func (x *CreateTournamentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Game) ProtoMessage() {}
+func (*CreateTournamentResponse) ProtoMessage() {}
 
-func (x *Game) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[4]
+func (x *CreateTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -259,68 +4870,115 @@ func (x *Game) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Game.ProtoReflect.Descriptor instead.
-func (*Game) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use CreateTournamentResponse.ProtoReflect.Descriptor instead.
+func (*CreateTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *Game) GetId() int32 {
+func (x *CreateTournamentResponse) GetTournament() *Tournament {
 	if x != nil {
-		return x.Id
+		return x.Tournament
 	}
-	return 0
+	return nil
 }
 
-func (x *Game) GetStatus() string {
+func (x *CreateTournamentResponse) GetOrganizerToken() string {
 	if x != nil {
-		return x.Status
+		return x.OrganizerToken
 	}
 	return ""
 }
 
-func (x *Game) GetName() string {
+func (x *CreateTournamentResponse) GetEntrantTokens() []string {
 	if x != nil {
-		return x.Name
+		return x.EntrantTokens
 	}
-	return ""
+	return nil
 }
 
-func (x *Game) GetPlayerCount() int32 {
+type RegisterTournamentPlayerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId   int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	OrganizerToken string                 `protobuf:"bytes,2,opt,name=organizer_token,json=organizerToken,proto3" json:"organizer_token,omitempty"`
+	PlayerName     string                 `protobuf:"bytes,3,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterTournamentPlayerRequest) Reset() {
+	*x = RegisterTournamentPlayerRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterTournamentPlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTournamentPlayerRequest) ProtoMessage() {}
+
+func (x *RegisterTournamentPlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[78]
 	if x != nil {
-		return x.PlayerCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Game) GetTotalRounds() int32 {
+// Deprecated: Use RegisterTournamentPlayerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterTournamentPlayerRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RegisterTournamentPlayerRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.TotalRounds
+		return x.TournamentId
 	}
 	return 0
 }
 
-type GetGamesResponse struct {
+func (x *RegisterTournamentPlayerRequest) GetOrganizerToken() string {
+	if x != nil {
+		return x.OrganizerToken
+	}
+	return ""
+}
+
+func (x *RegisterTournamentPlayerRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+type RegisterTournamentPlayerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Games         []*Game                `protobuf:"bytes,1,rep,name=games,proto3" json:"games,omitempty"`
+	Entrant       *TournamentEntrant     `protobuf:"bytes,1,opt,name=entrant,proto3" json:"entrant,omitempty"`
+	EntrantToken  string                 `protobuf:"bytes,2,opt,name=entrant_token,json=entrantToken,proto3" json:"entrant_token,omitempty"` // 試合参加用トークン。主催者が参加者に渡す
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGamesResponse) Reset() {
-	*x = GetGamesResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[5]
+func (x *RegisterTournamentPlayerResponse) Reset() {
+	*x = RegisterTournamentPlayerResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGamesResponse) String() string {
+func (x *RegisterTournamentPlayerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGamesResponse) ProtoMessage() {}
+func (*RegisterTournamentPlayerResponse) ProtoMessage() {}
 
-func (x *GetGamesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[5]
+func (x *RegisterTournamentPlayerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -331,42 +4989,48 @@ func (x *GetGamesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGamesResponse.ProtoReflect.Descriptor instead.
-func (*GetGamesResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use RegisterTournamentPlayerResponse.ProtoReflect.Descriptor instead.
+func (*RegisterTournamentPlayerResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetGamesResponse) GetGames() []*Game {
+func (x *RegisterTournamentPlayerResponse) GetEntrant() *TournamentEntrant {
 	if x != nil {
-		return x.Games
+		return x.Entrant
 	}
 	return nil
 }
 
-// Join game
-type JoinGameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
-	GameId        string                 `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RegisterTournamentPlayerResponse) GetEntrantToken() string {
+	if x != nil {
+		return x.EntrantToken
+	}
+	return ""
 }
 
-func (x *JoinGameRequest) Reset() {
-	*x = JoinGameRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[6]
+type StartTournamentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TournamentId   int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
+	OrganizerToken string                 `protobuf:"bytes,2,opt,name=organizer_token,json=organizerToken,proto3" json:"organizer_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartTournamentRequest) Reset() {
+	*x = StartTournamentRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameRequest) String() string {
+func (x *StartTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameRequest) ProtoMessage() {}
+func (*StartTournamentRequest) ProtoMessage() {}
 
-func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[6]
+func (x *StartTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,47 +5041,47 @@ func (x *JoinGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameRequest.ProtoReflect.Descriptor instead.
-func (*JoinGameRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use StartTournamentRequest.ProtoReflect.Descriptor instead.
+func (*StartTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *JoinGameRequest) GetPlayerName() string {
+func (x *StartTournamentRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.PlayerName
+		return x.TournamentId
 	}
-	return ""
+	return 0
 }
 
-func (x *JoinGameRequest) GetGameId() string {
+func (x *StartTournamentRequest) GetOrganizerToken() string {
 	if x != nil {
-		return x.GameId
+		return x.OrganizerToken
 	}
 	return ""
 }
 
-type JoinGameResponse struct {
+type StartTournamentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Player        *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JoinGameResponse) Reset() {
-	*x = JoinGameResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[7]
+func (x *StartTournamentResponse) Reset() {
+	*x = StartTournamentResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JoinGameResponse) String() string {
+func (x *StartTournamentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinGameResponse) ProtoMessage() {}
-
-func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[7]
+func (*StartTournamentResponse) ProtoMessage() {}
+
+func (x *StartTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -428,42 +5092,40 @@ func (x *JoinGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinGameResponse.ProtoReflect.Descriptor instead.
-func (*JoinGameResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StartTournamentResponse.ProtoReflect.Descriptor instead.
+func (*StartTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *JoinGameResponse) GetPlayer() *Player {
+func (x *StartTournamentResponse) GetTournament() *Tournament {
 	if x != nil {
-		return x.Player
+		return x.Tournament
 	}
 	return nil
 }
 
-// Start game
-type StartGameRequest struct {
+type GetTournamentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TournamentId  int32                  `protobuf:"varint,1,opt,name=tournament_id,json=tournamentId,proto3" json:"tournament_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameRequest) Reset() {
-	*x = StartGameRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[8]
+func (x *GetTournamentRequest) Reset() {
+	*x = GetTournamentRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameRequest) String() string {
+func (x *GetTournamentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameRequest) ProtoMessage() {}
+func (*GetTournamentRequest) ProtoMessage() {}
 
-func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[8]
+func (x *GetTournamentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,46 +5136,40 @@ func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
-func (*StartGameRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *StartGameRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+// Deprecated: Use GetTournamentRequest.ProtoReflect.Descriptor instead.
+func (*GetTournamentRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *StartGameRequest) GetUserId() string {
+func (x *GetTournamentRequest) GetTournamentId() int32 {
 	if x != nil {
-		return x.UserId
+		return x.TournamentId
 	}
-	return ""
+	return 0
 }
 
-type StartGameResponse struct {
+type GetTournamentResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tournament    *Tournament            `protobuf:"bytes,1,opt,name=tournament,proto3" json:"tournament,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartGameResponse) Reset() {
-	*x = StartGameResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[9]
+func (x *GetTournamentResponse) Reset() {
+	*x = GetTournamentResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartGameResponse) String() string {
+func (x *GetTournamentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGameResponse) ProtoMessage() {}
+func (*GetTournamentResponse) ProtoMessage() {}
 
-func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[9]
+func (x *GetTournamentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,34 +5180,41 @@ func (x *StartGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGameResponse.ProtoReflect.Descriptor instead.
-func (*StartGameResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetTournamentResponse.ProtoReflect.Descriptor instead.
+func (*GetTournamentResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{83}
 }
 
-// Report ready
-type ReportReadyRequest struct {
+func (x *GetTournamentResponse) GetTournament() *Tournament {
+	if x != nil {
+		return x.Tournament
+	}
+	return nil
+}
+
+// Delete game
+type DeleteGameRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportReadyRequest) Reset() {
-	*x = ReportReadyRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[10]
+func (x *DeleteGameRequest) Reset() {
+	*x = DeleteGameRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportReadyRequest) String() string {
+func (x *DeleteGameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportReadyRequest) ProtoMessage() {}
+func (*DeleteGameRequest) ProtoMessage() {}
 
-func (x *ReportReadyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[10]
+func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -562,39 +5225,39 @@ func (x *ReportReadyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportReadyRequest.ProtoReflect.Descriptor instead.
-func (*ReportReadyRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ReportReadyRequest) GetPlayerId() string {
+func (x *DeleteGameRequest) GetGameId() string {
 	if x != nil {
-		return x.PlayerId
+		return x.GameId
 	}
 	return ""
 }
 
-type ReportReadyResponse struct {
+type DeleteGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportReadyResponse) Reset() {
-	*x = ReportReadyResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[11]
+func (x *DeleteGameResponse) Reset() {
+	*x = DeleteGameResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportReadyResponse) String() string {
+func (x *DeleteGameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportReadyResponse) ProtoMessage() {}
+func (*DeleteGameResponse) ProtoMessage() {}
 
-func (x *ReportReadyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[11]
+func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,35 +5268,33 @@ func (x *ReportReadyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportReadyResponse.ProtoReflect.Descriptor instead.
-func (*ReportReadyResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{85}
 }
 
-// Submit Answer
-type Card struct {
+// Get team high scores
+type GetTeamHighScoresRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Card) Reset() {
-	*x = Card{}
-	mi := &file_game_v1_game_proto_msgTypes[12]
+func (x *GetTeamHighScoresRequest) Reset() {
+	*x = GetTeamHighScoresRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Card) String() string {
+func (x *GetTeamHighScoresRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Card) ProtoMessage() {}
+func (*GetTeamHighScoresRequest) ProtoMessage() {}
 
-func (x *Card) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[12]
+func (x *GetTeamHighScoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,50 +5305,36 @@ func (x *Card) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Card.ProtoReflect.Descriptor instead.
-func (*Card) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Card) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Card) GetText() string {
-	if x != nil {
-		return x.Text
-	}
-	return ""
+// Deprecated: Use GetTeamHighScoresRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamHighScoresRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{86}
 }
 
-type SubmitAnswerRequest struct {
+type TeamHighScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Card1         *Card                  `protobuf:"bytes,2,opt,name=card1,proto3" json:"card1,omitempty"`
-	Card2         *Card                  `protobuf:"bytes,3,opt,name=card2,proto3" json:"card2,omitempty"`
-	Answer        string                 `protobuf:"bytes,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	CardCount     int32                  `protobuf:"varint,1,opt,name=card_count,json=cardCount,proto3" json:"card_count,omitempty"`
+	TeamScore     int32                  `protobuf:"varint,2,opt,name=team_score,json=teamScore,proto3" json:"team_score,omitempty"`
+	GameId        int32                  `protobuf:"varint,3,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	GameName      string                 `protobuf:"bytes,4,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitAnswerRequest) Reset() {
-	*x = SubmitAnswerRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[13]
+func (x *TeamHighScore) Reset() {
+	*x = TeamHighScore{}
+	mi := &file_game_v1_game_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAnswerRequest) String() string {
+func (x *TeamHighScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAnswerRequest) ProtoMessage() {}
+func (*TeamHighScore) ProtoMessage() {}
 
-func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[13]
+func (x *TeamHighScore) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -698,61 +5345,61 @@ func (x *SubmitAnswerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAnswerRequest.ProtoReflect.Descriptor instead.
-func (*SubmitAnswerRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use TeamHighScore.ProtoReflect.Descriptor instead.
+func (*TeamHighScore) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *SubmitAnswerRequest) GetPlayerId() string {
+func (x *TeamHighScore) GetCardCount() int32 {
 	if x != nil {
-		return x.PlayerId
+		return x.CardCount
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitAnswerRequest) GetCard1() *Card {
+func (x *TeamHighScore) GetTeamScore() int32 {
 	if x != nil {
-		return x.Card1
+		return x.TeamScore
 	}
-	return nil
+	return 0
 }
 
-func (x *SubmitAnswerRequest) GetCard2() *Card {
+func (x *TeamHighScore) GetGameId() int32 {
 	if x != nil {
-		return x.Card2
+		return x.GameId
 	}
-	return nil
+	return 0
 }
 
-func (x *SubmitAnswerRequest) GetAnswer() string {
+func (x *TeamHighScore) GetGameName() string {
 	if x != nil {
-		return x.Answer
+		return x.GameName
 	}
 	return ""
 }
 
-type SubmitAnswerResponse struct {
+type GetTeamHighScoresResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsCorrect     string                 `protobuf:"bytes,1,opt,name=is_correct,json=isCorrect,proto3" json:"is_correct,omitempty"`
+	HighScores    []*TeamHighScore       `protobuf:"bytes,1,rep,name=high_scores,json=highScores,proto3" json:"high_scores,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitAnswerResponse) Reset() {
-	*x = SubmitAnswerResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[14]
+func (x *GetTeamHighScoresResponse) Reset() {
+	*x = GetTeamHighScoresResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitAnswerResponse) String() string {
+func (x *GetTeamHighScoresResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitAnswerResponse) ProtoMessage() {}
+func (*GetTeamHighScoresResponse) ProtoMessage() {}
 
-func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[14]
+func (x *GetTeamHighScoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -763,41 +5410,40 @@ func (x *SubmitAnswerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitAnswerResponse.ProtoReflect.Descriptor instead.
-func (*SubmitAnswerResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetTeamHighScoresResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamHighScoresResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *SubmitAnswerResponse) GetIsCorrect() string {
+func (x *GetTeamHighScoresResponse) GetHighScores() []*TeamHighScore {
 	if x != nil {
-		return x.IsCorrect
+		return x.HighScores
 	}
-	return ""
+	return nil
 }
 
-// Delete game
-type DeleteGameRequest struct {
+// Get game modes
+type GetGameModesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameId        string                 `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameRequest) Reset() {
-	*x = DeleteGameRequest{}
-	mi := &file_game_v1_game_proto_msgTypes[15]
+func (x *GetGameModesRequest) Reset() {
+	*x = GetGameModesRequest{}
+	mi := &file_game_v1_game_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameRequest) String() string {
+func (x *GetGameModesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameRequest) ProtoMessage() {}
+func (*GetGameModesRequest) ProtoMessage() {}
 
-func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[15]
+func (x *GetGameModesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,39 +5454,33 @@ func (x *DeleteGameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGameRequest) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *DeleteGameRequest) GetGameId() string {
-	if x != nil {
-		return x.GameId
-	}
-	return ""
+// Deprecated: Use GetGameModesRequest.ProtoReflect.Descriptor instead.
+func (*GetGameModesRequest) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{89}
 }
 
-type DeleteGameResponse struct {
+type GetGameModesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Modes         []string               `protobuf:"bytes,1,rep,name=modes,proto3" json:"modes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameResponse) Reset() {
-	*x = DeleteGameResponse{}
-	mi := &file_game_v1_game_proto_msgTypes[16]
+func (x *GetGameModesResponse) Reset() {
+	*x = GetGameModesResponse{}
+	mi := &file_game_v1_game_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameResponse) String() string {
+func (x *GetGameModesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameResponse) ProtoMessage() {}
+func (*GetGameModesResponse) ProtoMessage() {}
 
-func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_game_v1_game_proto_msgTypes[16]
+func (x *GetGameModesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_game_v1_game_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,46 +5491,219 @@ func (x *DeleteGameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGameResponse) Descriptor() ([]byte, []int) {
-	return file_game_v1_game_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetGameModesResponse.ProtoReflect.Descriptor instead.
+func (*GetGameModesResponse) Descriptor() ([]byte, []int) {
+	return file_game_v1_game_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetGameModesResponse) GetModes() []string {
+	if x != nil {
+		return x.Modes
+	}
+	return nil
 }
 
 var File_game_v1_game_proto protoreflect.FileDescriptor
 
 const file_game_v1_game_proto_rawDesc = "" +
 	"\n" +
-	"\x12game/v1/game.proto\x12\agame.v1\"[\n" +
+	"\x12game/v1/game.proto\x12\agame.v1\"\xc5\x01\n" +
 	"\x06Player\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
 	"\agame_id\x18\x03 \x01(\x05R\x06gameId\x12\x14\n" +
-	"\x05score\x18\x04 \x01(\x05R\x05score\"O\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x17\n" +
+	"\ais_host\x18\x05 \x01(\bR\x06isHost\x12\x1f\n" +
+	"\vseries_wins\x18\x06 \x01(\x05R\n" +
+	"seriesWins\x12\x15\n" +
+	"\x06is_bot\x18\a \x01(\bR\x05isBot\x12\x17\n" +
+	"\ateam_id\x18\b \x01(\x05R\x06teamId\"\x86\x01\n" +
+	"\bHandicap\x12#\n" +
+	"\rextra_symbols\x18\x01 \x01(\x05R\fextraSymbols\x12&\n" +
+	"\x0fanswer_delay_ms\x18\x02 \x01(\x05R\ranswerDelayMs\x12-\n" +
+	"\x12multiplier_percent\x18\x03 \x01(\x05R\x11multiplierPercent\"@\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05score\x18\x03 \x01(\x05R\x05score\"\xfd\x03\n" +
 	"\x11CreateGameRequest\x12\x1b\n" +
 	"\tgame_name\x18\x01 \x01(\tR\bgameName\x12\x1d\n" +
 	"\n" +
-	"card_count\x18\x02 \x01(\x05R\tcardCount\"-\n" +
+	"card_count\x18\x02 \x01(\x05R\tcardCount\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x121\n" +
+	"\x14elimination_interval\x18\x04 \x01(\x05R\x13eliminationInterval\x12!\n" +
+	"\fcenter_count\x18\x05 \x01(\x05R\vcenterCount\x12\x1b\n" +
+	"\ttie_break\x18\x06 \x01(\tR\btieBreak\x12/\n" +
+	"\ascoring\x18\a \x01(\v2\x15.game.v1.ScoringRulesR\ascoring\x121\n" +
+	"\bsettings\x18\b \x01(\v2\x15.game.v1.GameSettingsR\bsettings\x12\x1d\n" +
+	"\n" +
+	"team_count\x18\t \x01(\x05R\tteamCount\x12\x1d\n" +
+	"\n" +
+	"team_names\x18\n" +
+	" \x03(\tR\tteamNames\x12!\n" +
+	"\fauto_balance\x18\v \x01(\bR\vautoBalance\x12!\n" +
+	"\fmatch_format\x18\f \x01(\tR\vmatchFormat\x12!\n" +
+	"\fmatch_length\x18\r \x01(\x05R\vmatchLength\x12\x1a\n" +
+	"\bpassword\x18\x0e \x01(\tR\bpassword\"\x8f\x01\n" +
+	"\fGameSettings\x12\x1f\n" +
+	"\vmax_players\x18\x01 \x01(\x05R\n" +
+	"maxPlayers\x12\x1f\n" +
+	"\vmin_players\x18\x02 \x01(\x05R\n" +
+	"minPlayers\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x03 \x01(\tR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"auto_start\x18\x04 \x01(\bR\tautoStart\"\xb5\x02\n" +
+	"\fScoringRules\x12%\n" +
+	"\x0ecorrect_points\x18\x01 \x01(\x05R\rcorrectPoints\x12#\n" +
+	"\rwrong_penalty\x18\x02 \x01(\x05R\fwrongPenalty\x12'\n" +
+	"\x0flockout_seconds\x18\x03 \x01(\x05R\x0elockoutSeconds\x12,\n" +
+	"\x12speed_bonus_points\x18\x04 \x01(\x05R\x10speedBonusPoints\x121\n" +
+	"\x15speed_bonus_window_ms\x18\x05 \x01(\x05R\x12speedBonusWindowMs\x120\n" +
+	"\x14streak_bonus_percent\x18\x06 \x01(\x05R\x12streakBonusPercent\x12\x1d\n" +
+	"\n" +
+	"max_streak\x18\a \x01(\x05R\tmaxStreak\"m\n" +
 	"\x12CreateGameResponse\x12\x17\n" +
-	"\agame_id\x18\x01 \x01(\x05R\x06gameId\"\x11\n" +
-	"\x0fGetGamesRequest\"\x88\x01\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x1f\n" +
+	"\vinvite_code\x18\x02 \x01(\tR\n" +
+	"inviteCode\x12\x1d\n" +
+	"\n" +
+	"host_token\x18\x03 \x01(\tR\thostToken\"\x11\n" +
+	"\x0fGetGamesRequest\"\x91\x04\n" +
 	"\x04Game\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
 	"\fplayer_count\x18\x04 \x01(\x05R\vplayerCount\x12!\n" +
-	"\ftotal_rounds\x18\x05 \x01(\x05R\vtotalRounds\"7\n" +
+	"\ftotal_rounds\x18\x05 \x01(\x05R\vtotalRounds\x12\x12\n" +
+	"\x04mode\x18\x06 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"team_score\x18\a \x01(\x05R\tteamScore\x12/\n" +
+	"\ascoring\x18\b \x01(\v2\x15.game.v1.ScoringRulesR\ascoring\x121\n" +
+	"\bsettings\x18\t \x01(\v2\x15.game.v1.GameSettingsR\bsettings\x12\x17\n" +
+	"\ahost_id\x18\n" +
+	" \x01(\x05R\x06hostId\x12(\n" +
+	"\x10previous_game_id\x18\v \x01(\x05R\x0epreviousGameId\x12'\n" +
+	"\x0fspectator_count\x18\f \x01(\x05R\x0espectatorCount\x12#\n" +
+	"\x05teams\x18\r \x03(\v2\r.game.v1.TeamR\x05teams\x12!\n" +
+	"\fauto_balance\x18\x0e \x01(\bR\vautoBalance\x12\x19\n" +
+	"\bmatch_id\x18\x0f \x01(\x05R\amatchId\x12!\n" +
+	"\fhas_password\x18\x10 \x01(\bR\vhasPassword\"7\n" +
 	"\x10GetGamesResponse\x12#\n" +
-	"\x05games\x18\x01 \x03(\v2\r.game.v1.GameR\x05games\"K\n" +
+	"\x05games\x18\x01 \x03(\v2\r.game.v1.GameR\x05games\"\x84\x02\n" +
 	"\x0fJoinGameRequest\x12\x1f\n" +
 	"\vplayer_name\x18\x01 \x01(\tR\n" +
 	"playerName\x12\x17\n" +
-	"\agame_id\x18\x02 \x01(\tR\x06gameId\";\n" +
+	"\agame_id\x18\x02 \x01(\tR\x06gameId\x12\x17\n" +
+	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12\x1d\n" +
+	"\n" +
+	"user_token\x18\x04 \x01(\tR\tuserToken\x12\x1f\n" +
+	"\vinvite_code\x18\x05 \x01(\tR\n" +
+	"inviteCode\x12\x1a\n" +
+	"\bpassword\x18\x06 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"host_token\x18\a \x01(\tR\thostToken\x12#\n" +
+	"\rentrant_token\x18\b \x01(\tR\fentrantToken\"^\n" +
 	"\x10JoinGameResponse\x12'\n" +
-	"\x06player\x18\x01 \x01(\v2\x0f.game.v1.PlayerR\x06player\"D\n" +
+	"\x06player\x18\x01 \x01(\v2\x0f.game.v1.PlayerR\x06player\x12!\n" +
+	"\fresume_token\x18\x02 \x01(\tR\vresumeToken\"g\n" +
 	"\x10StartGameRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x13\n" +
-	"\x11StartGameResponse\"1\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\x13\n" +
+	"\x11StartGameResponse\"\xa3\x01\n" +
+	"\x19UpdateGameSettingsRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x121\n" +
+	"\bsettings\x18\x03 \x01(\v2\x15.game.v1.GameSettingsR\bsettings\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\x1c\n" +
+	"\x1aUpdateGameSettingsResponse\"\x85\x01\n" +
+	"\x11KickPlayerRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\x14\n" +
+	"\x12KickPlayerResponse\"\x84\x01\n" +
+	"\x10BanPlayerRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeToken\"\x13\n" +
+	"\x11BanPlayerResponse\"\x9b\x01\n" +
+	"\x11MutePlayerRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05muted\x18\x04 \x01(\bR\x05muted\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\x14\n" +
+	"\x12MutePlayerResponse\"~\n" +
+	"\x0fSendChatRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\"\x12\n" +
+	"\x10SendChatResponse\"n\n" +
+	"\x17RotateInviteCodeRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\";\n" +
+	"\x18RotateInviteCodeResponse\x12\x1f\n" +
+	"\vinvite_code\x18\x01 \x01(\tR\n" +
+	"inviteCode\"\x9e\x01\n" +
+	"\x11ChangeTeamRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12\x17\n" +
+	"\ateam_id\x18\x04 \x01(\x05R\x06teamId\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\x14\n" +
+	"\x12ChangeTeamResponse\"\xb5\x01\n" +
+	"\x12SetHandicapRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\x12-\n" +
+	"\bhandicap\x18\x04 \x01(\v2\x11.game.v1.HandicapR\bhandicap\x12!\n" +
+	"\fresume_token\x18\x05 \x01(\tR\vresumeToken\"\x15\n" +
+	"\x13SetHandicapResponse\"g\n" +
+	"\x10PauseGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"h\n" +
+	"\x11PauseGameResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\x12!\n" +
+	"\fvotes_needed\x18\x03 \x01(\x05R\vvotesNeeded\"h\n" +
+	"\x11ResumeGameRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"i\n" +
+	"\x12ResumeGameResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x12\x14\n" +
+	"\x05votes\x18\x02 \x01(\x05R\x05votes\x12!\n" +
+	"\fvotes_needed\x18\x03 \x01(\x05R\vvotesNeeded\"p\n" +
+	"\x15RequestRematchRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\"\x80\x01\n" +
+	"\x16RequestRematchResponse\x12\x18\n" +
+	"\acreated\x18\x01 \x01(\bR\acreated\x12\x1e\n" +
+	"\vnew_game_id\x18\x02 \x01(\x05R\tnewGameId\x12\x14\n" +
+	"\x05votes\x18\x03 \x01(\x05R\x05votes\x12\x16\n" +
+	"\x06voters\x18\x04 \x01(\x05R\x06voters\"\x87\x01\n" +
+	"\n" +
+	"BotProfile\x12)\n" +
+	"\x10accuracy_percent\x18\x01 \x01(\x05R\x0faccuracyPercent\x12&\n" +
+	"\x0fmin_reaction_ms\x18\x02 \x01(\x05R\rminReactionMs\x12&\n" +
+	"\x0fmax_reaction_ms\x18\x03 \x01(\x05R\rmaxReactionMs\"\xbd\x01\n" +
+	"\rAddBotRequest\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\tR\x06gameId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x04 \x01(\tR\x05level\x12-\n" +
+	"\aprofile\x18\x05 \x01(\v2\x13.game.v1.BotProfileR\aprofile\x12!\n" +
+	"\fresume_token\x18\x06 \x01(\tR\vresumeToken\"9\n" +
+	"\x0eAddBotResponse\x12'\n" +
+	"\x06player\x18\x01 \x01(\v2\x0f.game.v1.PlayerR\x06player\"1\n" +
 	"\x12ReportReadyRequest\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\"\x15\n" +
 	"\x13ReportReadyResponse\"*\n" +
@@ -904,10 +5717,220 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x06answer\x18\x04 \x01(\tR\x06answer\"5\n" +
 	"\x14SubmitAnswerResponse\x12\x1d\n" +
 	"\n" +
-	"is_correct\x18\x01 \x01(\tR\tisCorrect\",\n" +
+	"is_correct\x18\x01 \x01(\tR\tisCorrect\"V\n" +
+	"\x14StartPracticeRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x02 \x01(\x05R\tcardCount\"\xcd\x01\n" +
+	"\x15StartPracticeResponse\x12\x1f\n" +
+	"\vpractice_id\x18\x01 \x01(\tR\n" +
+	"practiceId\x12#\n" +
+	"\x05cards\x18\x02 \x03(\v2\r.game.v1.CardR\x05cards\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x03 \x01(\x05R\tcardCount\x12(\n" +
+	"\x10personal_best_ms\x18\x04 \x01(\x03R\x0epersonalBestMs\x12%\n" +
+	"\x0epractice_token\x18\x05 \x01(\tR\rpracticeToken\"}\n" +
+	"\x1bSubmitPracticeAnswerRequest\x12\x1f\n" +
+	"\vpractice_id\x18\x01 \x01(\tR\n" +
+	"practiceId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12%\n" +
+	"\x0epractice_token\x18\x03 \x01(\tR\rpracticeToken\"\x90\x03\n" +
+	"\x1cSubmitPracticeAnswerResponse\x12\x18\n" +
+	"\acorrect\x18\x01 \x01(\bR\acorrect\x12*\n" +
+	"\tnext_card\x18\x02 \x01(\v2\r.game.v1.CardR\bnextCard\x12\x1f\n" +
+	"\vreaction_ms\x18\x03 \x01(\x03R\n" +
+	"reactionMs\x12\x1c\n" +
+	"\tremaining\x18\x04 \x01(\x05R\tremaining\x12\x1a\n" +
+	"\bfinished\x18\x05 \x01(\bR\bfinished\x12\x19\n" +
+	"\btotal_ms\x18\x06 \x01(\x03R\atotalMs\x12*\n" +
+	"\x11reaction_times_ms\x18\a \x03(\x03R\x0freactionTimesMs\x12\x1a\n" +
+	"\bmistakes\x18\b \x01(\x05R\bmistakes\x12#\n" +
+	"\rpersonal_best\x18\t \x01(\bR\fpersonalBest\x12(\n" +
+	"\x10personal_best_ms\x18\n" +
+	" \x01(\x03R\x0epersonalBestMs\x12\x1d\n" +
+	"\n" +
+	"daily_rank\x18\v \x01(\x05R\tdailyRank\":\n" +
+	"\x17GetPersonalBestsRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\"\x8b\x01\n" +
+	"\fPersonalBest\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x01 \x01(\x05R\tcardCount\x12\x19\n" +
+	"\btotal_ms\x18\x02 \x01(\x03R\atotalMs\x12\x15\n" +
+	"\x06run_id\x18\x03 \x01(\x05R\x05runId\x12*\n" +
+	"\x11reaction_times_ms\x18\x04 \x03(\x03R\x0freactionTimesMs\"G\n" +
+	"\x18GetPersonalBestsResponse\x12+\n" +
+	"\x05bests\x18\x01 \x03(\v2\x15.game.v1.PersonalBestR\x05bests\"O\n" +
+	"\x15StartGhostRaceRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x15\n" +
+	"\x06run_id\x18\x02 \x01(\x05R\x05runId\"\xca\x01\n" +
+	"\x16StartGhostRaceResponse\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12'\n" +
+	"\x06player\x18\x02 \x01(\v2\x0f.game.v1.PlayerR\x06player\x12!\n" +
+	"\fresume_token\x18\x03 \x01(\tR\vresumeToken\x12%\n" +
+	"\x05ghost\x18\x04 \x01(\v2\x0f.game.v1.PlayerR\x05ghost\x12$\n" +
+	"\x0eghost_total_ms\x18\x05 \x01(\x03R\fghostTotalMs\"\\\n" +
+	"\x1aStartDailyChallengeRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x1d\n" +
+	"\n" +
+	"user_token\x18\x02 \x01(\tR\tuserToken\"\xd3\x01\n" +
+	"\x1bStartDailyChallengeResponse\x12\x1f\n" +
+	"\vpractice_id\x18\x01 \x01(\tR\n" +
+	"practiceId\x12#\n" +
+	"\x05cards\x18\x02 \x03(\v2\r.game.v1.CardR\x05cards\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x03 \x01(\x05R\tcardCount\x12\x10\n" +
+	"\x03day\x18\x04 \x01(\tR\x03day\x12\x16\n" +
+	"\x06ranked\x18\x05 \x01(\bR\x06ranked\x12%\n" +
+	"\x0epractice_token\x18\x06 \x01(\tR\rpracticeToken\"D\n" +
+	"\x1aGetDailyLeaderboardRequest\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x83\x01\n" +
+	"\x15DailyLeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1f\n" +
+	"\vplayer_name\x18\x02 \x01(\tR\n" +
+	"playerName\x12\x19\n" +
+	"\btotal_ms\x18\x03 \x01(\x03R\atotalMs\x12\x1a\n" +
+	"\bmistakes\x18\x04 \x01(\x05R\bmistakes\"i\n" +
+	"\x1bGetDailyLeaderboardResponse\x12\x10\n" +
+	"\x03day\x18\x01 \x01(\tR\x03day\x128\n" +
+	"\aentries\x18\x02 \x03(\v2\x1e.game.v1.DailyLeaderboardEntryR\aentries\"\x85\x01\n" +
+	"\x10JoinQueueRequest\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x12\n" +
+	"\x04mode\x18\x02 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x03 \x01(\x05R\tcardCount\x12\x1d\n" +
+	"\n" +
+	"user_token\x18\x04 \x01(\tR\tuserToken\"E\n" +
+	"\x11JoinQueueResponse\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x18\n" +
+	"\awaiting\x18\x02 \x01(\x05R\awaiting\"+\n" +
+	"\x11LeaveQueueRequest\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\"\x14\n" +
+	"\x12LeaveQueueResponse\"c\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12\x1f\n" +
+	"\vrated_games\x18\x04 \x01(\x05R\n" +
+	"ratedGames\")\n" +
+	"\x13RegisterUserRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"O\n" +
+	"\x14RegisterUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.game.v1.UserR\x04user\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\")\n" +
+	"\x11GetRatingsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"9\n" +
+	"\x12GetRatingsResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.game.v1.UserR\x05users\"D\n" +
+	"\rMatchStanding\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x12\n" +
+	"\x04wins\x18\x02 \x01(\x05R\x04wins\"G\n" +
+	"\x0eMatchGameScore\x12\x1f\n" +
+	"\vplayer_name\x18\x01 \x01(\tR\n" +
+	"playerName\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\"\x8e\x01\n" +
+	"\tMatchGame\x12\x17\n" +
+	"\agame_id\x18\x01 \x01(\x05R\x06gameId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1f\n" +
+	"\vwinner_name\x18\x03 \x01(\tR\n" +
+	"winnerName\x12/\n" +
+	"\x06scores\x18\x04 \x03(\v2\x17.game.v1.MatchGameScoreR\x06scores\"\xe0\x01\n" +
+	"\x05Match\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x05R\x06length\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\vwinner_name\x18\x05 \x01(\tR\n" +
+	"winnerName\x124\n" +
+	"\tstandings\x18\x06 \x03(\v2\x16.game.v1.MatchStandingR\tstandings\x12(\n" +
+	"\x05games\x18\a \x03(\v2\x12.game.v1.MatchGameR\x05games\",\n" +
+	"\x0fGetMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\x05R\amatchId\"8\n" +
+	"\x10GetMatchResponse\x12$\n" +
+	"\x05match\x18\x01 \x01(\v2\x0e.game.v1.MatchR\x05match\"K\n" +
+	"\x11TournamentEntrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04seed\x18\x03 \x01(\x05R\x04seed\"\x95\x02\n" +
+	"\x0fTournamentMatch\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\x05R\x05round\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x126\n" +
+	"\bentrant1\x18\x05 \x01(\v2\x1a.game.v1.TournamentEntrantR\bentrant1\x126\n" +
+	"\bentrant2\x18\x06 \x01(\v2\x1a.game.v1.TournamentEntrantR\bentrant2\x12\x1f\n" +
+	"\vwinner_seed\x18\a \x01(\x05R\n" +
+	"winnerSeed\x12\x17\n" +
+	"\agame_id\x18\b \x01(\x05R\x06gameId\"\xb7\x02\n" +
+	"\n" +
+	"Tournament\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04mode\x18\x05 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x06 \x01(\x05R\tcardCount\x126\n" +
+	"\bentrants\x18\a \x03(\v2\x1a.game.v1.TournamentEntrantR\bentrants\x122\n" +
+	"\amatches\x18\b \x03(\v2\x18.game.v1.TournamentMatchR\amatches\x126\n" +
+	"\bchampion\x18\t \x01(\v2\x1a.game.v1.TournamentEntrantR\bchampion\"\x9b\x01\n" +
+	"\x17CreateTournamentRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\tR\x04mode\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x04 \x01(\x05R\tcardCount\x12!\n" +
+	"\fplayer_names\x18\x05 \x03(\tR\vplayerNames\"\x9f\x01\n" +
+	"\x18CreateTournamentResponse\x123\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x13.game.v1.TournamentR\n" +
+	"tournament\x12'\n" +
+	"\x0forganizer_token\x18\x02 \x01(\tR\x0eorganizerToken\x12%\n" +
+	"\x0eentrant_tokens\x18\x03 \x03(\tR\rentrantTokens\"\x90\x01\n" +
+	"\x1fRegisterTournamentPlayerRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\x12'\n" +
+	"\x0forganizer_token\x18\x02 \x01(\tR\x0eorganizerToken\x12\x1f\n" +
+	"\vplayer_name\x18\x03 \x01(\tR\n" +
+	"playerName\"}\n" +
+	" RegisterTournamentPlayerResponse\x124\n" +
+	"\aentrant\x18\x01 \x01(\v2\x1a.game.v1.TournamentEntrantR\aentrant\x12#\n" +
+	"\rentrant_token\x18\x02 \x01(\tR\fentrantToken\"f\n" +
+	"\x16StartTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\x12'\n" +
+	"\x0forganizer_token\x18\x02 \x01(\tR\x0eorganizerToken\"N\n" +
+	"\x17StartTournamentResponse\x123\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x13.game.v1.TournamentR\n" +
+	"tournament\";\n" +
+	"\x14GetTournamentRequest\x12#\n" +
+	"\rtournament_id\x18\x01 \x01(\x05R\ftournamentId\"L\n" +
+	"\x15GetTournamentResponse\x123\n" +
+	"\n" +
+	"tournament\x18\x01 \x01(\v2\x13.game.v1.TournamentR\n" +
+	"tournament\",\n" +
 	"\x11DeleteGameRequest\x12\x17\n" +
 	"\agame_id\x18\x01 \x01(\tR\x06gameId\"\x14\n" +
-	"\x12DeleteGameResponse2\\\n" +
+	"\x12DeleteGameResponse\"\x1a\n" +
+	"\x18GetTeamHighScoresRequest\"\x83\x01\n" +
+	"\rTeamHighScore\x12\x1d\n" +
+	"\n" +
+	"card_count\x18\x01 \x01(\x05R\tcardCount\x12\x1d\n" +
+	"\n" +
+	"team_score\x18\x02 \x01(\x05R\tteamScore\x12\x17\n" +
+	"\agame_id\x18\x03 \x01(\x05R\x06gameId\x12\x1b\n" +
+	"\tgame_name\x18\x04 \x01(\tR\bgameName\"T\n" +
+	"\x19GetTeamHighScoresResponse\x127\n" +
+	"\vhigh_scores\x18\x01 \x03(\v2\x16.game.v1.TeamHighScoreR\n" +
+	"highScores\"\x15\n" +
+	"\x13GetGameModesRequest\",\n" +
+	"\x14GetGameModesResponse\x12\x14\n" +
+	"\x05modes\x18\x01 \x03(\tR\x05modes2\\\n" +
 	"\x11CreateGameService\x12G\n" +
 	"\n" +
 	"CreateGame\x12\x1a.game.v1.CreateGameRequest\x1a\x1b.game.v1.CreateGameResponse\"\x002T\n" +
@@ -916,14 +5939,78 @@ const file_game_v1_game_proto_rawDesc = "" +
 	"\x0fJoinGameService\x12A\n" +
 	"\bJoinGame\x12\x18.game.v1.JoinGameRequest\x1a\x19.game.v1.JoinGameResponse\"\x002X\n" +
 	"\x10StartGameService\x12D\n" +
-	"\tStartGame\x12\x19.game.v1.StartGameRequest\x1a\x1a.game.v1.StartGameResponse\"\x002`\n" +
+	"\tStartGame\x12\x19.game.v1.StartGameRequest\x1a\x1a.game.v1.StartGameResponse\"\x002|\n" +
+	"\x19UpdateGameSettingsService\x12_\n" +
+	"\x12UpdateGameSettings\x12\".game.v1.UpdateGameSettingsRequest\x1a#.game.v1.UpdateGameSettingsResponse\"\x002\\\n" +
+	"\x11KickPlayerService\x12G\n" +
+	"\n" +
+	"KickPlayer\x12\x1a.game.v1.KickPlayerRequest\x1a\x1b.game.v1.KickPlayerResponse\"\x002X\n" +
+	"\x10BanPlayerService\x12D\n" +
+	"\tBanPlayer\x12\x19.game.v1.BanPlayerRequest\x1a\x1a.game.v1.BanPlayerResponse\"\x002\\\n" +
+	"\x11MutePlayerService\x12G\n" +
+	"\n" +
+	"MutePlayer\x12\x1a.game.v1.MutePlayerRequest\x1a\x1b.game.v1.MutePlayerResponse\"\x002T\n" +
+	"\x0fSendChatService\x12A\n" +
+	"\bSendChat\x12\x18.game.v1.SendChatRequest\x1a\x19.game.v1.SendChatResponse\"\x002t\n" +
+	"\x17RotateInviteCodeService\x12Y\n" +
+	"\x10RotateInviteCode\x12 .game.v1.RotateInviteCodeRequest\x1a!.game.v1.RotateInviteCodeResponse\"\x002\\\n" +
+	"\x11ChangeTeamService\x12G\n" +
+	"\n" +
+	"ChangeTeam\x12\x1a.game.v1.ChangeTeamRequest\x1a\x1b.game.v1.ChangeTeamResponse\"\x002`\n" +
+	"\x12SetHandicapService\x12J\n" +
+	"\vSetHandicap\x12\x1b.game.v1.SetHandicapRequest\x1a\x1c.game.v1.SetHandicapResponse\"\x002X\n" +
+	"\x10PauseGameService\x12D\n" +
+	"\tPauseGame\x12\x19.game.v1.PauseGameRequest\x1a\x1a.game.v1.PauseGameResponse\"\x002\\\n" +
+	"\x11ResumeGameService\x12G\n" +
+	"\n" +
+	"ResumeGame\x12\x1a.game.v1.ResumeGameRequest\x1a\x1b.game.v1.ResumeGameResponse\"\x002l\n" +
+	"\x15RequestRematchService\x12S\n" +
+	"\x0eRequestRematch\x12\x1e.game.v1.RequestRematchRequest\x1a\x1f.game.v1.RequestRematchResponse\"\x002L\n" +
+	"\rAddBotService\x12;\n" +
+	"\x06AddBot\x12\x16.game.v1.AddBotRequest\x1a\x17.game.v1.AddBotResponse\"\x002`\n" +
 	"\x12ReportReadyService\x12J\n" +
 	"\vReportReady\x12\x1b.game.v1.ReportReadyRequest\x1a\x1c.game.v1.ReportReadyResponse\"\x002d\n" +
 	"\x13SubmitAnswerService\x12M\n" +
-	"\fSubmitAnswer\x12\x1c.game.v1.SubmitAnswerRequest\x1a\x1d.game.v1.SubmitAnswerResponse\"\x002\\\n" +
+	"\fSubmitAnswer\x12\x1c.game.v1.SubmitAnswerRequest\x1a\x1d.game.v1.SubmitAnswerResponse\"\x002h\n" +
+	"\x14StartPracticeService\x12P\n" +
+	"\rStartPractice\x12\x1d.game.v1.StartPracticeRequest\x1a\x1e.game.v1.StartPracticeResponse\"\x002\x84\x01\n" +
+	"\x1bSubmitPracticeAnswerService\x12e\n" +
+	"\x14SubmitPracticeAnswer\x12$.game.v1.SubmitPracticeAnswerRequest\x1a%.game.v1.SubmitPracticeAnswerResponse\"\x002t\n" +
+	"\x17GetPersonalBestsService\x12Y\n" +
+	"\x10GetPersonalBests\x12 .game.v1.GetPersonalBestsRequest\x1a!.game.v1.GetPersonalBestsResponse\"\x002l\n" +
+	"\x15StartGhostRaceService\x12S\n" +
+	"\x0eStartGhostRace\x12\x1e.game.v1.StartGhostRaceRequest\x1a\x1f.game.v1.StartGhostRaceResponse\"\x002\x80\x01\n" +
+	"\x1aStartDailyChallengeService\x12b\n" +
+	"\x13StartDailyChallenge\x12#.game.v1.StartDailyChallengeRequest\x1a$.game.v1.StartDailyChallengeResponse\"\x002\x80\x01\n" +
+	"\x1aGetDailyLeaderboardService\x12b\n" +
+	"\x13GetDailyLeaderboard\x12#.game.v1.GetDailyLeaderboardRequest\x1a$.game.v1.GetDailyLeaderboardResponse\"\x002X\n" +
+	"\x10JoinQueueService\x12D\n" +
+	"\tJoinQueue\x12\x19.game.v1.JoinQueueRequest\x1a\x1a.game.v1.JoinQueueResponse\"\x002\\\n" +
+	"\x11LeaveQueueService\x12G\n" +
+	"\n" +
+	"LeaveQueue\x12\x1a.game.v1.LeaveQueueRequest\x1a\x1b.game.v1.LeaveQueueResponse\"\x002d\n" +
+	"\x13RegisterUserService\x12M\n" +
+	"\fRegisterUser\x12\x1c.game.v1.RegisterUserRequest\x1a\x1d.game.v1.RegisterUserResponse\"\x002\\\n" +
+	"\x11GetRatingsService\x12G\n" +
+	"\n" +
+	"GetRatings\x12\x1a.game.v1.GetRatingsRequest\x1a\x1b.game.v1.GetRatingsResponse\"\x002T\n" +
+	"\x0fGetMatchService\x12A\n" +
+	"\bGetMatch\x12\x18.game.v1.GetMatchRequest\x1a\x19.game.v1.GetMatchResponse\"\x002t\n" +
+	"\x17CreateTournamentService\x12Y\n" +
+	"\x10CreateTournament\x12 .game.v1.CreateTournamentRequest\x1a!.game.v1.CreateTournamentResponse\"\x002\x94\x01\n" +
+	"\x1fRegisterTournamentPlayerService\x12q\n" +
+	"\x18RegisterTournamentPlayer\x12(.game.v1.RegisterTournamentPlayerRequest\x1a).game.v1.RegisterTournamentPlayerResponse\"\x002p\n" +
+	"\x16StartTournamentService\x12V\n" +
+	"\x0fStartTournament\x12\x1f.game.v1.StartTournamentRequest\x1a .game.v1.StartTournamentResponse\"\x002h\n" +
+	"\x14GetTournamentService\x12P\n" +
+	"\rGetTournament\x12\x1d.game.v1.GetTournamentRequest\x1a\x1e.game.v1.GetTournamentResponse\"\x002\\\n" +
 	"\x11DeleteGameService\x12G\n" +
 	"\n" +
-	"DeleteGame\x12\x1a.game.v1.DeleteGameRequest\x1a\x1b.game.v1.DeleteGameResponse\"\x00B\x1cZ\x1aexample/gen/game/v1;gamev1b\x06proto3"
+	"DeleteGame\x12\x1a.game.v1.DeleteGameRequest\x1a\x1b.game.v1.DeleteGameResponse\"\x002x\n" +
+	"\x18GetTeamHighScoresService\x12\\\n" +
+	"\x11GetTeamHighScores\x12!.game.v1.GetTeamHighScoresRequest\x1a\".game.v1.GetTeamHighScoresResponse\"\x002d\n" +
+	"\x13GetGameModesService\x12M\n" +
+	"\fGetGameModes\x12\x1c.game.v1.GetGameModesRequest\x1a\x1d.game.v1.GetGameModesResponse\"\x00B\x1cZ\x1aexample/gen/game/v1;gamev1b\x06proto3"
 
 var (
 	file_game_v1_game_proto_rawDescOnce sync.Once
@@ -937,50 +6024,214 @@ func file_game_v1_game_proto_rawDescGZIP() []byte {
 	return file_game_v1_game_proto_rawDescData
 }
 
-var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_game_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
 var file_game_v1_game_proto_goTypes = []any{
-	(*Player)(nil),               // 0: game.v1.Player
-	(*CreateGameRequest)(nil),    // 1: game.v1.CreateGameRequest
-	(*CreateGameResponse)(nil),   // 2: game.v1.CreateGameResponse
-	(*GetGamesRequest)(nil),      // 3: game.v1.GetGamesRequest
-	(*Game)(nil),                 // 4: game.v1.Game
-	(*GetGamesResponse)(nil),     // 5: game.v1.GetGamesResponse
-	(*JoinGameRequest)(nil),      // 6: game.v1.JoinGameRequest
-	(*JoinGameResponse)(nil),     // 7: game.v1.JoinGameResponse
-	(*StartGameRequest)(nil),     // 8: game.v1.StartGameRequest
-	(*StartGameResponse)(nil),    // 9: game.v1.StartGameResponse
-	(*ReportReadyRequest)(nil),   // 10: game.v1.ReportReadyRequest
-	(*ReportReadyResponse)(nil),  // 11: game.v1.ReportReadyResponse
-	(*Card)(nil),                 // 12: game.v1.Card
-	(*SubmitAnswerRequest)(nil),  // 13: game.v1.SubmitAnswerRequest
-	(*SubmitAnswerResponse)(nil), // 14: game.v1.SubmitAnswerResponse
-	(*DeleteGameRequest)(nil),    // 15: game.v1.DeleteGameRequest
-	(*DeleteGameResponse)(nil),   // 16: game.v1.DeleteGameResponse
+	(*Player)(nil),                           // 0: game.v1.Player
+	(*Handicap)(nil),                         // 1: game.v1.Handicap
+	(*Team)(nil),                             // 2: game.v1.Team
+	(*CreateGameRequest)(nil),                // 3: game.v1.CreateGameRequest
+	(*GameSettings)(nil),                     // 4: game.v1.GameSettings
+	(*ScoringRules)(nil),                     // 5: game.v1.ScoringRules
+	(*CreateGameResponse)(nil),               // 6: game.v1.CreateGameResponse
+	(*GetGamesRequest)(nil),                  // 7: game.v1.GetGamesRequest
+	(*Game)(nil),                             // 8: game.v1.Game
+	(*GetGamesResponse)(nil),                 // 9: game.v1.GetGamesResponse
+	(*JoinGameRequest)(nil),                  // 10: game.v1.JoinGameRequest
+	(*JoinGameResponse)(nil),                 // 11: game.v1.JoinGameResponse
+	(*StartGameRequest)(nil),                 // 12: game.v1.StartGameRequest
+	(*StartGameResponse)(nil),                // 13: game.v1.StartGameResponse
+	(*UpdateGameSettingsRequest)(nil),        // 14: game.v1.UpdateGameSettingsRequest
+	(*UpdateGameSettingsResponse)(nil),       // 15: game.v1.UpdateGameSettingsResponse
+	(*KickPlayerRequest)(nil),                // 16: game.v1.KickPlayerRequest
+	(*KickPlayerResponse)(nil),               // 17: game.v1.KickPlayerResponse
+	(*BanPlayerRequest)(nil),                 // 18: game.v1.BanPlayerRequest
+	(*BanPlayerResponse)(nil),                // 19: game.v1.BanPlayerResponse
+	(*MutePlayerRequest)(nil),                // 20: game.v1.MutePlayerRequest
+	(*MutePlayerResponse)(nil),               // 21: game.v1.MutePlayerResponse
+	(*SendChatRequest)(nil),                  // 22: game.v1.SendChatRequest
+	(*SendChatResponse)(nil),                 // 23: game.v1.SendChatResponse
+	(*RotateInviteCodeRequest)(nil),          // 24: game.v1.RotateInviteCodeRequest
+	(*RotateInviteCodeResponse)(nil),         // 25: game.v1.RotateInviteCodeResponse
+	(*ChangeTeamRequest)(nil),                // 26: game.v1.ChangeTeamRequest
+	(*ChangeTeamResponse)(nil),               // 27: game.v1.ChangeTeamResponse
+	(*SetHandicapRequest)(nil),               // 28: game.v1.SetHandicapRequest
+	(*SetHandicapResponse)(nil),              // 29: game.v1.SetHandicapResponse
+	(*PauseGameRequest)(nil),                 // 30: game.v1.PauseGameRequest
+	(*PauseGameResponse)(nil),                // 31: game.v1.PauseGameResponse
+	(*ResumeGameRequest)(nil),                // 32: game.v1.ResumeGameRequest
+	(*ResumeGameResponse)(nil),               // 33: game.v1.ResumeGameResponse
+	(*RequestRematchRequest)(nil),            // 34: game.v1.RequestRematchRequest
+	(*RequestRematchResponse)(nil),           // 35: game.v1.RequestRematchResponse
+	(*BotProfile)(nil),                       // 36: game.v1.BotProfile
+	(*AddBotRequest)(nil),                    // 37: game.v1.AddBotRequest
+	(*AddBotResponse)(nil),                   // 38: game.v1.AddBotResponse
+	(*ReportReadyRequest)(nil),               // 39: game.v1.ReportReadyRequest
+	(*ReportReadyResponse)(nil),              // 40: game.v1.ReportReadyResponse
+	(*Card)(nil),                             // 41: game.v1.Card
+	(*SubmitAnswerRequest)(nil),              // 42: game.v1.SubmitAnswerRequest
+	(*SubmitAnswerResponse)(nil),             // 43: game.v1.SubmitAnswerResponse
+	(*StartPracticeRequest)(nil),             // 44: game.v1.StartPracticeRequest
+	(*StartPracticeResponse)(nil),            // 45: game.v1.StartPracticeResponse
+	(*SubmitPracticeAnswerRequest)(nil),      // 46: game.v1.SubmitPracticeAnswerRequest
+	(*SubmitPracticeAnswerResponse)(nil),     // 47: game.v1.SubmitPracticeAnswerResponse
+	(*GetPersonalBestsRequest)(nil),          // 48: game.v1.GetPersonalBestsRequest
+	(*PersonalBest)(nil),                     // 49: game.v1.PersonalBest
+	(*GetPersonalBestsResponse)(nil),         // 50: game.v1.GetPersonalBestsResponse
+	(*StartGhostRaceRequest)(nil),            // 51: game.v1.StartGhostRaceRequest
+	(*StartGhostRaceResponse)(nil),           // 52: game.v1.StartGhostRaceResponse
+	(*StartDailyChallengeRequest)(nil),       // 53: game.v1.StartDailyChallengeRequest
+	(*StartDailyChallengeResponse)(nil),      // 54: game.v1.StartDailyChallengeResponse
+	(*GetDailyLeaderboardRequest)(nil),       // 55: game.v1.GetDailyLeaderboardRequest
+	(*DailyLeaderboardEntry)(nil),            // 56: game.v1.DailyLeaderboardEntry
+	(*GetDailyLeaderboardResponse)(nil),      // 57: game.v1.GetDailyLeaderboardResponse
+	(*JoinQueueRequest)(nil),                 // 58: game.v1.JoinQueueRequest
+	(*JoinQueueResponse)(nil),                // 59: game.v1.JoinQueueResponse
+	(*LeaveQueueRequest)(nil),                // 60: game.v1.LeaveQueueRequest
+	(*LeaveQueueResponse)(nil),               // 61: game.v1.LeaveQueueResponse
+	(*User)(nil),                             // 62: game.v1.User
+	(*RegisterUserRequest)(nil),              // 63: game.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),             // 64: game.v1.RegisterUserResponse
+	(*GetRatingsRequest)(nil),                // 65: game.v1.GetRatingsRequest
+	(*GetRatingsResponse)(nil),               // 66: game.v1.GetRatingsResponse
+	(*MatchStanding)(nil),                    // 67: game.v1.MatchStanding
+	(*MatchGameScore)(nil),                   // 68: game.v1.MatchGameScore
+	(*MatchGame)(nil),                        // 69: game.v1.MatchGame
+	(*Match)(nil),                            // 70: game.v1.Match
+	(*GetMatchRequest)(nil),                  // 71: game.v1.GetMatchRequest
+	(*GetMatchResponse)(nil),                 // 72: game.v1.GetMatchResponse
+	(*TournamentEntrant)(nil),                // 73: game.v1.TournamentEntrant
+	(*TournamentMatch)(nil),                  // 74: game.v1.TournamentMatch
+	(*Tournament)(nil),                       // 75: game.v1.Tournament
+	(*CreateTournamentRequest)(nil),          // 76: game.v1.CreateTournamentRequest
+	(*CreateTournamentResponse)(nil),         // 77: game.v1.CreateTournamentResponse
+	(*RegisterTournamentPlayerRequest)(nil),  // 78: game.v1.RegisterTournamentPlayerRequest
+	(*RegisterTournamentPlayerResponse)(nil), // 79: game.v1.RegisterTournamentPlayerResponse
+	(*StartTournamentRequest)(nil),           // 80: game.v1.StartTournamentRequest
+	(*StartTournamentResponse)(nil),          // 81: game.v1.StartTournamentResponse
+	(*GetTournamentRequest)(nil),             // 82: game.v1.GetTournamentRequest
+	(*GetTournamentResponse)(nil),            // 83: game.v1.GetTournamentResponse
+	(*DeleteGameRequest)(nil),                // 84: game.v1.DeleteGameRequest
+	(*DeleteGameResponse)(nil),               // 85: game.v1.DeleteGameResponse
+	(*GetTeamHighScoresRequest)(nil),         // 86: game.v1.GetTeamHighScoresRequest
+	(*TeamHighScore)(nil),                    // 87: game.v1.TeamHighScore
+	(*GetTeamHighScoresResponse)(nil),        // 88: game.v1.GetTeamHighScoresResponse
+	(*GetGameModesRequest)(nil),              // 89: game.v1.GetGameModesRequest
+	(*GetGameModesResponse)(nil),             // 90: game.v1.GetGameModesResponse
 }
 var file_game_v1_game_proto_depIdxs = []int32{
-	4,  // 0: game.v1.GetGamesResponse.games:type_name -> game.v1.Game
-	0,  // 1: game.v1.JoinGameResponse.player:type_name -> game.v1.Player
-	12, // 2: game.v1.SubmitAnswerRequest.card1:type_name -> game.v1.Card
-	12, // 3: game.v1.SubmitAnswerRequest.card2:type_name -> game.v1.Card
-	1,  // 4: game.v1.CreateGameService.CreateGame:input_type -> game.v1.CreateGameRequest
-	3,  // 5: game.v1.GetGamesService.GetGames:input_type -> game.v1.GetGamesRequest
-	6,  // 6: game.v1.JoinGameService.JoinGame:input_type -> game.v1.JoinGameRequest
-	8,  // 7: game.v1.StartGameService.StartGame:input_type -> game.v1.StartGameRequest
-	10, // 8: game.v1.ReportReadyService.ReportReady:input_type -> game.v1.ReportReadyRequest
-	13, // 9: game.v1.SubmitAnswerService.SubmitAnswer:input_type -> game.v1.SubmitAnswerRequest
-	15, // 10: game.v1.DeleteGameService.DeleteGame:input_type -> game.v1.DeleteGameRequest
-	2,  // 11: game.v1.CreateGameService.CreateGame:output_type -> game.v1.CreateGameResponse
-	5,  // 12: game.v1.GetGamesService.GetGames:output_type -> game.v1.GetGamesResponse
-	7,  // 13: game.v1.JoinGameService.JoinGame:output_type -> game.v1.JoinGameResponse
-	9,  // 14: game.v1.StartGameService.StartGame:output_type -> game.v1.StartGameResponse
-	11, // 15: game.v1.ReportReadyService.ReportReady:output_type -> game.v1.ReportReadyResponse
-	14, // 16: game.v1.SubmitAnswerService.SubmitAnswer:output_type -> game.v1.SubmitAnswerResponse
-	16, // 17: game.v1.DeleteGameService.DeleteGame:output_type -> game.v1.DeleteGameResponse
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	5,  // 0: game.v1.CreateGameRequest.scoring:type_name -> game.v1.ScoringRules
+	4,  // 1: game.v1.CreateGameRequest.settings:type_name -> game.v1.GameSettings
+	5,  // 2: game.v1.Game.scoring:type_name -> game.v1.ScoringRules
+	4,  // 3: game.v1.Game.settings:type_name -> game.v1.GameSettings
+	2,  // 4: game.v1.Game.teams:type_name -> game.v1.Team
+	8,  // 5: game.v1.GetGamesResponse.games:type_name -> game.v1.Game
+	0,  // 6: game.v1.JoinGameResponse.player:type_name -> game.v1.Player
+	4,  // 7: game.v1.UpdateGameSettingsRequest.settings:type_name -> game.v1.GameSettings
+	1,  // 8: game.v1.SetHandicapRequest.handicap:type_name -> game.v1.Handicap
+	36, // 9: game.v1.AddBotRequest.profile:type_name -> game.v1.BotProfile
+	0,  // 10: game.v1.AddBotResponse.player:type_name -> game.v1.Player
+	41, // 11: game.v1.SubmitAnswerRequest.card1:type_name -> game.v1.Card
+	41, // 12: game.v1.SubmitAnswerRequest.card2:type_name -> game.v1.Card
+	41, // 13: game.v1.StartPracticeResponse.cards:type_name -> game.v1.Card
+	41, // 14: game.v1.SubmitPracticeAnswerResponse.next_card:type_name -> game.v1.Card
+	49, // 15: game.v1.GetPersonalBestsResponse.bests:type_name -> game.v1.PersonalBest
+	0,  // 16: game.v1.StartGhostRaceResponse.player:type_name -> game.v1.Player
+	0,  // 17: game.v1.StartGhostRaceResponse.ghost:type_name -> game.v1.Player
+	41, // 18: game.v1.StartDailyChallengeResponse.cards:type_name -> game.v1.Card
+	56, // 19: game.v1.GetDailyLeaderboardResponse.entries:type_name -> game.v1.DailyLeaderboardEntry
+	62, // 20: game.v1.RegisterUserResponse.user:type_name -> game.v1.User
+	62, // 21: game.v1.GetRatingsResponse.users:type_name -> game.v1.User
+	68, // 22: game.v1.MatchGame.scores:type_name -> game.v1.MatchGameScore
+	67, // 23: game.v1.Match.standings:type_name -> game.v1.MatchStanding
+	69, // 24: game.v1.Match.games:type_name -> game.v1.MatchGame
+	70, // 25: game.v1.GetMatchResponse.match:type_name -> game.v1.Match
+	73, // 26: game.v1.TournamentMatch.entrant1:type_name -> game.v1.TournamentEntrant
+	73, // 27: game.v1.TournamentMatch.entrant2:type_name -> game.v1.TournamentEntrant
+	73, // 28: game.v1.Tournament.entrants:type_name -> game.v1.TournamentEntrant
+	74, // 29: game.v1.Tournament.matches:type_name -> game.v1.TournamentMatch
+	73, // 30: game.v1.Tournament.champion:type_name -> game.v1.TournamentEntrant
+	75, // 31: game.v1.CreateTournamentResponse.tournament:type_name -> game.v1.Tournament
+	73, // 32: game.v1.RegisterTournamentPlayerResponse.entrant:type_name -> game.v1.TournamentEntrant
+	75, // 33: game.v1.StartTournamentResponse.tournament:type_name -> game.v1.Tournament
+	75, // 34: game.v1.GetTournamentResponse.tournament:type_name -> game.v1.Tournament
+	87, // 35: game.v1.GetTeamHighScoresResponse.high_scores:type_name -> game.v1.TeamHighScore
+	3,  // 36: game.v1.CreateGameService.CreateGame:input_type -> game.v1.CreateGameRequest
+	7,  // 37: game.v1.GetGamesService.GetGames:input_type -> game.v1.GetGamesRequest
+	10, // 38: game.v1.JoinGameService.JoinGame:input_type -> game.v1.JoinGameRequest
+	12, // 39: game.v1.StartGameService.StartGame:input_type -> game.v1.StartGameRequest
+	14, // 40: game.v1.UpdateGameSettingsService.UpdateGameSettings:input_type -> game.v1.UpdateGameSettingsRequest
+	16, // 41: game.v1.KickPlayerService.KickPlayer:input_type -> game.v1.KickPlayerRequest
+	18, // 42: game.v1.BanPlayerService.BanPlayer:input_type -> game.v1.BanPlayerRequest
+	20, // 43: game.v1.MutePlayerService.MutePlayer:input_type -> game.v1.MutePlayerRequest
+	22, // 44: game.v1.SendChatService.SendChat:input_type -> game.v1.SendChatRequest
+	24, // 45: game.v1.RotateInviteCodeService.RotateInviteCode:input_type -> game.v1.RotateInviteCodeRequest
+	26, // 46: game.v1.ChangeTeamService.ChangeTeam:input_type -> game.v1.ChangeTeamRequest
+	28, // 47: game.v1.SetHandicapService.SetHandicap:input_type -> game.v1.SetHandicapRequest
+	30, // 48: game.v1.PauseGameService.PauseGame:input_type -> game.v1.PauseGameRequest
+	32, // 49: game.v1.ResumeGameService.ResumeGame:input_type -> game.v1.ResumeGameRequest
+	34, // 50: game.v1.RequestRematchService.RequestRematch:input_type -> game.v1.RequestRematchRequest
+	37, // 51: game.v1.AddBotService.AddBot:input_type -> game.v1.AddBotRequest
+	39, // 52: game.v1.ReportReadyService.ReportReady:input_type -> game.v1.ReportReadyRequest
+	42, // 53: game.v1.SubmitAnswerService.SubmitAnswer:input_type -> game.v1.SubmitAnswerRequest
+	44, // 54: game.v1.StartPracticeService.StartPractice:input_type -> game.v1.StartPracticeRequest
+	46, // 55: game.v1.SubmitPracticeAnswerService.SubmitPracticeAnswer:input_type -> game.v1.SubmitPracticeAnswerRequest
+	48, // 56: game.v1.GetPersonalBestsService.GetPersonalBests:input_type -> game.v1.GetPersonalBestsRequest
+	51, // 57: game.v1.StartGhostRaceService.StartGhostRace:input_type -> game.v1.StartGhostRaceRequest
+	53, // 58: game.v1.StartDailyChallengeService.StartDailyChallenge:input_type -> game.v1.StartDailyChallengeRequest
+	55, // 59: game.v1.GetDailyLeaderboardService.GetDailyLeaderboard:input_type -> game.v1.GetDailyLeaderboardRequest
+	58, // 60: game.v1.JoinQueueService.JoinQueue:input_type -> game.v1.JoinQueueRequest
+	60, // 61: game.v1.LeaveQueueService.LeaveQueue:input_type -> game.v1.LeaveQueueRequest
+	63, // 62: game.v1.RegisterUserService.RegisterUser:input_type -> game.v1.RegisterUserRequest
+	65, // 63: game.v1.GetRatingsService.GetRatings:input_type -> game.v1.GetRatingsRequest
+	71, // 64: game.v1.GetMatchService.GetMatch:input_type -> game.v1.GetMatchRequest
+	76, // 65: game.v1.CreateTournamentService.CreateTournament:input_type -> game.v1.CreateTournamentRequest
+	78, // 66: game.v1.RegisterTournamentPlayerService.RegisterTournamentPlayer:input_type -> game.v1.RegisterTournamentPlayerRequest
+	80, // 67: game.v1.StartTournamentService.StartTournament:input_type -> game.v1.StartTournamentRequest
+	82, // 68: game.v1.GetTournamentService.GetTournament:input_type -> game.v1.GetTournamentRequest
+	84, // 69: game.v1.DeleteGameService.DeleteGame:input_type -> game.v1.DeleteGameRequest
+	86, // 70: game.v1.GetTeamHighScoresService.GetTeamHighScores:input_type -> game.v1.GetTeamHighScoresRequest
+	89, // 71: game.v1.GetGameModesService.GetGameModes:input_type -> game.v1.GetGameModesRequest
+	6,  // 72: game.v1.CreateGameService.CreateGame:output_type -> game.v1.CreateGameResponse
+	9,  // 73: game.v1.GetGamesService.GetGames:output_type -> game.v1.GetGamesResponse
+	11, // 74: game.v1.JoinGameService.JoinGame:output_type -> game.v1.JoinGameResponse
+	13, // 75: game.v1.StartGameService.StartGame:output_type -> game.v1.StartGameResponse
+	15, // 76: game.v1.UpdateGameSettingsService.UpdateGameSettings:output_type -> game.v1.UpdateGameSettingsResponse
+	17, // 77: game.v1.KickPlayerService.KickPlayer:output_type -> game.v1.KickPlayerResponse
+	19, // 78: game.v1.BanPlayerService.BanPlayer:output_type -> game.v1.BanPlayerResponse
+	21, // 79: game.v1.MutePlayerService.MutePlayer:output_type -> game.v1.MutePlayerResponse
+	23, // 80: game.v1.SendChatService.SendChat:output_type -> game.v1.SendChatResponse
+	25, // 81: game.v1.RotateInviteCodeService.RotateInviteCode:output_type -> game.v1.RotateInviteCodeResponse
+	27, // 82: game.v1.ChangeTeamService.ChangeTeam:output_type -> game.v1.ChangeTeamResponse
+	29, // 83: game.v1.SetHandicapService.SetHandicap:output_type -> game.v1.SetHandicapResponse
+	31, // 84: game.v1.PauseGameService.PauseGame:output_type -> game.v1.PauseGameResponse
+	33, // 85: game.v1.ResumeGameService.ResumeGame:output_type -> game.v1.ResumeGameResponse
+	35, // 86: game.v1.RequestRematchService.RequestRematch:output_type -> game.v1.RequestRematchResponse
+	38, // 87: game.v1.AddBotService.AddBot:output_type -> game.v1.AddBotResponse
+	40, // 88: game.v1.ReportReadyService.ReportReady:output_type -> game.v1.ReportReadyResponse
+	43, // 89: game.v1.SubmitAnswerService.SubmitAnswer:output_type -> game.v1.SubmitAnswerResponse
+	45, // 90: game.v1.StartPracticeService.StartPractice:output_type -> game.v1.StartPracticeResponse
+	47, // 91: game.v1.SubmitPracticeAnswerService.SubmitPracticeAnswer:output_type -> game.v1.SubmitPracticeAnswerResponse
+	50, // 92: game.v1.GetPersonalBestsService.GetPersonalBests:output_type -> game.v1.GetPersonalBestsResponse
+	52, // 93: game.v1.StartGhostRaceService.StartGhostRace:output_type -> game.v1.StartGhostRaceResponse
+	54, // 94: game.v1.StartDailyChallengeService.StartDailyChallenge:output_type -> game.v1.StartDailyChallengeResponse
+	57, // 95: game.v1.GetDailyLeaderboardService.GetDailyLeaderboard:output_type -> game.v1.GetDailyLeaderboardResponse
+	59, // 96: game.v1.JoinQueueService.JoinQueue:output_type -> game.v1.JoinQueueResponse
+	61, // 97: game.v1.LeaveQueueService.LeaveQueue:output_type -> game.v1.LeaveQueueResponse
+	64, // 98: game.v1.RegisterUserService.RegisterUser:output_type -> game.v1.RegisterUserResponse
+	66, // 99: game.v1.GetRatingsService.GetRatings:output_type -> game.v1.GetRatingsResponse
+	72, // 100: game.v1.GetMatchService.GetMatch:output_type -> game.v1.GetMatchResponse
+	77, // 101: game.v1.CreateTournamentService.CreateTournament:output_type -> game.v1.CreateTournamentResponse
+	79, // 102: game.v1.RegisterTournamentPlayerService.RegisterTournamentPlayer:output_type -> game.v1.RegisterTournamentPlayerResponse
+	81, // 103: game.v1.StartTournamentService.StartTournament:output_type -> game.v1.StartTournamentResponse
+	83, // 104: game.v1.GetTournamentService.GetTournament:output_type -> game.v1.GetTournamentResponse
+	85, // 105: game.v1.DeleteGameService.DeleteGame:output_type -> game.v1.DeleteGameResponse
+	88, // 106: game.v1.GetTeamHighScoresService.GetTeamHighScores:output_type -> game.v1.GetTeamHighScoresResponse
+	90, // 107: game.v1.GetGameModesService.GetGameModes:output_type -> game.v1.GetGameModesResponse
+	72, // [72:108] is the sub-list for method output_type
+	36, // [36:72] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_game_v1_game_proto_init() }
@@ -994,9 +6245,9 @@ func file_game_v1_game_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_game_v1_game_proto_rawDesc), len(file_game_v1_game_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   91,
 			NumExtensions: 0,
-			NumServices:   7,
+			NumServices:   36,
 		},
 		GoTypes:           file_game_v1_game_proto_goTypes,
 		DependencyIndexes: file_game_v1_game_proto_depIdxs,
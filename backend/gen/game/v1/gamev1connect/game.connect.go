@@ -29,12 +29,75 @@ const (
 	JoinGameServiceName = "game.v1.JoinGameService"
 	// StartGameServiceName is the fully-qualified name of the StartGameService service.
 	StartGameServiceName = "game.v1.StartGameService"
+	// UpdateGameSettingsServiceName is the fully-qualified name of the UpdateGameSettingsService
+	// service.
+	UpdateGameSettingsServiceName = "game.v1.UpdateGameSettingsService"
+	// KickPlayerServiceName is the fully-qualified name of the KickPlayerService service.
+	KickPlayerServiceName = "game.v1.KickPlayerService"
+	// BanPlayerServiceName is the fully-qualified name of the BanPlayerService service.
+	BanPlayerServiceName = "game.v1.BanPlayerService"
+	// MutePlayerServiceName is the fully-qualified name of the MutePlayerService service.
+	MutePlayerServiceName = "game.v1.MutePlayerService"
+	// SendChatServiceName is the fully-qualified name of the SendChatService service.
+	SendChatServiceName = "game.v1.SendChatService"
+	// RotateInviteCodeServiceName is the fully-qualified name of the RotateInviteCodeService service.
+	RotateInviteCodeServiceName = "game.v1.RotateInviteCodeService"
+	// ChangeTeamServiceName is the fully-qualified name of the ChangeTeamService service.
+	ChangeTeamServiceName = "game.v1.ChangeTeamService"
+	// SetHandicapServiceName is the fully-qualified name of the SetHandicapService service.
+	SetHandicapServiceName = "game.v1.SetHandicapService"
+	// PauseGameServiceName is the fully-qualified name of the PauseGameService service.
+	PauseGameServiceName = "game.v1.PauseGameService"
+	// ResumeGameServiceName is the fully-qualified name of the ResumeGameService service.
+	ResumeGameServiceName = "game.v1.ResumeGameService"
+	// RequestRematchServiceName is the fully-qualified name of the RequestRematchService service.
+	RequestRematchServiceName = "game.v1.RequestRematchService"
+	// AddBotServiceName is the fully-qualified name of the AddBotService service.
+	AddBotServiceName = "game.v1.AddBotService"
 	// ReportReadyServiceName is the fully-qualified name of the ReportReadyService service.
 	ReportReadyServiceName = "game.v1.ReportReadyService"
 	// SubmitAnswerServiceName is the fully-qualified name of the SubmitAnswerService service.
 	SubmitAnswerServiceName = "game.v1.SubmitAnswerService"
+	// StartPracticeServiceName is the fully-qualified name of the StartPracticeService service.
+	StartPracticeServiceName = "game.v1.StartPracticeService"
+	// SubmitPracticeAnswerServiceName is the fully-qualified name of the SubmitPracticeAnswerService
+	// service.
+	SubmitPracticeAnswerServiceName = "game.v1.SubmitPracticeAnswerService"
+	// GetPersonalBestsServiceName is the fully-qualified name of the GetPersonalBestsService service.
+	GetPersonalBestsServiceName = "game.v1.GetPersonalBestsService"
+	// StartGhostRaceServiceName is the fully-qualified name of the StartGhostRaceService service.
+	StartGhostRaceServiceName = "game.v1.StartGhostRaceService"
+	// StartDailyChallengeServiceName is the fully-qualified name of the StartDailyChallengeService
+	// service.
+	StartDailyChallengeServiceName = "game.v1.StartDailyChallengeService"
+	// GetDailyLeaderboardServiceName is the fully-qualified name of the GetDailyLeaderboardService
+	// service.
+	GetDailyLeaderboardServiceName = "game.v1.GetDailyLeaderboardService"
+	// JoinQueueServiceName is the fully-qualified name of the JoinQueueService service.
+	JoinQueueServiceName = "game.v1.JoinQueueService"
+	// LeaveQueueServiceName is the fully-qualified name of the LeaveQueueService service.
+	LeaveQueueServiceName = "game.v1.LeaveQueueService"
+	// RegisterUserServiceName is the fully-qualified name of the RegisterUserService service.
+	RegisterUserServiceName = "game.v1.RegisterUserService"
+	// GetRatingsServiceName is the fully-qualified name of the GetRatingsService service.
+	GetRatingsServiceName = "game.v1.GetRatingsService"
+	// GetMatchServiceName is the fully-qualified name of the GetMatchService service.
+	GetMatchServiceName = "game.v1.GetMatchService"
+	// CreateTournamentServiceName is the fully-qualified name of the CreateTournamentService service.
+	CreateTournamentServiceName = "game.v1.CreateTournamentService"
+	// RegisterTournamentPlayerServiceName is the fully-qualified name of the
+	// RegisterTournamentPlayerService service.
+	RegisterTournamentPlayerServiceName = "game.v1.RegisterTournamentPlayerService"
+	// StartTournamentServiceName is the fully-qualified name of the StartTournamentService service.
+	StartTournamentServiceName = "game.v1.StartTournamentService"
+	// GetTournamentServiceName is the fully-qualified name of the GetTournamentService service.
+	GetTournamentServiceName = "game.v1.GetTournamentService"
 	// DeleteGameServiceName is the fully-qualified name of the DeleteGameService service.
 	DeleteGameServiceName = "game.v1.DeleteGameService"
+	// GetTeamHighScoresServiceName is the fully-qualified name of the GetTeamHighScoresService service.
+	GetTeamHighScoresServiceName = "game.v1.GetTeamHighScoresService"
+	// GetGameModesServiceName is the fully-qualified name of the GetGameModesService service.
+	GetGameModesServiceName = "game.v1.GetGameModesService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -57,15 +120,101 @@ const (
 	// StartGameServiceStartGameProcedure is the fully-qualified name of the StartGameService's
 	// StartGame RPC.
 	StartGameServiceStartGameProcedure = "/game.v1.StartGameService/StartGame"
+	// UpdateGameSettingsServiceUpdateGameSettingsProcedure is the fully-qualified name of the
+	// UpdateGameSettingsService's UpdateGameSettings RPC.
+	UpdateGameSettingsServiceUpdateGameSettingsProcedure = "/game.v1.UpdateGameSettingsService/UpdateGameSettings"
+	// KickPlayerServiceKickPlayerProcedure is the fully-qualified name of the KickPlayerService's
+	// KickPlayer RPC.
+	KickPlayerServiceKickPlayerProcedure = "/game.v1.KickPlayerService/KickPlayer"
+	// BanPlayerServiceBanPlayerProcedure is the fully-qualified name of the BanPlayerService's
+	// BanPlayer RPC.
+	BanPlayerServiceBanPlayerProcedure = "/game.v1.BanPlayerService/BanPlayer"
+	// MutePlayerServiceMutePlayerProcedure is the fully-qualified name of the MutePlayerService's
+	// MutePlayer RPC.
+	MutePlayerServiceMutePlayerProcedure = "/game.v1.MutePlayerService/MutePlayer"
+	// SendChatServiceSendChatProcedure is the fully-qualified name of the SendChatService's SendChat
+	// RPC.
+	SendChatServiceSendChatProcedure = "/game.v1.SendChatService/SendChat"
+	// RotateInviteCodeServiceRotateInviteCodeProcedure is the fully-qualified name of the
+	// RotateInviteCodeService's RotateInviteCode RPC.
+	RotateInviteCodeServiceRotateInviteCodeProcedure = "/game.v1.RotateInviteCodeService/RotateInviteCode"
+	// ChangeTeamServiceChangeTeamProcedure is the fully-qualified name of the ChangeTeamService's
+	// ChangeTeam RPC.
+	ChangeTeamServiceChangeTeamProcedure = "/game.v1.ChangeTeamService/ChangeTeam"
+	// SetHandicapServiceSetHandicapProcedure is the fully-qualified name of the SetHandicapService's
+	// SetHandicap RPC.
+	SetHandicapServiceSetHandicapProcedure = "/game.v1.SetHandicapService/SetHandicap"
+	// PauseGameServicePauseGameProcedure is the fully-qualified name of the PauseGameService's
+	// PauseGame RPC.
+	PauseGameServicePauseGameProcedure = "/game.v1.PauseGameService/PauseGame"
+	// ResumeGameServiceResumeGameProcedure is the fully-qualified name of the ResumeGameService's
+	// ResumeGame RPC.
+	ResumeGameServiceResumeGameProcedure = "/game.v1.ResumeGameService/ResumeGame"
+	// RequestRematchServiceRequestRematchProcedure is the fully-qualified name of the
+	// RequestRematchService's RequestRematch RPC.
+	RequestRematchServiceRequestRematchProcedure = "/game.v1.RequestRematchService/RequestRematch"
+	// AddBotServiceAddBotProcedure is the fully-qualified name of the AddBotService's AddBot RPC.
+	AddBotServiceAddBotProcedure = "/game.v1.AddBotService/AddBot"
 	// ReportReadyServiceReportReadyProcedure is the fully-qualified name of the ReportReadyService's
 	// ReportReady RPC.
 	ReportReadyServiceReportReadyProcedure = "/game.v1.ReportReadyService/ReportReady"
 	// SubmitAnswerServiceSubmitAnswerProcedure is the fully-qualified name of the SubmitAnswerService's
 	// SubmitAnswer RPC.
 	SubmitAnswerServiceSubmitAnswerProcedure = "/game.v1.SubmitAnswerService/SubmitAnswer"
+	// StartPracticeServiceStartPracticeProcedure is the fully-qualified name of the
+	// StartPracticeService's StartPractice RPC.
+	StartPracticeServiceStartPracticeProcedure = "/game.v1.StartPracticeService/StartPractice"
+	// SubmitPracticeAnswerServiceSubmitPracticeAnswerProcedure is the fully-qualified name of the
+	// SubmitPracticeAnswerService's SubmitPracticeAnswer RPC.
+	SubmitPracticeAnswerServiceSubmitPracticeAnswerProcedure = "/game.v1.SubmitPracticeAnswerService/SubmitPracticeAnswer"
+	// GetPersonalBestsServiceGetPersonalBestsProcedure is the fully-qualified name of the
+	// GetPersonalBestsService's GetPersonalBests RPC.
+	GetPersonalBestsServiceGetPersonalBestsProcedure = "/game.v1.GetPersonalBestsService/GetPersonalBests"
+	// StartGhostRaceServiceStartGhostRaceProcedure is the fully-qualified name of the
+	// StartGhostRaceService's StartGhostRace RPC.
+	StartGhostRaceServiceStartGhostRaceProcedure = "/game.v1.StartGhostRaceService/StartGhostRace"
+	// StartDailyChallengeServiceStartDailyChallengeProcedure is the fully-qualified name of the
+	// StartDailyChallengeService's StartDailyChallenge RPC.
+	StartDailyChallengeServiceStartDailyChallengeProcedure = "/game.v1.StartDailyChallengeService/StartDailyChallenge"
+	// GetDailyLeaderboardServiceGetDailyLeaderboardProcedure is the fully-qualified name of the
+	// GetDailyLeaderboardService's GetDailyLeaderboard RPC.
+	GetDailyLeaderboardServiceGetDailyLeaderboardProcedure = "/game.v1.GetDailyLeaderboardService/GetDailyLeaderboard"
+	// JoinQueueServiceJoinQueueProcedure is the fully-qualified name of the JoinQueueService's
+	// JoinQueue RPC.
+	JoinQueueServiceJoinQueueProcedure = "/game.v1.JoinQueueService/JoinQueue"
+	// LeaveQueueServiceLeaveQueueProcedure is the fully-qualified name of the LeaveQueueService's
+	// LeaveQueue RPC.
+	LeaveQueueServiceLeaveQueueProcedure = "/game.v1.LeaveQueueService/LeaveQueue"
+	// RegisterUserServiceRegisterUserProcedure is the fully-qualified name of the RegisterUserService's
+	// RegisterUser RPC.
+	RegisterUserServiceRegisterUserProcedure = "/game.v1.RegisterUserService/RegisterUser"
+	// GetRatingsServiceGetRatingsProcedure is the fully-qualified name of the GetRatingsService's
+	// GetRatings RPC.
+	GetRatingsServiceGetRatingsProcedure = "/game.v1.GetRatingsService/GetRatings"
+	// GetMatchServiceGetMatchProcedure is the fully-qualified name of the GetMatchService's GetMatch
+	// RPC.
+	GetMatchServiceGetMatchProcedure = "/game.v1.GetMatchService/GetMatch"
+	// CreateTournamentServiceCreateTournamentProcedure is the fully-qualified name of the
+	// CreateTournamentService's CreateTournament RPC.
+	CreateTournamentServiceCreateTournamentProcedure = "/game.v1.CreateTournamentService/CreateTournament"
+	// RegisterTournamentPlayerServiceRegisterTournamentPlayerProcedure is the fully-qualified name of
+	// the RegisterTournamentPlayerService's RegisterTournamentPlayer RPC.
+	RegisterTournamentPlayerServiceRegisterTournamentPlayerProcedure = "/game.v1.RegisterTournamentPlayerService/RegisterTournamentPlayer"
+	// StartTournamentServiceStartTournamentProcedure is the fully-qualified name of the
+	// StartTournamentService's StartTournament RPC.
+	StartTournamentServiceStartTournamentProcedure = "/game.v1.StartTournamentService/StartTournament"
+	// GetTournamentServiceGetTournamentProcedure is the fully-qualified name of the
+	// GetTournamentService's GetTournament RPC.
+	GetTournamentServiceGetTournamentProcedure = "/game.v1.GetTournamentService/GetTournament"
 	// DeleteGameServiceDeleteGameProcedure is the fully-qualified name of the DeleteGameService's
 	// DeleteGame RPC.
 	DeleteGameServiceDeleteGameProcedure = "/game.v1.DeleteGameService/DeleteGame"
+	// GetTeamHighScoresServiceGetTeamHighScoresProcedure is the fully-qualified name of the
+	// GetTeamHighScoresService's GetTeamHighScores RPC.
+	GetTeamHighScoresServiceGetTeamHighScoresProcedure = "/game.v1.GetTeamHighScoresService/GetTeamHighScores"
+	// GetGameModesServiceGetGameModesProcedure is the fully-qualified name of the GetGameModesService's
+	// GetGameModes RPC.
+	GetGameModesServiceGetGameModesProcedure = "/game.v1.GetGameModesService/GetGameModes"
 )
 
 // CreateGameServiceClient is a client for the game.v1.CreateGameService service.
@@ -348,212 +497,2253 @@ func (UnimplementedStartGameServiceHandler) StartGame(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.StartGameService.StartGame is not implemented"))
 }
 
-// ReportReadyServiceClient is a client for the game.v1.ReportReadyService service.
-type ReportReadyServiceClient interface {
-	ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error)
+// UpdateGameSettingsServiceClient is a client for the game.v1.UpdateGameSettingsService service.
+type UpdateGameSettingsServiceClient interface {
+	UpdateGameSettings(context.Context, *connect.Request[v1.UpdateGameSettingsRequest]) (*connect.Response[v1.UpdateGameSettingsResponse], error)
 }
 
-// NewReportReadyServiceClient constructs a client for the game.v1.ReportReadyService service. By
+// NewUpdateGameSettingsServiceClient constructs a client for the game.v1.UpdateGameSettingsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUpdateGameSettingsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UpdateGameSettingsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	updateGameSettingsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("UpdateGameSettingsService").Methods()
+	return &updateGameSettingsServiceClient{
+		updateGameSettings: connect.NewClient[v1.UpdateGameSettingsRequest, v1.UpdateGameSettingsResponse](
+			httpClient,
+			baseURL+UpdateGameSettingsServiceUpdateGameSettingsProcedure,
+			connect.WithSchema(updateGameSettingsServiceMethods.ByName("UpdateGameSettings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// updateGameSettingsServiceClient implements UpdateGameSettingsServiceClient.
+type updateGameSettingsServiceClient struct {
+	updateGameSettings *connect.Client[v1.UpdateGameSettingsRequest, v1.UpdateGameSettingsResponse]
+}
+
+// UpdateGameSettings calls game.v1.UpdateGameSettingsService.UpdateGameSettings.
+func (c *updateGameSettingsServiceClient) UpdateGameSettings(ctx context.Context, req *connect.Request[v1.UpdateGameSettingsRequest]) (*connect.Response[v1.UpdateGameSettingsResponse], error) {
+	return c.updateGameSettings.CallUnary(ctx, req)
+}
+
+// UpdateGameSettingsServiceHandler is an implementation of the game.v1.UpdateGameSettingsService
+// service.
+type UpdateGameSettingsServiceHandler interface {
+	UpdateGameSettings(context.Context, *connect.Request[v1.UpdateGameSettingsRequest]) (*connect.Response[v1.UpdateGameSettingsResponse], error)
+}
+
+// NewUpdateGameSettingsServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUpdateGameSettingsServiceHandler(svc UpdateGameSettingsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	updateGameSettingsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("UpdateGameSettingsService").Methods()
+	updateGameSettingsServiceUpdateGameSettingsHandler := connect.NewUnaryHandler(
+		UpdateGameSettingsServiceUpdateGameSettingsProcedure,
+		svc.UpdateGameSettings,
+		connect.WithSchema(updateGameSettingsServiceMethods.ByName("UpdateGameSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.UpdateGameSettingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UpdateGameSettingsServiceUpdateGameSettingsProcedure:
+			updateGameSettingsServiceUpdateGameSettingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUpdateGameSettingsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUpdateGameSettingsServiceHandler struct{}
+
+func (UnimplementedUpdateGameSettingsServiceHandler) UpdateGameSettings(context.Context, *connect.Request[v1.UpdateGameSettingsRequest]) (*connect.Response[v1.UpdateGameSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.UpdateGameSettingsService.UpdateGameSettings is not implemented"))
+}
+
+// KickPlayerServiceClient is a client for the game.v1.KickPlayerService service.
+type KickPlayerServiceClient interface {
+	KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error)
+}
+
+// NewKickPlayerServiceClient constructs a client for the game.v1.KickPlayerService service. By
 // default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
 // and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
 // connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewReportReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReportReadyServiceClient {
+func NewKickPlayerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KickPlayerServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	reportReadyServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ReportReadyService").Methods()
-	return &reportReadyServiceClient{
-		reportReady: connect.NewClient[v1.ReportReadyRequest, v1.ReportReadyResponse](
+	kickPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("KickPlayerService").Methods()
+	return &kickPlayerServiceClient{
+		kickPlayer: connect.NewClient[v1.KickPlayerRequest, v1.KickPlayerResponse](
 			httpClient,
-			baseURL+ReportReadyServiceReportReadyProcedure,
-			connect.WithSchema(reportReadyServiceMethods.ByName("ReportReady")),
+			baseURL+KickPlayerServiceKickPlayerProcedure,
+			connect.WithSchema(kickPlayerServiceMethods.ByName("KickPlayer")),
 			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
-// reportReadyServiceClient implements ReportReadyServiceClient.
-type reportReadyServiceClient struct {
-	reportReady *connect.Client[v1.ReportReadyRequest, v1.ReportReadyResponse]
+// kickPlayerServiceClient implements KickPlayerServiceClient.
+type kickPlayerServiceClient struct {
+	kickPlayer *connect.Client[v1.KickPlayerRequest, v1.KickPlayerResponse]
 }
 
-// ReportReady calls game.v1.ReportReadyService.ReportReady.
-func (c *reportReadyServiceClient) ReportReady(ctx context.Context, req *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error) {
-	return c.reportReady.CallUnary(ctx, req)
+// KickPlayer calls game.v1.KickPlayerService.KickPlayer.
+func (c *kickPlayerServiceClient) KickPlayer(ctx context.Context, req *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error) {
+	return c.kickPlayer.CallUnary(ctx, req)
 }
 
-// ReportReadyServiceHandler is an implementation of the game.v1.ReportReadyService service.
-type ReportReadyServiceHandler interface {
-	ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error)
+// KickPlayerServiceHandler is an implementation of the game.v1.KickPlayerService service.
+type KickPlayerServiceHandler interface {
+	KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error)
 }
 
-// NewReportReadyServiceHandler builds an HTTP handler from the service implementation. It returns
+// NewKickPlayerServiceHandler builds an HTTP handler from the service implementation. It returns
 // the path on which to mount the handler and the handler itself.
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewReportReadyServiceHandler(svc ReportReadyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	reportReadyServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ReportReadyService").Methods()
-	reportReadyServiceReportReadyHandler := connect.NewUnaryHandler(
-		ReportReadyServiceReportReadyProcedure,
-		svc.ReportReady,
-		connect.WithSchema(reportReadyServiceMethods.ByName("ReportReady")),
+func NewKickPlayerServiceHandler(svc KickPlayerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	kickPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("KickPlayerService").Methods()
+	kickPlayerServiceKickPlayerHandler := connect.NewUnaryHandler(
+		KickPlayerServiceKickPlayerProcedure,
+		svc.KickPlayer,
+		connect.WithSchema(kickPlayerServiceMethods.ByName("KickPlayer")),
 		connect.WithHandlerOptions(opts...),
 	)
-	return "/game.v1.ReportReadyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return "/game.v1.KickPlayerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
-		case ReportReadyServiceReportReadyProcedure:
-			reportReadyServiceReportReadyHandler.ServeHTTP(w, r)
+		case KickPlayerServiceKickPlayerProcedure:
+			kickPlayerServiceKickPlayerHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedReportReadyServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedReportReadyServiceHandler struct{}
+// UnimplementedKickPlayerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKickPlayerServiceHandler struct{}
 
-func (UnimplementedReportReadyServiceHandler) ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.ReportReadyService.ReportReady is not implemented"))
+func (UnimplementedKickPlayerServiceHandler) KickPlayer(context.Context, *connect.Request[v1.KickPlayerRequest]) (*connect.Response[v1.KickPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.KickPlayerService.KickPlayer is not implemented"))
 }
 
-// SubmitAnswerServiceClient is a client for the game.v1.SubmitAnswerService service.
-type SubmitAnswerServiceClient interface {
-	SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error)
+// BanPlayerServiceClient is a client for the game.v1.BanPlayerService service.
+type BanPlayerServiceClient interface {
+	BanPlayer(context.Context, *connect.Request[v1.BanPlayerRequest]) (*connect.Response[v1.BanPlayerResponse], error)
 }
 
-// NewSubmitAnswerServiceClient constructs a client for the game.v1.SubmitAnswerService service. By
+// NewBanPlayerServiceClient constructs a client for the game.v1.BanPlayerService service. By
 // default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
 // and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
 // connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewSubmitAnswerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubmitAnswerServiceClient {
+func NewBanPlayerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) BanPlayerServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	submitAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitAnswerService").Methods()
-	return &submitAnswerServiceClient{
-		submitAnswer: connect.NewClient[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse](
+	banPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("BanPlayerService").Methods()
+	return &banPlayerServiceClient{
+		banPlayer: connect.NewClient[v1.BanPlayerRequest, v1.BanPlayerResponse](
 			httpClient,
-			baseURL+SubmitAnswerServiceSubmitAnswerProcedure,
-			connect.WithSchema(submitAnswerServiceMethods.ByName("SubmitAnswer")),
+			baseURL+BanPlayerServiceBanPlayerProcedure,
+			connect.WithSchema(banPlayerServiceMethods.ByName("BanPlayer")),
 			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
-// submitAnswerServiceClient implements SubmitAnswerServiceClient.
-type submitAnswerServiceClient struct {
-	submitAnswer *connect.Client[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse]
+// banPlayerServiceClient implements BanPlayerServiceClient.
+type banPlayerServiceClient struct {
+	banPlayer *connect.Client[v1.BanPlayerRequest, v1.BanPlayerResponse]
 }
 
-// SubmitAnswer calls game.v1.SubmitAnswerService.SubmitAnswer.
-func (c *submitAnswerServiceClient) SubmitAnswer(ctx context.Context, req *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error) {
-	return c.submitAnswer.CallUnary(ctx, req)
+// BanPlayer calls game.v1.BanPlayerService.BanPlayer.
+func (c *banPlayerServiceClient) BanPlayer(ctx context.Context, req *connect.Request[v1.BanPlayerRequest]) (*connect.Response[v1.BanPlayerResponse], error) {
+	return c.banPlayer.CallUnary(ctx, req)
 }
 
-// SubmitAnswerServiceHandler is an implementation of the game.v1.SubmitAnswerService service.
-type SubmitAnswerServiceHandler interface {
-	SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error)
+// BanPlayerServiceHandler is an implementation of the game.v1.BanPlayerService service.
+type BanPlayerServiceHandler interface {
+	BanPlayer(context.Context, *connect.Request[v1.BanPlayerRequest]) (*connect.Response[v1.BanPlayerResponse], error)
 }
 
-// NewSubmitAnswerServiceHandler builds an HTTP handler from the service implementation. It returns
+// NewBanPlayerServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewBanPlayerServiceHandler(svc BanPlayerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	banPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("BanPlayerService").Methods()
+	banPlayerServiceBanPlayerHandler := connect.NewUnaryHandler(
+		BanPlayerServiceBanPlayerProcedure,
+		svc.BanPlayer,
+		connect.WithSchema(banPlayerServiceMethods.ByName("BanPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.BanPlayerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case BanPlayerServiceBanPlayerProcedure:
+			banPlayerServiceBanPlayerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedBanPlayerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedBanPlayerServiceHandler struct{}
+
+func (UnimplementedBanPlayerServiceHandler) BanPlayer(context.Context, *connect.Request[v1.BanPlayerRequest]) (*connect.Response[v1.BanPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.BanPlayerService.BanPlayer is not implemented"))
+}
+
+// MutePlayerServiceClient is a client for the game.v1.MutePlayerService service.
+type MutePlayerServiceClient interface {
+	MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error)
+}
+
+// NewMutePlayerServiceClient constructs a client for the game.v1.MutePlayerService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMutePlayerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MutePlayerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	mutePlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("MutePlayerService").Methods()
+	return &mutePlayerServiceClient{
+		mutePlayer: connect.NewClient[v1.MutePlayerRequest, v1.MutePlayerResponse](
+			httpClient,
+			baseURL+MutePlayerServiceMutePlayerProcedure,
+			connect.WithSchema(mutePlayerServiceMethods.ByName("MutePlayer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// mutePlayerServiceClient implements MutePlayerServiceClient.
+type mutePlayerServiceClient struct {
+	mutePlayer *connect.Client[v1.MutePlayerRequest, v1.MutePlayerResponse]
+}
+
+// MutePlayer calls game.v1.MutePlayerService.MutePlayer.
+func (c *mutePlayerServiceClient) MutePlayer(ctx context.Context, req *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error) {
+	return c.mutePlayer.CallUnary(ctx, req)
+}
+
+// MutePlayerServiceHandler is an implementation of the game.v1.MutePlayerService service.
+type MutePlayerServiceHandler interface {
+	MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error)
+}
+
+// NewMutePlayerServiceHandler builds an HTTP handler from the service implementation. It returns
 // the path on which to mount the handler and the handler itself.
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewSubmitAnswerServiceHandler(svc SubmitAnswerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	submitAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitAnswerService").Methods()
-	submitAnswerServiceSubmitAnswerHandler := connect.NewUnaryHandler(
-		SubmitAnswerServiceSubmitAnswerProcedure,
-		svc.SubmitAnswer,
-		connect.WithSchema(submitAnswerServiceMethods.ByName("SubmitAnswer")),
+func NewMutePlayerServiceHandler(svc MutePlayerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	mutePlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("MutePlayerService").Methods()
+	mutePlayerServiceMutePlayerHandler := connect.NewUnaryHandler(
+		MutePlayerServiceMutePlayerProcedure,
+		svc.MutePlayer,
+		connect.WithSchema(mutePlayerServiceMethods.ByName("MutePlayer")),
 		connect.WithHandlerOptions(opts...),
 	)
-	return "/game.v1.SubmitAnswerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return "/game.v1.MutePlayerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
-		case SubmitAnswerServiceSubmitAnswerProcedure:
-			submitAnswerServiceSubmitAnswerHandler.ServeHTTP(w, r)
+		case MutePlayerServiceMutePlayerProcedure:
+			mutePlayerServiceMutePlayerHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedSubmitAnswerServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedSubmitAnswerServiceHandler struct{}
+// UnimplementedMutePlayerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMutePlayerServiceHandler struct{}
 
-func (UnimplementedSubmitAnswerServiceHandler) SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.SubmitAnswerService.SubmitAnswer is not implemented"))
+func (UnimplementedMutePlayerServiceHandler) MutePlayer(context.Context, *connect.Request[v1.MutePlayerRequest]) (*connect.Response[v1.MutePlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.MutePlayerService.MutePlayer is not implemented"))
 }
 
-// DeleteGameServiceClient is a client for the game.v1.DeleteGameService service.
-type DeleteGameServiceClient interface {
-	DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error)
+// SendChatServiceClient is a client for the game.v1.SendChatService service.
+type SendChatServiceClient interface {
+	SendChat(context.Context, *connect.Request[v1.SendChatRequest]) (*connect.Response[v1.SendChatResponse], error)
 }
 
-// NewDeleteGameServiceClient constructs a client for the game.v1.DeleteGameService service. By
+// NewSendChatServiceClient constructs a client for the game.v1.SendChatService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSendChatServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SendChatServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	sendChatServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SendChatService").Methods()
+	return &sendChatServiceClient{
+		sendChat: connect.NewClient[v1.SendChatRequest, v1.SendChatResponse](
+			httpClient,
+			baseURL+SendChatServiceSendChatProcedure,
+			connect.WithSchema(sendChatServiceMethods.ByName("SendChat")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// sendChatServiceClient implements SendChatServiceClient.
+type sendChatServiceClient struct {
+	sendChat *connect.Client[v1.SendChatRequest, v1.SendChatResponse]
+}
+
+// SendChat calls game.v1.SendChatService.SendChat.
+func (c *sendChatServiceClient) SendChat(ctx context.Context, req *connect.Request[v1.SendChatRequest]) (*connect.Response[v1.SendChatResponse], error) {
+	return c.sendChat.CallUnary(ctx, req)
+}
+
+// SendChatServiceHandler is an implementation of the game.v1.SendChatService service.
+type SendChatServiceHandler interface {
+	SendChat(context.Context, *connect.Request[v1.SendChatRequest]) (*connect.Response[v1.SendChatResponse], error)
+}
+
+// NewSendChatServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSendChatServiceHandler(svc SendChatServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	sendChatServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SendChatService").Methods()
+	sendChatServiceSendChatHandler := connect.NewUnaryHandler(
+		SendChatServiceSendChatProcedure,
+		svc.SendChat,
+		connect.WithSchema(sendChatServiceMethods.ByName("SendChat")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.SendChatService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SendChatServiceSendChatProcedure:
+			sendChatServiceSendChatHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSendChatServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSendChatServiceHandler struct{}
+
+func (UnimplementedSendChatServiceHandler) SendChat(context.Context, *connect.Request[v1.SendChatRequest]) (*connect.Response[v1.SendChatResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.SendChatService.SendChat is not implemented"))
+}
+
+// RotateInviteCodeServiceClient is a client for the game.v1.RotateInviteCodeService service.
+type RotateInviteCodeServiceClient interface {
+	RotateInviteCode(context.Context, *connect.Request[v1.RotateInviteCodeRequest]) (*connect.Response[v1.RotateInviteCodeResponse], error)
+}
+
+// NewRotateInviteCodeServiceClient constructs a client for the game.v1.RotateInviteCodeService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRotateInviteCodeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RotateInviteCodeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	rotateInviteCodeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RotateInviteCodeService").Methods()
+	return &rotateInviteCodeServiceClient{
+		rotateInviteCode: connect.NewClient[v1.RotateInviteCodeRequest, v1.RotateInviteCodeResponse](
+			httpClient,
+			baseURL+RotateInviteCodeServiceRotateInviteCodeProcedure,
+			connect.WithSchema(rotateInviteCodeServiceMethods.ByName("RotateInviteCode")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// rotateInviteCodeServiceClient implements RotateInviteCodeServiceClient.
+type rotateInviteCodeServiceClient struct {
+	rotateInviteCode *connect.Client[v1.RotateInviteCodeRequest, v1.RotateInviteCodeResponse]
+}
+
+// RotateInviteCode calls game.v1.RotateInviteCodeService.RotateInviteCode.
+func (c *rotateInviteCodeServiceClient) RotateInviteCode(ctx context.Context, req *connect.Request[v1.RotateInviteCodeRequest]) (*connect.Response[v1.RotateInviteCodeResponse], error) {
+	return c.rotateInviteCode.CallUnary(ctx, req)
+}
+
+// RotateInviteCodeServiceHandler is an implementation of the game.v1.RotateInviteCodeService
+// service.
+type RotateInviteCodeServiceHandler interface {
+	RotateInviteCode(context.Context, *connect.Request[v1.RotateInviteCodeRequest]) (*connect.Response[v1.RotateInviteCodeResponse], error)
+}
+
+// NewRotateInviteCodeServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRotateInviteCodeServiceHandler(svc RotateInviteCodeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	rotateInviteCodeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RotateInviteCodeService").Methods()
+	rotateInviteCodeServiceRotateInviteCodeHandler := connect.NewUnaryHandler(
+		RotateInviteCodeServiceRotateInviteCodeProcedure,
+		svc.RotateInviteCode,
+		connect.WithSchema(rotateInviteCodeServiceMethods.ByName("RotateInviteCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.RotateInviteCodeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RotateInviteCodeServiceRotateInviteCodeProcedure:
+			rotateInviteCodeServiceRotateInviteCodeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRotateInviteCodeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRotateInviteCodeServiceHandler struct{}
+
+func (UnimplementedRotateInviteCodeServiceHandler) RotateInviteCode(context.Context, *connect.Request[v1.RotateInviteCodeRequest]) (*connect.Response[v1.RotateInviteCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.RotateInviteCodeService.RotateInviteCode is not implemented"))
+}
+
+// ChangeTeamServiceClient is a client for the game.v1.ChangeTeamService service.
+type ChangeTeamServiceClient interface {
+	ChangeTeam(context.Context, *connect.Request[v1.ChangeTeamRequest]) (*connect.Response[v1.ChangeTeamResponse], error)
+}
+
+// NewChangeTeamServiceClient constructs a client for the game.v1.ChangeTeamService service. By
 // default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
 // and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
 // connect.WithGRPC() or connect.WithGRPCWeb() options.
 //
 // The URL supplied here should be the base URL for the Connect or gRPC server (for example,
 // http://api.acme.com or https://acme.com/grpc).
-func NewDeleteGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DeleteGameServiceClient {
+func NewChangeTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ChangeTeamServiceClient {
 	baseURL = strings.TrimRight(baseURL, "/")
-	deleteGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("DeleteGameService").Methods()
-	return &deleteGameServiceClient{
-		deleteGame: connect.NewClient[v1.DeleteGameRequest, v1.DeleteGameResponse](
+	changeTeamServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ChangeTeamService").Methods()
+	return &changeTeamServiceClient{
+		changeTeam: connect.NewClient[v1.ChangeTeamRequest, v1.ChangeTeamResponse](
 			httpClient,
-			baseURL+DeleteGameServiceDeleteGameProcedure,
-			connect.WithSchema(deleteGameServiceMethods.ByName("DeleteGame")),
+			baseURL+ChangeTeamServiceChangeTeamProcedure,
+			connect.WithSchema(changeTeamServiceMethods.ByName("ChangeTeam")),
 			connect.WithClientOptions(opts...),
 		),
 	}
 }
 
-// deleteGameServiceClient implements DeleteGameServiceClient.
-type deleteGameServiceClient struct {
-	deleteGame *connect.Client[v1.DeleteGameRequest, v1.DeleteGameResponse]
+// changeTeamServiceClient implements ChangeTeamServiceClient.
+type changeTeamServiceClient struct {
+	changeTeam *connect.Client[v1.ChangeTeamRequest, v1.ChangeTeamResponse]
 }
 
-// DeleteGame calls game.v1.DeleteGameService.DeleteGame.
-func (c *deleteGameServiceClient) DeleteGame(ctx context.Context, req *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error) {
-	return c.deleteGame.CallUnary(ctx, req)
+// ChangeTeam calls game.v1.ChangeTeamService.ChangeTeam.
+func (c *changeTeamServiceClient) ChangeTeam(ctx context.Context, req *connect.Request[v1.ChangeTeamRequest]) (*connect.Response[v1.ChangeTeamResponse], error) {
+	return c.changeTeam.CallUnary(ctx, req)
 }
 
-// DeleteGameServiceHandler is an implementation of the game.v1.DeleteGameService service.
-type DeleteGameServiceHandler interface {
-	DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error)
+// ChangeTeamServiceHandler is an implementation of the game.v1.ChangeTeamService service.
+type ChangeTeamServiceHandler interface {
+	ChangeTeam(context.Context, *connect.Request[v1.ChangeTeamRequest]) (*connect.Response[v1.ChangeTeamResponse], error)
 }
 
-// NewDeleteGameServiceHandler builds an HTTP handler from the service implementation. It returns
+// NewChangeTeamServiceHandler builds an HTTP handler from the service implementation. It returns
 // the path on which to mount the handler and the handler itself.
 //
 // By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
 // and JSON codecs. They also support gzip compression.
-func NewDeleteGameServiceHandler(svc DeleteGameServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
-	deleteGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("DeleteGameService").Methods()
-	deleteGameServiceDeleteGameHandler := connect.NewUnaryHandler(
-		DeleteGameServiceDeleteGameProcedure,
-		svc.DeleteGame,
-		connect.WithSchema(deleteGameServiceMethods.ByName("DeleteGame")),
+func NewChangeTeamServiceHandler(svc ChangeTeamServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	changeTeamServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ChangeTeamService").Methods()
+	changeTeamServiceChangeTeamHandler := connect.NewUnaryHandler(
+		ChangeTeamServiceChangeTeamProcedure,
+		svc.ChangeTeam,
+		connect.WithSchema(changeTeamServiceMethods.ByName("ChangeTeam")),
 		connect.WithHandlerOptions(opts...),
 	)
-	return "/game.v1.DeleteGameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	return "/game.v1.ChangeTeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
-		case DeleteGameServiceDeleteGameProcedure:
-			deleteGameServiceDeleteGameHandler.ServeHTTP(w, r)
+		case ChangeTeamServiceChangeTeamProcedure:
+			changeTeamServiceChangeTeamHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
 	})
 }
 
-// UnimplementedDeleteGameServiceHandler returns CodeUnimplemented from all methods.
-type UnimplementedDeleteGameServiceHandler struct{}
+// UnimplementedChangeTeamServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedChangeTeamServiceHandler struct{}
 
-func (UnimplementedDeleteGameServiceHandler) DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error) {
-	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.DeleteGameService.DeleteGame is not implemented"))
+func (UnimplementedChangeTeamServiceHandler) ChangeTeam(context.Context, *connect.Request[v1.ChangeTeamRequest]) (*connect.Response[v1.ChangeTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.ChangeTeamService.ChangeTeam is not implemented"))
+}
+
+// SetHandicapServiceClient is a client for the game.v1.SetHandicapService service.
+type SetHandicapServiceClient interface {
+	SetHandicap(context.Context, *connect.Request[v1.SetHandicapRequest]) (*connect.Response[v1.SetHandicapResponse], error)
+}
+
+// NewSetHandicapServiceClient constructs a client for the game.v1.SetHandicapService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSetHandicapServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SetHandicapServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	setHandicapServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SetHandicapService").Methods()
+	return &setHandicapServiceClient{
+		setHandicap: connect.NewClient[v1.SetHandicapRequest, v1.SetHandicapResponse](
+			httpClient,
+			baseURL+SetHandicapServiceSetHandicapProcedure,
+			connect.WithSchema(setHandicapServiceMethods.ByName("SetHandicap")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// setHandicapServiceClient implements SetHandicapServiceClient.
+type setHandicapServiceClient struct {
+	setHandicap *connect.Client[v1.SetHandicapRequest, v1.SetHandicapResponse]
+}
+
+// SetHandicap calls game.v1.SetHandicapService.SetHandicap.
+func (c *setHandicapServiceClient) SetHandicap(ctx context.Context, req *connect.Request[v1.SetHandicapRequest]) (*connect.Response[v1.SetHandicapResponse], error) {
+	return c.setHandicap.CallUnary(ctx, req)
+}
+
+// SetHandicapServiceHandler is an implementation of the game.v1.SetHandicapService service.
+type SetHandicapServiceHandler interface {
+	SetHandicap(context.Context, *connect.Request[v1.SetHandicapRequest]) (*connect.Response[v1.SetHandicapResponse], error)
+}
+
+// NewSetHandicapServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSetHandicapServiceHandler(svc SetHandicapServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	setHandicapServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SetHandicapService").Methods()
+	setHandicapServiceSetHandicapHandler := connect.NewUnaryHandler(
+		SetHandicapServiceSetHandicapProcedure,
+		svc.SetHandicap,
+		connect.WithSchema(setHandicapServiceMethods.ByName("SetHandicap")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.SetHandicapService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SetHandicapServiceSetHandicapProcedure:
+			setHandicapServiceSetHandicapHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSetHandicapServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSetHandicapServiceHandler struct{}
+
+func (UnimplementedSetHandicapServiceHandler) SetHandicap(context.Context, *connect.Request[v1.SetHandicapRequest]) (*connect.Response[v1.SetHandicapResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.SetHandicapService.SetHandicap is not implemented"))
+}
+
+// PauseGameServiceClient is a client for the game.v1.PauseGameService service.
+type PauseGameServiceClient interface {
+	PauseGame(context.Context, *connect.Request[v1.PauseGameRequest]) (*connect.Response[v1.PauseGameResponse], error)
+}
+
+// NewPauseGameServiceClient constructs a client for the game.v1.PauseGameService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPauseGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PauseGameServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	pauseGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("PauseGameService").Methods()
+	return &pauseGameServiceClient{
+		pauseGame: connect.NewClient[v1.PauseGameRequest, v1.PauseGameResponse](
+			httpClient,
+			baseURL+PauseGameServicePauseGameProcedure,
+			connect.WithSchema(pauseGameServiceMethods.ByName("PauseGame")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// pauseGameServiceClient implements PauseGameServiceClient.
+type pauseGameServiceClient struct {
+	pauseGame *connect.Client[v1.PauseGameRequest, v1.PauseGameResponse]
+}
+
+// PauseGame calls game.v1.PauseGameService.PauseGame.
+func (c *pauseGameServiceClient) PauseGame(ctx context.Context, req *connect.Request[v1.PauseGameRequest]) (*connect.Response[v1.PauseGameResponse], error) {
+	return c.pauseGame.CallUnary(ctx, req)
+}
+
+// PauseGameServiceHandler is an implementation of the game.v1.PauseGameService service.
+type PauseGameServiceHandler interface {
+	PauseGame(context.Context, *connect.Request[v1.PauseGameRequest]) (*connect.Response[v1.PauseGameResponse], error)
+}
+
+// NewPauseGameServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPauseGameServiceHandler(svc PauseGameServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	pauseGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("PauseGameService").Methods()
+	pauseGameServicePauseGameHandler := connect.NewUnaryHandler(
+		PauseGameServicePauseGameProcedure,
+		svc.PauseGame,
+		connect.WithSchema(pauseGameServiceMethods.ByName("PauseGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.PauseGameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PauseGameServicePauseGameProcedure:
+			pauseGameServicePauseGameHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPauseGameServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPauseGameServiceHandler struct{}
+
+func (UnimplementedPauseGameServiceHandler) PauseGame(context.Context, *connect.Request[v1.PauseGameRequest]) (*connect.Response[v1.PauseGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.PauseGameService.PauseGame is not implemented"))
+}
+
+// ResumeGameServiceClient is a client for the game.v1.ResumeGameService service.
+type ResumeGameServiceClient interface {
+	ResumeGame(context.Context, *connect.Request[v1.ResumeGameRequest]) (*connect.Response[v1.ResumeGameResponse], error)
+}
+
+// NewResumeGameServiceClient constructs a client for the game.v1.ResumeGameService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewResumeGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ResumeGameServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	resumeGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ResumeGameService").Methods()
+	return &resumeGameServiceClient{
+		resumeGame: connect.NewClient[v1.ResumeGameRequest, v1.ResumeGameResponse](
+			httpClient,
+			baseURL+ResumeGameServiceResumeGameProcedure,
+			connect.WithSchema(resumeGameServiceMethods.ByName("ResumeGame")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// resumeGameServiceClient implements ResumeGameServiceClient.
+type resumeGameServiceClient struct {
+	resumeGame *connect.Client[v1.ResumeGameRequest, v1.ResumeGameResponse]
+}
+
+// ResumeGame calls game.v1.ResumeGameService.ResumeGame.
+func (c *resumeGameServiceClient) ResumeGame(ctx context.Context, req *connect.Request[v1.ResumeGameRequest]) (*connect.Response[v1.ResumeGameResponse], error) {
+	return c.resumeGame.CallUnary(ctx, req)
+}
+
+// ResumeGameServiceHandler is an implementation of the game.v1.ResumeGameService service.
+type ResumeGameServiceHandler interface {
+	ResumeGame(context.Context, *connect.Request[v1.ResumeGameRequest]) (*connect.Response[v1.ResumeGameResponse], error)
+}
+
+// NewResumeGameServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewResumeGameServiceHandler(svc ResumeGameServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	resumeGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ResumeGameService").Methods()
+	resumeGameServiceResumeGameHandler := connect.NewUnaryHandler(
+		ResumeGameServiceResumeGameProcedure,
+		svc.ResumeGame,
+		connect.WithSchema(resumeGameServiceMethods.ByName("ResumeGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.ResumeGameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ResumeGameServiceResumeGameProcedure:
+			resumeGameServiceResumeGameHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedResumeGameServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedResumeGameServiceHandler struct{}
+
+func (UnimplementedResumeGameServiceHandler) ResumeGame(context.Context, *connect.Request[v1.ResumeGameRequest]) (*connect.Response[v1.ResumeGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.ResumeGameService.ResumeGame is not implemented"))
+}
+
+// RequestRematchServiceClient is a client for the game.v1.RequestRematchService service.
+type RequestRematchServiceClient interface {
+	RequestRematch(context.Context, *connect.Request[v1.RequestRematchRequest]) (*connect.Response[v1.RequestRematchResponse], error)
+}
+
+// NewRequestRematchServiceClient constructs a client for the game.v1.RequestRematchService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRequestRematchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RequestRematchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	requestRematchServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RequestRematchService").Methods()
+	return &requestRematchServiceClient{
+		requestRematch: connect.NewClient[v1.RequestRematchRequest, v1.RequestRematchResponse](
+			httpClient,
+			baseURL+RequestRematchServiceRequestRematchProcedure,
+			connect.WithSchema(requestRematchServiceMethods.ByName("RequestRematch")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// requestRematchServiceClient implements RequestRematchServiceClient.
+type requestRematchServiceClient struct {
+	requestRematch *connect.Client[v1.RequestRematchRequest, v1.RequestRematchResponse]
+}
+
+// RequestRematch calls game.v1.RequestRematchService.RequestRematch.
+func (c *requestRematchServiceClient) RequestRematch(ctx context.Context, req *connect.Request[v1.RequestRematchRequest]) (*connect.Response[v1.RequestRematchResponse], error) {
+	return c.requestRematch.CallUnary(ctx, req)
+}
+
+// RequestRematchServiceHandler is an implementation of the game.v1.RequestRematchService service.
+type RequestRematchServiceHandler interface {
+	RequestRematch(context.Context, *connect.Request[v1.RequestRematchRequest]) (*connect.Response[v1.RequestRematchResponse], error)
+}
+
+// NewRequestRematchServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRequestRematchServiceHandler(svc RequestRematchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	requestRematchServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RequestRematchService").Methods()
+	requestRematchServiceRequestRematchHandler := connect.NewUnaryHandler(
+		RequestRematchServiceRequestRematchProcedure,
+		svc.RequestRematch,
+		connect.WithSchema(requestRematchServiceMethods.ByName("RequestRematch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.RequestRematchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RequestRematchServiceRequestRematchProcedure:
+			requestRematchServiceRequestRematchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRequestRematchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRequestRematchServiceHandler struct{}
+
+func (UnimplementedRequestRematchServiceHandler) RequestRematch(context.Context, *connect.Request[v1.RequestRematchRequest]) (*connect.Response[v1.RequestRematchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.RequestRematchService.RequestRematch is not implemented"))
+}
+
+// AddBotServiceClient is a client for the game.v1.AddBotService service.
+type AddBotServiceClient interface {
+	AddBot(context.Context, *connect.Request[v1.AddBotRequest]) (*connect.Response[v1.AddBotResponse], error)
+}
+
+// NewAddBotServiceClient constructs a client for the game.v1.AddBotService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAddBotServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AddBotServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	addBotServiceMethods := v1.File_game_v1_game_proto.Services().ByName("AddBotService").Methods()
+	return &addBotServiceClient{
+		addBot: connect.NewClient[v1.AddBotRequest, v1.AddBotResponse](
+			httpClient,
+			baseURL+AddBotServiceAddBotProcedure,
+			connect.WithSchema(addBotServiceMethods.ByName("AddBot")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// addBotServiceClient implements AddBotServiceClient.
+type addBotServiceClient struct {
+	addBot *connect.Client[v1.AddBotRequest, v1.AddBotResponse]
+}
+
+// AddBot calls game.v1.AddBotService.AddBot.
+func (c *addBotServiceClient) AddBot(ctx context.Context, req *connect.Request[v1.AddBotRequest]) (*connect.Response[v1.AddBotResponse], error) {
+	return c.addBot.CallUnary(ctx, req)
+}
+
+// AddBotServiceHandler is an implementation of the game.v1.AddBotService service.
+type AddBotServiceHandler interface {
+	AddBot(context.Context, *connect.Request[v1.AddBotRequest]) (*connect.Response[v1.AddBotResponse], error)
+}
+
+// NewAddBotServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAddBotServiceHandler(svc AddBotServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	addBotServiceMethods := v1.File_game_v1_game_proto.Services().ByName("AddBotService").Methods()
+	addBotServiceAddBotHandler := connect.NewUnaryHandler(
+		AddBotServiceAddBotProcedure,
+		svc.AddBot,
+		connect.WithSchema(addBotServiceMethods.ByName("AddBot")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.AddBotService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AddBotServiceAddBotProcedure:
+			addBotServiceAddBotHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAddBotServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAddBotServiceHandler struct{}
+
+func (UnimplementedAddBotServiceHandler) AddBot(context.Context, *connect.Request[v1.AddBotRequest]) (*connect.Response[v1.AddBotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.AddBotService.AddBot is not implemented"))
+}
+
+// ReportReadyServiceClient is a client for the game.v1.ReportReadyService service.
+type ReportReadyServiceClient interface {
+	ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error)
+}
+
+// NewReportReadyServiceClient constructs a client for the game.v1.ReportReadyService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewReportReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ReportReadyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	reportReadyServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ReportReadyService").Methods()
+	return &reportReadyServiceClient{
+		reportReady: connect.NewClient[v1.ReportReadyRequest, v1.ReportReadyResponse](
+			httpClient,
+			baseURL+ReportReadyServiceReportReadyProcedure,
+			connect.WithSchema(reportReadyServiceMethods.ByName("ReportReady")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// reportReadyServiceClient implements ReportReadyServiceClient.
+type reportReadyServiceClient struct {
+	reportReady *connect.Client[v1.ReportReadyRequest, v1.ReportReadyResponse]
+}
+
+// ReportReady calls game.v1.ReportReadyService.ReportReady.
+func (c *reportReadyServiceClient) ReportReady(ctx context.Context, req *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error) {
+	return c.reportReady.CallUnary(ctx, req)
+}
+
+// ReportReadyServiceHandler is an implementation of the game.v1.ReportReadyService service.
+type ReportReadyServiceHandler interface {
+	ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error)
+}
+
+// NewReportReadyServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewReportReadyServiceHandler(svc ReportReadyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	reportReadyServiceMethods := v1.File_game_v1_game_proto.Services().ByName("ReportReadyService").Methods()
+	reportReadyServiceReportReadyHandler := connect.NewUnaryHandler(
+		ReportReadyServiceReportReadyProcedure,
+		svc.ReportReady,
+		connect.WithSchema(reportReadyServiceMethods.ByName("ReportReady")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.ReportReadyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ReportReadyServiceReportReadyProcedure:
+			reportReadyServiceReportReadyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedReportReadyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedReportReadyServiceHandler struct{}
+
+func (UnimplementedReportReadyServiceHandler) ReportReady(context.Context, *connect.Request[v1.ReportReadyRequest]) (*connect.Response[v1.ReportReadyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.ReportReadyService.ReportReady is not implemented"))
+}
+
+// SubmitAnswerServiceClient is a client for the game.v1.SubmitAnswerService service.
+type SubmitAnswerServiceClient interface {
+	SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error)
+}
+
+// NewSubmitAnswerServiceClient constructs a client for the game.v1.SubmitAnswerService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubmitAnswerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubmitAnswerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	submitAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitAnswerService").Methods()
+	return &submitAnswerServiceClient{
+		submitAnswer: connect.NewClient[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse](
+			httpClient,
+			baseURL+SubmitAnswerServiceSubmitAnswerProcedure,
+			connect.WithSchema(submitAnswerServiceMethods.ByName("SubmitAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// submitAnswerServiceClient implements SubmitAnswerServiceClient.
+type submitAnswerServiceClient struct {
+	submitAnswer *connect.Client[v1.SubmitAnswerRequest, v1.SubmitAnswerResponse]
+}
+
+// SubmitAnswer calls game.v1.SubmitAnswerService.SubmitAnswer.
+func (c *submitAnswerServiceClient) SubmitAnswer(ctx context.Context, req *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error) {
+	return c.submitAnswer.CallUnary(ctx, req)
+}
+
+// SubmitAnswerServiceHandler is an implementation of the game.v1.SubmitAnswerService service.
+type SubmitAnswerServiceHandler interface {
+	SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error)
+}
+
+// NewSubmitAnswerServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubmitAnswerServiceHandler(svc SubmitAnswerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	submitAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitAnswerService").Methods()
+	submitAnswerServiceSubmitAnswerHandler := connect.NewUnaryHandler(
+		SubmitAnswerServiceSubmitAnswerProcedure,
+		svc.SubmitAnswer,
+		connect.WithSchema(submitAnswerServiceMethods.ByName("SubmitAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.SubmitAnswerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubmitAnswerServiceSubmitAnswerProcedure:
+			submitAnswerServiceSubmitAnswerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubmitAnswerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubmitAnswerServiceHandler struct{}
+
+func (UnimplementedSubmitAnswerServiceHandler) SubmitAnswer(context.Context, *connect.Request[v1.SubmitAnswerRequest]) (*connect.Response[v1.SubmitAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.SubmitAnswerService.SubmitAnswer is not implemented"))
+}
+
+// StartPracticeServiceClient is a client for the game.v1.StartPracticeService service.
+type StartPracticeServiceClient interface {
+	StartPractice(context.Context, *connect.Request[v1.StartPracticeRequest]) (*connect.Response[v1.StartPracticeResponse], error)
+}
+
+// NewStartPracticeServiceClient constructs a client for the game.v1.StartPracticeService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStartPracticeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StartPracticeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	startPracticeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartPracticeService").Methods()
+	return &startPracticeServiceClient{
+		startPractice: connect.NewClient[v1.StartPracticeRequest, v1.StartPracticeResponse](
+			httpClient,
+			baseURL+StartPracticeServiceStartPracticeProcedure,
+			connect.WithSchema(startPracticeServiceMethods.ByName("StartPractice")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// startPracticeServiceClient implements StartPracticeServiceClient.
+type startPracticeServiceClient struct {
+	startPractice *connect.Client[v1.StartPracticeRequest, v1.StartPracticeResponse]
+}
+
+// StartPractice calls game.v1.StartPracticeService.StartPractice.
+func (c *startPracticeServiceClient) StartPractice(ctx context.Context, req *connect.Request[v1.StartPracticeRequest]) (*connect.Response[v1.StartPracticeResponse], error) {
+	return c.startPractice.CallUnary(ctx, req)
+}
+
+// StartPracticeServiceHandler is an implementation of the game.v1.StartPracticeService service.
+type StartPracticeServiceHandler interface {
+	StartPractice(context.Context, *connect.Request[v1.StartPracticeRequest]) (*connect.Response[v1.StartPracticeResponse], error)
+}
+
+// NewStartPracticeServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStartPracticeServiceHandler(svc StartPracticeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	startPracticeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartPracticeService").Methods()
+	startPracticeServiceStartPracticeHandler := connect.NewUnaryHandler(
+		StartPracticeServiceStartPracticeProcedure,
+		svc.StartPractice,
+		connect.WithSchema(startPracticeServiceMethods.ByName("StartPractice")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.StartPracticeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StartPracticeServiceStartPracticeProcedure:
+			startPracticeServiceStartPracticeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStartPracticeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStartPracticeServiceHandler struct{}
+
+func (UnimplementedStartPracticeServiceHandler) StartPractice(context.Context, *connect.Request[v1.StartPracticeRequest]) (*connect.Response[v1.StartPracticeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.StartPracticeService.StartPractice is not implemented"))
+}
+
+// SubmitPracticeAnswerServiceClient is a client for the game.v1.SubmitPracticeAnswerService
+// service.
+type SubmitPracticeAnswerServiceClient interface {
+	SubmitPracticeAnswer(context.Context, *connect.Request[v1.SubmitPracticeAnswerRequest]) (*connect.Response[v1.SubmitPracticeAnswerResponse], error)
+}
+
+// NewSubmitPracticeAnswerServiceClient constructs a client for the
+// game.v1.SubmitPracticeAnswerService service. By default, it uses the Connect protocol with the
+// binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use the
+// gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewSubmitPracticeAnswerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) SubmitPracticeAnswerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	submitPracticeAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitPracticeAnswerService").Methods()
+	return &submitPracticeAnswerServiceClient{
+		submitPracticeAnswer: connect.NewClient[v1.SubmitPracticeAnswerRequest, v1.SubmitPracticeAnswerResponse](
+			httpClient,
+			baseURL+SubmitPracticeAnswerServiceSubmitPracticeAnswerProcedure,
+			connect.WithSchema(submitPracticeAnswerServiceMethods.ByName("SubmitPracticeAnswer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// submitPracticeAnswerServiceClient implements SubmitPracticeAnswerServiceClient.
+type submitPracticeAnswerServiceClient struct {
+	submitPracticeAnswer *connect.Client[v1.SubmitPracticeAnswerRequest, v1.SubmitPracticeAnswerResponse]
+}
+
+// SubmitPracticeAnswer calls game.v1.SubmitPracticeAnswerService.SubmitPracticeAnswer.
+func (c *submitPracticeAnswerServiceClient) SubmitPracticeAnswer(ctx context.Context, req *connect.Request[v1.SubmitPracticeAnswerRequest]) (*connect.Response[v1.SubmitPracticeAnswerResponse], error) {
+	return c.submitPracticeAnswer.CallUnary(ctx, req)
+}
+
+// SubmitPracticeAnswerServiceHandler is an implementation of the
+// game.v1.SubmitPracticeAnswerService service.
+type SubmitPracticeAnswerServiceHandler interface {
+	SubmitPracticeAnswer(context.Context, *connect.Request[v1.SubmitPracticeAnswerRequest]) (*connect.Response[v1.SubmitPracticeAnswerResponse], error)
+}
+
+// NewSubmitPracticeAnswerServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewSubmitPracticeAnswerServiceHandler(svc SubmitPracticeAnswerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	submitPracticeAnswerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("SubmitPracticeAnswerService").Methods()
+	submitPracticeAnswerServiceSubmitPracticeAnswerHandler := connect.NewUnaryHandler(
+		SubmitPracticeAnswerServiceSubmitPracticeAnswerProcedure,
+		svc.SubmitPracticeAnswer,
+		connect.WithSchema(submitPracticeAnswerServiceMethods.ByName("SubmitPracticeAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.SubmitPracticeAnswerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SubmitPracticeAnswerServiceSubmitPracticeAnswerProcedure:
+			submitPracticeAnswerServiceSubmitPracticeAnswerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedSubmitPracticeAnswerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedSubmitPracticeAnswerServiceHandler struct{}
+
+func (UnimplementedSubmitPracticeAnswerServiceHandler) SubmitPracticeAnswer(context.Context, *connect.Request[v1.SubmitPracticeAnswerRequest]) (*connect.Response[v1.SubmitPracticeAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.SubmitPracticeAnswerService.SubmitPracticeAnswer is not implemented"))
+}
+
+// GetPersonalBestsServiceClient is a client for the game.v1.GetPersonalBestsService service.
+type GetPersonalBestsServiceClient interface {
+	GetPersonalBests(context.Context, *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error)
+}
+
+// NewGetPersonalBestsServiceClient constructs a client for the game.v1.GetPersonalBestsService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetPersonalBestsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetPersonalBestsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getPersonalBestsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetPersonalBestsService").Methods()
+	return &getPersonalBestsServiceClient{
+		getPersonalBests: connect.NewClient[v1.GetPersonalBestsRequest, v1.GetPersonalBestsResponse](
+			httpClient,
+			baseURL+GetPersonalBestsServiceGetPersonalBestsProcedure,
+			connect.WithSchema(getPersonalBestsServiceMethods.ByName("GetPersonalBests")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getPersonalBestsServiceClient implements GetPersonalBestsServiceClient.
+type getPersonalBestsServiceClient struct {
+	getPersonalBests *connect.Client[v1.GetPersonalBestsRequest, v1.GetPersonalBestsResponse]
+}
+
+// GetPersonalBests calls game.v1.GetPersonalBestsService.GetPersonalBests.
+func (c *getPersonalBestsServiceClient) GetPersonalBests(ctx context.Context, req *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error) {
+	return c.getPersonalBests.CallUnary(ctx, req)
+}
+
+// GetPersonalBestsServiceHandler is an implementation of the game.v1.GetPersonalBestsService
+// service.
+type GetPersonalBestsServiceHandler interface {
+	GetPersonalBests(context.Context, *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error)
+}
+
+// NewGetPersonalBestsServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetPersonalBestsServiceHandler(svc GetPersonalBestsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getPersonalBestsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetPersonalBestsService").Methods()
+	getPersonalBestsServiceGetPersonalBestsHandler := connect.NewUnaryHandler(
+		GetPersonalBestsServiceGetPersonalBestsProcedure,
+		svc.GetPersonalBests,
+		connect.WithSchema(getPersonalBestsServiceMethods.ByName("GetPersonalBests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetPersonalBestsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetPersonalBestsServiceGetPersonalBestsProcedure:
+			getPersonalBestsServiceGetPersonalBestsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetPersonalBestsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetPersonalBestsServiceHandler struct{}
+
+func (UnimplementedGetPersonalBestsServiceHandler) GetPersonalBests(context.Context, *connect.Request[v1.GetPersonalBestsRequest]) (*connect.Response[v1.GetPersonalBestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetPersonalBestsService.GetPersonalBests is not implemented"))
+}
+
+// StartGhostRaceServiceClient is a client for the game.v1.StartGhostRaceService service.
+type StartGhostRaceServiceClient interface {
+	StartGhostRace(context.Context, *connect.Request[v1.StartGhostRaceRequest]) (*connect.Response[v1.StartGhostRaceResponse], error)
+}
+
+// NewStartGhostRaceServiceClient constructs a client for the game.v1.StartGhostRaceService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStartGhostRaceServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StartGhostRaceServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	startGhostRaceServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartGhostRaceService").Methods()
+	return &startGhostRaceServiceClient{
+		startGhostRace: connect.NewClient[v1.StartGhostRaceRequest, v1.StartGhostRaceResponse](
+			httpClient,
+			baseURL+StartGhostRaceServiceStartGhostRaceProcedure,
+			connect.WithSchema(startGhostRaceServiceMethods.ByName("StartGhostRace")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// startGhostRaceServiceClient implements StartGhostRaceServiceClient.
+type startGhostRaceServiceClient struct {
+	startGhostRace *connect.Client[v1.StartGhostRaceRequest, v1.StartGhostRaceResponse]
+}
+
+// StartGhostRace calls game.v1.StartGhostRaceService.StartGhostRace.
+func (c *startGhostRaceServiceClient) StartGhostRace(ctx context.Context, req *connect.Request[v1.StartGhostRaceRequest]) (*connect.Response[v1.StartGhostRaceResponse], error) {
+	return c.startGhostRace.CallUnary(ctx, req)
+}
+
+// StartGhostRaceServiceHandler is an implementation of the game.v1.StartGhostRaceService service.
+type StartGhostRaceServiceHandler interface {
+	StartGhostRace(context.Context, *connect.Request[v1.StartGhostRaceRequest]) (*connect.Response[v1.StartGhostRaceResponse], error)
+}
+
+// NewStartGhostRaceServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStartGhostRaceServiceHandler(svc StartGhostRaceServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	startGhostRaceServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartGhostRaceService").Methods()
+	startGhostRaceServiceStartGhostRaceHandler := connect.NewUnaryHandler(
+		StartGhostRaceServiceStartGhostRaceProcedure,
+		svc.StartGhostRace,
+		connect.WithSchema(startGhostRaceServiceMethods.ByName("StartGhostRace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.StartGhostRaceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StartGhostRaceServiceStartGhostRaceProcedure:
+			startGhostRaceServiceStartGhostRaceHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStartGhostRaceServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStartGhostRaceServiceHandler struct{}
+
+func (UnimplementedStartGhostRaceServiceHandler) StartGhostRace(context.Context, *connect.Request[v1.StartGhostRaceRequest]) (*connect.Response[v1.StartGhostRaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.StartGhostRaceService.StartGhostRace is not implemented"))
+}
+
+// StartDailyChallengeServiceClient is a client for the game.v1.StartDailyChallengeService service.
+type StartDailyChallengeServiceClient interface {
+	StartDailyChallenge(context.Context, *connect.Request[v1.StartDailyChallengeRequest]) (*connect.Response[v1.StartDailyChallengeResponse], error)
+}
+
+// NewStartDailyChallengeServiceClient constructs a client for the
+// game.v1.StartDailyChallengeService service. By default, it uses the Connect protocol with the
+// binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use the
+// gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStartDailyChallengeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StartDailyChallengeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	startDailyChallengeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartDailyChallengeService").Methods()
+	return &startDailyChallengeServiceClient{
+		startDailyChallenge: connect.NewClient[v1.StartDailyChallengeRequest, v1.StartDailyChallengeResponse](
+			httpClient,
+			baseURL+StartDailyChallengeServiceStartDailyChallengeProcedure,
+			connect.WithSchema(startDailyChallengeServiceMethods.ByName("StartDailyChallenge")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// startDailyChallengeServiceClient implements StartDailyChallengeServiceClient.
+type startDailyChallengeServiceClient struct {
+	startDailyChallenge *connect.Client[v1.StartDailyChallengeRequest, v1.StartDailyChallengeResponse]
+}
+
+// StartDailyChallenge calls game.v1.StartDailyChallengeService.StartDailyChallenge.
+func (c *startDailyChallengeServiceClient) StartDailyChallenge(ctx context.Context, req *connect.Request[v1.StartDailyChallengeRequest]) (*connect.Response[v1.StartDailyChallengeResponse], error) {
+	return c.startDailyChallenge.CallUnary(ctx, req)
+}
+
+// StartDailyChallengeServiceHandler is an implementation of the game.v1.StartDailyChallengeService
+// service.
+type StartDailyChallengeServiceHandler interface {
+	StartDailyChallenge(context.Context, *connect.Request[v1.StartDailyChallengeRequest]) (*connect.Response[v1.StartDailyChallengeResponse], error)
+}
+
+// NewStartDailyChallengeServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStartDailyChallengeServiceHandler(svc StartDailyChallengeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	startDailyChallengeServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartDailyChallengeService").Methods()
+	startDailyChallengeServiceStartDailyChallengeHandler := connect.NewUnaryHandler(
+		StartDailyChallengeServiceStartDailyChallengeProcedure,
+		svc.StartDailyChallenge,
+		connect.WithSchema(startDailyChallengeServiceMethods.ByName("StartDailyChallenge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.StartDailyChallengeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StartDailyChallengeServiceStartDailyChallengeProcedure:
+			startDailyChallengeServiceStartDailyChallengeHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStartDailyChallengeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStartDailyChallengeServiceHandler struct{}
+
+func (UnimplementedStartDailyChallengeServiceHandler) StartDailyChallenge(context.Context, *connect.Request[v1.StartDailyChallengeRequest]) (*connect.Response[v1.StartDailyChallengeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.StartDailyChallengeService.StartDailyChallenge is not implemented"))
+}
+
+// GetDailyLeaderboardServiceClient is a client for the game.v1.GetDailyLeaderboardService service.
+type GetDailyLeaderboardServiceClient interface {
+	GetDailyLeaderboard(context.Context, *connect.Request[v1.GetDailyLeaderboardRequest]) (*connect.Response[v1.GetDailyLeaderboardResponse], error)
+}
+
+// NewGetDailyLeaderboardServiceClient constructs a client for the
+// game.v1.GetDailyLeaderboardService service. By default, it uses the Connect protocol with the
+// binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use the
+// gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetDailyLeaderboardServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetDailyLeaderboardServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getDailyLeaderboardServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetDailyLeaderboardService").Methods()
+	return &getDailyLeaderboardServiceClient{
+		getDailyLeaderboard: connect.NewClient[v1.GetDailyLeaderboardRequest, v1.GetDailyLeaderboardResponse](
+			httpClient,
+			baseURL+GetDailyLeaderboardServiceGetDailyLeaderboardProcedure,
+			connect.WithSchema(getDailyLeaderboardServiceMethods.ByName("GetDailyLeaderboard")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getDailyLeaderboardServiceClient implements GetDailyLeaderboardServiceClient.
+type getDailyLeaderboardServiceClient struct {
+	getDailyLeaderboard *connect.Client[v1.GetDailyLeaderboardRequest, v1.GetDailyLeaderboardResponse]
+}
+
+// GetDailyLeaderboard calls game.v1.GetDailyLeaderboardService.GetDailyLeaderboard.
+func (c *getDailyLeaderboardServiceClient) GetDailyLeaderboard(ctx context.Context, req *connect.Request[v1.GetDailyLeaderboardRequest]) (*connect.Response[v1.GetDailyLeaderboardResponse], error) {
+	return c.getDailyLeaderboard.CallUnary(ctx, req)
+}
+
+// GetDailyLeaderboardServiceHandler is an implementation of the game.v1.GetDailyLeaderboardService
+// service.
+type GetDailyLeaderboardServiceHandler interface {
+	GetDailyLeaderboard(context.Context, *connect.Request[v1.GetDailyLeaderboardRequest]) (*connect.Response[v1.GetDailyLeaderboardResponse], error)
+}
+
+// NewGetDailyLeaderboardServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetDailyLeaderboardServiceHandler(svc GetDailyLeaderboardServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getDailyLeaderboardServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetDailyLeaderboardService").Methods()
+	getDailyLeaderboardServiceGetDailyLeaderboardHandler := connect.NewUnaryHandler(
+		GetDailyLeaderboardServiceGetDailyLeaderboardProcedure,
+		svc.GetDailyLeaderboard,
+		connect.WithSchema(getDailyLeaderboardServiceMethods.ByName("GetDailyLeaderboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetDailyLeaderboardService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetDailyLeaderboardServiceGetDailyLeaderboardProcedure:
+			getDailyLeaderboardServiceGetDailyLeaderboardHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetDailyLeaderboardServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetDailyLeaderboardServiceHandler struct{}
+
+func (UnimplementedGetDailyLeaderboardServiceHandler) GetDailyLeaderboard(context.Context, *connect.Request[v1.GetDailyLeaderboardRequest]) (*connect.Response[v1.GetDailyLeaderboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetDailyLeaderboardService.GetDailyLeaderboard is not implemented"))
+}
+
+// JoinQueueServiceClient is a client for the game.v1.JoinQueueService service.
+type JoinQueueServiceClient interface {
+	JoinQueue(context.Context, *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.JoinQueueResponse], error)
+}
+
+// NewJoinQueueServiceClient constructs a client for the game.v1.JoinQueueService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewJoinQueueServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) JoinQueueServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	joinQueueServiceMethods := v1.File_game_v1_game_proto.Services().ByName("JoinQueueService").Methods()
+	return &joinQueueServiceClient{
+		joinQueue: connect.NewClient[v1.JoinQueueRequest, v1.JoinQueueResponse](
+			httpClient,
+			baseURL+JoinQueueServiceJoinQueueProcedure,
+			connect.WithSchema(joinQueueServiceMethods.ByName("JoinQueue")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// joinQueueServiceClient implements JoinQueueServiceClient.
+type joinQueueServiceClient struct {
+	joinQueue *connect.Client[v1.JoinQueueRequest, v1.JoinQueueResponse]
+}
+
+// JoinQueue calls game.v1.JoinQueueService.JoinQueue.
+func (c *joinQueueServiceClient) JoinQueue(ctx context.Context, req *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.JoinQueueResponse], error) {
+	return c.joinQueue.CallUnary(ctx, req)
+}
+
+// JoinQueueServiceHandler is an implementation of the game.v1.JoinQueueService service.
+type JoinQueueServiceHandler interface {
+	JoinQueue(context.Context, *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.JoinQueueResponse], error)
+}
+
+// NewJoinQueueServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewJoinQueueServiceHandler(svc JoinQueueServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	joinQueueServiceMethods := v1.File_game_v1_game_proto.Services().ByName("JoinQueueService").Methods()
+	joinQueueServiceJoinQueueHandler := connect.NewUnaryHandler(
+		JoinQueueServiceJoinQueueProcedure,
+		svc.JoinQueue,
+		connect.WithSchema(joinQueueServiceMethods.ByName("JoinQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.JoinQueueService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case JoinQueueServiceJoinQueueProcedure:
+			joinQueueServiceJoinQueueHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedJoinQueueServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedJoinQueueServiceHandler struct{}
+
+func (UnimplementedJoinQueueServiceHandler) JoinQueue(context.Context, *connect.Request[v1.JoinQueueRequest]) (*connect.Response[v1.JoinQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.JoinQueueService.JoinQueue is not implemented"))
+}
+
+// LeaveQueueServiceClient is a client for the game.v1.LeaveQueueService service.
+type LeaveQueueServiceClient interface {
+	LeaveQueue(context.Context, *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error)
+}
+
+// NewLeaveQueueServiceClient constructs a client for the game.v1.LeaveQueueService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLeaveQueueServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LeaveQueueServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	leaveQueueServiceMethods := v1.File_game_v1_game_proto.Services().ByName("LeaveQueueService").Methods()
+	return &leaveQueueServiceClient{
+		leaveQueue: connect.NewClient[v1.LeaveQueueRequest, v1.LeaveQueueResponse](
+			httpClient,
+			baseURL+LeaveQueueServiceLeaveQueueProcedure,
+			connect.WithSchema(leaveQueueServiceMethods.ByName("LeaveQueue")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// leaveQueueServiceClient implements LeaveQueueServiceClient.
+type leaveQueueServiceClient struct {
+	leaveQueue *connect.Client[v1.LeaveQueueRequest, v1.LeaveQueueResponse]
+}
+
+// LeaveQueue calls game.v1.LeaveQueueService.LeaveQueue.
+func (c *leaveQueueServiceClient) LeaveQueue(ctx context.Context, req *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error) {
+	return c.leaveQueue.CallUnary(ctx, req)
+}
+
+// LeaveQueueServiceHandler is an implementation of the game.v1.LeaveQueueService service.
+type LeaveQueueServiceHandler interface {
+	LeaveQueue(context.Context, *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error)
+}
+
+// NewLeaveQueueServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLeaveQueueServiceHandler(svc LeaveQueueServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	leaveQueueServiceMethods := v1.File_game_v1_game_proto.Services().ByName("LeaveQueueService").Methods()
+	leaveQueueServiceLeaveQueueHandler := connect.NewUnaryHandler(
+		LeaveQueueServiceLeaveQueueProcedure,
+		svc.LeaveQueue,
+		connect.WithSchema(leaveQueueServiceMethods.ByName("LeaveQueue")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.LeaveQueueService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LeaveQueueServiceLeaveQueueProcedure:
+			leaveQueueServiceLeaveQueueHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLeaveQueueServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLeaveQueueServiceHandler struct{}
+
+func (UnimplementedLeaveQueueServiceHandler) LeaveQueue(context.Context, *connect.Request[v1.LeaveQueueRequest]) (*connect.Response[v1.LeaveQueueResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.LeaveQueueService.LeaveQueue is not implemented"))
+}
+
+// RegisterUserServiceClient is a client for the game.v1.RegisterUserService service.
+type RegisterUserServiceClient interface {
+	RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error)
+}
+
+// NewRegisterUserServiceClient constructs a client for the game.v1.RegisterUserService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRegisterUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RegisterUserServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	registerUserServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RegisterUserService").Methods()
+	return &registerUserServiceClient{
+		registerUser: connect.NewClient[v1.RegisterUserRequest, v1.RegisterUserResponse](
+			httpClient,
+			baseURL+RegisterUserServiceRegisterUserProcedure,
+			connect.WithSchema(registerUserServiceMethods.ByName("RegisterUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// registerUserServiceClient implements RegisterUserServiceClient.
+type registerUserServiceClient struct {
+	registerUser *connect.Client[v1.RegisterUserRequest, v1.RegisterUserResponse]
+}
+
+// RegisterUser calls game.v1.RegisterUserService.RegisterUser.
+func (c *registerUserServiceClient) RegisterUser(ctx context.Context, req *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error) {
+	return c.registerUser.CallUnary(ctx, req)
+}
+
+// RegisterUserServiceHandler is an implementation of the game.v1.RegisterUserService service.
+type RegisterUserServiceHandler interface {
+	RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error)
+}
+
+// NewRegisterUserServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRegisterUserServiceHandler(svc RegisterUserServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	registerUserServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RegisterUserService").Methods()
+	registerUserServiceRegisterUserHandler := connect.NewUnaryHandler(
+		RegisterUserServiceRegisterUserProcedure,
+		svc.RegisterUser,
+		connect.WithSchema(registerUserServiceMethods.ByName("RegisterUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.RegisterUserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RegisterUserServiceRegisterUserProcedure:
+			registerUserServiceRegisterUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRegisterUserServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRegisterUserServiceHandler struct{}
+
+func (UnimplementedRegisterUserServiceHandler) RegisterUser(context.Context, *connect.Request[v1.RegisterUserRequest]) (*connect.Response[v1.RegisterUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.RegisterUserService.RegisterUser is not implemented"))
+}
+
+// GetRatingsServiceClient is a client for the game.v1.GetRatingsService service.
+type GetRatingsServiceClient interface {
+	GetRatings(context.Context, *connect.Request[v1.GetRatingsRequest]) (*connect.Response[v1.GetRatingsResponse], error)
+}
+
+// NewGetRatingsServiceClient constructs a client for the game.v1.GetRatingsService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetRatingsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetRatingsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getRatingsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetRatingsService").Methods()
+	return &getRatingsServiceClient{
+		getRatings: connect.NewClient[v1.GetRatingsRequest, v1.GetRatingsResponse](
+			httpClient,
+			baseURL+GetRatingsServiceGetRatingsProcedure,
+			connect.WithSchema(getRatingsServiceMethods.ByName("GetRatings")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getRatingsServiceClient implements GetRatingsServiceClient.
+type getRatingsServiceClient struct {
+	getRatings *connect.Client[v1.GetRatingsRequest, v1.GetRatingsResponse]
+}
+
+// GetRatings calls game.v1.GetRatingsService.GetRatings.
+func (c *getRatingsServiceClient) GetRatings(ctx context.Context, req *connect.Request[v1.GetRatingsRequest]) (*connect.Response[v1.GetRatingsResponse], error) {
+	return c.getRatings.CallUnary(ctx, req)
+}
+
+// GetRatingsServiceHandler is an implementation of the game.v1.GetRatingsService service.
+type GetRatingsServiceHandler interface {
+	GetRatings(context.Context, *connect.Request[v1.GetRatingsRequest]) (*connect.Response[v1.GetRatingsResponse], error)
+}
+
+// NewGetRatingsServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetRatingsServiceHandler(svc GetRatingsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getRatingsServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetRatingsService").Methods()
+	getRatingsServiceGetRatingsHandler := connect.NewUnaryHandler(
+		GetRatingsServiceGetRatingsProcedure,
+		svc.GetRatings,
+		connect.WithSchema(getRatingsServiceMethods.ByName("GetRatings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetRatingsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetRatingsServiceGetRatingsProcedure:
+			getRatingsServiceGetRatingsHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetRatingsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetRatingsServiceHandler struct{}
+
+func (UnimplementedGetRatingsServiceHandler) GetRatings(context.Context, *connect.Request[v1.GetRatingsRequest]) (*connect.Response[v1.GetRatingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetRatingsService.GetRatings is not implemented"))
+}
+
+// GetMatchServiceClient is a client for the game.v1.GetMatchService service.
+type GetMatchServiceClient interface {
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+}
+
+// NewGetMatchServiceClient constructs a client for the game.v1.GetMatchService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetMatchServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetMatchServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getMatchServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetMatchService").Methods()
+	return &getMatchServiceClient{
+		getMatch: connect.NewClient[v1.GetMatchRequest, v1.GetMatchResponse](
+			httpClient,
+			baseURL+GetMatchServiceGetMatchProcedure,
+			connect.WithSchema(getMatchServiceMethods.ByName("GetMatch")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getMatchServiceClient implements GetMatchServiceClient.
+type getMatchServiceClient struct {
+	getMatch *connect.Client[v1.GetMatchRequest, v1.GetMatchResponse]
+}
+
+// GetMatch calls game.v1.GetMatchService.GetMatch.
+func (c *getMatchServiceClient) GetMatch(ctx context.Context, req *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return c.getMatch.CallUnary(ctx, req)
+}
+
+// GetMatchServiceHandler is an implementation of the game.v1.GetMatchService service.
+type GetMatchServiceHandler interface {
+	GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error)
+}
+
+// NewGetMatchServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetMatchServiceHandler(svc GetMatchServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getMatchServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetMatchService").Methods()
+	getMatchServiceGetMatchHandler := connect.NewUnaryHandler(
+		GetMatchServiceGetMatchProcedure,
+		svc.GetMatch,
+		connect.WithSchema(getMatchServiceMethods.ByName("GetMatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetMatchService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetMatchServiceGetMatchProcedure:
+			getMatchServiceGetMatchHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetMatchServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetMatchServiceHandler struct{}
+
+func (UnimplementedGetMatchServiceHandler) GetMatch(context.Context, *connect.Request[v1.GetMatchRequest]) (*connect.Response[v1.GetMatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetMatchService.GetMatch is not implemented"))
+}
+
+// CreateTournamentServiceClient is a client for the game.v1.CreateTournamentService service.
+type CreateTournamentServiceClient interface {
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error)
+}
+
+// NewCreateTournamentServiceClient constructs a client for the game.v1.CreateTournamentService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCreateTournamentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CreateTournamentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	createTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("CreateTournamentService").Methods()
+	return &createTournamentServiceClient{
+		createTournament: connect.NewClient[v1.CreateTournamentRequest, v1.CreateTournamentResponse](
+			httpClient,
+			baseURL+CreateTournamentServiceCreateTournamentProcedure,
+			connect.WithSchema(createTournamentServiceMethods.ByName("CreateTournament")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// createTournamentServiceClient implements CreateTournamentServiceClient.
+type createTournamentServiceClient struct {
+	createTournament *connect.Client[v1.CreateTournamentRequest, v1.CreateTournamentResponse]
+}
+
+// CreateTournament calls game.v1.CreateTournamentService.CreateTournament.
+func (c *createTournamentServiceClient) CreateTournament(ctx context.Context, req *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error) {
+	return c.createTournament.CallUnary(ctx, req)
+}
+
+// CreateTournamentServiceHandler is an implementation of the game.v1.CreateTournamentService
+// service.
+type CreateTournamentServiceHandler interface {
+	CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error)
+}
+
+// NewCreateTournamentServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCreateTournamentServiceHandler(svc CreateTournamentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	createTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("CreateTournamentService").Methods()
+	createTournamentServiceCreateTournamentHandler := connect.NewUnaryHandler(
+		CreateTournamentServiceCreateTournamentProcedure,
+		svc.CreateTournament,
+		connect.WithSchema(createTournamentServiceMethods.ByName("CreateTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.CreateTournamentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CreateTournamentServiceCreateTournamentProcedure:
+			createTournamentServiceCreateTournamentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCreateTournamentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCreateTournamentServiceHandler struct{}
+
+func (UnimplementedCreateTournamentServiceHandler) CreateTournament(context.Context, *connect.Request[v1.CreateTournamentRequest]) (*connect.Response[v1.CreateTournamentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.CreateTournamentService.CreateTournament is not implemented"))
+}
+
+// RegisterTournamentPlayerServiceClient is a client for the game.v1.RegisterTournamentPlayerService
+// service.
+type RegisterTournamentPlayerServiceClient interface {
+	RegisterTournamentPlayer(context.Context, *connect.Request[v1.RegisterTournamentPlayerRequest]) (*connect.Response[v1.RegisterTournamentPlayerResponse], error)
+}
+
+// NewRegisterTournamentPlayerServiceClient constructs a client for the
+// game.v1.RegisterTournamentPlayerService service. By default, it uses the Connect protocol with
+// the binary Protobuf Codec, asks for gzipped responses, and sends uncompressed requests. To use
+// the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRegisterTournamentPlayerServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RegisterTournamentPlayerServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	registerTournamentPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RegisterTournamentPlayerService").Methods()
+	return &registerTournamentPlayerServiceClient{
+		registerTournamentPlayer: connect.NewClient[v1.RegisterTournamentPlayerRequest, v1.RegisterTournamentPlayerResponse](
+			httpClient,
+			baseURL+RegisterTournamentPlayerServiceRegisterTournamentPlayerProcedure,
+			connect.WithSchema(registerTournamentPlayerServiceMethods.ByName("RegisterTournamentPlayer")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// registerTournamentPlayerServiceClient implements RegisterTournamentPlayerServiceClient.
+type registerTournamentPlayerServiceClient struct {
+	registerTournamentPlayer *connect.Client[v1.RegisterTournamentPlayerRequest, v1.RegisterTournamentPlayerResponse]
+}
+
+// RegisterTournamentPlayer calls game.v1.RegisterTournamentPlayerService.RegisterTournamentPlayer.
+func (c *registerTournamentPlayerServiceClient) RegisterTournamentPlayer(ctx context.Context, req *connect.Request[v1.RegisterTournamentPlayerRequest]) (*connect.Response[v1.RegisterTournamentPlayerResponse], error) {
+	return c.registerTournamentPlayer.CallUnary(ctx, req)
+}
+
+// RegisterTournamentPlayerServiceHandler is an implementation of the
+// game.v1.RegisterTournamentPlayerService service.
+type RegisterTournamentPlayerServiceHandler interface {
+	RegisterTournamentPlayer(context.Context, *connect.Request[v1.RegisterTournamentPlayerRequest]) (*connect.Response[v1.RegisterTournamentPlayerResponse], error)
+}
+
+// NewRegisterTournamentPlayerServiceHandler builds an HTTP handler from the service implementation.
+// It returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRegisterTournamentPlayerServiceHandler(svc RegisterTournamentPlayerServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	registerTournamentPlayerServiceMethods := v1.File_game_v1_game_proto.Services().ByName("RegisterTournamentPlayerService").Methods()
+	registerTournamentPlayerServiceRegisterTournamentPlayerHandler := connect.NewUnaryHandler(
+		RegisterTournamentPlayerServiceRegisterTournamentPlayerProcedure,
+		svc.RegisterTournamentPlayer,
+		connect.WithSchema(registerTournamentPlayerServiceMethods.ByName("RegisterTournamentPlayer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.RegisterTournamentPlayerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RegisterTournamentPlayerServiceRegisterTournamentPlayerProcedure:
+			registerTournamentPlayerServiceRegisterTournamentPlayerHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRegisterTournamentPlayerServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRegisterTournamentPlayerServiceHandler struct{}
+
+func (UnimplementedRegisterTournamentPlayerServiceHandler) RegisterTournamentPlayer(context.Context, *connect.Request[v1.RegisterTournamentPlayerRequest]) (*connect.Response[v1.RegisterTournamentPlayerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.RegisterTournamentPlayerService.RegisterTournamentPlayer is not implemented"))
+}
+
+// StartTournamentServiceClient is a client for the game.v1.StartTournamentService service.
+type StartTournamentServiceClient interface {
+	StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.StartTournamentResponse], error)
+}
+
+// NewStartTournamentServiceClient constructs a client for the game.v1.StartTournamentService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStartTournamentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StartTournamentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	startTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartTournamentService").Methods()
+	return &startTournamentServiceClient{
+		startTournament: connect.NewClient[v1.StartTournamentRequest, v1.StartTournamentResponse](
+			httpClient,
+			baseURL+StartTournamentServiceStartTournamentProcedure,
+			connect.WithSchema(startTournamentServiceMethods.ByName("StartTournament")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// startTournamentServiceClient implements StartTournamentServiceClient.
+type startTournamentServiceClient struct {
+	startTournament *connect.Client[v1.StartTournamentRequest, v1.StartTournamentResponse]
+}
+
+// StartTournament calls game.v1.StartTournamentService.StartTournament.
+func (c *startTournamentServiceClient) StartTournament(ctx context.Context, req *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.StartTournamentResponse], error) {
+	return c.startTournament.CallUnary(ctx, req)
+}
+
+// StartTournamentServiceHandler is an implementation of the game.v1.StartTournamentService service.
+type StartTournamentServiceHandler interface {
+	StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.StartTournamentResponse], error)
+}
+
+// NewStartTournamentServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStartTournamentServiceHandler(svc StartTournamentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	startTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("StartTournamentService").Methods()
+	startTournamentServiceStartTournamentHandler := connect.NewUnaryHandler(
+		StartTournamentServiceStartTournamentProcedure,
+		svc.StartTournament,
+		connect.WithSchema(startTournamentServiceMethods.ByName("StartTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.StartTournamentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StartTournamentServiceStartTournamentProcedure:
+			startTournamentServiceStartTournamentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStartTournamentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStartTournamentServiceHandler struct{}
+
+func (UnimplementedStartTournamentServiceHandler) StartTournament(context.Context, *connect.Request[v1.StartTournamentRequest]) (*connect.Response[v1.StartTournamentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.StartTournamentService.StartTournament is not implemented"))
+}
+
+// GetTournamentServiceClient is a client for the game.v1.GetTournamentService service.
+type GetTournamentServiceClient interface {
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error)
+}
+
+// NewGetTournamentServiceClient constructs a client for the game.v1.GetTournamentService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetTournamentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetTournamentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetTournamentService").Methods()
+	return &getTournamentServiceClient{
+		getTournament: connect.NewClient[v1.GetTournamentRequest, v1.GetTournamentResponse](
+			httpClient,
+			baseURL+GetTournamentServiceGetTournamentProcedure,
+			connect.WithSchema(getTournamentServiceMethods.ByName("GetTournament")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getTournamentServiceClient implements GetTournamentServiceClient.
+type getTournamentServiceClient struct {
+	getTournament *connect.Client[v1.GetTournamentRequest, v1.GetTournamentResponse]
+}
+
+// GetTournament calls game.v1.GetTournamentService.GetTournament.
+func (c *getTournamentServiceClient) GetTournament(ctx context.Context, req *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error) {
+	return c.getTournament.CallUnary(ctx, req)
+}
+
+// GetTournamentServiceHandler is an implementation of the game.v1.GetTournamentService service.
+type GetTournamentServiceHandler interface {
+	GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error)
+}
+
+// NewGetTournamentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetTournamentServiceHandler(svc GetTournamentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getTournamentServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetTournamentService").Methods()
+	getTournamentServiceGetTournamentHandler := connect.NewUnaryHandler(
+		GetTournamentServiceGetTournamentProcedure,
+		svc.GetTournament,
+		connect.WithSchema(getTournamentServiceMethods.ByName("GetTournament")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetTournamentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetTournamentServiceGetTournamentProcedure:
+			getTournamentServiceGetTournamentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetTournamentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetTournamentServiceHandler struct{}
+
+func (UnimplementedGetTournamentServiceHandler) GetTournament(context.Context, *connect.Request[v1.GetTournamentRequest]) (*connect.Response[v1.GetTournamentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetTournamentService.GetTournament is not implemented"))
+}
+
+// DeleteGameServiceClient is a client for the game.v1.DeleteGameService service.
+type DeleteGameServiceClient interface {
+	DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error)
+}
+
+// NewDeleteGameServiceClient constructs a client for the game.v1.DeleteGameService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewDeleteGameServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) DeleteGameServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	deleteGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("DeleteGameService").Methods()
+	return &deleteGameServiceClient{
+		deleteGame: connect.NewClient[v1.DeleteGameRequest, v1.DeleteGameResponse](
+			httpClient,
+			baseURL+DeleteGameServiceDeleteGameProcedure,
+			connect.WithSchema(deleteGameServiceMethods.ByName("DeleteGame")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// deleteGameServiceClient implements DeleteGameServiceClient.
+type deleteGameServiceClient struct {
+	deleteGame *connect.Client[v1.DeleteGameRequest, v1.DeleteGameResponse]
+}
+
+// DeleteGame calls game.v1.DeleteGameService.DeleteGame.
+func (c *deleteGameServiceClient) DeleteGame(ctx context.Context, req *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error) {
+	return c.deleteGame.CallUnary(ctx, req)
+}
+
+// DeleteGameServiceHandler is an implementation of the game.v1.DeleteGameService service.
+type DeleteGameServiceHandler interface {
+	DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error)
+}
+
+// NewDeleteGameServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewDeleteGameServiceHandler(svc DeleteGameServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	deleteGameServiceMethods := v1.File_game_v1_game_proto.Services().ByName("DeleteGameService").Methods()
+	deleteGameServiceDeleteGameHandler := connect.NewUnaryHandler(
+		DeleteGameServiceDeleteGameProcedure,
+		svc.DeleteGame,
+		connect.WithSchema(deleteGameServiceMethods.ByName("DeleteGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.DeleteGameService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case DeleteGameServiceDeleteGameProcedure:
+			deleteGameServiceDeleteGameHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedDeleteGameServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedDeleteGameServiceHandler struct{}
+
+func (UnimplementedDeleteGameServiceHandler) DeleteGame(context.Context, *connect.Request[v1.DeleteGameRequest]) (*connect.Response[v1.DeleteGameResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.DeleteGameService.DeleteGame is not implemented"))
+}
+
+// GetTeamHighScoresServiceClient is a client for the game.v1.GetTeamHighScoresService service.
+type GetTeamHighScoresServiceClient interface {
+	GetTeamHighScores(context.Context, *connect.Request[v1.GetTeamHighScoresRequest]) (*connect.Response[v1.GetTeamHighScoresResponse], error)
+}
+
+// NewGetTeamHighScoresServiceClient constructs a client for the game.v1.GetTeamHighScoresService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetTeamHighScoresServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetTeamHighScoresServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getTeamHighScoresServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetTeamHighScoresService").Methods()
+	return &getTeamHighScoresServiceClient{
+		getTeamHighScores: connect.NewClient[v1.GetTeamHighScoresRequest, v1.GetTeamHighScoresResponse](
+			httpClient,
+			baseURL+GetTeamHighScoresServiceGetTeamHighScoresProcedure,
+			connect.WithSchema(getTeamHighScoresServiceMethods.ByName("GetTeamHighScores")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getTeamHighScoresServiceClient implements GetTeamHighScoresServiceClient.
+type getTeamHighScoresServiceClient struct {
+	getTeamHighScores *connect.Client[v1.GetTeamHighScoresRequest, v1.GetTeamHighScoresResponse]
+}
+
+// GetTeamHighScores calls game.v1.GetTeamHighScoresService.GetTeamHighScores.
+func (c *getTeamHighScoresServiceClient) GetTeamHighScores(ctx context.Context, req *connect.Request[v1.GetTeamHighScoresRequest]) (*connect.Response[v1.GetTeamHighScoresResponse], error) {
+	return c.getTeamHighScores.CallUnary(ctx, req)
+}
+
+// GetTeamHighScoresServiceHandler is an implementation of the game.v1.GetTeamHighScoresService
+// service.
+type GetTeamHighScoresServiceHandler interface {
+	GetTeamHighScores(context.Context, *connect.Request[v1.GetTeamHighScoresRequest]) (*connect.Response[v1.GetTeamHighScoresResponse], error)
+}
+
+// NewGetTeamHighScoresServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetTeamHighScoresServiceHandler(svc GetTeamHighScoresServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getTeamHighScoresServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetTeamHighScoresService").Methods()
+	getTeamHighScoresServiceGetTeamHighScoresHandler := connect.NewUnaryHandler(
+		GetTeamHighScoresServiceGetTeamHighScoresProcedure,
+		svc.GetTeamHighScores,
+		connect.WithSchema(getTeamHighScoresServiceMethods.ByName("GetTeamHighScores")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetTeamHighScoresService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetTeamHighScoresServiceGetTeamHighScoresProcedure:
+			getTeamHighScoresServiceGetTeamHighScoresHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetTeamHighScoresServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetTeamHighScoresServiceHandler struct{}
+
+func (UnimplementedGetTeamHighScoresServiceHandler) GetTeamHighScores(context.Context, *connect.Request[v1.GetTeamHighScoresRequest]) (*connect.Response[v1.GetTeamHighScoresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetTeamHighScoresService.GetTeamHighScores is not implemented"))
+}
+
+// GetGameModesServiceClient is a client for the game.v1.GetGameModesService service.
+type GetGameModesServiceClient interface {
+	GetGameModes(context.Context, *connect.Request[v1.GetGameModesRequest]) (*connect.Response[v1.GetGameModesResponse], error)
+}
+
+// NewGetGameModesServiceClient constructs a client for the game.v1.GetGameModesService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewGetGameModesServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) GetGameModesServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	getGameModesServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetGameModesService").Methods()
+	return &getGameModesServiceClient{
+		getGameModes: connect.NewClient[v1.GetGameModesRequest, v1.GetGameModesResponse](
+			httpClient,
+			baseURL+GetGameModesServiceGetGameModesProcedure,
+			connect.WithSchema(getGameModesServiceMethods.ByName("GetGameModes")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// getGameModesServiceClient implements GetGameModesServiceClient.
+type getGameModesServiceClient struct {
+	getGameModes *connect.Client[v1.GetGameModesRequest, v1.GetGameModesResponse]
+}
+
+// GetGameModes calls game.v1.GetGameModesService.GetGameModes.
+func (c *getGameModesServiceClient) GetGameModes(ctx context.Context, req *connect.Request[v1.GetGameModesRequest]) (*connect.Response[v1.GetGameModesResponse], error) {
+	return c.getGameModes.CallUnary(ctx, req)
+}
+
+// GetGameModesServiceHandler is an implementation of the game.v1.GetGameModesService service.
+type GetGameModesServiceHandler interface {
+	GetGameModes(context.Context, *connect.Request[v1.GetGameModesRequest]) (*connect.Response[v1.GetGameModesResponse], error)
+}
+
+// NewGetGameModesServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewGetGameModesServiceHandler(svc GetGameModesServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	getGameModesServiceMethods := v1.File_game_v1_game_proto.Services().ByName("GetGameModesService").Methods()
+	getGameModesServiceGetGameModesHandler := connect.NewUnaryHandler(
+		GetGameModesServiceGetGameModesProcedure,
+		svc.GetGameModes,
+		connect.WithSchema(getGameModesServiceMethods.ByName("GetGameModes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/game.v1.GetGameModesService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case GetGameModesServiceGetGameModesProcedure:
+			getGameModesServiceGetGameModesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedGetGameModesServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedGetGameModesServiceHandler struct{}
+
+func (UnimplementedGetGameModesServiceHandler) GetGameModes(context.Context, *connect.Request[v1.GetGameModesRequest]) (*connect.Response[v1.GetGameModesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("game.v1.GetGameModesService.GetGameModes is not implemented"))
 }
@@ -0,0 +1,136 @@
+package bot
+
+import (
+	"errors"
+	"math/rand/v2"
+	"sort"
+	"time"
+
+	"example/internal/gamemode"
+)
+
+// Strategy decides when and how a bot answers.
+type Strategy interface {
+	// ReactionTime is called once per round and returns how long the bot waits before answering.
+	ReactionTime(r *rand.Rand) time.Duration
+	// Answer picks the cards and the symbol the bot names; ok is false if it cannot answer.
+	Answer(table []gamemode.Card, r *rand.Rand) (card1, card2 gamemode.Card, symbol string, ok bool)
+}
+
+// Profile describes how well a bot plays.
+type Profile struct {
+	// Accuracy is the probability of naming the correct symbol, from 0 to 1.
+	Accuracy float64
+	// MinReaction and MaxReaction bound the simulated time a bot takes to answer.
+	MinReaction time.Duration
+	MaxReaction time.Duration
+}
+
+var levels = map[string]Profile{
+	"EASY":   {Accuracy: 0.6, MinReaction: 4 * time.Second, MaxReaction: 8 * time.Second},
+	"NORMAL": {Accuracy: 0.8, MinReaction: 2500 * time.Millisecond, MaxReaction: 5 * time.Second},
+	"HARD":   {Accuracy: 0.95, MinReaction: 1200 * time.Millisecond, MaxReaction: 2500 * time.Millisecond},
+}
+
+// Level returns the preset profile with the given name.
+func Level(name string) (Profile, bool) {
+	p, ok := levels[name]
+	return p, ok
+}
+
+// Levels returns the names of the preset profiles in sorted order.
+func Levels() []string {
+	var names []string
+	for name := range levels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate reports whether the profile can be played with.
+func (p Profile) Validate() error {
+	if p.Accuracy < 0 || p.Accuracy > 1 {
+		return errors.New("accuracy must be between 0 and 1")
+	}
+	if p.MinReaction < 0 || p.MaxReaction < p.MinReaction {
+		return errors.New("reaction times must satisfy 0 <= min <= max")
+	}
+	return nil
+}
+
+// ReactionTime returns how long the bot waits before answering.
+func (p Profile) ReactionTime(r *rand.Rand) time.Duration {
+	if p.MaxReaction == p.MinReaction {
+		return p.MinReaction
+	}
+	return p.MinReaction + time.Duration(r.Int64N(int64(p.MaxReaction-p.MinReaction)))
+}
+
+// Answer picks a pair of cards from the table and the symbol the bot names for them.
+// With two cards the bot answers for that pair; with more it picks any pair, as in SPEED mode.
+// ok is false if fewer than two cards are on the table.
+func (p Profile) Answer(table []gamemode.Card, r *rand.Rand) (card1, card2 gamemode.Card, symbol string, ok bool) {
+	if len(table) < 2 {
+		return gamemode.Card{}, gamemode.Card{}, "", false
+	}
+	i, j := len(table)-2, len(table)-1
+	if len(table) > 2 {
+		i = r.IntN(len(table))
+		j = r.IntN(len(table) - 1)
+		if j >= i {
+			j++
+		}
+	}
+	card1, card2 = table[i], table[j]
+	symbol = gamemode.CommonSymbol(card1, card2)
+	if r.Float64() < p.Accuracy {
+		return card1, card2, symbol, true
+	}
+
+	// A wrong answer names another symbol from one of the two cards.
+	var wrong []string
+	for _, s := range append(card1.Symbols(), card2.Symbols()...) {
+		if s != symbol {
+			wrong = append(wrong, s)
+		}
+	}
+	if len(wrong) > 0 {
+		symbol = wrong[r.IntN(len(wrong))]
+	}
+	return card1, card2, symbol, true
+}
+
+// Ghost replays a recorded run: for each round it waits the recorded reaction time
+// and names the correct symbol for the last two cards.
+type Ghost struct {
+	Reactions []time.Duration
+	round     int
+}
+
+// NewGhost returns a ghost replaying the given reaction times in milliseconds.
+func NewGhost(reactionMs []int64) *Ghost {
+	g := &Ghost{}
+	for _, ms := range reactionMs {
+		g.Reactions = append(g.Reactions, time.Duration(ms)*time.Millisecond)
+	}
+	return g
+}
+
+// ReactionTime returns the recorded reaction time of the next round.
+func (g *Ghost) ReactionTime(*rand.Rand) time.Duration {
+	g.round++
+	if g.round > len(g.Reactions) {
+		return 0
+	}
+	return g.Reactions[g.round-1]
+}
+
+// Answer names the correct symbol for the last two cards, or reports false once the recording has run out.
+func (g *Ghost) Answer(table []gamemode.Card, _ *rand.Rand) (card1, card2 gamemode.Card, symbol string, ok bool) {
+	if len(table) < 2 || g.round > len(g.Reactions) {
+		return gamemode.Card{}, gamemode.Card{}, "", false
+	}
+	card1, card2 = table[len(table)-2], table[len(table)-1]
+	return card1, card2, gamemode.CommonSymbol(card1, card2), true
+}
@@ -0,0 +1,89 @@
+package bot
+
+import (
+	"math/rand/v2"
+	"testing"
+	"time"
+
+	"example/internal/gamemode"
+)
+
+var table = []gamemode.Card{
+	{ID: 1, Text: "symbols: [1 2 3]"},
+	{ID: 2, Text: "symbols: [1 4 5]"},
+	{ID: 3, Text: "symbols: [2 4 6]"},
+}
+
+func TestLevels(t *testing.T) {
+	for _, name := range Levels() {
+		p, ok := Level(name)
+		if !ok {
+			t.Fatalf("level %s is not found", name)
+		}
+		if err := p.Validate(); err != nil {
+			t.Errorf("level %s is invalid: %v", name, err)
+		}
+	}
+	if err := (Profile{Accuracy: 1.5}).Validate(); err == nil {
+		t.Errorf("expected an error for accuracy above 1")
+	}
+	if err := (Profile{MinReaction: time.Second}).Validate(); err == nil {
+		t.Errorf("expected an error for max reaction below min reaction")
+	}
+}
+
+func TestReactionTime(t *testing.T) {
+	p := Profile{MinReaction: time.Second, MaxReaction: 2 * time.Second}
+	r := rand.New(rand.NewPCG(1, 2))
+	for i := 0; i < 100; i++ {
+		if d := p.ReactionTime(r); d < p.MinReaction || d >= p.MaxReaction {
+			t.Fatalf("reaction time %v out of range", d)
+		}
+	}
+}
+
+func TestAnswer(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+
+	perfect := Profile{Accuracy: 1}
+	for i := 0; i < 20; i++ {
+		card1, card2, symbol, ok := perfect.Answer(table[:2], r)
+		if !ok || card1.ID != 1 || card2.ID != 2 || symbol != "1" {
+			t.Fatalf("expected cards 1 and 2 with symbol 1, got %d %d %s", card1.ID, card2.ID, symbol)
+		}
+		card1, card2, symbol, _ = perfect.Answer(table, r)
+		if card1.ID == card2.ID || symbol != gamemode.CommonSymbol(card1, card2) {
+			t.Fatalf("expected a correct answer for a pair, got %d %d %s", card1.ID, card2.ID, symbol)
+		}
+	}
+
+	hopeless := Profile{Accuracy: 0}
+	for i := 0; i < 20; i++ {
+		card1, card2, symbol, _ := hopeless.Answer(table, r)
+		if symbol == gamemode.CommonSymbol(card1, card2) {
+			t.Fatalf("expected a wrong answer, got %s", symbol)
+		}
+	}
+
+	if _, _, _, ok := perfect.Answer(table[:1], r); ok {
+		t.Errorf("expected no answer with a single card")
+	}
+}
+
+func TestGhost(t *testing.T) {
+	g := NewGhost([]int64{1500, 800})
+	if d := g.ReactionTime(nil); d != 1500*time.Millisecond {
+		t.Fatalf("expected 1.5s for the first round, got %v", d)
+	}
+	card1, card2, symbol, ok := g.Answer(table, nil)
+	if !ok || card1.ID != 2 || card2.ID != 3 || symbol != "4" {
+		t.Fatalf("expected cards 2 and 3 with symbol 4, got %d %d %s", card1.ID, card2.ID, symbol)
+	}
+	if d := g.ReactionTime(nil); d != 800*time.Millisecond {
+		t.Fatalf("expected 0.8s for the second round, got %v", d)
+	}
+	g.ReactionTime(nil)
+	if _, _, _, ok := g.Answer(table, nil); ok {
+		t.Errorf("expected no answer after the recording has run out")
+	}
+}
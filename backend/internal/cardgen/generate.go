@@ -1,8 +1,13 @@
 package cardgen
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"time"
 )
 
 // Card represents a Dobble card with an ID and symbols.
@@ -70,6 +75,28 @@ func GenerateDobbleCards(n int) ([]Card, []Item, error) {
 	return cards, items, nil
 }
 
+// GenerateSeededDeck generates Dobble cards like GenerateDobbleCards and shuffles them
+// deterministically, so the same seed always yields the same card order.
+func GenerateSeededDeck(n int, seed int64) ([]Card, error) {
+	cards, _, err := GenerateDobbleCards(n)
+	if err != nil {
+		return nil, err
+	}
+	r := rand.New(rand.NewPCG(uint64(seed), 0))
+	r.Shuffle(len(cards), func(i, j int) {
+		cards[i], cards[j] = cards[j], cards[i]
+	})
+	return cards, nil
+}
+
+// DailySeed derives the deck seed for the calendar day of t in its location.
+// The seed is keyed with secret so that the day's deck cannot be computed in advance.
+func DailySeed(secret []byte, t time.Time) int64 {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(t.Format(time.DateOnly)))
+	return int64(binary.BigEndian.Uint64(h.Sum(nil)))
+}
+
 // DebugPrint prints cards for debugging purposes.
 func DebugPrint(cards []Card) {
 	for _, card := range cards {
@@ -2,6 +2,7 @@ package cardgen
 
 import (
 	"testing"
+	"time"
 )
 
 func TestGenerateDobbleCards(t *testing.T) {
@@ -36,3 +37,44 @@ func TestGenerateDobbleCards(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateSeededDeck(t *testing.T) {
+	deck1, err := GenerateSeededDeck(3, 42)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	deck2, _ := GenerateSeededDeck(3, 42)
+	deck3, _ := GenerateSeededDeck(3, 43)
+
+	same, differs := true, false
+	for i := range deck1 {
+		if deck1[i].ID != deck2[i].ID {
+			same = false
+		}
+		if deck1[i].ID != deck3[i].ID {
+			differs = true
+		}
+	}
+	if !same {
+		t.Errorf("expected the same order for the same seed")
+	}
+	if !differs {
+		t.Errorf("expected a different order for another seed")
+	}
+}
+
+func TestDailySeed(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	morning := time.Date(2026, 10, 19, 0, 30, 0, 0, jst)
+	night := time.Date(2026, 10, 19, 23, 30, 0, 0, jst)
+	secret := []byte("secret")
+	if DailySeed(secret, morning) != DailySeed(secret, night) {
+		t.Errorf("expected the same seed within a day")
+	}
+	if DailySeed(secret, morning) == DailySeed(secret, morning.AddDate(0, 0, 1)) {
+		t.Errorf("expected a different seed on the next day")
+	}
+	if DailySeed(secret, morning) == DailySeed([]byte("other"), morning) {
+		t.Errorf("expected a different seed for another secret")
+	}
+}
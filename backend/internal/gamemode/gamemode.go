@@ -0,0 +1,261 @@
+package gamemode
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"example/internal/scoring"
+)
+
+// ErrAnswered is returned by ValidateAnswer when the pair was already named this round.
+var ErrAnswered = errors.New("the pair has already been named this round")
+
+// Card represents a card dealt in a game.
+type Card struct {
+	ID   int    `json:"id"`
+	Text string `json:"text"`
+}
+
+// Symbols returns the symbols printed on the card.
+func (c Card) Symbols() []string {
+	start := strings.Index(c.Text, "[")
+	end := strings.Index(c.Text, "]")
+	if start == -1 || end == -1 || start >= end {
+		return []string{}
+	}
+	return strings.Fields(c.Text[start+1 : end])
+}
+
+// CommonSymbol returns the symbol shared by two cards, or "" if there is none.
+func CommonSymbol(card1, card2 Card) string {
+	symbolSet := make(map[string]struct{})
+	for _, s := range card1.Symbols() {
+		symbolSet[s] = struct{}{}
+	}
+	for _, s := range card2.Symbols() {
+		if _, exists := symbolSet[s]; exists {
+			return s
+		}
+	}
+	return ""
+}
+
+// Options holds the per-game settings a mode may use.
+type Options struct {
+	EliminationInterval int
+	CenterCount         int
+	Scoring             scoring.Rules
+	// TeamCount is the number of teams in a Teamed mode.
+	TeamCount int
+}
+
+// Player is a player as seen by a mode.
+type Player struct {
+	ID         int
+	Score      int
+	WrongCount int
+	// Active is false for players who are eliminated or only watching.
+	Active bool
+	// Team is the ID of the player's team in a Teamed mode, or 0 if the player has none.
+	Team int
+}
+
+// State is the in-memory state of a running game.
+type State struct {
+	GameID  int
+	Options Options
+	// Deck holds the cards not dealt yet.
+	Deck []Card
+	// Table holds the cards currently on the table, oldest first.
+	Table []Card
+	// Round is the number of completed rounds.
+	Round   int
+	Players []*Player
+	// TimeUp is set when the shared clock of a Clocked mode runs out.
+	TimeUp bool
+	// DealtAt is when the current round was dealt, used to measure reaction times.
+	DealtAt time.Time
+	// Answered is set once the last pair dealt one card at a time has been named correctly.
+	Answered bool
+}
+
+// ActivePlayers returns the players still playing.
+func (s *State) ActivePlayers() []*Player {
+	var active []*Player
+	for _, p := range s.Players {
+		if p.Active {
+			active = append(active, p)
+		}
+	}
+	return active
+}
+
+// TableCard returns the card with the given ID if it is on the table.
+func (s *State) TableCard(id int) (Card, bool) {
+	for _, c := range s.Table {
+		if c.ID == id {
+			return c, true
+		}
+	}
+	return Card{}, false
+}
+
+// Answer is a symbol named by a player for a pair of cards.
+type Answer struct {
+	PlayerID int
+	CardIDs  [2]int
+	Symbol   string
+	// ReactionTime is measured by the server from the deal to the answer.
+	ReactionTime time.Duration
+	// Streak is the number of consecutive correct answers the player made before this one.
+	Streak int
+}
+
+// Verdict is the result of checking an answer.
+type Verdict struct {
+	Correct       bool
+	CorrectSymbol string
+	CardIDs       [2]int
+}
+
+// Round is what was dealt at the start of a round.
+// Card is set by modes that deal one card at a time; otherwise the whole Table is announced.
+// A Round with neither Card nor Table means nothing could be dealt.
+type Round struct {
+	Card       *Card
+	Table      []Card
+	Eliminated []int
+}
+
+// Score is the effect of an answer on scores and the shared clock.
+type Score struct {
+	Player int
+	Team   int
+	Time   time.Duration
+}
+
+// GameMode is the set of rules a game is played with.
+type GameMode interface {
+	// Name is the identifier stored on the game and named by CreateGameRequest.
+	Name() string
+	// Setup validates and fills in the options and returns the number of rounds for a deck of deckSize cards.
+	Setup(opts *Options, deckSize int) (int, error)
+	// NextRound deals the cards for the next round.
+	NextRound(s *State) Round
+	// ValidateAnswer checks an answer against the cards on the table.
+	ValidateAnswer(s *State, a Answer) (Verdict, error)
+	// Score applies a checked answer to the state and returns the score changes.
+	Score(s *State, a Answer, v Verdict) Score
+	// IsOver reports whether the game has ended.
+	IsOver(s *State) bool
+}
+
+// Clocked is implemented by modes played against a shared clock.
+type Clocked interface {
+	InitialTime() time.Duration
+}
+
+// TeamScored is implemented by modes where the table plays as one team.
+// Such games record a team score and never go to a tie-break.
+type TeamScored interface {
+	TeamScored()
+}
+
+// Teamed is implemented by modes where players are split into teams
+// and each team competes on the total of its members' scores.
+type Teamed interface {
+	Teamed()
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = make(map[string]GameMode)
+)
+
+// Register makes a mode available by its name. It panics if the name is already registered.
+func Register(m GameMode) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	if _, exists := registry[m.Name()]; exists {
+		panic(fmt.Sprintf("gamemode: mode %s is already registered", m.Name()))
+	}
+	registry[m.Name()] = m
+}
+
+// Lookup returns the mode registered with the given name.
+func Lookup(name string) (GameMode, bool) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	m, ok := registry[name]
+	return m, ok
+}
+
+// Names returns the names of all registered modes in sorted order.
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+	var names []string
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// dealOne deals the next card of the deck, keeping the last two cards on the table.
+func dealOne(s *State) Round {
+	if len(s.Deck) == 0 {
+		return Round{}
+	}
+	if len(s.Table) >= 2 {
+		s.Round++
+	}
+	card := s.Deck[0]
+	s.Deck = s.Deck[1:]
+	s.Answered = false
+	s.Table = append(s.Table, card)
+	if len(s.Table) > 2 {
+		s.Table = s.Table[len(s.Table)-2:]
+	}
+	return Round{Card: &card}
+}
+
+// validateLastPair checks an answer against the last two cards dealt.
+func validateLastPair(s *State, a Answer) (Verdict, error) {
+	if len(s.Table) < 2 {
+		return Verdict{}, errors.New("two cards must be on the table to answer")
+	}
+	if s.Answered {
+		return Verdict{}, ErrAnswered
+	}
+	card1, card2 := s.Table[len(s.Table)-2], s.Table[len(s.Table)-1]
+	symbol := CommonSymbol(card1, card2)
+	return Verdict{
+		Correct:       symbol == a.Symbol,
+		CorrectSymbol: symbol,
+		CardIDs:       [2]int{card1.ID, card2.ID},
+	}, nil
+}
+
+// claimLastPair marks the last pair as named after a correct answer, so later answers to it are rejected.
+func claimLastPair(s *State, v Verdict) {
+	if v.Correct {
+		s.Answered = true
+	}
+}
+
+// playerScore scores an answer for the player with the game's scoring rules.
+func playerScore(s *State, a Answer, v Verdict) Score {
+	return Score{Player: s.Options.Scoring.Points(v.Correct, a.ReactionTime, a.Streak)}
+}
+
+func roundsOf(deckSize int) int {
+	if deckSize < 1 {
+		return 0
+	}
+	return deckSize - 1
+}
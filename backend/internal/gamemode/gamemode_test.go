@@ -0,0 +1,224 @@
+package gamemode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"example/internal/cardgen"
+	"example/internal/scoring"
+)
+
+func newDeck(t *testing.T) []Card {
+	t.Helper()
+	generated, _, err := cardgen.GenerateDobbleCards(3)
+	if err != nil {
+		t.Fatalf("failed to generate cards: %v", err)
+	}
+	var deck []Card
+	for _, c := range generated {
+		deck = append(deck, Card{ID: c.ID, Text: "symbols: " + fmt.Sprint(c.Symbols)})
+	}
+	return deck
+}
+
+func TestRegistry(t *testing.T) {
+	for _, name := range []string{"NORMAL", "COOP", "BATTLE_ROYALE", "SPEED", "TEAMS"} {
+		m, ok := Lookup(name)
+		if !ok {
+			t.Fatalf("mode %s is not registered", name)
+		}
+		if m.Name() != name {
+			t.Errorf("expected mode %s, got %s", name, m.Name())
+		}
+	}
+	if _, ok := Lookup("UNKNOWN"); ok {
+		t.Errorf("expected UNKNOWN not to be registered")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected duplicate registration to panic")
+		}
+	}()
+	Register(Normal{})
+}
+
+func TestNormalRounds(t *testing.T) {
+	deck := newDeck(t)
+	mode := Normal{}
+	rounds, err := mode.Setup(&Options{}, len(deck))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rounds != len(deck)-1 {
+		t.Fatalf("expected %d rounds, got %d", len(deck)-1, rounds)
+	}
+
+	s := &State{Options: Options{Scoring: scoring.Default()}, Deck: deck}
+	mode.NextRound(s)
+	if _, err := mode.ValidateAnswer(s, Answer{}); err == nil {
+		t.Errorf("expected an error with a single card on the table")
+	}
+
+	for !mode.IsOver(s) {
+		round := mode.NextRound(s)
+		if round.Card == nil {
+			t.Fatalf("expected a card to be dealt")
+		}
+		symbol := CommonSymbol(s.Table[0], s.Table[1])
+		v, err := mode.ValidateAnswer(s, Answer{Symbol: symbol})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !v.Correct || mode.Score(s, Answer{}, v).Player != 1 {
+			t.Errorf("expected %s to be a correct answer worth 1 point", symbol)
+		}
+	}
+	if s.Round != rounds-1 {
+		t.Errorf("expected %d completed rounds before the last answer, got %d", rounds-1, s.Round)
+	}
+}
+
+func TestLastPairAnsweredOnce(t *testing.T) {
+	for _, mode := range []GameMode{Normal{}, Coop{}, BattleRoyale{}, Teams{}} {
+		s := &State{
+			Options: Options{Scoring: scoring.Default()},
+			Deck:    newDeck(t),
+			Players: []*Player{{ID: 1, Active: true}, {ID: 2, Active: true}},
+		}
+		if _, err := mode.Setup(&s.Options, len(s.Deck)); err != nil {
+			t.Fatalf("%s: unexpected error: %v", mode.Name(), err)
+		}
+		mode.NextRound(s)
+		mode.NextRound(s)
+		symbol := CommonSymbol(s.Table[0], s.Table[1])
+
+		// A wrong answer leaves the round open.
+		v, err := mode.ValidateAnswer(s, Answer{Symbol: "none"})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", mode.Name(), err)
+		}
+		mode.Score(s, Answer{}, v)
+		v, err = mode.ValidateAnswer(s, Answer{Symbol: symbol})
+		if err != nil || !v.Correct {
+			t.Fatalf("%s: expected a correct answer after a wrong one, got %+v, %v", mode.Name(), v, err)
+		}
+		mode.Score(s, Answer{}, v)
+
+		if _, err := mode.ValidateAnswer(s, Answer{Symbol: symbol}); !errors.Is(err, ErrAnswered) {
+			t.Errorf("%s: expected a second answer to the pair to be rejected, got %v", mode.Name(), err)
+		}
+		mode.NextRound(s)
+		if _, err := mode.ValidateAnswer(s, Answer{}); err != nil {
+			t.Errorf("%s: expected answers to be accepted after the next deal, got %v", mode.Name(), err)
+		}
+	}
+}
+
+func TestSpeedValidateAnswer(t *testing.T) {
+	deck := newDeck(t)
+	mode := Speed{}
+	opts := Options{}
+	if _, err := mode.Setup(&opts, len(deck)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.CenterCount != 3 {
+		t.Fatalf("expected default center count 3, got %d", opts.CenterCount)
+	}
+	if _, err := mode.Setup(&Options{CenterCount: 2}, len(deck)); err == nil {
+		t.Errorf("expected an error for 2 center cards")
+	}
+
+	s := &State{Options: opts, Deck: deck}
+	round := mode.NextRound(s)
+	if len(round.Table) != 3 {
+		t.Fatalf("expected 3 cards on the table, got %d", len(round.Table))
+	}
+
+	card1, card3 := s.Table[0], s.Table[2]
+	v, err := mode.ValidateAnswer(s, Answer{CardIDs: [2]int{card1.ID, card3.ID}, Symbol: CommonSymbol(card1, card3)})
+	if err != nil || !v.Correct {
+		t.Fatalf("expected a correct answer, got %+v (%v)", v, err)
+	}
+	mode.Score(s, Answer{}, v)
+	if _, ok := s.TableCard(card1.ID); ok {
+		t.Errorf("expected card %d to be cleared from the table", card1.ID)
+	}
+
+	if _, err := mode.ValidateAnswer(s, Answer{CardIDs: [2]int{card1.ID, card3.ID}}); err == nil {
+		t.Errorf("expected an error for a card not on the table")
+	}
+	if _, err := mode.ValidateAnswer(s, Answer{CardIDs: [2]int{card3.ID, card3.ID}}); err == nil {
+		t.Errorf("expected an error for the same card twice")
+	}
+}
+
+func TestBattleRoyaleElimination(t *testing.T) {
+	deck := newDeck(t)
+	mode := BattleRoyale{}
+	opts := Options{EliminationInterval: 1}
+	if _, err := mode.Setup(&opts, len(deck)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s := &State{
+		Options: opts,
+		Deck:    deck,
+		Players: []*Player{
+			{ID: 1, Score: 2, Active: true},
+			{ID: 2, Score: 0, WrongCount: 1, Active: true},
+			{ID: 3, Score: 0, WrongCount: 1, Active: true},
+		},
+	}
+
+	mode.NextRound(s)
+	mode.NextRound(s)
+	round := mode.NextRound(s)
+	if len(round.Eliminated) != 1 || round.Eliminated[0] != 3 {
+		t.Fatalf("expected player 3 to be eliminated, got %v", round.Eliminated)
+	}
+
+	round = mode.NextRound(s)
+	if len(round.Eliminated) != 1 || round.Eliminated[0] != 2 {
+		t.Fatalf("expected player 2 to be eliminated, got %v", round.Eliminated)
+	}
+	if round.Card != nil || !mode.IsOver(s) {
+		t.Errorf("expected the game to be over with one player left")
+	}
+}
+
+func TestTeamsSetup(t *testing.T) {
+	opts := Options{}
+	if _, err := (Teams{}).Setup(&opts, 13); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts.TeamCount != 2 {
+		t.Errorf("expected 2 teams by default, got %d", opts.TeamCount)
+	}
+	if _, err := (Teams{}).Setup(&Options{TeamCount: 1}, 13); err == nil {
+		t.Errorf("expected an error for a single team")
+	}
+}
+
+func TestBalanceTeams(t *testing.T) {
+	players := []*Player{
+		{ID: 1, Team: 10},
+		{ID: 2, Team: 10},
+		{ID: 3, Team: 10},
+		{ID: 4},
+	}
+	moves := AssignTeams(players, []int{10, 20})
+	if len(moves) != 1 || moves[4] != 20 {
+		t.Fatalf("expected player 4 to join team 20, got %v", moves)
+	}
+
+	moves = BalanceTeams(players, []int{10, 20})
+	if len(moves) != 2 || moves[4] != 20 || moves[3] != 20 {
+		t.Fatalf("expected players 3 and 4 to move to team 20, got %v", moves)
+	}
+
+	players = []*Player{{ID: 1, Team: 10}, {ID: 2, Team: 20}, {ID: 3, Team: 10}}
+	if moves := BalanceTeams(players, []int{10, 20}); len(moves) != 0 {
+		t.Errorf("expected no moves for balanced teams, got %v", moves)
+	}
+}
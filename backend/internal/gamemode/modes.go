@@ -0,0 +1,261 @@
+package gamemode
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+func init() {
+	Register(Normal{})
+	Register(Coop{})
+	Register(BattleRoyale{})
+	Register(Speed{})
+	Register(Teams{})
+}
+
+// Normal is the classic game: one card is dealt per round and the fastest correct answer scores.
+type Normal struct{}
+
+func (Normal) Name() string { return "NORMAL" }
+
+func (Normal) Setup(opts *Options, deckSize int) (int, error) {
+	return roundsOf(deckSize), nil
+}
+
+func (Normal) NextRound(s *State) Round {
+	return dealOne(s)
+}
+
+func (Normal) ValidateAnswer(s *State, a Answer) (Verdict, error) {
+	return validateLastPair(s, a)
+}
+
+func (Normal) Score(s *State, a Answer, v Verdict) Score {
+	claimLastPair(s, v)
+	return playerScore(s, a, v)
+}
+
+func (Normal) IsOver(s *State) bool {
+	return len(s.Deck) == 0
+}
+
+// Coop is played by the whole table against a shared clock.
+// Correct answers add time and wrong answers take it away.
+type Coop struct{}
+
+const (
+	coopInitialTime = 60 * time.Second
+	coopBonusTime   = 5 * time.Second
+	coopPenaltyTime = 5 * time.Second
+)
+
+func (Coop) Name() string { return "COOP" }
+
+func (Coop) Setup(opts *Options, deckSize int) (int, error) {
+	return roundsOf(deckSize), nil
+}
+
+func (Coop) NextRound(s *State) Round {
+	return dealOne(s)
+}
+
+func (Coop) ValidateAnswer(s *State, a Answer) (Verdict, error) {
+	return validateLastPair(s, a)
+}
+
+func (Coop) Score(s *State, a Answer, v Verdict) Score {
+	claimLastPair(s, v)
+	if v.Correct {
+		return Score{Team: 1, Time: coopBonusTime}
+	}
+	return Score{Time: -coopPenaltyTime}
+}
+
+func (Coop) IsOver(s *State) bool {
+	return len(s.Deck) == 0 || s.TimeUp
+}
+
+func (Coop) InitialTime() time.Duration { return coopInitialTime }
+
+func (Coop) TeamScored() {}
+
+// BattleRoyale eliminates the lowest scorer every EliminationInterval rounds until one player remains.
+type BattleRoyale struct{}
+
+func (BattleRoyale) Name() string { return "BATTLE_ROYALE" }
+
+func (BattleRoyale) Setup(opts *Options, deckSize int) (int, error) {
+	if opts.EliminationInterval < 0 {
+		return 0, errors.New("elimination interval must be at least 1")
+	}
+	if opts.EliminationInterval == 0 {
+		opts.EliminationInterval = 3
+	}
+	return roundsOf(deckSize), nil
+}
+
+func (BattleRoyale) NextRound(s *State) Round {
+	completed := s.Round
+	if len(s.Table) >= 2 {
+		completed++
+	}
+	var eliminated []int
+	if completed > 0 && completed%s.Options.EliminationInterval == 0 {
+		// Before dealing the next card, eliminate the last-placed player once the interval of rounds is complete.
+		if loser := PickEliminated(s.ActivePlayers()); loser != nil {
+			loser.Active = false
+			eliminated = append(eliminated, loser.ID)
+		}
+	}
+	if len(s.ActivePlayers()) <= 1 {
+		return Round{Eliminated: eliminated}
+	}
+	round := dealOne(s)
+	round.Eliminated = eliminated
+	return round
+}
+
+func (BattleRoyale) ValidateAnswer(s *State, a Answer) (Verdict, error) {
+	return validateLastPair(s, a)
+}
+
+func (BattleRoyale) Score(s *State, a Answer, v Verdict) Score {
+	claimLastPair(s, v)
+	return playerScore(s, a, v)
+}
+
+func (BattleRoyale) IsOver(s *State) bool {
+	return len(s.Deck) == 0 || len(s.ActivePlayers()) <= 1
+}
+
+// PickEliminated chooses the player to eliminate among players.
+// The lowest score goes out; ties go to the player with more wrong answers, then to the player who joined last.
+// It returns nil if fewer than two players remain.
+func PickEliminated(players []*Player) *Player {
+	if len(players) < 2 {
+		return nil
+	}
+	loser := players[0]
+	for _, p := range players[1:] {
+		switch {
+		case p.Score != loser.Score:
+			if p.Score < loser.Score {
+				loser = p
+			}
+		case p.WrongCount != loser.WrongCount:
+			if p.WrongCount > loser.WrongCount {
+				loser = p
+			}
+		case p.ID > loser.ID:
+			loser = p
+		}
+	}
+	return loser
+}
+
+// Speed keeps CenterCount cards on the table; a player scores by naming the symbol shared by any pair of them.
+type Speed struct{}
+
+func (Speed) Name() string { return "SPEED" }
+
+func (Speed) Setup(opts *Options, deckSize int) (int, error) {
+	if opts.CenterCount == 0 {
+		opts.CenterCount = 3
+	}
+	if opts.CenterCount < 3 || opts.CenterCount > deckSize {
+		return 0, fmt.Errorf("center count must be between 3 and %d", deckSize)
+	}
+	// Every round refills one card, apart from the initial center cards.
+	return deckSize - opts.CenterCount + 1, nil
+}
+
+// NextRound tops the table up to CenterCount cards.
+// If nobody cleared a card in the last round the oldest card is discarded.
+func (Speed) NextRound(s *State) Round {
+	if len(s.Deck) == 0 {
+		return Round{}
+	}
+	if len(s.Table) > 0 {
+		s.Round++
+	}
+	need := s.Options.CenterCount - len(s.Table)
+	if need <= 0 {
+		s.Table = s.Table[1:]
+		need = 1
+	}
+	if need > len(s.Deck) {
+		need = len(s.Deck)
+	}
+	s.Table = append(s.Table, s.Deck[:need]...)
+	s.Deck = s.Deck[need:]
+	return Round{Table: append([]Card(nil), s.Table...)}
+}
+
+// ValidateAnswer checks that both claimed cards are on the table and share the named symbol.
+func (Speed) ValidateAnswer(s *State, a Answer) (Verdict, error) {
+	card1, ok1 := s.TableCard(a.CardIDs[0])
+	card2, ok2 := s.TableCard(a.CardIDs[1])
+	if !ok1 || !ok2 || card1.ID == card2.ID {
+		return Verdict{}, errors.New("name two different cards on the table")
+	}
+	symbol := CommonSymbol(card1, card2)
+	return Verdict{
+		Correct:       symbol == a.Symbol,
+		CorrectSymbol: symbol,
+		CardIDs:       a.CardIDs,
+	}, nil
+}
+
+// Score removes the first card of a correctly named pair from the table so the pair cannot be claimed again.
+func (Speed) Score(s *State, a Answer, v Verdict) Score {
+	if v.Correct {
+		for i, c := range s.Table {
+			if c.ID == v.CardIDs[0] {
+				s.Table = append(s.Table[:i:i], s.Table[i+1:]...)
+				break
+			}
+		}
+	}
+	return playerScore(s, a, v)
+}
+
+func (Speed) IsOver(s *State) bool {
+	return len(s.Deck) == 0
+}
+
+// Teams splits the table into TeamCount teams; every point a player scores also counts for their team.
+type Teams struct{}
+
+const maxTeamCount = 8
+
+func (Teams) Name() string { return "TEAMS" }
+
+func (Teams) Setup(opts *Options, deckSize int) (int, error) {
+	if opts.TeamCount == 0 {
+		opts.TeamCount = 2
+	}
+	if opts.TeamCount < 2 || opts.TeamCount > maxTeamCount {
+		return 0, fmt.Errorf("team count must be between 2 and %d", maxTeamCount)
+	}
+	return roundsOf(deckSize), nil
+}
+
+func (Teams) NextRound(s *State) Round {
+	return dealOne(s)
+}
+
+func (Teams) ValidateAnswer(s *State, a Answer) (Verdict, error) {
+	return validateLastPair(s, a)
+}
+
+func (Teams) Score(s *State, a Answer, v Verdict) Score {
+	claimLastPair(s, v)
+	return playerScore(s, a, v)
+}
+
+func (Teams) IsOver(s *State) bool {
+	return len(s.Deck) == 0
+}
+
+func (Teams) Teamed() {}
@@ -0,0 +1,79 @@
+package gamemode
+
+import "sort"
+
+// AssignTeams puts every player without one of the given teams into the smallest team.
+// It returns the new team of every player whose team changed.
+func AssignTeams(players []*Player, teams []int) map[int]int {
+	moves, _ := arrangeTeams(players, teams)
+	return moves
+}
+
+// BalanceTeams assigns players like AssignTeams and then moves players from the largest
+// to the smallest team until team sizes differ by at most one. The player who joined
+// last moves first. It returns the new team of every player whose team changed.
+func BalanceTeams(players []*Player, teams []int) map[int]int {
+	moves, members := arrangeTeams(players, teams)
+	if len(teams) == 0 {
+		return moves
+	}
+	for {
+		large, small := largestTeam(members, teams), smallestTeam(members, teams)
+		if len(members[large])-len(members[small]) <= 1 {
+			return moves
+		}
+		last := members[large][len(members[large])-1]
+		members[large] = members[large][:len(members[large])-1]
+		members[small] = append(members[small], last)
+		moves[last.ID] = small
+	}
+}
+
+// arrangeTeams assigns players without a team and returns the moves and the members of each team in join order.
+func arrangeTeams(players []*Player, teams []int) (map[int]int, map[int][]*Player) {
+	moves := make(map[int]int)
+	members := make(map[int][]*Player)
+	for _, t := range teams {
+		members[t] = nil
+	}
+	sorted := append([]*Player(nil), players...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	var unassigned []*Player
+	for _, p := range sorted {
+		if _, ok := members[p.Team]; ok {
+			members[p.Team] = append(members[p.Team], p)
+		} else {
+			unassigned = append(unassigned, p)
+		}
+	}
+	if len(teams) == 0 {
+		return moves, members
+	}
+	for _, p := range unassigned {
+		t := smallestTeam(members, teams)
+		members[t] = append(members[t], p)
+		moves[p.ID] = t
+	}
+	return moves, members
+}
+
+func smallestTeam(members map[int][]*Player, teams []int) int {
+	best := teams[0]
+	for _, t := range teams[1:] {
+		if len(members[t]) < len(members[best]) {
+			best = t
+		}
+	}
+	return best
+}
+
+func largestTeam(members map[int][]*Player, teams []int) int {
+	best := teams[0]
+	for _, t := range teams[1:] {
+		if len(members[t]) > len(members[best]) {
+			best = t
+		}
+	}
+	return best
+}
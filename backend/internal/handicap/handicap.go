@@ -0,0 +1,87 @@
+package handicap
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// MaxExtraSymbols is the most decoy symbols a card can be given.
+	MaxExtraSymbols = 3
+	// MinMultiplierPercent and MaxMultiplierPercent bound MultiplierPercent, apart from 0 for no change.
+	MinMultiplierPercent = 10
+	MaxMultiplierPercent = 300
+	// decoyPoolSize is the number of decoy symbols used for each parity of deal order.
+	decoyPoolSize = 5
+	// DecoyCount is the number of distinct decoy symbols, numbered from the base passed to Decorate.
+	DecoyCount = 2 * decoyPoolSize
+)
+
+// Handicap evens out a table of mixed skill for one player.
+// The zero value is no handicap.
+type Handicap struct {
+	// ExtraSymbols adds decoy symbols to every card the player sees.
+	ExtraSymbols int `json:"extra_symbols"`
+	// AnswerDelayMs rejects the player's answers until this long after the cards are dealt.
+	AnswerDelayMs int `json:"answer_delay_ms"`
+	// MultiplierPercent scales the points of the player's correct answers; 0 means 100.
+	MultiplierPercent int `json:"multiplier_percent"`
+}
+
+// Validate reports whether the handicap can be played with.
+func (h Handicap) Validate() error {
+	if h.ExtraSymbols < 0 || h.ExtraSymbols > MaxExtraSymbols {
+		return fmt.Errorf("extra symbols must be between 0 and %d", MaxExtraSymbols)
+	}
+	if h.AnswerDelayMs < 0 || h.MultiplierPercent < 0 {
+		return errors.New("handicap values must not be negative")
+	}
+	if h.MultiplierPercent != 0 && (h.MultiplierPercent < MinMultiplierPercent || h.MultiplierPercent > MaxMultiplierPercent) {
+		return fmt.Errorf("multiplier must be between %d%% and %d%%", MinMultiplierPercent, MaxMultiplierPercent)
+	}
+	return nil
+}
+
+// IsZero reports whether the handicap changes nothing.
+func (h Handicap) IsZero() bool {
+	return h.ExtraSymbols == 0 && h.AnswerDelayMs == 0 && (h.MultiplierPercent == 0 || h.MultiplierPercent == 100)
+}
+
+// AnswerDelay returns how long after the deal the player's answers are accepted.
+func (h Handicap) AnswerDelay() time.Duration {
+	return time.Duration(h.AnswerDelayMs) * time.Millisecond
+}
+
+// ApplyPoints scales the points of a correct answer. Penalties are left as they are.
+func (h Handicap) ApplyPoints(points int) int {
+	if points <= 0 || h.MultiplierPercent == 0 {
+		return points
+	}
+	return points * h.MultiplierPercent / 100
+}
+
+// Decorate returns the symbols of a card as seen by a player with n extra symbols.
+// Decoys are numbered from base, which must be above every real symbol.
+// Cards dealt one after the other (dealIndex of different parity) never share a decoy,
+// so a decorated pair still has exactly one common symbol.
+func Decorate(symbols []string, cardID int, dealIndex int, n int, base int) []string {
+	if n <= 0 {
+		return symbols
+	}
+	if n > MaxExtraSymbols {
+		n = MaxExtraSymbols
+	}
+	pool := base + (dealIndex%2)*decoyPoolSize
+	decorated := append([]string{}, symbols...)
+	for i := 0; i < n; i++ {
+		decorated = append(decorated, fmt.Sprint(pool+(cardID+i)%decoyPoolSize))
+	}
+	// Mix the decoys in with a per-card fixed order so they don't always come last.
+	r := rand.New(rand.NewPCG(uint64(cardID), uint64(dealIndex)))
+	r.Shuffle(len(decorated), func(i, j int) {
+		decorated[i], decorated[j] = decorated[j], decorated[i]
+	})
+	return decorated
+}
@@ -0,0 +1,74 @@
+package handicap
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	if err := (Handicap{ExtraSymbols: 2, AnswerDelayMs: 500, MultiplierPercent: 150}).Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := (Handicap{ExtraSymbols: MaxExtraSymbols + 1}).Validate(); err == nil {
+		t.Errorf("expected an error for too many extra symbols")
+	}
+	if err := (Handicap{AnswerDelayMs: -1}).Validate(); err == nil {
+		t.Errorf("expected an error for a negative delay")
+	}
+	if err := (Handicap{MultiplierPercent: MaxMultiplierPercent + 1}).Validate(); err == nil {
+		t.Errorf("expected an error for a multiplier above the cap")
+	}
+	if err := (Handicap{MultiplierPercent: MinMultiplierPercent - 1}).Validate(); err == nil {
+		t.Errorf("expected an error for a multiplier below the floor")
+	}
+	if err := (Handicap{MultiplierPercent: MaxMultiplierPercent}).Validate(); err != nil {
+		t.Errorf("unexpected error at the cap: %v", err)
+	}
+	if !(Handicap{MultiplierPercent: 100}).IsZero() {
+		t.Errorf("expected a 100%% multiplier to be no handicap")
+	}
+}
+
+func TestApplyPoints(t *testing.T) {
+	h := Handicap{MultiplierPercent: 200, AnswerDelayMs: 1500}
+	if p := h.ApplyPoints(3); p != 6 {
+		t.Errorf("expected 6 points, got %d", p)
+	}
+	if p := h.ApplyPoints(-1); p != -1 {
+		t.Errorf("expected the penalty to be unchanged, got %d", p)
+	}
+	if p := (Handicap{}).ApplyPoints(3); p != 3 {
+		t.Errorf("expected 3 points without a multiplier, got %d", p)
+	}
+	if d := h.AnswerDelay(); d != 1500*time.Millisecond {
+		t.Errorf("expected a 1.5s delay, got %v", d)
+	}
+}
+
+func TestDecorate(t *testing.T) {
+	symbols1 := []string{"0", "1", "2"}
+	symbols2 := []string{"0", "3", "4"}
+
+	d1 := Decorate(symbols1, 1, 0, 2, 7)
+	d2 := Decorate(symbols2, 2, 1, 2, 7)
+	if len(d1) != 5 || len(d2) != 5 {
+		t.Fatalf("expected 5 symbols per card, got %v and %v", d1, d2)
+	}
+	var common []string
+	for _, a := range d1 {
+		for _, b := range d2 {
+			if a == b {
+				common = append(common, a)
+			}
+		}
+	}
+	if len(common) != 1 || common[0] != "0" {
+		t.Errorf("expected only the real symbol to be shared, got %v and %v", d1, d2)
+	}
+	if got := Decorate(symbols1, 1, 0, 0, 7); len(got) != 3 {
+		t.Errorf("expected the card to be unchanged without extra symbols, got %v", got)
+	}
+	if symbols1[0] != "0" || symbols1[2] != "2" {
+		t.Errorf("expected the original symbols to be left alone, got %v", symbols1)
+	}
+}
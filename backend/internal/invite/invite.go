@@ -0,0 +1,94 @@
+package invite
+
+import (
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CodeLength is the number of characters of an invite code.
+const CodeLength = 10
+
+// codeAlphabet leaves out characters that are easily mistaken for each other (0/O, 1/I).
+// It has 32 characters, so every character carries 5 bits and a code 50 bits.
+const codeAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// NewCode returns a random invite code.
+func NewCode() string {
+	b := make([]byte, CodeLength)
+	if _, err := rand.Read(b); err != nil {
+		panic(fmt.Sprintf("failed to generate invite code: %v", err))
+	}
+	code := make([]byte, CodeLength)
+	for i, v := range b {
+		code[i] = codeAlphabet[int(v)%len(codeAlphabet)]
+	}
+	return string(code)
+}
+
+// Normalize turns a code as typed by a player into the form NewCode returns: upper
+// case, without spaces or dashes.
+func Normalize(code string) string {
+	code = strings.ToUpper(code)
+	return strings.NewReplacer(" ", "", "-", "").Replace(code)
+}
+
+// MatchCode reports whether a code typed by a player is the given invite code.
+func MatchCode(code, typed string) bool {
+	if code == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(code), []byte(Normalize(typed))) == 1
+}
+
+const (
+	hashScheme     = "pbkdf2-sha256"
+	hashIterations = 100000
+	saltLength     = 16
+	keyLength      = 32
+)
+
+// HashPassword returns a salted hash of the password to be stored in place of it.
+func HashPassword(password string) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key, err := pbkdf2.Key(sha256.New, password, salt, hashIterations, keyLength)
+	if err != nil {
+		return "", err
+	}
+	enc := base64.RawStdEncoding
+	return strings.Join([]string{hashScheme, strconv.Itoa(hashIterations), enc.EncodeToString(salt), enc.EncodeToString(key)}, "$"), nil
+}
+
+// CheckPassword reports whether the password matches a hash returned by HashPassword.
+func CheckPassword(hash, password string) bool {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 4 || parts[0] != hashScheme {
+		return false
+	}
+	iterations, err := strconv.Atoi(parts[1])
+	if err != nil || iterations < 1 {
+		return false
+	}
+	enc := base64.RawStdEncoding
+	salt, err := enc.DecodeString(parts[2])
+	if err != nil {
+		return false
+	}
+	want, err := enc.DecodeString(parts[3])
+	if err != nil {
+		return false
+	}
+	key, err := pbkdf2.Key(sha256.New, password, salt, iterations, len(want))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(key, want) == 1
+}
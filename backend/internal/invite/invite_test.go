@@ -0,0 +1,63 @@
+package invite
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewCode(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		code := NewCode()
+		if len(code) != CodeLength {
+			t.Fatalf("expected a code of %d characters, got %q", CodeLength, code)
+		}
+		for _, c := range code {
+			if !strings.ContainsRune(codeAlphabet, c) {
+				t.Fatalf("unexpected character %q in %q", c, code)
+			}
+		}
+		if seen[code] {
+			t.Fatalf("code %q was generated twice", code)
+		}
+		seen[code] = true
+	}
+}
+
+func TestMatchCode(t *testing.T) {
+	if !MatchCode("ABCDE23456", "abcde-23456") {
+		t.Errorf("expected a code typed in lower case with a dash to match")
+	}
+	if !MatchCode("ABCDE23456", " ABCDE 23456 ") {
+		t.Errorf("expected spaces to be ignored")
+	}
+	if MatchCode("ABCDE23456", "ABCDE23457") {
+		t.Errorf("expected a different code not to match")
+	}
+	if MatchCode("", "") {
+		t.Errorf("expected an empty code never to match")
+	}
+}
+
+func TestPassword(t *testing.T) {
+	hash, err := HashPassword("secret")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(hash, "secret") {
+		t.Errorf("expected the hash not to contain the password: %s", hash)
+	}
+	if !CheckPassword(hash, "secret") {
+		t.Errorf("expected the password to match its hash")
+	}
+	if CheckPassword(hash, "Secret") {
+		t.Errorf("expected a different password not to match")
+	}
+	// The same password hashes differently with a different salt.
+	if other, _ := HashPassword("secret"); other == hash {
+		t.Errorf("expected a fresh salt for every hash")
+	}
+	if CheckPassword("plain", "plain") {
+		t.Errorf("expected a malformed hash never to match")
+	}
+}
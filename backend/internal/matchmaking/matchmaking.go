@@ -0,0 +1,106 @@
+package matchmaking
+
+import (
+	"math"
+	"time"
+)
+
+// Ticket is a player waiting in the queue. An empty Mode or a zero CardCount means
+// the player has no preference.
+type Ticket struct {
+	ID        string
+	Name      string
+	Mode      string
+	CardCount int
+	// Rating is the player's skill rating, used to group players of similar skill.
+	Rating   float64
+	QueuedAt time.Time
+	// UserToken identifies the registered user behind the ticket, if any.
+	UserToken string
+}
+
+// Group is a set of tickets to be put into one game with the given settings.
+// Settings nobody in the group asked for are left empty.
+type Group struct {
+	Mode      string
+	CardCount int
+	Tickets   []Ticket
+}
+
+// Config controls how many players go into a game and how long they wait for a full one.
+type Config struct {
+	// GameSize is the number of players that forms a game right away.
+	GameSize int
+	// MinPlayers is the smallest game formed once MaxWait has passed.
+	MinPlayers int
+	// MaxWait is how long the longest waiting player of a group waits for a full game.
+	MaxWait time.Duration
+	// RatingRange is how far apart in rating the players of a group may start out. It
+	// widens with the wait of the group's first ticket, doubling at MaxWait. Zero
+	// ignores ratings.
+	RatingRange float64
+}
+
+// ratingRange is how far from the rating of a ticket that has waited for the given
+// time other players may be to share its game.
+func (c Config) ratingRange(waited time.Duration) float64 {
+	if c.MaxWait <= 0 {
+		return c.RatingRange
+	}
+	return c.RatingRange * (1 + float64(waited)/float64(c.MaxWait))
+}
+
+// compatible reports whether a ticket can join a group with the given settings.
+func compatible(t Ticket, mode string, cardCount int) bool {
+	return (t.Mode == "" || mode == "" || t.Mode == mode) &&
+		(t.CardCount == 0 || cardCount == 0 || t.CardCount == cardCount)
+}
+
+// Form groups the tickets into games. Tickets are taken in queue order: the longest
+// waiting ticket starts a group and is joined by the next tickets whose preferences
+// agree with everyone already in it and whose rating is within range of its own. A
+// group becomes a game when it is full, or when its first ticket has waited MaxWait
+// and it has at least MinPlayers. Tickets left over are returned in queue order.
+func (c Config) Form(tickets []Ticket, now time.Time) (groups []Group, waiting []Ticket) {
+	used := make([]bool, len(tickets))
+	for i, first := range tickets {
+		if used[i] {
+			continue
+		}
+		group := Group{Mode: first.Mode, CardCount: first.CardCount, Tickets: []Ticket{first}}
+		members := []int{i}
+		maxDiff := c.ratingRange(now.Sub(first.QueuedAt))
+		for j := i + 1; j < len(tickets) && len(members) < c.GameSize; j++ {
+			t := tickets[j]
+			if used[j] || !compatible(t, group.Mode, group.CardCount) {
+				continue
+			}
+			if c.RatingRange > 0 && math.Abs(t.Rating-first.Rating) > maxDiff {
+				continue
+			}
+			if group.Mode == "" {
+				group.Mode = t.Mode
+			}
+			if group.CardCount == 0 {
+				group.CardCount = t.CardCount
+			}
+			group.Tickets = append(group.Tickets, t)
+			members = append(members, j)
+		}
+		full := len(members) >= c.GameSize
+		timedOut := now.Sub(first.QueuedAt) >= c.MaxWait && len(members) >= c.MinPlayers
+		if !full && !timedOut {
+			continue
+		}
+		for _, m := range members {
+			used[m] = true
+		}
+		groups = append(groups, group)
+	}
+	for i, t := range tickets {
+		if !used[i] {
+			waiting = append(waiting, t)
+		}
+	}
+	return groups, waiting
+}
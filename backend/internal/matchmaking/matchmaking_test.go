@@ -0,0 +1,93 @@
+package matchmaking
+
+import (
+	"testing"
+	"time"
+)
+
+var config = Config{GameSize: 3, MinPlayers: 2, MaxWait: 30 * time.Second}
+
+func TestFormFullGame(t *testing.T) {
+	now := time.Now()
+	tickets := []Ticket{
+		{ID: "a", QueuedAt: now},
+		{ID: "b", Mode: "SPEED", QueuedAt: now},
+		{ID: "c", Mode: "NORMAL", QueuedAt: now},
+		{ID: "d", CardCount: 10, QueuedAt: now},
+		{ID: "e", Mode: "SPEED", CardCount: 20, QueuedAt: now},
+	}
+	groups, waiting := config.Form(tickets, now)
+	if len(groups) != 1 {
+		t.Fatalf("expected one game, got %+v", groups)
+	}
+	// c wants NORMAL, so it is not put into the SPEED group.
+	g := groups[0]
+	if g.Mode != "SPEED" || g.CardCount != 10 || len(g.Tickets) != 3 {
+		t.Errorf("unexpected group: %+v", g)
+	}
+	for i, id := range []string{"a", "b", "d"} {
+		if g.Tickets[i].ID != id {
+			t.Errorf("expected ticket %s at %d, got %s", id, i, g.Tickets[i].ID)
+		}
+	}
+	if len(waiting) != 2 || waiting[0].ID != "c" || waiting[1].ID != "e" {
+		t.Errorf("unexpected waiting tickets: %+v", waiting)
+	}
+}
+
+func TestFormAfterTimeout(t *testing.T) {
+	now := time.Now()
+	tickets := []Ticket{
+		{ID: "a", Mode: "SPEED", QueuedAt: now.Add(-time.Minute)},
+		{ID: "b", Mode: "NORMAL", QueuedAt: now},
+		{ID: "c", QueuedAt: now},
+	}
+	if groups, _ := config.Form(tickets[:1], now); len(groups) != 0 {
+		t.Errorf("expected a single player to keep waiting, got %+v", groups)
+	}
+	groups, waiting := config.Form(tickets, now)
+	if len(groups) != 1 || len(groups[0].Tickets) != 2 || groups[0].Tickets[1].ID != "c" {
+		t.Fatalf("expected a and c to play after the timeout, got %+v", groups)
+	}
+	if len(waiting) != 1 || waiting[0].ID != "b" {
+		t.Errorf("expected b to keep waiting, got %+v", waiting)
+	}
+}
+
+func TestFormByRating(t *testing.T) {
+	rated := Config{GameSize: 2, MinPlayers: 2, MaxWait: 30 * time.Second, RatingRange: 100}
+	now := time.Now()
+	tickets := []Ticket{
+		{ID: "a", Rating: 1500, QueuedAt: now},
+		{ID: "b", Rating: 1800, QueuedAt: now},
+		{ID: "c", Rating: 1580, QueuedAt: now},
+		{ID: "d", Rating: 1700, QueuedAt: now},
+	}
+	groups, waiting := rated.Form(tickets, now)
+	if len(groups) != 2 {
+		t.Fatalf("expected two games, got %+v", groups)
+	}
+	// Players with close ratings are paired.
+	if groups[0].Tickets[0].ID != "a" || groups[0].Tickets[1].ID != "c" {
+		t.Errorf("expected a to play c, got %+v", groups[0].Tickets)
+	}
+	if groups[1].Tickets[0].ID != "b" || groups[1].Tickets[1].ID != "d" {
+		t.Errorf("expected b to play d, got %+v", groups[1].Tickets)
+	}
+	if len(waiting) != 0 {
+		t.Errorf("expected nobody to wait, got %+v", waiting)
+	}
+
+	// The longer a player waits, the wider the rating range they can be paired with.
+	far := []Ticket{
+		{ID: "a", Rating: 1500, QueuedAt: now},
+		{ID: "b", Rating: 1650, QueuedAt: now},
+	}
+	if groups, _ := rated.Form(far, now); len(groups) != 0 {
+		t.Errorf("expected players 150 apart to wait, got %+v", groups)
+	}
+	far[0].QueuedAt = now.Add(-20 * time.Second)
+	if groups, _ := rated.Form(far, now); len(groups) != 1 {
+		t.Errorf("expected the range to widen with the wait, got %+v", groups)
+	}
+}
@@ -0,0 +1,65 @@
+package rating
+
+import "math"
+
+// Initial is the rating of a player who has not finished a rated game yet.
+const Initial = 1500.0
+
+// K is the largest change a single opponent can make to a rating in one game.
+const K = 32.0
+
+// Standing is a player's rating before a game and where they finished in it.
+// A lower Place is better; players with the same Place drew with each other.
+type Standing struct {
+	Rating float64
+	Place  int
+}
+
+// Expected returns the chance of a player rated a beating a player rated b.
+func Expected(a, b float64) float64 {
+	return 1 / (1 + math.Pow(10, (b-a)/400))
+}
+
+// Update returns the new ratings after a game, in the order of the standings. A game
+// of n players is scored as n-1 head-to-head Elo games per player against everyone
+// else, with K shared between them so that one game moves a rating as far as a
+// two-player game would. The changes always add up to zero.
+func Update(standings []Standing) []float64 {
+	ratings := make([]float64, len(standings))
+	n := len(standings)
+	for i, s := range standings {
+		ratings[i] = s.Rating
+		if n < 2 {
+			continue
+		}
+		delta := 0.0
+		for j, o := range standings {
+			if i == j {
+				continue
+			}
+			score := 0.5
+			if s.Place < o.Place {
+				score = 1
+			} else if s.Place > o.Place {
+				score = 0
+			}
+			delta += score - Expected(s.Rating, o.Rating)
+		}
+		ratings[i] += K / float64(n-1) * delta
+	}
+	return ratings
+}
+
+// Places ranks the scores from the highest, giving equal scores the same place.
+func Places(scores []int) []int {
+	places := make([]int, len(scores))
+	for i, s := range scores {
+		places[i] = 1
+		for _, o := range scores {
+			if o > s {
+				places[i]++
+			}
+		}
+	}
+	return places
+}
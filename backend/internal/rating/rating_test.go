@@ -0,0 +1,58 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUpdateTwoPlayers(t *testing.T) {
+	ratings := Update([]Standing{{Rating: Initial, Place: 1}, {Rating: Initial, Place: 2}})
+	if ratings[0] != Initial+K/2 || ratings[1] != Initial-K/2 {
+		t.Errorf("expected an even game to move %v points, got %v", K/2, ratings)
+	}
+	// A favourite winning moves the ratings only a little.
+	ratings = Update([]Standing{{Rating: 1900, Place: 1}, {Rating: 1500, Place: 2}})
+	if gain := ratings[0] - 1900; gain <= 0 || gain >= 4 {
+		t.Errorf("expected a small gain for the favourite, got %v", gain)
+	}
+}
+
+func TestUpdateMultiplayer(t *testing.T) {
+	standings := []Standing{
+		{Rating: 1500, Place: 2},
+		{Rating: 1600, Place: 1},
+		{Rating: 1400, Place: 2},
+		{Rating: 1550, Place: 4},
+	}
+	ratings := Update(standings)
+	sum := 0.0
+	for i, r := range ratings {
+		sum += r - standings[i].Rating
+	}
+	if math.Abs(sum) > 1e-9 {
+		t.Errorf("expected the changes to add up to zero, got %v", sum)
+	}
+	if ratings[1] <= 1600 || ratings[3] >= 1550 {
+		t.Errorf("expected the winner to gain and the last to lose, got %v", ratings)
+	}
+	// Tied players count as a draw, so the lower-rated one of a tied pair gains more.
+	if ratings[2]-1400 <= ratings[0]-1500 {
+		t.Errorf("expected the lower rated of a tie to gain more, got %v", ratings)
+	}
+}
+
+func TestUpdateSinglePlayer(t *testing.T) {
+	if ratings := Update([]Standing{{Rating: 1234, Place: 1}}); ratings[0] != 1234 {
+		t.Errorf("expected a lone player to keep their rating, got %v", ratings)
+	}
+}
+
+func TestPlaces(t *testing.T) {
+	places := Places([]int{5, 9, 5, 1})
+	want := []int{2, 1, 2, 4}
+	for i := range want {
+		if places[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, places)
+		}
+	}
+}
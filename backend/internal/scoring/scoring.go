@@ -0,0 +1,71 @@
+package scoring
+
+import (
+	"errors"
+	"time"
+)
+
+// Rules describes how answers are scored in a game.
+type Rules struct {
+	// CorrectPoints is awarded for a correct answer.
+	CorrectPoints int `json:"correct_points"`
+	// WrongPenalty is taken for a wrong answer unless LockoutSeconds is set.
+	WrongPenalty int `json:"wrong_penalty"`
+	// LockoutSeconds locks a player out after a wrong answer instead of the penalty.
+	LockoutSeconds int `json:"lockout_seconds"`
+	// SpeedBonusPoints is the extra score for an instant answer.
+	// It decreases linearly to zero over SpeedBonusWindowMs.
+	SpeedBonusPoints   int `json:"speed_bonus_points"`
+	SpeedBonusWindowMs int `json:"speed_bonus_window_ms"`
+	// StreakBonusPercent multiplies the points of a correct answer by this percentage
+	// for each consecutive correct answer before it, up to MaxStreak answers (0 means no cap).
+	StreakBonusPercent int `json:"streak_bonus_percent"`
+	MaxStreak          int `json:"max_streak"`
+}
+
+// Default returns the classic rules: +1 for a correct answer and -1 for a wrong one.
+func Default() Rules {
+	return Rules{CorrectPoints: 1, WrongPenalty: 1}
+}
+
+// Validate reports whether the rules can be played with.
+func (r Rules) Validate() error {
+	if r.CorrectPoints < 1 {
+		return errors.New("correct points must be at least 1")
+	}
+	if r.WrongPenalty < 0 || r.LockoutSeconds < 0 || r.SpeedBonusPoints < 0 || r.StreakBonusPercent < 0 || r.MaxStreak < 0 {
+		return errors.New("scoring values must not be negative")
+	}
+	if r.SpeedBonusPoints > 0 && r.SpeedBonusWindowMs <= 0 {
+		return errors.New("speed bonus needs a positive window")
+	}
+	return nil
+}
+
+// Lockout returns how long a player is locked out after a wrong answer.
+func (r Rules) Lockout() time.Duration {
+	return time.Duration(r.LockoutSeconds) * time.Second
+}
+
+// Points returns the score change for an answer given the server-measured reaction time
+// and the number of consecutive correct answers the player made before it.
+func (r Rules) Points(correct bool, reaction time.Duration, streak int) int {
+	if !correct {
+		if r.LockoutSeconds > 0 {
+			return 0
+		}
+		return -r.WrongPenalty
+	}
+
+	points := r.CorrectPoints
+	if window := time.Duration(r.SpeedBonusWindowMs) * time.Millisecond; r.SpeedBonusPoints > 0 && reaction < window {
+		if reaction < 0 {
+			reaction = 0
+		}
+		points += int(int64(r.SpeedBonusPoints) * int64(window-reaction) / int64(window))
+	}
+	if r.MaxStreak > 0 && streak > r.MaxStreak {
+		streak = r.MaxStreak
+	}
+	return points * (100 + r.StreakBonusPercent*streak) / 100
+}
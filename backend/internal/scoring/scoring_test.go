@@ -0,0 +1,71 @@
+package scoring
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultPoints(t *testing.T) {
+	r := Default()
+	if err := r.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p := r.Points(true, time.Second, 5); p != 1 {
+		t.Errorf("expected 1 point for a correct answer, got %d", p)
+	}
+	if p := r.Points(false, time.Second, 5); p != -1 {
+		t.Errorf("expected -1 point for a wrong answer, got %d", p)
+	}
+}
+
+func TestPoints(t *testing.T) {
+	r := Rules{
+		CorrectPoints:      10,
+		WrongPenalty:       5,
+		SpeedBonusPoints:   10,
+		SpeedBonusWindowMs: 2000,
+		StreakBonusPercent: 50,
+		MaxStreak:          2,
+	}
+	if err := r.Validate(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		correct  bool
+		reaction time.Duration
+		streak   int
+		want     int
+	}{
+		{"instant", true, 0, 0, 20},
+		{"half window", true, time.Second, 0, 15},
+		{"too slow for bonus", true, 3 * time.Second, 0, 10},
+		{"streak", true, 3 * time.Second, 1, 15},
+		{"streak capped", true, 3 * time.Second, 10, 20},
+		{"wrong", false, 0, 3, -5},
+	}
+	for _, tt := range tests {
+		if got := r.Points(tt.correct, tt.reaction, tt.streak); got != tt.want {
+			t.Errorf("%s: expected %d points, got %d", tt.name, tt.want, got)
+		}
+	}
+
+	r.LockoutSeconds = 3
+	if p := r.Points(false, 0, 0); p != 0 {
+		t.Errorf("expected no penalty with a lockout, got %d", p)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := []Rules{
+		{},
+		{CorrectPoints: 1, WrongPenalty: -1},
+		{CorrectPoints: 1, SpeedBonusPoints: 5},
+	}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("expected %+v to be invalid", r)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package series
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Format is how the length of a series is counted.
+type Format string
+
+const (
+	// BestOf plays at most Length games; the first to win a majority takes the series.
+	BestOf Format = "BEST_OF"
+	// FirstTo plays until someone has won Length games.
+	FirstTo Format = "FIRST_TO"
+)
+
+// MaxLength bounds the length of a series.
+const MaxLength = 15
+
+// Rules describes when a series is over.
+type Rules struct {
+	Format Format
+	Length int
+}
+
+// Validate reports whether the series can be played.
+func (r Rules) Validate() error {
+	switch r.Format {
+	case BestOf:
+		if r.Length < 1 || r.Length > MaxLength || r.Length%2 == 0 {
+			return fmt.Errorf("best of must be an odd number between 1 and %d", MaxLength)
+		}
+	case FirstTo:
+		if r.Length < 1 || r.Length > MaxLength {
+			return fmt.Errorf("first to must be between 1 and %d", MaxLength)
+		}
+	default:
+		return fmt.Errorf("unknown series format: %s", r.Format)
+	}
+	return nil
+}
+
+// WinsNeeded returns the number of game wins that takes the series.
+func (r Rules) WinsNeeded() int {
+	if r.Format == BestOf {
+		return r.Length/2 + 1
+	}
+	return r.Length
+}
+
+// Standing is the number of games a player has won in a series.
+type Standing struct {
+	ID   int // identifies the player across the games of the series; names may repeat
+	Name string
+	Wins int
+}
+
+// Standings orders the players of a series by wins, ties keeping the given order.
+func Standings(players []Standing) []Standing {
+	standings := append([]Standing(nil), players...)
+	sort.SliceStable(standings, func(i, j int) bool { return standings[i].Wins > standings[j].Wins })
+	return standings
+}
+
+// Winner returns the player who has taken the series, if any.
+func (r Rules) Winner(standings []Standing) (string, bool) {
+	if len(standings) > 0 && standings[0].Wins >= r.WinsNeeded() {
+		return standings[0].Name, true
+	}
+	return "", false
+}
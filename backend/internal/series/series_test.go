@@ -0,0 +1,46 @@
+package series
+
+import "testing"
+
+func TestValidate(t *testing.T) {
+	valid := []Rules{{BestOf, 1}, {BestOf, 5}, {FirstTo, 2}}
+	for _, r := range valid {
+		if err := r.Validate(); err != nil {
+			t.Errorf("unexpected error for %+v: %v", r, err)
+		}
+	}
+	invalid := []Rules{{BestOf, 4}, {BestOf, 0}, {FirstTo, MaxLength + 1}, {"ROUND_ROBIN", 3}}
+	for _, r := range invalid {
+		if err := r.Validate(); err == nil {
+			t.Errorf("expected an error for %+v", r)
+		}
+	}
+}
+
+func TestWinsNeeded(t *testing.T) {
+	if n := (Rules{BestOf, 3}).WinsNeeded(); n != 2 {
+		t.Errorf("expected 2 wins for best of 3, got %d", n)
+	}
+	if n := (Rules{FirstTo, 5}).WinsNeeded(); n != 5 {
+		t.Errorf("expected 5 wins for first to 5, got %d", n)
+	}
+}
+
+func TestStandings(t *testing.T) {
+	r := Rules{BestOf, 3}
+	standings := Standings([]Standing{{1, "a", 0}, {2, "b", 1}, {3, "a", 1}})
+	if len(standings) != 3 || standings[0] != (Standing{2, "b", 1}) || standings[1] != (Standing{3, "a", 1}) || standings[2] != (Standing{1, "a", 0}) {
+		t.Errorf("unexpected standings: %+v", standings)
+	}
+	if _, ok := r.Winner(standings); ok {
+		t.Errorf("expected the series to go on")
+	}
+
+	standings = Standings([]Standing{{1, "a", 1}, {2, "b", 2}})
+	if standings[0] != (Standing{2, "b", 2}) {
+		t.Errorf("expected b to lead with 2 wins, got %+v", standings)
+	}
+	if winner, ok := r.Winner(standings); !ok || winner != "b" {
+		t.Errorf("expected b to take the series, got %q (decided=%v)", winner, ok)
+	}
+}
@@ -0,0 +1,242 @@
+package tournament
+
+import "fmt"
+
+// Format is the elimination format of a bracket.
+type Format string
+
+const (
+	SingleElimination Format = "SINGLE_ELIMINATION"
+	DoubleElimination Format = "DOUBLE_ELIMINATION"
+)
+
+// Side is the part of a bracket a match belongs to.
+type Side string
+
+const (
+	Winners    Side = "WINNERS"
+	Losers     Side = "LOSERS"
+	GrandFinal Side = "GRAND_FINAL"
+)
+
+// MaxEntrants is the largest field a bracket can be built for.
+const MaxEntrants = 64
+
+// Source tells where the entrant of a match slot comes from.
+type Source struct {
+	// Seed is the entrant seeded into the slot (1 is the top seed). Seeds above the
+	// number of entrants are byes.
+	Seed int
+	// From is the match whose winner (or loser, if Loser is set) fills the slot; it is
+	// only used when Seed is 0.
+	From  int
+	Loser bool
+}
+
+// Match is one game of a bracket. Matches only take entrants from earlier matches.
+type Match struct {
+	Number  int
+	Side    Side
+	Round   int
+	Sources [2]Source
+}
+
+// Bracket is the shape of a tournament for a number of entrants.
+type Bracket struct {
+	Format   Format
+	Entrants int
+	Matches  []Match
+}
+
+// Results holds the seed of the winner of every decided match by match number.
+// A decided match with no entrant at all has a winner of 0.
+type Results map[int]int
+
+// Build lays out a bracket for n entrants seeded 1 to n. The field is padded to a power
+// of two with byes given to the top seeds. A double elimination bracket ends with a
+// single grand final between the winners and losers bracket champions.
+func Build(format Format, n int) (Bracket, error) {
+	if format != SingleElimination && format != DoubleElimination {
+		return Bracket{}, fmt.Errorf("unknown format: %s", format)
+	}
+	if n < 2 || n > MaxEntrants {
+		return Bracket{}, fmt.Errorf("a bracket needs between 2 and %d entrants", MaxEntrants)
+	}
+	b := Bracket{Format: format, Entrants: n}
+	size := 1
+	for size < n {
+		size *= 2
+	}
+
+	// Winners side: round 1 is laid out in seed order; later rounds pair the winners of the previous round.
+	var winners [][]int
+	var round []int
+	order := seedOrder(size)
+	for i := 0; i < size; i += 2 {
+		round = append(round, b.add(Winners, 1, Source{Seed: order[i]}, Source{Seed: order[i+1]}))
+	}
+	winners = append(winners, round)
+	for len(round) > 1 {
+		var next []int
+		for i := 0; i < len(round); i += 2 {
+			next = append(next, b.add(Winners, len(winners)+1, winnerOf(round[i]), winnerOf(round[i+1])))
+		}
+		round = next
+		winners = append(winners, round)
+	}
+	if format == SingleElimination {
+		return b, nil
+	}
+
+	final := winners[len(winners)-1][0]
+	if len(winners) == 1 {
+		b.add(GrandFinal, 1, winnerOf(final), loserOf(final))
+		return b, nil
+	}
+	// Losers side: it starts with the round-1 losers, then alternates between rounds where
+	// the losers of each winners round drop in and rounds that narrow down the survivors.
+	var losers []int
+	lround := 1
+	first := winners[0]
+	for i := 0; i < len(first); i += 2 {
+		losers = append(losers, b.add(Losers, lround, loserOf(first[i]), loserOf(first[i+1])))
+	}
+	for r := 1; r < len(winners); r++ {
+		lround++
+		dropping := winners[r]
+		var next []int
+		for i := range losers {
+			// Pair dropping losers in reverse order so early rematches are avoided.
+			next = append(next, b.add(Losers, lround, winnerOf(losers[i]), loserOf(dropping[len(dropping)-1-i])))
+		}
+		losers = next
+		if len(losers) > 1 {
+			lround++
+			next = nil
+			for i := 0; i < len(losers); i += 2 {
+				next = append(next, b.add(Losers, lround, winnerOf(losers[i]), winnerOf(losers[i+1])))
+			}
+			losers = next
+		}
+	}
+	b.add(GrandFinal, 1, winnerOf(final), winnerOf(losers[0]))
+	return b, nil
+}
+
+func (b *Bracket) add(side Side, round int, s1, s2 Source) int {
+	number := len(b.Matches) + 1
+	b.Matches = append(b.Matches, Match{Number: number, Side: side, Round: round, Sources: [2]Source{s1, s2}})
+	return number
+}
+
+func winnerOf(match int) Source { return Source{From: match} }
+
+func loserOf(match int) Source { return Source{From: match, Loser: true} }
+
+// seedOrder returns the seeds of a field of the given size in bracket order, so that
+// the top seeds can only meet in the late rounds.
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		n := len(order) * 2
+		var next []int
+		for _, s := range order {
+			next = append(next, s, n+1-s)
+		}
+		order = next
+	}
+	return order
+}
+
+// Match returns the match with the given number.
+func (b Bracket) Match(number int) (Match, bool) {
+	if number < 1 || number > len(b.Matches) {
+		return Match{}, false
+	}
+	return b.Matches[number-1], true
+}
+
+// Seeds returns the seeds in both slots of a match. A slot is 0 if it is still
+// undecided or empty; ok is false while either slot is undecided.
+func (b Bracket) Seeds(results Results, number int) (seeds [2]int, ok bool) {
+	m, found := b.Match(number)
+	if !found {
+		return seeds, false
+	}
+	ok = true
+	for i, src := range m.Sources {
+		seed, decided := b.resolve(results, src)
+		seeds[i] = seed
+		ok = ok && decided
+	}
+	return seeds, ok
+}
+
+func (b Bracket) resolve(results Results, src Source) (int, bool) {
+	if src.Seed > 0 {
+		if src.Seed > b.Entrants {
+			return 0, true
+		}
+		return src.Seed, true
+	}
+	winner, decided := results[src.From]
+	if !decided {
+		return 0, false
+	}
+	if !src.Loser {
+		return winner, true
+	}
+	seeds, _ := b.Seeds(results, src.From)
+	if seeds[0] == winner {
+		return seeds[1], true
+	}
+	return seeds[0], true
+}
+
+// Advance decides every match that has fewer than two entrants, adding the byes to
+// results, and returns the numbers of the matches that are ready to be played.
+func (b Bracket) Advance(results Results) (ready []int) {
+	for _, m := range b.Matches {
+		if _, decided := results[m.Number]; decided {
+			continue
+		}
+		seeds, ok := b.Seeds(results, m.Number)
+		if !ok {
+			continue
+		}
+		switch {
+		case seeds[0] != 0 && seeds[1] != 0:
+			ready = append(ready, m.Number)
+		case seeds[0] != 0:
+			results[m.Number] = seeds[0]
+		default:
+			results[m.Number] = seeds[1]
+		}
+	}
+	return ready
+}
+
+// Record stores the winner of a ready match.
+func (b Bracket) Record(results Results, number int, winner int) error {
+	if _, decided := results[number]; decided {
+		return fmt.Errorf("match %d is already decided", number)
+	}
+	seeds, ok := b.Seeds(results, number)
+	if !ok {
+		return fmt.Errorf("match %d is not ready", number)
+	}
+	if winner == 0 || (winner != seeds[0] && winner != seeds[1]) {
+		return fmt.Errorf("seed %d is not playing match %d", winner, number)
+	}
+	results[number] = winner
+	return nil
+}
+
+// Champion returns the seed of the tournament winner once the last match is decided.
+func (b Bracket) Champion(results Results) (int, bool) {
+	if len(b.Matches) == 0 {
+		return 0, false
+	}
+	winner, decided := results[len(b.Matches)]
+	return winner, decided
+}
@@ -0,0 +1,131 @@
+package tournament
+
+import "testing"
+
+// play decides every ready match in favour of the better seed until the bracket is done.
+func play(t *testing.T, b Bracket, results Results) {
+	t.Helper()
+	for i := 0; i < len(b.Matches); i++ {
+		ready := b.Advance(results)
+		if len(ready) == 0 {
+			return
+		}
+		for _, number := range ready {
+			seeds, _ := b.Seeds(results, number)
+			winner := seeds[0]
+			if seeds[1] < winner {
+				winner = seeds[1]
+			}
+			if err := b.Record(results, number, winner); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	if _, err := Build(SingleElimination, 1); err == nil {
+		t.Errorf("expected an error for a single entrant")
+	}
+	if _, err := Build(SingleElimination, MaxEntrants+1); err == nil {
+		t.Errorf("expected an error for too many entrants")
+	}
+	if _, err := Build("ROUND_ROBIN", 4); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
+
+func TestSingleEliminationByes(t *testing.T) {
+	b, err := Build(SingleElimination, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(b.Matches) != 7 {
+		t.Fatalf("expected 7 matches for a field of 8, got %d", len(b.Matches))
+	}
+	results := Results{}
+	ready := b.Advance(results)
+	// Only seeds 4 and 5 play in round 1. The top three seeds get byes, and seeds 2 and 3 meet straight away in round 2.
+	if len(ready) != 2 {
+		t.Fatalf("expected two playable matches, got %v", ready)
+	}
+	if seeds, _ := b.Seeds(results, ready[1]); seeds != [2]int{2, 3} {
+		t.Errorf("expected seeds 2 and 3 to play the second round, got %v", seeds)
+	}
+	if seeds, _ := b.Seeds(results, ready[0]); seeds != [2]int{4, 5} {
+		t.Errorf("expected seeds 4 and 5 to play first, got %v", seeds)
+	}
+	if err := b.Record(results, ready[0], 3); err == nil {
+		t.Errorf("expected an error for a seed not in the match")
+	}
+	play(t, b, results)
+	if champion, ok := b.Champion(results); !ok || champion != 1 {
+		t.Errorf("expected seed 1 to win, got %d (decided=%v)", champion, ok)
+	}
+}
+
+func TestDoubleElimination(t *testing.T) {
+	for _, n := range []int{2, 3, 4, 6, 8, 13} {
+		b, err := Build(DoubleElimination, n)
+		if err != nil {
+			t.Fatalf("unexpected error for %d entrants: %v", n, err)
+		}
+		if last := b.Matches[len(b.Matches)-1]; last.Side != GrandFinal {
+			t.Errorf("expected the bracket of %d to end with the grand final, got %s", n, last.Side)
+		}
+		results := Results{}
+		play(t, b, results)
+		champion, ok := b.Champion(results)
+		if !ok || champion != 1 {
+			t.Errorf("expected seed 1 to win a field of %d, got %d (decided=%v)", n, champion, ok)
+		}
+		// Everyone but the champion stays in until their second loss.
+		losses := make(map[int]int)
+		for _, m := range b.Matches {
+			seeds, _ := b.Seeds(results, m.Number)
+			if seeds[0] == 0 || seeds[1] == 0 {
+				continue
+			}
+			if results[m.Number] == seeds[0] {
+				losses[seeds[1]]++
+			} else {
+				losses[seeds[0]]++
+			}
+		}
+		for seed := 2; seed <= n; seed++ {
+			if losses[seed] != 2 {
+				t.Errorf("expected seed %d of %d to lose twice, lost %d", seed, n, losses[seed])
+			}
+		}
+	}
+}
+
+func TestLoserDropsIntoLosersBracket(t *testing.T) {
+	b, err := Build(DoubleElimination, 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	results := Results{}
+	ready := b.Advance(results)
+	if len(ready) != 2 {
+		t.Fatalf("expected two first round matches, got %v", ready)
+	}
+	// Seed 1 loses to seed 4.
+	if err := b.Record(results, ready[0], 4); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := b.Record(results, ready[1], 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ready = b.Advance(results)
+	var losersMatch []int
+	for _, number := range ready {
+		if m, _ := b.Match(number); m.Side == Losers {
+			seeds, _ := b.Seeds(results, number)
+			losersMatch = seeds[:]
+		}
+	}
+	if len(losersMatch) != 2 || losersMatch[0] != 1 || losersMatch[1] != 3 {
+		t.Errorf("expected seeds 1 and 3 to meet in the losers bracket, got %v", losersMatch)
+	}
+}
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file game/v1/game.proto.
  */
 export const file_game_v1_game: GenFile = /*@__PURE__*/
-  fileDesc("ChJnYW1lL3YxL2dhbWUucHJvdG8SB2dhbWUudjEiQgoGUGxheWVyEgoKAmlkGAEgASgFEgwKBG5hbWUYAiABKAkSDwoHZ2FtZV9pZBgDIAEoBRINCgVzY29yZRgEIAEoBSI6ChFDcmVhdGVHYW1lUmVxdWVzdBIRCglnYW1lX25hbWUYASABKAkSEgoKY2FyZF9jb3VudBgCIAEoBSIlChJDcmVhdGVHYW1lUmVzcG9uc2USDwoHZ2FtZV9pZBgBIAEoBSIRCg9HZXRHYW1lc1JlcXVlc3QiXAoER2FtZRIKCgJpZBgBIAEoBRIOCgZzdGF0dXMYAiABKAkSDAoEbmFtZRgDIAEoCRIUCgxwbGF5ZXJfY291bnQYBCABKAUSFAoMdG90YWxfcm91bmRzGAUgASgFIjAKEEdldEdhbWVzUmVzcG9uc2USHAoFZ2FtZXMYASADKAsyDS5nYW1lLnYxLkdhbWUiNwoPSm9pbkdhbWVSZXF1ZXN0EhMKC3BsYXllcl9uYW1lGAEgASgJEg8KB2dhbWVfaWQYAiABKAkiMwoQSm9pbkdhbWVSZXNwb25zZRIfCgZwbGF5ZXIYASABKAsyDy5nYW1lLnYxLlBsYXllciI0ChBTdGFydEdhbWVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCSITChFTdGFydEdhbWVSZXNwb25zZSInChJSZXBvcnRSZWFkeVJlcXVlc3QSEQoJcGxheWVyX2lkGAEgASgJIhUKE1JlcG9ydFJlYWR5UmVzcG9uc2UiIAoEQ2FyZBIKCgJpZBgBIAEoBRIMCgR0ZXh0GAIgASgJInQKE1N1Ym1pdEFuc3dlclJlcXVlc3QSEQoJcGxheWVyX2lkGAEgASgJEhwKBWNhcmQxGAIgASgLMg0uZ2FtZS52MS5DYXJkEhwKBWNhcmQyGAMgASgLMg0uZ2FtZS52MS5DYXJkEg4KBmFuc3dlchgEIAEoCSIqChRTdWJtaXRBbnN3ZXJSZXNwb25zZRISCgppc19jb3JyZWN0GAEgASgJIiQKEURlbGV0ZUdhbWVSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkiFAoSRGVsZXRlR2FtZVJlc3BvbnNlMlwKEUNyZWF0ZUdhbWVTZXJ2aWNlEkcKCkNyZWF0ZUdhbWUSGi5nYW1lLnYxLkNyZWF0ZUdhbWVSZXF1ZXN0GhsuZ2FtZS52MS5DcmVhdGVHYW1lUmVzcG9uc2UiADJUCg9HZXRHYW1lc1NlcnZpY2USQQoIR2V0R2FtZXMSGC5nYW1lLnYxLkdldEdhbWVzUmVxdWVzdBoZLmdhbWUudjEuR2V0R2FtZXNSZXNwb25zZSIAMlQKD0pvaW5HYW1lU2VydmljZRJBCghKb2luR2FtZRIYLmdhbWUudjEuSm9pbkdhbWVSZXF1ZXN0GhkuZ2FtZS52MS5Kb2luR2FtZVJlc3BvbnNlIgAyWAoQU3RhcnRHYW1lU2VydmljZRJECglTdGFydEdhbWUSGS5nYW1lLnYxLlN0YXJ0R2FtZVJlcXVlc3QaGi5nYW1lLnYxLlN0YXJ0R2FtZVJlc3BvbnNlIgAyYAoSUmVwb3J0UmVhZHlTZXJ2aWNlEkoKC1JlcG9ydFJlYWR5EhsuZ2FtZS52MS5SZXBvcnRSZWFkeVJlcXVlc3QaHC5nYW1lLnYxLlJlcG9ydFJlYWR5UmVzcG9uc2UiADJkChNTdWJtaXRBbnN3ZXJTZXJ2aWNlEk0KDFN1Ym1pdEFuc3dlchIcLmdhbWUudjEuU3VibWl0QW5zd2VyUmVxdWVzdBodLmdhbWUudjEuU3VibWl0QW5zd2VyUmVzcG9uc2UiADJcChFEZWxldGVHYW1lU2VydmljZRJHCgpEZWxldGVHYW1lEhouZ2FtZS52MS5EZWxldGVHYW1lUmVxdWVzdBobLmdhbWUudjEuRGVsZXRlR2FtZVJlc3BvbnNlIgBCHFoaZXhhbXBsZS9nZW4vZ2FtZS92MTtnYW1ldjFiBnByb3RvMw");
+  fileDesc("ChJnYW1lL3YxL2dhbWUucHJvdG8SB2dhbWUudjEiiQEKBlBsYXllchIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEg8KB2dhbWVfaWQYAyABKAUSDQoFc2NvcmUYBCABKAUSDwoHaXNfaG9zdBgFIAEoCBITCgtzZXJpZXNfd2lucxgGIAEoBRIOCgZpc19ib3QYByABKAgSDwoHdGVhbV9pZBgIIAEoBSJWCghIYW5kaWNhcBIVCg1leHRyYV9zeW1ib2xzGAEgASgFEhcKD2Fuc3dlcl9kZWxheV9tcxgCIAEoBRIaChJtdWx0aXBsaWVyX3BlcmNlbnQYAyABKAUiLwoEVGVhbRIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEg0KBXNjb3JlGAMgASgFItwCChFDcmVhdGVHYW1lUmVxdWVzdBIRCglnYW1lX25hbWUYASABKAkSEgoKY2FyZF9jb3VudBgCIAEoBRIMCgRtb2RlGAMgASgJEhwKFGVsaW1pbmF0aW9uX2ludGVydmFsGAQgASgFEhQKDGNlbnRlcl9jb3VudBgFIAEoBRIRCgl0aWVfYnJlYWsYBiABKAkSJgoHc2NvcmluZxgHIAEoCzIVLmdhbWUudjEuU2NvcmluZ1J1bGVzEicKCHNldHRpbmdzGAggASgLMhUuZ2FtZS52MS5HYW1lU2V0dGluZ3MSEgoKdGVhbV9jb3VudBgJIAEoBRISCgp0ZWFtX25hbWVzGAogAygJEhQKDGF1dG9fYmFsYW5jZRgLIAEoCBIUCgxtYXRjaF9mb3JtYXQYDCABKAkSFAoMbWF0Y2hfbGVuZ3RoGA0gASgFEhAKCHBhc3N3b3JkGA4gASgJImAKDEdhbWVTZXR0aW5ncxITCgttYXhfcGxheWVycxgBIAEoBRITCgttaW5fcGxheWVycxgCIAEoBRISCgp2aXNpYmlsaXR5GAMgASgJEhIKCmF1dG9fc3RhcnQYBCABKAgiwwEKDFNjb3JpbmdSdWxlcxIWCg5jb3JyZWN0X3BvaW50cxgBIAEoBRIVCg13cm9uZ19wZW5hbHR5GAIgASgFEhcKD2xvY2tvdXRfc2Vjb25kcxgDIAEoBRIaChJzcGVlZF9ib251c19wb2ludHMYBCABKAUSHQoVc3BlZWRfYm9udXNfd2luZG93X21zGAUgASgFEhwKFHN0cmVha19ib251c19wZXJjZW50GAYgASgFEhIKCm1heF9zdHJlYWsYByABKAUiTgoSQ3JlYXRlR2FtZVJlc3BvbnNlEg8KB2dhbWVfaWQYASABKAUSEwoLaW52aXRlX2NvZGUYAiABKAkSEgoKaG9zdF90b2tlbhgDIAEoCSIRCg9HZXRHYW1lc1JlcXVlc3Qi7wIKBEdhbWUSCgoCaWQYASABKAUSDgoGc3RhdHVzGAIgASgJEgwKBG5hbWUYAyABKAkSFAoMcGxheWVyX2NvdW50GAQgASgFEhQKDHRvdGFsX3JvdW5kcxgFIAEoBRIMCgRtb2RlGAYgASgJEhIKCnRlYW1fc2NvcmUYByABKAUSJgoHc2NvcmluZxgIIAEoCzIVLmdhbWUudjEuU2NvcmluZ1J1bGVzEicKCHNldHRpbmdzGAkgASgLMhUuZ2FtZS52MS5HYW1lU2V0dGluZ3MSDwoHaG9zdF9pZBgKIAEoBRIYChBwcmV2aW91c19nYW1lX2lkGAsgASgFEhcKD3NwZWN0YXRvcl9jb3VudBgMIAEoBRIcCgV0ZWFtcxgNIAMoCzINLmdhbWUudjEuVGVhbRIUCgxhdXRvX2JhbGFuY2UYDiABKAgSEAoIbWF0Y2hfaWQYDyABKAUSFAoMaGFzX3Bhc3N3b3JkGBAgASgIIjAKEEdldEdhbWVzUmVzcG9uc2USHAoFZ2FtZXMYASADKAsyDS5nYW1lLnYxLkdhbWUirgEKD0pvaW5HYW1lUmVxdWVzdBITCgtwbGF5ZXJfbmFtZRgBIAEoCRIPCgdnYW1lX2lkGAIgASgJEg8KB3RlYW1faWQYAyABKAUSEgoKdXNlcl90b2tlbhgEIAEoCRITCgtpbnZpdGVfY29kZRgFIAEoCRIQCghwYXNzd29yZBgGIAEoCRISCgpob3N0X3Rva2VuGAcgASgJEhUKDWVudHJhbnRfdG9rZW4YCCABKAkiSQoQSm9pbkdhbWVSZXNwb25zZRIfCgZwbGF5ZXIYASABKAsyDy5nYW1lLnYxLlBsYXllchIUCgxyZXN1bWVfdG9rZW4YAiABKAkiSgoQU3RhcnRHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSFAoMcmVzdW1lX3Rva2VuGAMgASgJIhMKEVN0YXJ0R2FtZVJlc3BvbnNlInwKGVVwZGF0ZUdhbWVTZXR0aW5nc1JlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEicKCHNldHRpbmdzGAMgASgLMhUuZ2FtZS52MS5HYW1lU2V0dGluZ3MSFAoMcmVzdW1lX3Rva2VuGAQgASgJIhwKGlVwZGF0ZUdhbWVTZXR0aW5nc1Jlc3BvbnNlIl4KEUtpY2tQbGF5ZXJSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwbGF5ZXJfaWQYAyABKAkSFAoMcmVzdW1lX3Rva2VuGAQgASgJIhQKEktpY2tQbGF5ZXJSZXNwb25zZSJdChBCYW5QbGF5ZXJSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwbGF5ZXJfaWQYAyABKAkSFAoMcmVzdW1lX3Rva2VuGAQgASgJIhMKEUJhblBsYXllclJlc3BvbnNlIm0KEU11dGVQbGF5ZXJSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwbGF5ZXJfaWQYAyABKAkSDQoFbXV0ZWQYBCABKAgSFAoMcmVzdW1lX3Rva2VuGAUgASgJIhQKEk11dGVQbGF5ZXJSZXNwb25zZSJZCg9TZW5kQ2hhdFJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIRCglwbGF5ZXJfaWQYAiABKAkSFAoMcmVzdW1lX3Rva2VuGAMgASgJEgwKBHRleHQYBCABKAkiEgoQU2VuZENoYXRSZXNwb25zZSJRChdSb3RhdGVJbnZpdGVDb2RlUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSFAoMcmVzdW1lX3Rva2VuGAMgASgJIi8KGFJvdGF0ZUludml0ZUNvZGVSZXNwb25zZRITCgtpbnZpdGVfY29kZRgBIAEoCSJvChFDaGFuZ2VUZWFtUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSEQoJcGxheWVyX2lkGAMgASgJEg8KB3RlYW1faWQYBCABKAUSFAoMcmVzdW1lX3Rva2VuGAUgASgJIhQKEkNoYW5nZVRlYW1SZXNwb25zZSKEAQoSU2V0SGFuZGljYXBSZXF1ZXN0Eg8KB2dhbWVfaWQYASABKAkSDwoHdXNlcl9pZBgCIAEoCRIRCglwbGF5ZXJfaWQYAyABKAkSIwoIaGFuZGljYXAYBCABKAsyES5nYW1lLnYxLkhhbmRpY2FwEhQKDHJlc3VtZV90b2tlbhgFIAEoCSIVChNTZXRIYW5kaWNhcFJlc3BvbnNlIkoKEFBhdXNlR2FtZVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhQKDHJlc3VtZV90b2tlbhgDIAEoCSJKChFQYXVzZUdhbWVSZXNwb25zZRIQCghhY2NlcHRlZBgBIAEoCBINCgV2b3RlcxgCIAEoBRIUCgx2b3Rlc19uZWVkZWQYAyABKAUiSwoRUmVzdW1lR2FtZVJlcXVlc3QSDwoHZ2FtZV9pZBgBIAEoCRIPCgd1c2VyX2lkGAIgASgJEhQKDHJlc3VtZV90b2tlbhgDIAEoCSJLChJSZXN1bWVHYW1lUmVzcG9uc2USEAoIYWNjZXB0ZWQYASABKAgSDQoFdm90ZXMYAiABKAUSFAoMdm90ZXNfbmVlZGVkGAMgASgFIlEKFVJlcXVlc3RSZW1hdGNoUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEhEKCXBsYXllcl9pZBgCIAEoCRIUCgxyZXN1bWVfdG9rZW4YAyABKAkiXQoWUmVxdWVzdFJlbWF0Y2hSZXNwb25zZRIPCgdjcmVhdGVkGAEgASgIEhMKC25ld19nYW1lX2lkGAIgASgFEg0KBXZvdGVzGAMgASgFEg4KBnZvdGVycxgEIAEoBSJYCgpCb3RQcm9maWxlEhgKEGFjY3VyYWN5X3BlcmNlbnQYASABKAUSFwoPbWluX3JlYWN0aW9uX21zGAIgASgFEhcKD21heF9yZWFjdGlvbl9tcxgDIAEoBSKKAQoNQWRkQm90UmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJEg8KB3VzZXJfaWQYAiABKAkSDAoEbmFtZRgDIAEoCRINCgVsZXZlbBgEIAEoCRIkCgdwcm9maWxlGAUgASgLMhMuZ2FtZS52MS5Cb3RQcm9maWxlEhQKDHJlc3VtZV90b2tlbhgGIAEoCSIxCg5BZGRCb3RSZXNwb25zZRIfCgZwbGF5ZXIYASABKAsyDy5nYW1lLnYxLlBsYXllciInChJSZXBvcnRSZWFkeVJlcXVlc3QSEQoJcGxheWVyX2lkGAEgASgJIhUKE1JlcG9ydFJlYWR5UmVzcG9uc2UiIAoEQ2FyZBIKCgJpZBgBIAEoBRIMCgR0ZXh0GAIgASgJInQKE1N1Ym1pdEFuc3dlclJlcXVlc3QSEQoJcGxheWVyX2lkGAEgASgJEhwKBWNhcmQxGAIgASgLMg0uZ2FtZS52MS5DYXJkEhwKBWNhcmQyGAMgASgLMg0uZ2FtZS52MS5DYXJkEg4KBmFuc3dlchgEIAEoCSIqChRTdWJtaXRBbnN3ZXJSZXNwb25zZRISCgppc19jb3JyZWN0GAEgASgJIj8KFFN0YXJ0UHJhY3RpY2VSZXF1ZXN0EhMKC3BsYXllcl9uYW1lGAEgASgJEhIKCmNhcmRfY291bnQYAiABKAUikAEKFVN0YXJ0UHJhY3RpY2VSZXNwb25zZRITCgtwcmFjdGljZV9pZBgBIAEoCRIcCgVjYXJkcxgCIAMoCzINLmdhbWUudjEuQ2FyZBISCgpjYXJkX2NvdW50GAMgASgFEhgKEHBlcnNvbmFsX2Jlc3RfbXMYBCABKAMSFgoOcHJhY3RpY2VfdG9rZW4YBSABKAkiWgobU3VibWl0UHJhY3RpY2VBbnN3ZXJSZXF1ZXN0EhMKC3ByYWN0aWNlX2lkGAEgASgJEg4KBmFuc3dlchgCIAEoCRIWCg5wcmFjdGljZV90b2tlbhgDIAEoCSKPAgocU3VibWl0UHJhY3RpY2VBbnN3ZXJSZXNwb25zZRIPCgdjb3JyZWN0GAEgASgIEiAKCW5leHRfY2FyZBgCIAEoCzINLmdhbWUudjEuQ2FyZBITCgtyZWFjdGlvbl9tcxgDIAEoAxIRCglyZW1haW5pbmcYBCABKAUSEAoIZmluaXNoZWQYBSABKAgSEAoIdG90YWxfbXMYBiABKAMSGQoRcmVhY3Rpb25fdGltZXNfbXMYByADKAMSEAoIbWlzdGFrZXMYCCABKAUSFQoNcGVyc29uYWxfYmVzdBgJIAEoCBIYChBwZXJzb25hbF9iZXN0X21zGAogASgDEhIKCmRhaWx5X3JhbmsYCyABKAUiLgoXR2V0UGVyc29uYWxCZXN0c1JlcXVlc3QSEwoLcGxheWVyX25hbWUYASABKAkiXwoMUGVyc29uYWxCZXN0EhIKCmNhcmRfY291bnQYASABKAUSEAoIdG90YWxfbXMYAiABKAMSDgoGcnVuX2lkGAMgASgFEhkKEXJlYWN0aW9uX3RpbWVzX21zGAQgAygDIkAKGEdldFBlcnNvbmFsQmVzdHNSZXNwb25zZRIkCgViZXN0cxgBIAMoCzIVLmdhbWUudjEuUGVyc29uYWxCZXN0IjwKFVN0YXJ0R2hvc3RSYWNlUmVxdWVzdBITCgtwbGF5ZXJfbmFtZRgBIAEoCRIOCgZydW5faWQYAiABKAUimAEKFlN0YXJ0R2hvc3RSYWNlUmVzcG9uc2USDwoHZ2FtZV9pZBgBIAEoBRIfCgZwbGF5ZXIYAiABKAsyDy5nYW1lLnYxLlBsYXllchIUCgxyZXN1bWVfdG9rZW4YAyABKAkSHgoFZ2hvc3QYBCABKAsyDy5nYW1lLnYxLlBsYXllchIWCg5naG9zdF90b3RhbF9tcxgFIAEoAyJFChpTdGFydERhaWx5Q2hhbGxlbmdlUmVxdWVzdBITCgtwbGF5ZXJfbmFtZRgBIAEoCRISCgp1c2VyX3Rva2VuGAIgASgJIpkBChtTdGFydERhaWx5Q2hhbGxlbmdlUmVzcG9uc2USEwoLcHJhY3RpY2VfaWQYASABKAkSHAoFY2FyZHMYAiADKAsyDS5nYW1lLnYxLkNhcmQSEgoKY2FyZF9jb3VudBgDIAEoBRILCgNkYXkYBCABKAkSDgoGcmFua2VkGAUgASgIEhYKDnByYWN0aWNlX3Rva2VuGAYgASgJIjgKGkdldERhaWx5TGVhZGVyYm9hcmRSZXF1ZXN0EgsKA2RheRgBIAEoCRINCgVsaW1pdBgCIAEoBSJeChVEYWlseUxlYWRlcmJvYXJkRW50cnkSDAoEcmFuaxgBIAEoBRITCgtwbGF5ZXJfbmFtZRgCIAEoCRIQCgh0b3RhbF9tcxgDIAEoAxIQCghtaXN0YWtlcxgEIAEoBSJbChtHZXREYWlseUxlYWRlcmJvYXJkUmVzcG9uc2USCwoDZGF5GAEgASgJEi8KB2VudHJpZXMYAiADKAsyHi5nYW1lLnYxLkRhaWx5TGVhZGVyYm9hcmRFbnRyeSJdChBKb2luUXVldWVSZXF1ZXN0EhMKC3BsYXllcl9uYW1lGAEgASgJEgwKBG1vZGUYAiABKAkSEgoKY2FyZF9jb3VudBgDIAEoBRISCgp1c2VyX3Rva2VuGAQgASgJIjQKEUpvaW5RdWV1ZVJlc3BvbnNlEg4KBnRpY2tldBgBIAEoCRIPCgd3YWl0aW5nGAIgASgFIiMKEUxlYXZlUXVldWVSZXF1ZXN0Eg4KBnRpY2tldBgBIAEoCSIUChJMZWF2ZVF1ZXVlUmVzcG9uc2UiRQoEVXNlchIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEg4KBnJhdGluZxgDIAEoARITCgtyYXRlZF9nYW1lcxgEIAEoBSIjChNSZWdpc3RlclVzZXJSZXF1ZXN0EgwKBG5hbWUYASABKAkiQgoUUmVnaXN0ZXJVc2VyUmVzcG9uc2USGwoEdXNlchgBIAEoCzINLmdhbWUudjEuVXNlchINCgV0b2tlbhgCIAEoCSIiChFHZXRSYXRpbmdzUmVxdWVzdBINCgVsaW1pdBgBIAEoBSIyChJHZXRSYXRpbmdzUmVzcG9uc2USHAoFdXNlcnMYASADKAsyDS5nYW1lLnYxLlVzZXIiMgoNTWF0Y2hTdGFuZGluZxITCgtwbGF5ZXJfbmFtZRgBIAEoCRIMCgR3aW5zGAIgASgFIjQKDk1hdGNoR2FtZVNjb3JlEhMKC3BsYXllcl9uYW1lGAEgASgJEg0KBXNjb3JlGAIgASgFImoKCU1hdGNoR2FtZRIPCgdnYW1lX2lkGAEgASgFEg4KBnN0YXR1cxgCIAEoCRITCgt3aW5uZXJfbmFtZRgDIAEoCRInCgZzY29yZXMYBCADKAsyFy5nYW1lLnYxLk1hdGNoR2FtZVNjb3JlIqYBCgVNYXRjaBIKCgJpZBgBIAEoBRIOCgZmb3JtYXQYAiABKAkSDgoGbGVuZ3RoGAMgASgFEg4KBnN0YXR1cxgEIAEoCRITCgt3aW5uZXJfbmFtZRgFIAEoCRIpCglzdGFuZGluZ3MYBiADKAsyFi5nYW1lLnYxLk1hdGNoU3RhbmRpbmcSIQoFZ2FtZXMYByADKAsyEi5nYW1lLnYxLk1hdGNoR2FtZSIjCg9HZXRNYXRjaFJlcXVlc3QSEAoIbWF0Y2hfaWQYASABKAUiMQoQR2V0TWF0Y2hSZXNwb25zZRIdCgVtYXRjaBgBIAEoCzIOLmdhbWUudjEuTWF0Y2giOwoRVG91cm5hbWVudEVudHJhbnQSCgoCaWQYASABKAUSDAoEbmFtZRgCIAEoCRIMCgRzZWVkGAMgASgFItABCg9Ub3VybmFtZW50TWF0Y2gSDgoGbnVtYmVyGAEgASgFEgwKBHNpZGUYAiABKAkSDQoFcm91bmQYAyABKAUSDgoGc3RhdHVzGAQgASgJEiwKCGVudHJhbnQxGAUgASgLMhouZ2FtZS52MS5Ub3VybmFtZW50RW50cmFudBIsCghlbnRyYW50MhgGIAEoCzIaLmdhbWUudjEuVG91cm5hbWVudEVudHJhbnQSEwoLd2lubmVyX3NlZWQYByABKAUSDwoHZ2FtZV9pZBgIIAEoBSLvAQoKVG91cm5hbWVudBIKCgJpZBgBIAEoBRIMCgRuYW1lGAIgASgJEg4KBmZvcm1hdBgDIAEoCRIOCgZzdGF0dXMYBCABKAkSDAoEbW9kZRgFIAEoCRISCgpjYXJkX2NvdW50GAYgASgFEiwKCGVudHJhbnRzGAcgAygLMhouZ2FtZS52MS5Ub3VybmFtZW50RW50cmFudBIpCgdtYXRjaGVzGAggAygLMhguZ2FtZS52MS5Ub3VybmFtZW50TWF0Y2gSLAoIY2hhbXBpb24YCSABKAsyGi5nYW1lLnYxLlRvdXJuYW1lbnRFbnRyYW50Im8KF0NyZWF0ZVRvdXJuYW1lbnRSZXF1ZXN0EgwKBG5hbWUYASABKAkSDgoGZm9ybWF0GAIgASgJEgwKBG1vZGUYAyABKAkSEgoKY2FyZF9jb3VudBgEIAEoBRIUCgxwbGF5ZXJfbmFtZXMYBSADKAkidAoYQ3JlYXRlVG91cm5hbWVudFJlc3BvbnNlEicKCnRvdXJuYW1lbnQYASABKAsyEy5nYW1lLnYxLlRvdXJuYW1lbnQSFwoPb3JnYW5pemVyX3Rva2VuGAIgASgJEhYKDmVudHJhbnRfdG9rZW5zGAMgAygJImYKH1JlZ2lzdGVyVG91cm5hbWVudFBsYXllclJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoBRIXCg9vcmdhbml6ZXJfdG9rZW4YAiABKAkSEwoLcGxheWVyX25hbWUYAyABKAkiZgogUmVnaXN0ZXJUb3VybmFtZW50UGxheWVyUmVzcG9uc2USKwoHZW50cmFudBgBIAEoCzIaLmdhbWUudjEuVG91cm5hbWVudEVudHJhbnQSFQoNZW50cmFudF90b2tlbhgCIAEoCSJIChZTdGFydFRvdXJuYW1lbnRSZXF1ZXN0EhUKDXRvdXJuYW1lbnRfaWQYASABKAUSFwoPb3JnYW5pemVyX3Rva2VuGAIgASgJIkIKF1N0YXJ0VG91cm5hbWVudFJlc3BvbnNlEicKCnRvdXJuYW1lbnQYASABKAsyEy5nYW1lLnYxLlRvdXJuYW1lbnQiLQoUR2V0VG91cm5hbWVudFJlcXVlc3QSFQoNdG91cm5hbWVudF9pZBgBIAEoBSJAChVHZXRUb3VybmFtZW50UmVzcG9uc2USJwoKdG91cm5hbWVudBgBIAEoCzITLmdhbWUudjEuVG91cm5hbWVudCIkChFEZWxldGVHYW1lUmVxdWVzdBIPCgdnYW1lX2lkGAEgASgJIhQKEkRlbGV0ZUdhbWVSZXNwb25zZSIaChhHZXRUZWFtSGlnaFNjb3Jlc1JlcXVlc3QiWwoNVGVhbUhpZ2hTY29yZRISCgpjYXJkX2NvdW50GAEgASgFEhIKCnRlYW1fc2NvcmUYAiABKAUSDwoHZ2FtZV9pZBgDIAEoBRIRCglnYW1lX25hbWUYBCABKAkiSAoZR2V0VGVhbUhpZ2hTY29yZXNSZXNwb25zZRIrCgtoaWdoX3Njb3JlcxgBIAMoCzIWLmdhbWUudjEuVGVhbUhpZ2hTY29yZSIVChNHZXRHYW1lTW9kZXNSZXF1ZXN0IiUKFEdldEdhbWVNb2Rlc1Jlc3BvbnNlEg0KBW1vZGVzGAEgAygJMlwKEUNyZWF0ZUdhbWVTZXJ2aWNlEkcKCkNyZWF0ZUdhbWUSGi5nYW1lLnYxLkNyZWF0ZUdhbWVSZXF1ZXN0GhsuZ2FtZS52MS5DcmVhdGVHYW1lUmVzcG9uc2UiADJUCg9HZXRHYW1lc1NlcnZpY2USQQoIR2V0R2FtZXMSGC5nYW1lLnYxLkdldEdhbWVzUmVxdWVzdBoZLmdhbWUudjEuR2V0R2FtZXNSZXNwb25zZSIAMlQKD0pvaW5HYW1lU2VydmljZRJBCghKb2luR2FtZRIYLmdhbWUudjEuSm9pbkdhbWVSZXF1ZXN0GhkuZ2FtZS52MS5Kb2luR2FtZVJlc3BvbnNlIgAyWAoQU3RhcnRHYW1lU2VydmljZRJECglTdGFydEdhbWUSGS5nYW1lLnYxLlN0YXJ0R2FtZVJlcXVlc3QaGi5nYW1lLnYxLlN0YXJ0R2FtZVJlc3BvbnNlIgAyfAoZVXBkYXRlR2FtZVNldHRpbmdzU2VydmljZRJfChJVcGRhdGVHYW1lU2V0dGluZ3MSIi5nYW1lLnYxLlVwZGF0ZUdhbWVTZXR0aW5nc1JlcXVlc3QaIy5nYW1lLnYxLlVwZGF0ZUdhbWVTZXR0aW5nc1Jlc3BvbnNlIgAyXAoRS2lja1BsYXllclNlcnZpY2USRwoKS2lja1BsYXllchIaLmdhbWUudjEuS2lja1BsYXllclJlcXVlc3QaGy5nYW1lLnYxLktpY2tQbGF5ZXJSZXNwb25zZSIAMlgKEEJhblBsYXllclNlcnZpY2USRAoJQmFuUGxheWVyEhkuZ2FtZS52MS5CYW5QbGF5ZXJSZXF1ZXN0GhouZ2FtZS52MS5CYW5QbGF5ZXJSZXNwb25zZSIAMlwKEU11dGVQbGF5ZXJTZXJ2aWNlEkcKCk11dGVQbGF5ZXISGi5nYW1lLnYxLk11dGVQbGF5ZXJSZXF1ZXN0GhsuZ2FtZS52MS5NdXRlUGxheWVyUmVzcG9uc2UiADJUCg9TZW5kQ2hhdFNlcnZpY2USQQoIU2VuZENoYXQSGC5nYW1lLnYxLlNlbmRDaGF0UmVxdWVzdBoZLmdhbWUudjEuU2VuZENoYXRSZXNwb25zZSIAMnQKF1JvdGF0ZUludml0ZUNvZGVTZXJ2aWNlElkKEFJvdGF0ZUludml0ZUNvZGUSIC5nYW1lLnYxLlJvdGF0ZUludml0ZUNvZGVSZXF1ZXN0GiEuZ2FtZS52MS5Sb3RhdGVJbnZpdGVDb2RlUmVzcG9uc2UiADJcChFDaGFuZ2VUZWFtU2VydmljZRJHCgpDaGFuZ2VUZWFtEhouZ2FtZS52MS5DaGFuZ2VUZWFtUmVxdWVzdBobLmdhbWUudjEuQ2hhbmdlVGVhbVJlc3BvbnNlIgAyYAoSU2V0SGFuZGljYXBTZXJ2aWNlEkoKC1NldEhhbmRpY2FwEhsuZ2FtZS52MS5TZXRIYW5kaWNhcFJlcXVlc3QaHC5nYW1lLnYxLlNldEhhbmRpY2FwUmVzcG9uc2UiADJYChBQYXVzZUdhbWVTZXJ2aWNlEkQKCVBhdXNlR2FtZRIZLmdhbWUudjEuUGF1c2VHYW1lUmVxdWVzdBoaLmdhbWUudjEuUGF1c2VHYW1lUmVzcG9uc2UiADJcChFSZXN1bWVHYW1lU2VydmljZRJHCgpSZXN1bWVHYW1lEhouZ2FtZS52MS5SZXN1bWVHYW1lUmVxdWVzdBobLmdhbWUudjEuUmVzdW1lR2FtZVJlc3BvbnNlIgAybAoVUmVxdWVzdFJlbWF0Y2hTZXJ2aWNlElMKDlJlcXVlc3RSZW1hdGNoEh4uZ2FtZS52MS5SZXF1ZXN0UmVtYXRjaFJlcXVlc3QaHy5nYW1lLnYxLlJlcXVlc3RSZW1hdGNoUmVzcG9uc2UiADJMCg1BZGRCb3RTZXJ2aWNlEjsKBkFkZEJvdBIWLmdhbWUudjEuQWRkQm90UmVxdWVzdBoXLmdhbWUudjEuQWRkQm90UmVzcG9uc2UiADJgChJSZXBvcnRSZWFkeVNlcnZpY2USSgoLUmVwb3J0UmVhZHkSGy5nYW1lLnYxLlJlcG9ydFJlYWR5UmVxdWVzdBocLmdhbWUudjEuUmVwb3J0UmVhZHlSZXNwb25zZSIAMmQKE1N1Ym1pdEFuc3dlclNlcnZpY2USTQoMU3VibWl0QW5zd2VyEhwuZ2FtZS52MS5TdWJtaXRBbnN3ZXJSZXF1ZXN0Gh0uZ2FtZS52MS5TdWJtaXRBbnN3ZXJSZXNwb25zZSIAMmgKFFN0YXJ0UHJhY3RpY2VTZXJ2aWNlElAKDVN0YXJ0UHJhY3RpY2USHS5nYW1lLnYxLlN0YXJ0UHJhY3RpY2VSZXF1ZXN0Gh4uZ2FtZS52MS5TdGFydFByYWN0aWNlUmVzcG9uc2UiADKEAQobU3VibWl0UHJhY3RpY2VBbnN3ZXJTZXJ2aWNlEmUKFFN1Ym1pdFByYWN0aWNlQW5zd2VyEiQuZ2FtZS52MS5TdWJtaXRQcmFjdGljZUFuc3dlclJlcXVlc3QaJS5nYW1lLnYxLlN1Ym1pdFByYWN0aWNlQW5zd2VyUmVzcG9uc2UiADJ0ChdHZXRQZXJzb25hbEJlc3RzU2VydmljZRJZChBHZXRQZXJzb25hbEJlc3RzEiAuZ2FtZS52MS5HZXRQZXJzb25hbEJlc3RzUmVxdWVzdBohLmdhbWUudjEuR2V0UGVyc29uYWxCZXN0c1Jlc3BvbnNlIgAybAoVU3RhcnRHaG9zdFJhY2VTZXJ2aWNlElMKDlN0YXJ0R2hvc3RSYWNlEh4uZ2FtZS52MS5TdGFydEdob3N0UmFjZVJlcXVlc3QaHy5nYW1lLnYxLlN0YXJ0R2hvc3RSYWNlUmVzcG9uc2UiADKAAQoaU3RhcnREYWlseUNoYWxsZW5nZVNlcnZpY2USYgoTU3RhcnREYWlseUNoYWxsZW5nZRIjLmdhbWUudjEuU3RhcnREYWlseUNoYWxsZW5nZVJlcXVlc3QaJC5nYW1lLnYxLlN0YXJ0RGFpbHlDaGFsbGVuZ2VSZXNwb25zZSIAMoABChpHZXREYWlseUxlYWRlcmJvYXJkU2VydmljZRJiChNHZXREYWlseUxlYWRlcmJvYXJkEiMuZ2FtZS52MS5HZXREYWlseUxlYWRlcmJvYXJkUmVxdWVzdBokLmdhbWUudjEuR2V0RGFpbHlMZWFkZXJib2FyZFJlc3BvbnNlIgAyWAoQSm9pblF1ZXVlU2VydmljZRJECglKb2luUXVldWUSGS5nYW1lLnYxLkpvaW5RdWV1ZVJlcXVlc3QaGi5nYW1lLnYxLkpvaW5RdWV1ZVJlc3BvbnNlIgAyXAoRTGVhdmVRdWV1ZVNlcnZpY2USRwoKTGVhdmVRdWV1ZRIaLmdhbWUudjEuTGVhdmVRdWV1ZVJlcXVlc3QaGy5nYW1lLnYxLkxlYXZlUXVldWVSZXNwb25zZSIAMmQKE1JlZ2lzdGVyVXNlclNlcnZpY2USTQoMUmVnaXN0ZXJVc2VyEhwuZ2FtZS52MS5SZWdpc3RlclVzZXJSZXF1ZXN0Gh0uZ2FtZS52MS5SZWdpc3RlclVzZXJSZXNwb25zZSIAMlwKEUdldFJhdGluZ3NTZXJ2aWNlEkcKCkdldFJhdGluZ3MSGi5nYW1lLnYxLkdldFJhdGluZ3NSZXF1ZXN0GhsuZ2FtZS52MS5HZXRSYXRpbmdzUmVzcG9uc2UiADJUCg9HZXRNYXRjaFNlcnZpY2USQQoIR2V0TWF0Y2gSGC5nYW1lLnYxLkdldE1hdGNoUmVxdWVzdBoZLmdhbWUudjEuR2V0TWF0Y2hSZXNwb25zZSIAMnQKF0NyZWF0ZVRvdXJuYW1lbnRTZXJ2aWNlElkKEENyZWF0ZVRvdXJuYW1lbnQSIC5nYW1lLnYxLkNyZWF0ZVRvdXJuYW1lbnRSZXF1ZXN0GiEuZ2FtZS52MS5DcmVhdGVUb3VybmFtZW50UmVzcG9uc2UiADKUAQofUmVnaXN0ZXJUb3VybmFtZW50UGxheWVyU2VydmljZRJxChhSZWdpc3RlclRvdXJuYW1lbnRQbGF5ZXISKC5nYW1lLnYxLlJlZ2lzdGVyVG91cm5hbWVudFBsYXllclJlcXVlc3QaKS5nYW1lLnYxLlJlZ2lzdGVyVG91cm5hbWVudFBsYXllclJlc3BvbnNlIgAycAoWU3RhcnRUb3VybmFtZW50U2VydmljZRJWCg9TdGFydFRvdXJuYW1lbnQSHy5nYW1lLnYxLlN0YXJ0VG91cm5hbWVudFJlcXVlc3QaIC5nYW1lLnYxLlN0YXJ0VG91cm5hbWVudFJlc3BvbnNlIgAyaAoUR2V0VG91cm5hbWVudFNlcnZpY2USUAoNR2V0VG91cm5hbWVudBIdLmdhbWUudjEuR2V0VG91cm5hbWVudFJlcXVlc3QaHi5nYW1lLnYxLkdldFRvdXJuYW1lbnRSZXNwb25zZSIAMlwKEURlbGV0ZUdhbWVTZXJ2aWNlEkcKCkRlbGV0ZUdhbWUSGi5nYW1lLnYxLkRlbGV0ZUdhbWVSZXF1ZXN0GhsuZ2FtZS52MS5EZWxldGVHYW1lUmVzcG9uc2UiADJ4ChhHZXRUZWFtSGlnaFNjb3Jlc1NlcnZpY2USXAoRR2V0VGVhbUhpZ2hTY29yZXMSIS5nYW1lLnYxLkdldFRlYW1IaWdoU2NvcmVzUmVxdWVzdBoiLmdhbWUudjEuR2V0VGVhbUhpZ2hTY29yZXNSZXNwb25zZSIAMmQKE0dldEdhbWVNb2Rlc1NlcnZpY2USTQoMR2V0R2FtZU1vZGVzEhwuZ2FtZS52MS5HZXRHYW1lTW9kZXNSZXF1ZXN0Gh0uZ2FtZS52MS5HZXRHYW1lTW9kZXNSZXNwb25zZSIAQhxaGmV4YW1wbGUvZ2VuL2dhbWUvdjE7Z2FtZXYxYgZwcm90bzM");
 
 /**
  * Create game 
@@ -39,6 +39,34 @@ export type Player = Message<"game.v1.Player"> & {
    * @generated from field: int32 score = 4;
    */
   score: number;
+
+  /**
+   * ゲームを開始・設定変更・キックできるホストか
+   *
+   * @generated from field: bool is_host = 5;
+   */
+  isHost: boolean;
+
+  /**
+   * 再戦を続けたシリーズでの勝利数
+   *
+   * @generated from field: int32 series_wins = 6;
+   */
+  seriesWins: number;
+
+  /**
+   * サーバー内で動くボットか
+   *
+   * @generated from field: bool is_bot = 7;
+   */
+  isBot: boolean;
+
+  /**
+   * TEAMS: 所属するチーム（未所属は0）
+   *
+   * @generated from field: int32 team_id = 8;
+   */
+  teamId: number;
 };
 
 /**
@@ -48,6 +76,68 @@ export type Player = Message<"game.v1.Player"> & {
 export const PlayerSchema: GenMessage<Player> = /*@__PURE__*/
   messageDesc(file_game_v1_game, 0);
 
+/**
+ * @generated from message game.v1.Handicap
+ */
+export type Handicap = Message<"game.v1.Handicap"> & {
+  /**
+   * このプレイヤーに見えるカードに足すおとりのシンボル数（0〜3、SPEED以外）
+   *
+   * @generated from field: int32 extra_symbols = 1;
+   */
+  extraSymbols: number;
+
+  /**
+   * カードが配られてから回答を受け付けるまでの時間
+   *
+   * @generated from field: int32 answer_delay_ms = 2;
+   */
+  answerDelayMs: number;
+
+  /**
+   * 正解時の得点の倍率（10〜300%、0は100%）
+   *
+   * @generated from field: int32 multiplier_percent = 3;
+   */
+  multiplierPercent: number;
+};
+
+/**
+ * Describes the message game.v1.Handicap.
+ * Use `create(HandicapSchema)` to create a new message.
+ */
+export const HandicapSchema: GenMessage<Handicap> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 1);
+
+/**
+ * @generated from message game.v1.Team
+ */
+export type Team = Message<"game.v1.Team"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * メンバーの得点の合計
+   *
+   * @generated from field: int32 score = 3;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message game.v1.Team.
+ * Use `create(TeamSchema)` to create a new message.
+ */
+export const TeamSchema: GenMessage<Team> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 2);
+
 /**
  * @generated from message game.v1.CreateGameRequest
  */
@@ -61,6 +151,88 @@ export type CreateGameRequest = Message<"game.v1.CreateGameRequest"> & {
    * @generated from field: int32 card_count = 2;
    */
   cardCount: number;
+
+  /**
+   * GetGameModesで取得できるモード名（未指定はNORMAL）
+   *
+   * @generated from field: string mode = 3;
+   */
+  mode: string;
+
+  /**
+   * BATTLE_ROYALE: 何ラウンドごとに最下位を脱落させるか（未指定は3）
+   *
+   * @generated from field: int32 elimination_interval = 4;
+   */
+  eliminationInterval: number;
+
+  /**
+   * SPEED: 場に同時に出すカード枚数（3以上、未指定は3）
+   *
+   * @generated from field: int32 center_count = 5;
+   */
+  centerCount: number;
+
+  /**
+   * 同点時のサドンデス: NONE, REDRAW（同じデッキを引き直す）, FRESH_DECK（新しいデッキ）
+   *
+   * @generated from field: string tie_break = 6;
+   */
+  tieBreak: string;
+
+  /**
+   * 未指定は正解+1、不正解-1
+   *
+   * @generated from field: game.v1.ScoringRules scoring = 7;
+   */
+  scoring?: ScoringRules;
+
+  /**
+   * @generated from field: game.v1.GameSettings settings = 8;
+   */
+  settings?: GameSettings;
+
+  /**
+   * TEAMS: チーム数（2以上、未指定は2）
+   *
+   * @generated from field: int32 team_count = 9;
+   */
+  teamCount: number;
+
+  /**
+   * TEAMS: チーム名（未指定は「チーム1」など）
+   *
+   * @generated from field: repeated string team_names = 10;
+   */
+  teamNames: string[];
+
+  /**
+   * TEAMS: 人数が揃うようにチームを自動で振り分ける
+   *
+   * @generated from field: bool auto_balance = 11;
+   */
+  autoBalance: boolean;
+
+  /**
+   * 複数ゲームの対戦にする場合: BEST_OF, FIRST_TO（未指定は1ゲームのみ）
+   *
+   * @generated from field: string match_format = 12;
+   */
+  matchFormat: string;
+
+  /**
+   * BEST_OF: 最大ゲーム数（奇数）、FIRST_TO: 必要な勝利数
+   *
+   * @generated from field: int32 match_length = 13;
+   */
+  matchLength: number;
+
+  /**
+   * 参加に必要なパスワード（未指定はなし）
+   *
+   * @generated from field: string password = 14;
+   */
+  password: string;
 };
 
 /**
@@ -68,7 +240,106 @@ export type CreateGameRequest = Message<"game.v1.CreateGameRequest"> & {
  * Use `create(CreateGameRequestSchema)` to create a new message.
  */
 export const CreateGameRequestSchema: GenMessage<CreateGameRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 1);
+  messageDesc(file_game_v1_game, 3);
+
+/**
+ * @generated from message game.v1.GameSettings
+ */
+export type GameSettings = Message<"game.v1.GameSettings"> & {
+  /**
+   * 参加人数の上限（0は無制限）
+   *
+   * @generated from field: int32 max_players = 1;
+   */
+  maxPlayers: number;
+
+  /**
+   * 開始に必要な人数（未指定は1）
+   *
+   * @generated from field: int32 min_players = 2;
+   */
+  minPlayers: number;
+
+  /**
+   * PUBLIC（ロビーに表示）, PRIVATE（ロビーに表示せず、招待コードかパスワードで参加）。未指定はPUBLIC
+   *
+   * @generated from field: string visibility = 3;
+   */
+  visibility: string;
+
+  /**
+   * 参加人数がmax_players（無制限ならmin_players）に達したら自動で開始
+   *
+   * @generated from field: bool auto_start = 4;
+   */
+  autoStart: boolean;
+};
+
+/**
+ * Describes the message game.v1.GameSettings.
+ * Use `create(GameSettingsSchema)` to create a new message.
+ */
+export const GameSettingsSchema: GenMessage<GameSettings> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 4);
+
+/**
+ * @generated from message game.v1.ScoringRules
+ */
+export type ScoringRules = Message<"game.v1.ScoringRules"> & {
+  /**
+   * 正解時の得点（1以上）
+   *
+   * @generated from field: int32 correct_points = 1;
+   */
+  correctPoints: number;
+
+  /**
+   * 不正解時の減点
+   *
+   * @generated from field: int32 wrong_penalty = 2;
+   */
+  wrongPenalty: number;
+
+  /**
+   * 1以上なら不正解時は減点の代わりにこの秒数回答できなくなる
+   *
+   * @generated from field: int32 lockout_seconds = 3;
+   */
+  lockoutSeconds: number;
+
+  /**
+   * 即答時のボーナス（speed_bonus_window_msかけて0まで減る）
+   *
+   * @generated from field: int32 speed_bonus_points = 4;
+   */
+  speedBonusPoints: number;
+
+  /**
+   * @generated from field: int32 speed_bonus_window_ms = 5;
+   */
+  speedBonusWindowMs: number;
+
+  /**
+   * 連続正解1回ごとに得点に加算する割合（%）
+   *
+   * @generated from field: int32 streak_bonus_percent = 6;
+   */
+  streakBonusPercent: number;
+
+  /**
+   * 連続正解ボーナスの上限回数（0は上限なし）
+   *
+   * @generated from field: int32 max_streak = 7;
+   */
+  maxStreak: number;
+};
+
+/**
+ * Describes the message game.v1.ScoringRules.
+ * Use `create(ScoringRulesSchema)` to create a new message.
+ */
+export const ScoringRulesSchema: GenMessage<ScoringRules> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 5);
 
 /**
  * @generated from message game.v1.CreateGameResponse
@@ -78,6 +349,20 @@ export type CreateGameResponse = Message<"game.v1.CreateGameResponse"> & {
    * @generated from field: int32 game_id = 1;
    */
   gameId: number;
+
+  /**
+   * 友達に共有する招待コード（PRIVATEやパスワード付きのゲームに参加するときに使う）
+   *
+   * @generated from field: string invite_code = 2;
+   */
+  inviteCode: string;
+
+  /**
+   * 作成者がJoinGameのhost_tokenに指定するとホストとして参加する（共有しない）
+   *
+   * @generated from field: string host_token = 3;
+   */
+  hostToken: string;
 };
 
 /**
@@ -85,7 +370,7 @@ export type CreateGameResponse = Message<"game.v1.CreateGameResponse"> & {
  * Use `create(CreateGameResponseSchema)` to create a new message.
  */
 export const CreateGameResponseSchema: GenMessage<CreateGameResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 2);
+  messageDesc(file_game_v1_game, 6);
 
 /**
  * Get games 
@@ -100,7 +385,7 @@ export type GetGamesRequest = Message<"game.v1.GetGamesRequest"> & {
  * Use `create(GetGamesRequestSchema)` to create a new message.
  */
 export const GetGamesRequestSchema: GenMessage<GetGamesRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 3);
+  messageDesc(file_game_v1_game, 7);
 
 /**
  * @generated from message game.v1.Game
@@ -130,6 +415,75 @@ export type Game = Message<"game.v1.Game"> & {
    * @generated from field: int32 total_rounds = 5;
    */
   totalRounds: number;
+
+  /**
+   * @generated from field: string mode = 6;
+   */
+  mode: string;
+
+  /**
+   * 協力モードのチームスコア
+   *
+   * @generated from field: int32 team_score = 7;
+   */
+  teamScore: number;
+
+  /**
+   * @generated from field: game.v1.ScoringRules scoring = 8;
+   */
+  scoring?: ScoringRules;
+
+  /**
+   * @generated from field: game.v1.GameSettings settings = 9;
+   */
+  settings?: GameSettings;
+
+  /**
+   * ホストのプレイヤーID（参加者がいなければ0）
+   *
+   * @generated from field: int32 host_id = 10;
+   */
+  hostId: number;
+
+  /**
+   * 再戦元のゲームID（再戦でなければ0）
+   *
+   * @generated from field: int32 previous_game_id = 11;
+   */
+  previousGameId: number;
+
+  /**
+   * 観戦中の接続数
+   *
+   * @generated from field: int32 spectator_count = 12;
+   */
+  spectatorCount: number;
+
+  /**
+   * TEAMS: チームと合計得点
+   *
+   * @generated from field: repeated game.v1.Team teams = 13;
+   */
+  teams: Team[];
+
+  /**
+   * @generated from field: bool auto_balance = 14;
+   */
+  autoBalance: boolean;
+
+  /**
+   * 複数ゲームの対戦の一部であればその対戦（単発は0）
+   *
+   * @generated from field: int32 match_id = 15;
+   */
+  matchId: number;
+
+  /**
+   * 参加にパスワード（または招待コード）が必要か
+   *
+   * @generated from field: bool has_password = 16;
+   */
+  hasPassword: boolean;
 };
 
 /**
@@ -137,7 +491,7 @@ export type Game = Message<"game.v1.Game"> & {
  * Use `create(GameSchema)` to create a new message.
  */
 export const GameSchema: GenMessage<Game> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 4);
+  messageDesc(file_game_v1_game, 8);
 
 /**
  * @generated from message game.v1.GetGamesResponse
@@ -154,7 +508,7 @@ export type GetGamesResponse = Message<"game.v1.GetGamesResponse"> & {
  * Use `create(GetGamesResponseSchema)` to create a new message.
  */
 export const GetGamesResponseSchema: GenMessage<GetGamesResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 5);
+  messageDesc(file_game_v1_game, 9);
 
 /**
  * Join game 
@@ -171,6 +525,48 @@ export type JoinGameRequest = Message<"game.v1.JoinGameRequest"> & {
    * @generated from field: string game_id = 2;
    */
   gameId: string;
+
+  /**
+   * TEAMS: 参加するチーム（未指定で自動振り分けなら人数の少ないチーム）
+   *
+   * @generated from field: int32 team_id = 3;
+   */
+  teamId: number;
+
+  /**
+   * 登録ユーザーとして参加するときのトークン（ゲームの順位でレーティングが変わる）
+   *
+   * @generated from field: string user_token = 4;
+   */
+  userToken: string;
+
+  /**
+   * PRIVATEやパスワード付きのゲームの招待コード。game_idを省略するとコードからゲームを探す
+   *
+   * @generated from field: string invite_code = 5;
+   */
+  inviteCode: string;
+
+  /**
+   * パスワード付きのゲームのパスワード
+   *
+   * @generated from field: string password = 6;
+   */
+  password: string;
+
+  /**
+   * CreateGameで受け取ったトークン。ゲームの作成者がホストとして参加する
+   *
+   * @generated from field: string host_token = 7;
+   */
+  hostToken: string;
+
+  /**
+   * トーナメントの試合に参加するときの参加者のトークン
+   *
+   * @generated from field: string entrant_token = 8;
+   */
+  entrantToken: string;
 };
 
 /**
@@ -178,7 +574,7 @@ export type JoinGameRequest = Message<"game.v1.JoinGameRequest"> & {
  * Use `create(JoinGameRequestSchema)` to create a new message.
  */
 export const JoinGameRequestSchema: GenMessage<JoinGameRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 6);
+  messageDesc(file_game_v1_game, 10);
 
 /**
  * @generated from message game.v1.JoinGameResponse
@@ -188,6 +584,13 @@ export type JoinGameResponse = Message<"game.v1.JoinGameResponse"> & {
    * @generated from field: game.v1.Player player = 1;
    */
   player?: Player;
+
+  /**
+   * 本人確認用トークン。WebSocketの初回メッセージで毎回送る（切断後の再接続にも使う）
+   *
+   * @generated from field: string resume_token = 2;
+   */
+  resumeToken: string;
 };
 
 /**
@@ -195,7 +598,7 @@ export type JoinGameResponse = Message<"game.v1.JoinGameResponse"> & {
  * Use `create(JoinGameResponseSchema)` to create a new message.
  */
 export const JoinGameResponseSchema: GenMessage<JoinGameResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 7);
+  messageDesc(file_game_v1_game, 11);
 
 /**
  * Start game 
@@ -209,9 +612,18 @@ export type StartGameRequest = Message<"game.v1.StartGameRequest"> & {
   gameId: string;
 
   /**
+   * 開始するホストのプレイヤーID
+   *
    * @generated from field: string user_id = 2;
    */
   userId: string;
+
+  /**
+   * ホストの本人確認用トークン（JoinGameで受け取ったもの）
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
 };
 
 /**
@@ -219,247 +631,2701 @@ export type StartGameRequest = Message<"game.v1.StartGameRequest"> & {
  * Use `create(StartGameRequestSchema)` to create a new message.
  */
 export const StartGameRequestSchema: GenMessage<StartGameRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 8);
+  messageDesc(file_game_v1_game, 12);
+
+/**
+ * @generated from message game.v1.StartGameResponse
+ */
+export type StartGameResponse = Message<"game.v1.StartGameResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.StartGameResponse.
+ * Use `create(StartGameResponseSchema)` to create a new message.
+ */
+export const StartGameResponseSchema: GenMessage<StartGameResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 13);
+
+/**
+ * Update game settings 
+ *
+ * @generated from message game.v1.UpdateGameSettingsRequest
+ */
+export type UpdateGameSettingsRequest = Message<"game.v1.UpdateGameSettingsRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: game.v1.GameSettings settings = 3;
+   */
+  settings?: GameSettings;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 4;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.UpdateGameSettingsRequest.
+ * Use `create(UpdateGameSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateGameSettingsRequestSchema: GenMessage<UpdateGameSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 14);
+
+/**
+ * @generated from message game.v1.UpdateGameSettingsResponse
+ */
+export type UpdateGameSettingsResponse = Message<"game.v1.UpdateGameSettingsResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.UpdateGameSettingsResponse.
+ * Use `create(UpdateGameSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateGameSettingsResponseSchema: GenMessage<UpdateGameSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 15);
+
+/**
+ * Kick player 
+ *
+ * @generated from message game.v1.KickPlayerRequest
+ */
+export type KickPlayerRequest = Message<"game.v1.KickPlayerRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * キックするプレイヤーID
+   *
+   * @generated from field: string player_id = 3;
+   */
+  playerId: string;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 4;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.KickPlayerRequest.
+ * Use `create(KickPlayerRequestSchema)` to create a new message.
+ */
+export const KickPlayerRequestSchema: GenMessage<KickPlayerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 16);
+
+/**
+ * @generated from message game.v1.KickPlayerResponse
+ */
+export type KickPlayerResponse = Message<"game.v1.KickPlayerResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.KickPlayerResponse.
+ * Use `create(KickPlayerResponseSchema)` to create a new message.
+ */
+export const KickPlayerResponseSchema: GenMessage<KickPlayerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 17);
+
+/**
+ * Ban player 
+ *
+ * @generated from message game.v1.BanPlayerRequest
+ */
+export type BanPlayerRequest = Message<"game.v1.BanPlayerRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * キックして再参加を禁止するプレイヤーID
+   *
+   * @generated from field: string player_id = 3;
+   */
+  playerId: string;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 4;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.BanPlayerRequest.
+ * Use `create(BanPlayerRequestSchema)` to create a new message.
+ */
+export const BanPlayerRequestSchema: GenMessage<BanPlayerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 18);
+
+/**
+ * @generated from message game.v1.BanPlayerResponse
+ */
+export type BanPlayerResponse = Message<"game.v1.BanPlayerResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.BanPlayerResponse.
+ * Use `create(BanPlayerResponseSchema)` to create a new message.
+ */
+export const BanPlayerResponseSchema: GenMessage<BanPlayerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 19);
+
+/**
+ * Mute player 
+ *
+ * @generated from message game.v1.MutePlayerRequest
+ */
+export type MutePlayerRequest = Message<"game.v1.MutePlayerRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * @generated from field: string player_id = 3;
+   */
+  playerId: string;
+
+  /**
+   * falseでミュートを解除する
+   *
+   * @generated from field: bool muted = 4;
+   */
+  muted: boolean;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 5;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.MutePlayerRequest.
+ * Use `create(MutePlayerRequestSchema)` to create a new message.
+ */
+export const MutePlayerRequestSchema: GenMessage<MutePlayerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 20);
+
+/**
+ * @generated from message game.v1.MutePlayerResponse
+ */
+export type MutePlayerResponse = Message<"game.v1.MutePlayerResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.MutePlayerResponse.
+ * Use `create(MutePlayerResponseSchema)` to create a new message.
+ */
+export const MutePlayerResponseSchema: GenMessage<MutePlayerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 21);
+
+/**
+ * Chat 
+ *
+ * @generated from message game.v1.SendChatRequest
+ */
+export type SendChatRequest = Message<"game.v1.SendChatRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  /**
+   * JoinGameで受け取った本人確認用トークン
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+
+  /**
+   * 200文字まで
+   *
+   * @generated from field: string text = 4;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message game.v1.SendChatRequest.
+ * Use `create(SendChatRequestSchema)` to create a new message.
+ */
+export const SendChatRequestSchema: GenMessage<SendChatRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 22);
+
+/**
+ * @generated from message game.v1.SendChatResponse
+ */
+export type SendChatResponse = Message<"game.v1.SendChatResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.SendChatResponse.
+ * Use `create(SendChatResponseSchema)` to create a new message.
+ */
+export const SendChatResponseSchema: GenMessage<SendChatResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 23);
+
+/**
+ * Rotate invite code 
+ *
+ * @generated from message game.v1.RotateInviteCodeRequest
+ */
+export type RotateInviteCodeRequest = Message<"game.v1.RotateInviteCodeRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.RotateInviteCodeRequest.
+ * Use `create(RotateInviteCodeRequestSchema)` to create a new message.
+ */
+export const RotateInviteCodeRequestSchema: GenMessage<RotateInviteCodeRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 24);
+
+/**
+ * @generated from message game.v1.RotateInviteCodeResponse
+ */
+export type RotateInviteCodeResponse = Message<"game.v1.RotateInviteCodeResponse"> & {
+  /**
+   * 新しい招待コード（古いコードは使えなくなる）
+   *
+   * @generated from field: string invite_code = 1;
+   */
+  inviteCode: string;
+};
+
+/**
+ * Describes the message game.v1.RotateInviteCodeResponse.
+ * Use `create(RotateInviteCodeResponseSchema)` to create a new message.
+ */
+export const RotateInviteCodeResponseSchema: GenMessage<RotateInviteCodeResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 25);
+
+/**
+ * Change team 
+ *
+ * @generated from message game.v1.ChangeTeamRequest
+ */
+export type ChangeTeamRequest = Message<"game.v1.ChangeTeamRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * 本人またはホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * チームを変えるプレイヤーID
+   *
+   * @generated from field: string player_id = 3;
+   */
+  playerId: string;
+
+  /**
+   * @generated from field: int32 team_id = 4;
+   */
+  teamId: number;
+
+  /**
+   * user_idのプレイヤーの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 5;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.ChangeTeamRequest.
+ * Use `create(ChangeTeamRequestSchema)` to create a new message.
+ */
+export const ChangeTeamRequestSchema: GenMessage<ChangeTeamRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 26);
+
+/**
+ * @generated from message game.v1.ChangeTeamResponse
+ */
+export type ChangeTeamResponse = Message<"game.v1.ChangeTeamResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.ChangeTeamResponse.
+ * Use `create(ChangeTeamResponseSchema)` to create a new message.
+ */
+export const ChangeTeamResponseSchema: GenMessage<ChangeTeamResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 27);
+
+/**
+ * Set handicap 
+ *
+ * @generated from message game.v1.SetHandicapRequest
+ */
+export type SetHandicapRequest = Message<"game.v1.SetHandicapRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * ハンデを設定するプレイヤーID
+   *
+   * @generated from field: string player_id = 3;
+   */
+  playerId: string;
+
+  /**
+   * 未設定ならハンデを外す
+   *
+   * @generated from field: game.v1.Handicap handicap = 4;
+   */
+  handicap?: Handicap;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 5;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.SetHandicapRequest.
+ * Use `create(SetHandicapRequestSchema)` to create a new message.
+ */
+export const SetHandicapRequestSchema: GenMessage<SetHandicapRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 28);
+
+/**
+ * @generated from message game.v1.SetHandicapResponse
+ */
+export type SetHandicapResponse = Message<"game.v1.SetHandicapResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.SetHandicapResponse.
+ * Use `create(SetHandicapResponseSchema)` to create a new message.
+ */
+export const SetHandicapResponseSchema: GenMessage<SetHandicapResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 29);
+
+/**
+ * Pause game 
+ *
+ * @generated from message game.v1.PauseGameRequest
+ */
+export type PauseGameRequest = Message<"game.v1.PauseGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストなら即座に一時停止、それ以外は投票として数える
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * 投票するプレイヤーの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.PauseGameRequest.
+ * Use `create(PauseGameRequestSchema)` to create a new message.
+ */
+export const PauseGameRequestSchema: GenMessage<PauseGameRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 30);
+
+/**
+ * @generated from message game.v1.PauseGameResponse
+ */
+export type PauseGameResponse = Message<"game.v1.PauseGameResponse"> & {
+  /**
+   * 一時停止が決まったか
+   *
+   * @generated from field: bool accepted = 1;
+   */
+  accepted: boolean;
+
+  /**
+   * 現在の賛成票
+   *
+   * @generated from field: int32 votes = 2;
+   */
+  votes: number;
+
+  /**
+   * 過半数に必要な票数
+   *
+   * @generated from field: int32 votes_needed = 3;
+   */
+  votesNeeded: number;
+};
+
+/**
+ * Describes the message game.v1.PauseGameResponse.
+ * Use `create(PauseGameResponseSchema)` to create a new message.
+ */
+export const PauseGameResponseSchema: GenMessage<PauseGameResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 31);
+
+/**
+ * Resume game 
+ *
+ * @generated from message game.v1.ResumeGameRequest
+ */
+export type ResumeGameRequest = Message<"game.v1.ResumeGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストなら即座に再開、それ以外は投票として数える
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * 投票するプレイヤーの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.ResumeGameRequest.
+ * Use `create(ResumeGameRequestSchema)` to create a new message.
+ */
+export const ResumeGameRequestSchema: GenMessage<ResumeGameRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 32);
+
+/**
+ * @generated from message game.v1.ResumeGameResponse
+ */
+export type ResumeGameResponse = Message<"game.v1.ResumeGameResponse"> & {
+  /**
+   * 再開が決まったか（カウントダウン後に再開する）
+   *
+   * @generated from field: bool accepted = 1;
+   */
+  accepted: boolean;
+
+  /**
+   * @generated from field: int32 votes = 2;
+   */
+  votes: number;
+
+  /**
+   * @generated from field: int32 votes_needed = 3;
+   */
+  votesNeeded: number;
+};
+
+/**
+ * Describes the message game.v1.ResumeGameResponse.
+ * Use `create(ResumeGameResponseSchema)` to create a new message.
+ */
+export const ResumeGameResponseSchema: GenMessage<ResumeGameResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 33);
+
+/**
+ * Request rematch 
+ *
+ * @generated from message game.v1.RequestRematchRequest
+ */
+export type RequestRematchRequest = Message<"game.v1.RequestRematchRequest"> & {
+  /**
+   * 終了したゲームのID
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * 再戦に賛成するプレイヤーID
+   *
+   * @generated from field: string player_id = 2;
+   */
+  playerId: string;
+
+  /**
+   * 投票するプレイヤーの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.RequestRematchRequest.
+ * Use `create(RequestRematchRequestSchema)` to create a new message.
+ */
+export const RequestRematchRequestSchema: GenMessage<RequestRematchRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 34);
+
+/**
+ * @generated from message game.v1.RequestRematchResponse
+ */
+export type RequestRematchResponse = Message<"game.v1.RequestRematchResponse"> & {
+  /**
+   * 再戦のゲームが作られたか
+   *
+   * @generated from field: bool created = 1;
+   */
+  created: boolean;
+
+  /**
+   * @generated from field: int32 new_game_id = 2;
+   */
+  newGameId: number;
+
+  /**
+   * 現在の賛成票
+   *
+   * @generated from field: int32 votes = 3;
+   */
+  votes: number;
+
+  /**
+   * 接続中で投票できるプレイヤー数
+   *
+   * @generated from field: int32 voters = 4;
+   */
+  voters: number;
+};
+
+/**
+ * Describes the message game.v1.RequestRematchResponse.
+ * Use `create(RequestRematchResponseSchema)` to create a new message.
+ */
+export const RequestRematchResponseSchema: GenMessage<RequestRematchResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 35);
+
+/**
+ * Add bot 
+ *
+ * @generated from message game.v1.BotProfile
+ */
+export type BotProfile = Message<"game.v1.BotProfile"> & {
+  /**
+   * 正しいシンボルを答える確率（0〜100）
+   *
+   * @generated from field: int32 accuracy_percent = 1;
+   */
+  accuracyPercent: number;
+
+  /**
+   * カードが揃ってから回答するまでの最短時間
+   *
+   * @generated from field: int32 min_reaction_ms = 2;
+   */
+  minReactionMs: number;
+
+  /**
+   * カードが揃ってから回答するまでの最長時間
+   *
+   * @generated from field: int32 max_reaction_ms = 3;
+   */
+  maxReactionMs: number;
+};
+
+/**
+ * Describes the message game.v1.BotProfile.
+ * Use `create(BotProfileSchema)` to create a new message.
+ */
+export const BotProfileSchema: GenMessage<BotProfile> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 36);
+
+/**
+ * @generated from message game.v1.AddBotRequest
+ */
+export type AddBotRequest = Message<"game.v1.AddBotRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+
+  /**
+   * ホストのプレイヤーID
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId: string;
+
+  /**
+   * 未指定は「BOT」
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * EASY, NORMAL, HARD（未指定はNORMAL、profile指定時は無視）
+   *
+   * @generated from field: string level = 4;
+   */
+  level: string;
+
+  /**
+   * @generated from field: game.v1.BotProfile profile = 5;
+   */
+  profile?: BotProfile;
+
+  /**
+   * ホストの本人確認用トークン
+   *
+   * @generated from field: string resume_token = 6;
+   */
+  resumeToken: string;
+};
+
+/**
+ * Describes the message game.v1.AddBotRequest.
+ * Use `create(AddBotRequestSchema)` to create a new message.
+ */
+export const AddBotRequestSchema: GenMessage<AddBotRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 37);
+
+/**
+ * @generated from message game.v1.AddBotResponse
+ */
+export type AddBotResponse = Message<"game.v1.AddBotResponse"> & {
+  /**
+   * @generated from field: game.v1.Player player = 1;
+   */
+  player?: Player;
+};
+
+/**
+ * Describes the message game.v1.AddBotResponse.
+ * Use `create(AddBotResponseSchema)` to create a new message.
+ */
+export const AddBotResponseSchema: GenMessage<AddBotResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 38);
+
+/**
+ * Report ready 
+ *
+ * @generated from message game.v1.ReportReadyRequest
+ */
+export type ReportReadyRequest = Message<"game.v1.ReportReadyRequest"> & {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+};
+
+/**
+ * Describes the message game.v1.ReportReadyRequest.
+ * Use `create(ReportReadyRequestSchema)` to create a new message.
+ */
+export const ReportReadyRequestSchema: GenMessage<ReportReadyRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 39);
+
+/**
+ * @generated from message game.v1.ReportReadyResponse
+ */
+export type ReportReadyResponse = Message<"game.v1.ReportReadyResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.ReportReadyResponse.
+ * Use `create(ReportReadyResponseSchema)` to create a new message.
+ */
+export const ReportReadyResponseSchema: GenMessage<ReportReadyResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 40);
+
+/**
+ * Submit Answer 
+ *
+ * @generated from message game.v1.Card
+ */
+export type Card = Message<"game.v1.Card"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string text = 2;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message game.v1.Card.
+ * Use `create(CardSchema)` to create a new message.
+ */
+export const CardSchema: GenMessage<Card> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 41);
+
+/**
+ * @generated from message game.v1.SubmitAnswerRequest
+ */
+export type SubmitAnswerRequest = Message<"game.v1.SubmitAnswerRequest"> & {
+  /**
+   * @generated from field: string player_id = 1;
+   */
+  playerId: string;
+
+  /**
+   * SPEEDモードでは場のカードのうち共通シンボルを見つけた2枚を指定する
+   *
+   * @generated from field: game.v1.Card card1 = 2;
+   */
+  card1?: Card;
+
+  /**
+   * @generated from field: game.v1.Card card2 = 3;
+   */
+  card2?: Card;
+
+  /**
+   * @generated from field: string answer = 4;
+   */
+  answer: string;
+};
+
+/**
+ * Describes the message game.v1.SubmitAnswerRequest.
+ * Use `create(SubmitAnswerRequestSchema)` to create a new message.
+ */
+export const SubmitAnswerRequestSchema: GenMessage<SubmitAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 42);
+
+/**
+ * @generated from message game.v1.SubmitAnswerResponse
+ */
+export type SubmitAnswerResponse = Message<"game.v1.SubmitAnswerResponse"> & {
+  /**
+   * @generated from field: string is_correct = 1;
+   */
+  isCorrect: string;
+};
+
+/**
+ * Describes the message game.v1.SubmitAnswerResponse.
+ * Use `create(SubmitAnswerResponseSchema)` to create a new message.
+ */
+export const SubmitAnswerResponseSchema: GenMessage<SubmitAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 43);
+
+/**
+ * Solo practice (time attack) 
+ *
+ * @generated from message game.v1.StartPracticeRequest
+ */
+export type StartPracticeRequest = Message<"game.v1.StartPracticeRequest"> & {
+  /**
+   * 自己ベストを記録する名前
+   *
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * デッキのカード枚数（0は全カード）
+   *
+   * @generated from field: int32 card_count = 2;
+   */
+  cardCount: number;
+};
+
+/**
+ * Describes the message game.v1.StartPracticeRequest.
+ * Use `create(StartPracticeRequestSchema)` to create a new message.
+ */
+export const StartPracticeRequestSchema: GenMessage<StartPracticeRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 44);
+
+/**
+ * @generated from message game.v1.StartPracticeResponse
+ */
+export type StartPracticeResponse = Message<"game.v1.StartPracticeResponse"> & {
+  /**
+   * @generated from field: string practice_id = 1;
+   */
+  practiceId: string;
+
+  /**
+   * 最初に場に出る2枚
+   *
+   * @generated from field: repeated game.v1.Card cards = 2;
+   */
+  cards: Card[];
+
+  /**
+   * @generated from field: int32 card_count = 3;
+   */
+  cardCount: number;
+
+  /**
+   * このデッキサイズの自己ベスト（記録がなければ0）
+   *
+   * @generated from field: int64 personal_best_ms = 4;
+   */
+  personalBestMs: bigint;
+
+  /**
+   * 回答するときの本人確認用トークン
+   *
+   * @generated from field: string practice_token = 5;
+   */
+  practiceToken: string;
+};
+
+/**
+ * Describes the message game.v1.StartPracticeResponse.
+ * Use `create(StartPracticeResponseSchema)` to create a new message.
+ */
+export const StartPracticeResponseSchema: GenMessage<StartPracticeResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 45);
+
+/**
+ * @generated from message game.v1.SubmitPracticeAnswerRequest
+ */
+export type SubmitPracticeAnswerRequest = Message<"game.v1.SubmitPracticeAnswerRequest"> & {
+  /**
+   * @generated from field: string practice_id = 1;
+   */
+  practiceId: string;
+
+  /**
+   * 場の2枚の共通シンボル
+   *
+   * @generated from field: string answer = 2;
+   */
+  answer: string;
+
+  /**
+   * StartPractice・StartDailyChallengeで受け取ったトークン
+   *
+   * @generated from field: string practice_token = 3;
+   */
+  practiceToken: string;
+};
+
+/**
+ * Describes the message game.v1.SubmitPracticeAnswerRequest.
+ * Use `create(SubmitPracticeAnswerRequestSchema)` to create a new message.
+ */
+export const SubmitPracticeAnswerRequestSchema: GenMessage<SubmitPracticeAnswerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 46);
+
+/**
+ * @generated from message game.v1.SubmitPracticeAnswerResponse
+ */
+export type SubmitPracticeAnswerResponse = Message<"game.v1.SubmitPracticeAnswerResponse"> & {
+  /**
+   * @generated from field: bool correct = 1;
+   */
+  correct: boolean;
+
+  /**
+   * 正解したときに配られる次のカード
+   *
+   * @generated from field: game.v1.Card next_card = 2;
+   */
+  nextCard?: Card;
+
+  /**
+   * 正解したカードの反応時間
+   *
+   * @generated from field: int64 reaction_ms = 3;
+   */
+  reactionMs: bigint;
+
+  /**
+   * 残りのカード枚数
+   *
+   * @generated from field: int32 remaining = 4;
+   */
+  remaining: number;
+
+  /**
+   * @generated from field: bool finished = 5;
+   */
+  finished: boolean;
+
+  /**
+   * 以下は終了時のみ
+   *
+   * @generated from field: int64 total_ms = 6;
+   */
+  totalMs: bigint;
+
+  /**
+   * @generated from field: repeated int64 reaction_times_ms = 7;
+   */
+  reactionTimesMs: bigint[];
+
+  /**
+   * @generated from field: int32 mistakes = 8;
+   */
+  mistakes: number;
+
+  /**
+   * 自己ベストを更新したか
+   *
+   * @generated from field: bool personal_best = 9;
+   */
+  personalBest: boolean;
+
+  /**
+   * @generated from field: int64 personal_best_ms = 10;
+   */
+  personalBestMs: bigint;
+
+  /**
+   * デイリーチャレンジのランキング対象の挑戦なら順位
+   *
+   * @generated from field: int32 daily_rank = 11;
+   */
+  dailyRank: number;
+};
+
+/**
+ * Describes the message game.v1.SubmitPracticeAnswerResponse.
+ * Use `create(SubmitPracticeAnswerResponseSchema)` to create a new message.
+ */
+export const SubmitPracticeAnswerResponseSchema: GenMessage<SubmitPracticeAnswerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 47);
+
+/**
+ * @generated from message game.v1.GetPersonalBestsRequest
+ */
+export type GetPersonalBestsRequest = Message<"game.v1.GetPersonalBestsRequest"> & {
+  /**
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+};
+
+/**
+ * Describes the message game.v1.GetPersonalBestsRequest.
+ * Use `create(GetPersonalBestsRequestSchema)` to create a new message.
+ */
+export const GetPersonalBestsRequestSchema: GenMessage<GetPersonalBestsRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 48);
+
+/**
+ * @generated from message game.v1.PersonalBest
+ */
+export type PersonalBest = Message<"game.v1.PersonalBest"> & {
+  /**
+   * @generated from field: int32 card_count = 1;
+   */
+  cardCount: number;
+
+  /**
+   * @generated from field: int64 total_ms = 2;
+   */
+  totalMs: bigint;
+
+  /**
+   * @generated from field: int32 run_id = 3;
+   */
+  runId: number;
+
+  /**
+   * @generated from field: repeated int64 reaction_times_ms = 4;
+   */
+  reactionTimesMs: bigint[];
+};
+
+/**
+ * Describes the message game.v1.PersonalBest.
+ * Use `create(PersonalBestSchema)` to create a new message.
+ */
+export const PersonalBestSchema: GenMessage<PersonalBest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 49);
+
+/**
+ * @generated from message game.v1.GetPersonalBestsResponse
+ */
+export type GetPersonalBestsResponse = Message<"game.v1.GetPersonalBestsResponse"> & {
+  /**
+   * @generated from field: repeated game.v1.PersonalBest bests = 1;
+   */
+  bests: PersonalBest[];
+};
+
+/**
+ * Describes the message game.v1.GetPersonalBestsResponse.
+ * Use `create(GetPersonalBestsResponseSchema)` to create a new message.
+ */
+export const GetPersonalBestsResponseSchema: GenMessage<GetPersonalBestsResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 50);
+
+/**
+ * Ghost race 
+ *
+ * @generated from message game.v1.StartGhostRaceRequest
+ */
+export type StartGhostRaceRequest = Message<"game.v1.StartGhostRaceRequest"> & {
+  /**
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * 対戦する練習の記録（自分や他のプレイヤーの記録）
+   *
+   * @generated from field: int32 run_id = 2;
+   */
+  runId: number;
+};
+
+/**
+ * Describes the message game.v1.StartGhostRaceRequest.
+ * Use `create(StartGhostRaceRequestSchema)` to create a new message.
+ */
+export const StartGhostRaceRequestSchema: GenMessage<StartGhostRaceRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 51);
+
+/**
+ * @generated from message game.v1.StartGhostRaceResponse
+ */
+export type StartGhostRaceResponse = Message<"game.v1.StartGhostRaceResponse"> & {
+  /**
+   * @generated from field: int32 game_id = 1;
+   */
+  gameId: number;
+
+  /**
+   * 自分
+   *
+   * @generated from field: game.v1.Player player = 2;
+   */
+  player?: Player;
+
+  /**
+   * @generated from field: string resume_token = 3;
+   */
+  resumeToken: string;
+
+  /**
+   * 記録を再生する相手
+   *
+   * @generated from field: game.v1.Player ghost = 4;
+   */
+  ghost?: Player;
+
+  /**
+   * 記録のタイム
+   *
+   * @generated from field: int64 ghost_total_ms = 5;
+   */
+  ghostTotalMs: bigint;
+};
+
+/**
+ * Describes the message game.v1.StartGhostRaceResponse.
+ * Use `create(StartGhostRaceResponseSchema)` to create a new message.
+ */
+export const StartGhostRaceResponseSchema: GenMessage<StartGhostRaceResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 52);
+
+/**
+ * Daily challenge 
+ *
+ * @generated from message game.v1.StartDailyChallengeRequest
+ */
+export type StartDailyChallengeRequest = Message<"game.v1.StartDailyChallengeRequest"> & {
+  /**
+   * 未登録で挑戦するときの名前（ランキングの対象外）
+   *
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * 登録ユーザーのトークン。ランキングの対象は登録ユーザーの1日1回目の挑戦のみ
+   *
+   * @generated from field: string user_token = 2;
+   */
+  userToken: string;
+};
+
+/**
+ * Describes the message game.v1.StartDailyChallengeRequest.
+ * Use `create(StartDailyChallengeRequestSchema)` to create a new message.
+ */
+export const StartDailyChallengeRequestSchema: GenMessage<StartDailyChallengeRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 53);
+
+/**
+ * @generated from message game.v1.StartDailyChallengeResponse
+ */
+export type StartDailyChallengeResponse = Message<"game.v1.StartDailyChallengeResponse"> & {
+  /**
+   * 回答はSubmitPracticeAnswerで送る
+   *
+   * @generated from field: string practice_id = 1;
+   */
+  practiceId: string;
+
+  /**
+   * @generated from field: repeated game.v1.Card cards = 2;
+   */
+  cards: Card[];
+
+  /**
+   * @generated from field: int32 card_count = 3;
+   */
+  cardCount: number;
+
+  /**
+   * YYYY-MM-DD
+   *
+   * @generated from field: string day = 4;
+   */
+  day: string;
+
+  /**
+   * 今日の最初の挑戦ならランキング対象
+   *
+   * @generated from field: bool ranked = 5;
+   */
+  ranked: boolean;
+
+  /**
+   * 回答するときの本人確認用トークン
+   *
+   * @generated from field: string practice_token = 6;
+   */
+  practiceToken: string;
+};
+
+/**
+ * Describes the message game.v1.StartDailyChallengeResponse.
+ * Use `create(StartDailyChallengeResponseSchema)` to create a new message.
+ */
+export const StartDailyChallengeResponseSchema: GenMessage<StartDailyChallengeResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 54);
+
+/**
+ * @generated from message game.v1.GetDailyLeaderboardRequest
+ */
+export type GetDailyLeaderboardRequest = Message<"game.v1.GetDailyLeaderboardRequest"> & {
+  /**
+   * 未指定は今日
+   *
+   * @generated from field: string day = 1;
+   */
+  day: string;
+
+  /**
+   * 未指定は100件
+   *
+   * @generated from field: int32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message game.v1.GetDailyLeaderboardRequest.
+ * Use `create(GetDailyLeaderboardRequestSchema)` to create a new message.
+ */
+export const GetDailyLeaderboardRequestSchema: GenMessage<GetDailyLeaderboardRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 55);
+
+/**
+ * @generated from message game.v1.DailyLeaderboardEntry
+ */
+export type DailyLeaderboardEntry = Message<"game.v1.DailyLeaderboardEntry"> & {
+  /**
+   * @generated from field: int32 rank = 1;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string player_name = 2;
+   */
+  playerName: string;
+
+  /**
+   * @generated from field: int64 total_ms = 3;
+   */
+  totalMs: bigint;
+
+  /**
+   * @generated from field: int32 mistakes = 4;
+   */
+  mistakes: number;
+};
+
+/**
+ * Describes the message game.v1.DailyLeaderboardEntry.
+ * Use `create(DailyLeaderboardEntrySchema)` to create a new message.
+ */
+export const DailyLeaderboardEntrySchema: GenMessage<DailyLeaderboardEntry> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 56);
+
+/**
+ * @generated from message game.v1.GetDailyLeaderboardResponse
+ */
+export type GetDailyLeaderboardResponse = Message<"game.v1.GetDailyLeaderboardResponse"> & {
+  /**
+   * @generated from field: string day = 1;
+   */
+  day: string;
+
+  /**
+   * @generated from field: repeated game.v1.DailyLeaderboardEntry entries = 2;
+   */
+  entries: DailyLeaderboardEntry[];
+};
+
+/**
+ * Describes the message game.v1.GetDailyLeaderboardResponse.
+ * Use `create(GetDailyLeaderboardResponseSchema)` to create a new message.
+ */
+export const GetDailyLeaderboardResponseSchema: GenMessage<GetDailyLeaderboardResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 57);
+
+/**
+ * Matchmaking queue 
+ *
+ * @generated from message game.v1.JoinQueueRequest
+ */
+export type JoinQueueRequest = Message<"game.v1.JoinQueueRequest"> & {
+  /**
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * 希望するゲームモード（未指定はどれでもよい）
+   *
+   * @generated from field: string mode = 2;
+   */
+  mode: string;
+
+  /**
+   * 希望するデッキの枚数（0はどれでもよい）
+   *
+   * @generated from field: int32 card_count = 3;
+   */
+  cardCount: number;
+
+  /**
+   * 登録ユーザーならレーティングの近いプレイヤーと組む
+   *
+   * @generated from field: string user_token = 4;
+   */
+  userToken: string;
+};
+
+/**
+ * Describes the message game.v1.JoinQueueRequest.
+ * Use `create(JoinQueueRequestSchema)` to create a new message.
+ */
+export const JoinQueueRequestSchema: GenMessage<JoinQueueRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 58);
+
+/**
+ * @generated from message game.v1.JoinQueueResponse
+ */
+export type JoinQueueResponse = Message<"game.v1.JoinQueueResponse"> & {
+  /**
+   * ロビーのWebSocketで{"ticket": ...}を送ると、ゲームが決まったときにMATCHEDが届く
+   *
+   * @generated from field: string ticket = 1;
+   */
+  ticket: string;
+
+  /**
+   * 待っている人数（自分を含む）
+   *
+   * @generated from field: int32 waiting = 2;
+   */
+  waiting: number;
+};
+
+/**
+ * Describes the message game.v1.JoinQueueResponse.
+ * Use `create(JoinQueueResponseSchema)` to create a new message.
+ */
+export const JoinQueueResponseSchema: GenMessage<JoinQueueResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 59);
+
+/**
+ * @generated from message game.v1.LeaveQueueRequest
+ */
+export type LeaveQueueRequest = Message<"game.v1.LeaveQueueRequest"> & {
+  /**
+   * @generated from field: string ticket = 1;
+   */
+  ticket: string;
+};
+
+/**
+ * Describes the message game.v1.LeaveQueueRequest.
+ * Use `create(LeaveQueueRequestSchema)` to create a new message.
+ */
+export const LeaveQueueRequestSchema: GenMessage<LeaveQueueRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 60);
+
+/**
+ * @generated from message game.v1.LeaveQueueResponse
+ */
+export type LeaveQueueResponse = Message<"game.v1.LeaveQueueResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.LeaveQueueResponse.
+ * Use `create(LeaveQueueResponseSchema)` to create a new message.
+ */
+export const LeaveQueueResponseSchema: GenMessage<LeaveQueueResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 61);
+
+/**
+ * Ratings 
+ *
+ * @generated from message game.v1.User
+ */
+export type User = Message<"game.v1.User"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * Elo レーティング（初期値1500）
+   *
+   * @generated from field: double rating = 3;
+   */
+  rating: number;
+
+  /**
+   * レーティングの対象になったゲーム数
+   *
+   * @generated from field: int32 rated_games = 4;
+   */
+  ratedGames: number;
+};
+
+/**
+ * Describes the message game.v1.User.
+ * Use `create(UserSchema)` to create a new message.
+ */
+export const UserSchema: GenMessage<User> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 62);
+
+/**
+ * @generated from message game.v1.RegisterUserRequest
+ */
+export type RegisterUserRequest = Message<"game.v1.RegisterUserRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message game.v1.RegisterUserRequest.
+ * Use `create(RegisterUserRequestSchema)` to create a new message.
+ */
+export const RegisterUserRequestSchema: GenMessage<RegisterUserRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 63);
+
+/**
+ * @generated from message game.v1.RegisterUserResponse
+ */
+export type RegisterUserResponse = Message<"game.v1.RegisterUserResponse"> & {
+  /**
+   * @generated from field: game.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * JoinGame・JoinQueueのuser_tokenに指定する
+   *
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message game.v1.RegisterUserResponse.
+ * Use `create(RegisterUserResponseSchema)` to create a new message.
+ */
+export const RegisterUserResponseSchema: GenMessage<RegisterUserResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 64);
+
+/**
+ * @generated from message game.v1.GetRatingsRequest
+ */
+export type GetRatingsRequest = Message<"game.v1.GetRatingsRequest"> & {
+  /**
+   * 上位何人を返すか（0は10人）
+   *
+   * @generated from field: int32 limit = 1;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message game.v1.GetRatingsRequest.
+ * Use `create(GetRatingsRequestSchema)` to create a new message.
+ */
+export const GetRatingsRequestSchema: GenMessage<GetRatingsRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 65);
+
+/**
+ * @generated from message game.v1.GetRatingsResponse
+ */
+export type GetRatingsResponse = Message<"game.v1.GetRatingsResponse"> & {
+  /**
+   * レーティングの高い順
+   *
+   * @generated from field: repeated game.v1.User users = 1;
+   */
+  users: User[];
+};
+
+/**
+ * Describes the message game.v1.GetRatingsResponse.
+ * Use `create(GetRatingsResponseSchema)` to create a new message.
+ */
+export const GetRatingsResponseSchema: GenMessage<GetRatingsResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 66);
+
+/**
+ * Match series 
+ *
+ * @generated from message game.v1.MatchStanding
+ */
+export type MatchStanding = Message<"game.v1.MatchStanding"> & {
+  /**
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * @generated from field: int32 wins = 2;
+   */
+  wins: number;
+};
+
+/**
+ * Describes the message game.v1.MatchStanding.
+ * Use `create(MatchStandingSchema)` to create a new message.
+ */
+export const MatchStandingSchema: GenMessage<MatchStanding> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 67);
+
+/**
+ * @generated from message game.v1.MatchGameScore
+ */
+export type MatchGameScore = Message<"game.v1.MatchGameScore"> & {
+  /**
+   * @generated from field: string player_name = 1;
+   */
+  playerName: string;
+
+  /**
+   * @generated from field: int32 score = 2;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message game.v1.MatchGameScore.
+ * Use `create(MatchGameScoreSchema)` to create a new message.
+ */
+export const MatchGameScoreSchema: GenMessage<MatchGameScore> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 68);
+
+/**
+ * @generated from message game.v1.MatchGame
+ */
+export type MatchGame = Message<"game.v1.MatchGame"> & {
+  /**
+   * @generated from field: int32 game_id = 1;
+   */
+  gameId: number;
+
+  /**
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * 終了していなければ空
+   *
+   * @generated from field: string winner_name = 3;
+   */
+  winnerName: string;
+
+  /**
+   * @generated from field: repeated game.v1.MatchGameScore scores = 4;
+   */
+  scores: MatchGameScore[];
+};
+
+/**
+ * Describes the message game.v1.MatchGame.
+ * Use `create(MatchGameSchema)` to create a new message.
+ */
+export const MatchGameSchema: GenMessage<MatchGame> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 69);
+
+/**
+ * @generated from message game.v1.Match
+ */
+export type Match = Message<"game.v1.Match"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * BEST_OF, FIRST_TO
+   *
+   * @generated from field: string format = 2;
+   */
+  format: string;
+
+  /**
+   * @generated from field: int32 length = 3;
+   */
+  length: number;
+
+  /**
+   * PLAYING, FINISHED
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string winner_name = 5;
+   */
+  winnerName: string;
+
+  /**
+   * 勝利数の多い順
+   *
+   * @generated from field: repeated game.v1.MatchStanding standings = 6;
+   */
+  standings: MatchStanding[];
+
+  /**
+   * 行った順
+   *
+   * @generated from field: repeated game.v1.MatchGame games = 7;
+   */
+  games: MatchGame[];
+};
+
+/**
+ * Describes the message game.v1.Match.
+ * Use `create(MatchSchema)` to create a new message.
+ */
+export const MatchSchema: GenMessage<Match> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 70);
+
+/**
+ * @generated from message game.v1.GetMatchRequest
+ */
+export type GetMatchRequest = Message<"game.v1.GetMatchRequest"> & {
+  /**
+   * @generated from field: int32 match_id = 1;
+   */
+  matchId: number;
+};
+
+/**
+ * Describes the message game.v1.GetMatchRequest.
+ * Use `create(GetMatchRequestSchema)` to create a new message.
+ */
+export const GetMatchRequestSchema: GenMessage<GetMatchRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 71);
+
+/**
+ * @generated from message game.v1.GetMatchResponse
+ */
+export type GetMatchResponse = Message<"game.v1.GetMatchResponse"> & {
+  /**
+   * @generated from field: game.v1.Match match = 1;
+   */
+  match?: Match;
+};
+
+/**
+ * Describes the message game.v1.GetMatchResponse.
+ * Use `create(GetMatchResponseSchema)` to create a new message.
+ */
+export const GetMatchResponseSchema: GenMessage<GetMatchResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 72);
+
+/**
+ * Tournament 
+ *
+ * @generated from message game.v1.TournamentEntrant
+ */
+export type TournamentEntrant = Message<"game.v1.TournamentEntrant"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * 登録順（1が第1シード）
+   *
+   * @generated from field: int32 seed = 3;
+   */
+  seed: number;
+};
+
+/**
+ * Describes the message game.v1.TournamentEntrant.
+ * Use `create(TournamentEntrantSchema)` to create a new message.
+ */
+export const TournamentEntrantSchema: GenMessage<TournamentEntrant> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 73);
+
+/**
+ * @generated from message game.v1.TournamentMatch
+ */
+export type TournamentMatch = Message<"game.v1.TournamentMatch"> & {
+  /**
+   * ブラケット内の試合番号
+   *
+   * @generated from field: int32 number = 1;
+   */
+  number: number;
+
+  /**
+   * WINNERS, LOSERS, GRAND_FINAL
+   *
+   * @generated from field: string side = 2;
+   */
+  side: string;
+
+  /**
+   * @generated from field: int32 round = 3;
+   */
+  round: number;
+
+  /**
+   * PENDING, PLAYING, FINISHED
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * 決まっていなければ未設定
+   *
+   * @generated from field: game.v1.TournamentEntrant entrant1 = 5;
+   */
+  entrant1?: TournamentEntrant;
+
+  /**
+   * @generated from field: game.v1.TournamentEntrant entrant2 = 6;
+   */
+  entrant2?: TournamentEntrant;
+
+  /**
+   * 決まっていなければ0
+   *
+   * @generated from field: int32 winner_seed = 7;
+   */
+  winnerSeed: number;
+
+  /**
+   * 試合中・終了した試合のゲーム（不戦勝は0）
+   *
+   * @generated from field: int32 game_id = 8;
+   */
+  gameId: number;
+};
+
+/**
+ * Describes the message game.v1.TournamentMatch.
+ * Use `create(TournamentMatchSchema)` to create a new message.
+ */
+export const TournamentMatchSchema: GenMessage<TournamentMatch> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 74);
+
+/**
+ * @generated from message game.v1.Tournament
+ */
+export type Tournament = Message<"game.v1.Tournament"> & {
+  /**
+   * @generated from field: int32 id = 1;
+   */
+  id: number;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * SINGLE_ELIMINATION, DOUBLE_ELIMINATION
+   *
+   * @generated from field: string format = 3;
+   */
+  format: string;
+
+  /**
+   * REGISTERING, STARTED, FINISHED
+   *
+   * @generated from field: string status = 4;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string mode = 5;
+   */
+  mode: string;
+
+  /**
+   * @generated from field: int32 card_count = 6;
+   */
+  cardCount: number;
+
+  /**
+   * @generated from field: repeated game.v1.TournamentEntrant entrants = 7;
+   */
+  entrants: TournamentEntrant[];
+
+  /**
+   * 開始後に作られる
+   *
+   * @generated from field: repeated game.v1.TournamentMatch matches = 8;
+   */
+  matches: TournamentMatch[];
+
+  /**
+   * @generated from field: game.v1.TournamentEntrant champion = 9;
+   */
+  champion?: TournamentEntrant;
+};
+
+/**
+ * Describes the message game.v1.Tournament.
+ * Use `create(TournamentSchema)` to create a new message.
+ */
+export const TournamentSchema: GenMessage<Tournament> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 75);
+
+/**
+ * @generated from message game.v1.CreateTournamentRequest
+ */
+export type CreateTournamentRequest = Message<"game.v1.CreateTournamentRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * 未指定はSINGLE_ELIMINATION
+   *
+   * @generated from field: string format = 2;
+   */
+  format: string;
+
+  /**
+   * 各試合のゲームモード（勝者が決まるモードのみ）
+   *
+   * @generated from field: string mode = 3;
+   */
+  mode: string;
+
+  /**
+   * @generated from field: int32 card_count = 4;
+   */
+  cardCount: number;
+
+  /**
+   * 作成と同時に登録するプレイヤー
+   *
+   * @generated from field: repeated string player_names = 5;
+   */
+  playerNames: string[];
+};
+
+/**
+ * Describes the message game.v1.CreateTournamentRequest.
+ * Use `create(CreateTournamentRequestSchema)` to create a new message.
+ */
+export const CreateTournamentRequestSchema: GenMessage<CreateTournamentRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 76);
+
+/**
+ * @generated from message game.v1.CreateTournamentResponse
+ */
+export type CreateTournamentResponse = Message<"game.v1.CreateTournamentResponse"> & {
+  /**
+   * @generated from field: game.v1.Tournament tournament = 1;
+   */
+  tournament?: Tournament;
+
+  /**
+   * 登録と開始に使う
+   *
+   * @generated from field: string organizer_token = 2;
+   */
+  organizerToken: string;
+
+  /**
+   * 参加者ごとの試合参加用トークン（シード順）。主催者が各参加者に渡す
+   *
+   * @generated from field: repeated string entrant_tokens = 3;
+   */
+  entrantTokens: string[];
+};
+
+/**
+ * Describes the message game.v1.CreateTournamentResponse.
+ * Use `create(CreateTournamentResponseSchema)` to create a new message.
+ */
+export const CreateTournamentResponseSchema: GenMessage<CreateTournamentResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 77);
+
+/**
+ * @generated from message game.v1.RegisterTournamentPlayerRequest
+ */
+export type RegisterTournamentPlayerRequest = Message<"game.v1.RegisterTournamentPlayerRequest"> & {
+  /**
+   * @generated from field: int32 tournament_id = 1;
+   */
+  tournamentId: number;
+
+  /**
+   * @generated from field: string organizer_token = 2;
+   */
+  organizerToken: string;
+
+  /**
+   * @generated from field: string player_name = 3;
+   */
+  playerName: string;
+};
+
+/**
+ * Describes the message game.v1.RegisterTournamentPlayerRequest.
+ * Use `create(RegisterTournamentPlayerRequestSchema)` to create a new message.
+ */
+export const RegisterTournamentPlayerRequestSchema: GenMessage<RegisterTournamentPlayerRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 78);
+
+/**
+ * @generated from message game.v1.RegisterTournamentPlayerResponse
+ */
+export type RegisterTournamentPlayerResponse = Message<"game.v1.RegisterTournamentPlayerResponse"> & {
+  /**
+   * @generated from field: game.v1.TournamentEntrant entrant = 1;
+   */
+  entrant?: TournamentEntrant;
+
+  /**
+   * 試合参加用トークン。主催者が参加者に渡す
+   *
+   * @generated from field: string entrant_token = 2;
+   */
+  entrantToken: string;
+};
+
+/**
+ * Describes the message game.v1.RegisterTournamentPlayerResponse.
+ * Use `create(RegisterTournamentPlayerResponseSchema)` to create a new message.
+ */
+export const RegisterTournamentPlayerResponseSchema: GenMessage<RegisterTournamentPlayerResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 79);
+
+/**
+ * @generated from message game.v1.StartTournamentRequest
+ */
+export type StartTournamentRequest = Message<"game.v1.StartTournamentRequest"> & {
+  /**
+   * @generated from field: int32 tournament_id = 1;
+   */
+  tournamentId: number;
+
+  /**
+   * @generated from field: string organizer_token = 2;
+   */
+  organizerToken: string;
+};
+
+/**
+ * Describes the message game.v1.StartTournamentRequest.
+ * Use `create(StartTournamentRequestSchema)` to create a new message.
+ */
+export const StartTournamentRequestSchema: GenMessage<StartTournamentRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 80);
+
+/**
+ * @generated from message game.v1.StartTournamentResponse
+ */
+export type StartTournamentResponse = Message<"game.v1.StartTournamentResponse"> & {
+  /**
+   * @generated from field: game.v1.Tournament tournament = 1;
+   */
+  tournament?: Tournament;
+};
+
+/**
+ * Describes the message game.v1.StartTournamentResponse.
+ * Use `create(StartTournamentResponseSchema)` to create a new message.
+ */
+export const StartTournamentResponseSchema: GenMessage<StartTournamentResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 81);
+
+/**
+ * @generated from message game.v1.GetTournamentRequest
+ */
+export type GetTournamentRequest = Message<"game.v1.GetTournamentRequest"> & {
+  /**
+   * @generated from field: int32 tournament_id = 1;
+   */
+  tournamentId: number;
+};
+
+/**
+ * Describes the message game.v1.GetTournamentRequest.
+ * Use `create(GetTournamentRequestSchema)` to create a new message.
+ */
+export const GetTournamentRequestSchema: GenMessage<GetTournamentRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 82);
+
+/**
+ * @generated from message game.v1.GetTournamentResponse
+ */
+export type GetTournamentResponse = Message<"game.v1.GetTournamentResponse"> & {
+  /**
+   * @generated from field: game.v1.Tournament tournament = 1;
+   */
+  tournament?: Tournament;
+};
+
+/**
+ * Describes the message game.v1.GetTournamentResponse.
+ * Use `create(GetTournamentResponseSchema)` to create a new message.
+ */
+export const GetTournamentResponseSchema: GenMessage<GetTournamentResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 83);
+
+/**
+ * Delete game 
+ *
+ * @generated from message game.v1.DeleteGameRequest
+ */
+export type DeleteGameRequest = Message<"game.v1.DeleteGameRequest"> & {
+  /**
+   * @generated from field: string game_id = 1;
+   */
+  gameId: string;
+};
+
+/**
+ * Describes the message game.v1.DeleteGameRequest.
+ * Use `create(DeleteGameRequestSchema)` to create a new message.
+ */
+export const DeleteGameRequestSchema: GenMessage<DeleteGameRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 84);
+
+/**
+ * @generated from message game.v1.DeleteGameResponse
+ */
+export type DeleteGameResponse = Message<"game.v1.DeleteGameResponse"> & {
+};
+
+/**
+ * Describes the message game.v1.DeleteGameResponse.
+ * Use `create(DeleteGameResponseSchema)` to create a new message.
+ */
+export const DeleteGameResponseSchema: GenMessage<DeleteGameResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 85);
+
+/**
+ * Get team high scores 
+ *
+ * @generated from message game.v1.GetTeamHighScoresRequest
+ */
+export type GetTeamHighScoresRequest = Message<"game.v1.GetTeamHighScoresRequest"> & {
+};
+
+/**
+ * Describes the message game.v1.GetTeamHighScoresRequest.
+ * Use `create(GetTeamHighScoresRequestSchema)` to create a new message.
+ */
+export const GetTeamHighScoresRequestSchema: GenMessage<GetTeamHighScoresRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 86);
+
+/**
+ * @generated from message game.v1.TeamHighScore
+ */
+export type TeamHighScore = Message<"game.v1.TeamHighScore"> & {
+  /**
+   * @generated from field: int32 card_count = 1;
+   */
+  cardCount: number;
+
+  /**
+   * @generated from field: int32 team_score = 2;
+   */
+  teamScore: number;
+
+  /**
+   * @generated from field: int32 game_id = 3;
+   */
+  gameId: number;
+
+  /**
+   * @generated from field: string game_name = 4;
+   */
+  gameName: string;
+};
+
+/**
+ * Describes the message game.v1.TeamHighScore.
+ * Use `create(TeamHighScoreSchema)` to create a new message.
+ */
+export const TeamHighScoreSchema: GenMessage<TeamHighScore> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 87);
+
+/**
+ * @generated from message game.v1.GetTeamHighScoresResponse
+ */
+export type GetTeamHighScoresResponse = Message<"game.v1.GetTeamHighScoresResponse"> & {
+  /**
+   * @generated from field: repeated game.v1.TeamHighScore high_scores = 1;
+   */
+  highScores: TeamHighScore[];
+};
+
+/**
+ * Describes the message game.v1.GetTeamHighScoresResponse.
+ * Use `create(GetTeamHighScoresResponseSchema)` to create a new message.
+ */
+export const GetTeamHighScoresResponseSchema: GenMessage<GetTeamHighScoresResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 88);
+
+/**
+ * Get game modes 
+ *
+ * @generated from message game.v1.GetGameModesRequest
+ */
+export type GetGameModesRequest = Message<"game.v1.GetGameModesRequest"> & {
+};
+
+/**
+ * Describes the message game.v1.GetGameModesRequest.
+ * Use `create(GetGameModesRequestSchema)` to create a new message.
+ */
+export const GetGameModesRequestSchema: GenMessage<GetGameModesRequest> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 89);
+
+/**
+ * @generated from message game.v1.GetGameModesResponse
+ */
+export type GetGameModesResponse = Message<"game.v1.GetGameModesResponse"> & {
+  /**
+   * @generated from field: repeated string modes = 1;
+   */
+  modes: string[];
+};
+
+/**
+ * Describes the message game.v1.GetGameModesResponse.
+ * Use `create(GetGameModesResponseSchema)` to create a new message.
+ */
+export const GetGameModesResponseSchema: GenMessage<GetGameModesResponse> = /*@__PURE__*/
+  messageDesc(file_game_v1_game, 90);
+
+/**
+ * @generated from service game.v1.CreateGameService
+ */
+export const CreateGameService: GenService<{
+  /**
+   * @generated from rpc game.v1.CreateGameService.CreateGame
+   */
+  createGame: {
+    methodKind: "unary";
+    input: typeof CreateGameRequestSchema;
+    output: typeof CreateGameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 0);
+
+/**
+ * @generated from service game.v1.GetGamesService
+ */
+export const GetGamesService: GenService<{
+  /**
+   * @generated from rpc game.v1.GetGamesService.GetGames
+   */
+  getGames: {
+    methodKind: "unary";
+    input: typeof GetGamesRequestSchema;
+    output: typeof GetGamesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 1);
+
+/**
+ * @generated from service game.v1.JoinGameService
+ */
+export const JoinGameService: GenService<{
+  /**
+   * @generated from rpc game.v1.JoinGameService.JoinGame
+   */
+  joinGame: {
+    methodKind: "unary";
+    input: typeof JoinGameRequestSchema;
+    output: typeof JoinGameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 2);
+
+/**
+ * @generated from service game.v1.StartGameService
+ */
+export const StartGameService: GenService<{
+  /**
+   * @generated from rpc game.v1.StartGameService.StartGame
+   */
+  startGame: {
+    methodKind: "unary";
+    input: typeof StartGameRequestSchema;
+    output: typeof StartGameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 3);
+
+/**
+ * @generated from service game.v1.UpdateGameSettingsService
+ */
+export const UpdateGameSettingsService: GenService<{
+  /**
+   * @generated from rpc game.v1.UpdateGameSettingsService.UpdateGameSettings
+   */
+  updateGameSettings: {
+    methodKind: "unary";
+    input: typeof UpdateGameSettingsRequestSchema;
+    output: typeof UpdateGameSettingsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 4);
+
+/**
+ * @generated from service game.v1.KickPlayerService
+ */
+export const KickPlayerService: GenService<{
+  /**
+   * @generated from rpc game.v1.KickPlayerService.KickPlayer
+   */
+  kickPlayer: {
+    methodKind: "unary";
+    input: typeof KickPlayerRequestSchema;
+    output: typeof KickPlayerResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 5);
 
 /**
- * @generated from message game.v1.StartGameResponse
+ * @generated from service game.v1.BanPlayerService
  */
-export type StartGameResponse = Message<"game.v1.StartGameResponse"> & {
-};
+export const BanPlayerService: GenService<{
+  /**
+   * @generated from rpc game.v1.BanPlayerService.BanPlayer
+   */
+  banPlayer: {
+    methodKind: "unary";
+    input: typeof BanPlayerRequestSchema;
+    output: typeof BanPlayerResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 6);
 
 /**
- * Describes the message game.v1.StartGameResponse.
- * Use `create(StartGameResponseSchema)` to create a new message.
+ * @generated from service game.v1.MutePlayerService
  */
-export const StartGameResponseSchema: GenMessage<StartGameResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 9);
+export const MutePlayerService: GenService<{
+  /**
+   * @generated from rpc game.v1.MutePlayerService.MutePlayer
+   */
+  mutePlayer: {
+    methodKind: "unary";
+    input: typeof MutePlayerRequestSchema;
+    output: typeof MutePlayerResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 7);
 
 /**
- * Report ready 
- *
- * @generated from message game.v1.ReportReadyRequest
+ * @generated from service game.v1.SendChatService
  */
-export type ReportReadyRequest = Message<"game.v1.ReportReadyRequest"> & {
+export const SendChatService: GenService<{
   /**
-   * @generated from field: string player_id = 1;
+   * @generated from rpc game.v1.SendChatService.SendChat
    */
-  playerId: string;
-};
+  sendChat: {
+    methodKind: "unary";
+    input: typeof SendChatRequestSchema;
+    output: typeof SendChatResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 8);
 
 /**
- * Describes the message game.v1.ReportReadyRequest.
- * Use `create(ReportReadyRequestSchema)` to create a new message.
+ * @generated from service game.v1.RotateInviteCodeService
  */
-export const ReportReadyRequestSchema: GenMessage<ReportReadyRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 10);
+export const RotateInviteCodeService: GenService<{
+  /**
+   * @generated from rpc game.v1.RotateInviteCodeService.RotateInviteCode
+   */
+  rotateInviteCode: {
+    methodKind: "unary";
+    input: typeof RotateInviteCodeRequestSchema;
+    output: typeof RotateInviteCodeResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 9);
 
 /**
- * @generated from message game.v1.ReportReadyResponse
+ * @generated from service game.v1.ChangeTeamService
  */
-export type ReportReadyResponse = Message<"game.v1.ReportReadyResponse"> & {
-};
+export const ChangeTeamService: GenService<{
+  /**
+   * @generated from rpc game.v1.ChangeTeamService.ChangeTeam
+   */
+  changeTeam: {
+    methodKind: "unary";
+    input: typeof ChangeTeamRequestSchema;
+    output: typeof ChangeTeamResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 10);
 
 /**
- * Describes the message game.v1.ReportReadyResponse.
- * Use `create(ReportReadyResponseSchema)` to create a new message.
+ * @generated from service game.v1.SetHandicapService
  */
-export const ReportReadyResponseSchema: GenMessage<ReportReadyResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 11);
+export const SetHandicapService: GenService<{
+  /**
+   * @generated from rpc game.v1.SetHandicapService.SetHandicap
+   */
+  setHandicap: {
+    methodKind: "unary";
+    input: typeof SetHandicapRequestSchema;
+    output: typeof SetHandicapResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 11);
 
 /**
- * Submit Answer 
- *
- * @generated from message game.v1.Card
+ * @generated from service game.v1.PauseGameService
  */
-export type Card = Message<"game.v1.Card"> & {
+export const PauseGameService: GenService<{
   /**
-   * @generated from field: int32 id = 1;
+   * @generated from rpc game.v1.PauseGameService.PauseGame
    */
-  id: number;
+  pauseGame: {
+    methodKind: "unary";
+    input: typeof PauseGameRequestSchema;
+    output: typeof PauseGameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 12);
 
+/**
+ * @generated from service game.v1.ResumeGameService
+ */
+export const ResumeGameService: GenService<{
   /**
-   * @generated from field: string text = 2;
+   * @generated from rpc game.v1.ResumeGameService.ResumeGame
    */
-  text: string;
-};
+  resumeGame: {
+    methodKind: "unary";
+    input: typeof ResumeGameRequestSchema;
+    output: typeof ResumeGameResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 13);
 
 /**
- * Describes the message game.v1.Card.
- * Use `create(CardSchema)` to create a new message.
+ * @generated from service game.v1.RequestRematchService
  */
-export const CardSchema: GenMessage<Card> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 12);
+export const RequestRematchService: GenService<{
+  /**
+   * @generated from rpc game.v1.RequestRematchService.RequestRematch
+   */
+  requestRematch: {
+    methodKind: "unary";
+    input: typeof RequestRematchRequestSchema;
+    output: typeof RequestRematchResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 14);
 
 /**
- * @generated from message game.v1.SubmitAnswerRequest
+ * @generated from service game.v1.AddBotService
  */
-export type SubmitAnswerRequest = Message<"game.v1.SubmitAnswerRequest"> & {
+export const AddBotService: GenService<{
   /**
-   * @generated from field: string player_id = 1;
+   * @generated from rpc game.v1.AddBotService.AddBot
    */
-  playerId: string;
+  addBot: {
+    methodKind: "unary";
+    input: typeof AddBotRequestSchema;
+    output: typeof AddBotResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 15);
 
+/**
+ * @generated from service game.v1.ReportReadyService
+ */
+export const ReportReadyService: GenService<{
   /**
-   * @generated from field: game.v1.Card card1 = 2;
+   * @generated from rpc game.v1.ReportReadyService.ReportReady
    */
-  card1?: Card;
+  reportReady: {
+    methodKind: "unary";
+    input: typeof ReportReadyRequestSchema;
+    output: typeof ReportReadyResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 16);
 
+/**
+ * @generated from service game.v1.SubmitAnswerService
+ */
+export const SubmitAnswerService: GenService<{
   /**
-   * @generated from field: game.v1.Card card2 = 3;
+   * @generated from rpc game.v1.SubmitAnswerService.SubmitAnswer
    */
-  card2?: Card;
+  submitAnswer: {
+    methodKind: "unary";
+    input: typeof SubmitAnswerRequestSchema;
+    output: typeof SubmitAnswerResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 17);
 
+/**
+ * @generated from service game.v1.StartPracticeService
+ */
+export const StartPracticeService: GenService<{
   /**
-   * @generated from field: string answer = 4;
+   * @generated from rpc game.v1.StartPracticeService.StartPractice
    */
-  answer: string;
-};
+  startPractice: {
+    methodKind: "unary";
+    input: typeof StartPracticeRequestSchema;
+    output: typeof StartPracticeResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 18);
 
 /**
- * Describes the message game.v1.SubmitAnswerRequest.
- * Use `create(SubmitAnswerRequestSchema)` to create a new message.
+ * @generated from service game.v1.SubmitPracticeAnswerService
  */
-export const SubmitAnswerRequestSchema: GenMessage<SubmitAnswerRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 13);
+export const SubmitPracticeAnswerService: GenService<{
+  /**
+   * @generated from rpc game.v1.SubmitPracticeAnswerService.SubmitPracticeAnswer
+   */
+  submitPracticeAnswer: {
+    methodKind: "unary";
+    input: typeof SubmitPracticeAnswerRequestSchema;
+    output: typeof SubmitPracticeAnswerResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 19);
 
 /**
- * @generated from message game.v1.SubmitAnswerResponse
+ * @generated from service game.v1.GetPersonalBestsService
  */
-export type SubmitAnswerResponse = Message<"game.v1.SubmitAnswerResponse"> & {
+export const GetPersonalBestsService: GenService<{
   /**
-   * @generated from field: string is_correct = 1;
+   * @generated from rpc game.v1.GetPersonalBestsService.GetPersonalBests
    */
-  isCorrect: string;
-};
+  getPersonalBests: {
+    methodKind: "unary";
+    input: typeof GetPersonalBestsRequestSchema;
+    output: typeof GetPersonalBestsResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 20);
 
 /**
- * Describes the message game.v1.SubmitAnswerResponse.
- * Use `create(SubmitAnswerResponseSchema)` to create a new message.
+ * @generated from service game.v1.StartGhostRaceService
  */
-export const SubmitAnswerResponseSchema: GenMessage<SubmitAnswerResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 14);
+export const StartGhostRaceService: GenService<{
+  /**
+   * @generated from rpc game.v1.StartGhostRaceService.StartGhostRace
+   */
+  startGhostRace: {
+    methodKind: "unary";
+    input: typeof StartGhostRaceRequestSchema;
+    output: typeof StartGhostRaceResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 21);
 
 /**
- * Delete game 
- *
- * @generated from message game.v1.DeleteGameRequest
+ * @generated from service game.v1.StartDailyChallengeService
  */
-export type DeleteGameRequest = Message<"game.v1.DeleteGameRequest"> & {
+export const StartDailyChallengeService: GenService<{
   /**
-   * @generated from field: string game_id = 1;
+   * @generated from rpc game.v1.StartDailyChallengeService.StartDailyChallenge
    */
-  gameId: string;
-};
+  startDailyChallenge: {
+    methodKind: "unary";
+    input: typeof StartDailyChallengeRequestSchema;
+    output: typeof StartDailyChallengeResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 22);
 
 /**
- * Describes the message game.v1.DeleteGameRequest.
- * Use `create(DeleteGameRequestSchema)` to create a new message.
+ * @generated from service game.v1.GetDailyLeaderboardService
  */
-export const DeleteGameRequestSchema: GenMessage<DeleteGameRequest> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 15);
+export const GetDailyLeaderboardService: GenService<{
+  /**
+   * @generated from rpc game.v1.GetDailyLeaderboardService.GetDailyLeaderboard
+   */
+  getDailyLeaderboard: {
+    methodKind: "unary";
+    input: typeof GetDailyLeaderboardRequestSchema;
+    output: typeof GetDailyLeaderboardResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 23);
 
 /**
- * @generated from message game.v1.DeleteGameResponse
+ * @generated from service game.v1.JoinQueueService
  */
-export type DeleteGameResponse = Message<"game.v1.DeleteGameResponse"> & {
-};
+export const JoinQueueService: GenService<{
+  /**
+   * @generated from rpc game.v1.JoinQueueService.JoinQueue
+   */
+  joinQueue: {
+    methodKind: "unary";
+    input: typeof JoinQueueRequestSchema;
+    output: typeof JoinQueueResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 24);
 
 /**
- * Describes the message game.v1.DeleteGameResponse.
- * Use `create(DeleteGameResponseSchema)` to create a new message.
+ * @generated from service game.v1.LeaveQueueService
  */
-export const DeleteGameResponseSchema: GenMessage<DeleteGameResponse> = /*@__PURE__*/
-  messageDesc(file_game_v1_game, 16);
+export const LeaveQueueService: GenService<{
+  /**
+   * @generated from rpc game.v1.LeaveQueueService.LeaveQueue
+   */
+  leaveQueue: {
+    methodKind: "unary";
+    input: typeof LeaveQueueRequestSchema;
+    output: typeof LeaveQueueResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 25);
 
 /**
- * @generated from service game.v1.CreateGameService
+ * @generated from service game.v1.RegisterUserService
  */
-export const CreateGameService: GenService<{
+export const RegisterUserService: GenService<{
   /**
-   * @generated from rpc game.v1.CreateGameService.CreateGame
+   * @generated from rpc game.v1.RegisterUserService.RegisterUser
    */
-  createGame: {
+  registerUser: {
     methodKind: "unary";
-    input: typeof CreateGameRequestSchema;
-    output: typeof CreateGameResponseSchema;
+    input: typeof RegisterUserRequestSchema;
+    output: typeof RegisterUserResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 0);
+  serviceDesc(file_game_v1_game, 26);
 
 /**
- * @generated from service game.v1.GetGamesService
+ * @generated from service game.v1.GetRatingsService
  */
-export const GetGamesService: GenService<{
+export const GetRatingsService: GenService<{
   /**
-   * @generated from rpc game.v1.GetGamesService.GetGames
+   * @generated from rpc game.v1.GetRatingsService.GetRatings
    */
-  getGames: {
+  getRatings: {
     methodKind: "unary";
-    input: typeof GetGamesRequestSchema;
-    output: typeof GetGamesResponseSchema;
+    input: typeof GetRatingsRequestSchema;
+    output: typeof GetRatingsResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 1);
+  serviceDesc(file_game_v1_game, 27);
 
 /**
- * @generated from service game.v1.JoinGameService
+ * @generated from service game.v1.GetMatchService
  */
-export const JoinGameService: GenService<{
+export const GetMatchService: GenService<{
   /**
-   * @generated from rpc game.v1.JoinGameService.JoinGame
+   * @generated from rpc game.v1.GetMatchService.GetMatch
    */
-  joinGame: {
+  getMatch: {
     methodKind: "unary";
-    input: typeof JoinGameRequestSchema;
-    output: typeof JoinGameResponseSchema;
+    input: typeof GetMatchRequestSchema;
+    output: typeof GetMatchResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 2);
+  serviceDesc(file_game_v1_game, 28);
 
 /**
- * @generated from service game.v1.StartGameService
+ * @generated from service game.v1.CreateTournamentService
  */
-export const StartGameService: GenService<{
+export const CreateTournamentService: GenService<{
   /**
-   * @generated from rpc game.v1.StartGameService.StartGame
+   * @generated from rpc game.v1.CreateTournamentService.CreateTournament
    */
-  startGame: {
+  createTournament: {
     methodKind: "unary";
-    input: typeof StartGameRequestSchema;
-    output: typeof StartGameResponseSchema;
+    input: typeof CreateTournamentRequestSchema;
+    output: typeof CreateTournamentResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 3);
+  serviceDesc(file_game_v1_game, 29);
 
 /**
- * @generated from service game.v1.ReportReadyService
+ * @generated from service game.v1.RegisterTournamentPlayerService
  */
-export const ReportReadyService: GenService<{
+export const RegisterTournamentPlayerService: GenService<{
   /**
-   * @generated from rpc game.v1.ReportReadyService.ReportReady
+   * @generated from rpc game.v1.RegisterTournamentPlayerService.RegisterTournamentPlayer
    */
-  reportReady: {
+  registerTournamentPlayer: {
     methodKind: "unary";
-    input: typeof ReportReadyRequestSchema;
-    output: typeof ReportReadyResponseSchema;
+    input: typeof RegisterTournamentPlayerRequestSchema;
+    output: typeof RegisterTournamentPlayerResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 4);
+  serviceDesc(file_game_v1_game, 30);
 
 /**
- * @generated from service game.v1.SubmitAnswerService
+ * @generated from service game.v1.StartTournamentService
  */
-export const SubmitAnswerService: GenService<{
+export const StartTournamentService: GenService<{
   /**
-   * @generated from rpc game.v1.SubmitAnswerService.SubmitAnswer
+   * @generated from rpc game.v1.StartTournamentService.StartTournament
    */
-  submitAnswer: {
+  startTournament: {
     methodKind: "unary";
-    input: typeof SubmitAnswerRequestSchema;
-    output: typeof SubmitAnswerResponseSchema;
+    input: typeof StartTournamentRequestSchema;
+    output: typeof StartTournamentResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 5);
+  serviceDesc(file_game_v1_game, 31);
+
+/**
+ * @generated from service game.v1.GetTournamentService
+ */
+export const GetTournamentService: GenService<{
+  /**
+   * @generated from rpc game.v1.GetTournamentService.GetTournament
+   */
+  getTournament: {
+    methodKind: "unary";
+    input: typeof GetTournamentRequestSchema;
+    output: typeof GetTournamentResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 32);
 
 /**
  * @generated from service game.v1.DeleteGameService
@@ -474,5 +3340,35 @@ export const DeleteGameService: GenService<{
     output: typeof DeleteGameResponseSchema;
   },
 }> = /*@__PURE__*/
-  serviceDesc(file_game_v1_game, 6);
+  serviceDesc(file_game_v1_game, 33);
+
+/**
+ * @generated from service game.v1.GetTeamHighScoresService
+ */
+export const GetTeamHighScoresService: GenService<{
+  /**
+   * @generated from rpc game.v1.GetTeamHighScoresService.GetTeamHighScores
+   */
+  getTeamHighScores: {
+    methodKind: "unary";
+    input: typeof GetTeamHighScoresRequestSchema;
+    output: typeof GetTeamHighScoresResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 34);
+
+/**
+ * @generated from service game.v1.GetGameModesService
+ */
+export const GetGameModesService: GenService<{
+  /**
+   * @generated from rpc game.v1.GetGameModesService.GetGameModes
+   */
+  getGameModes: {
+    methodKind: "unary";
+    input: typeof GetGameModesRequestSchema;
+    output: typeof GetGameModesResponseSchema;
+  },
+}> = /*@__PURE__*/
+  serviceDesc(file_game_v1_game, 35);
 
@@ -204,6 +204,9 @@ const GameComponent = (props: GameProps) => {
     "20": "🐬", "21": "🚗", "22": "🚀", "23": "✈️", "24": "🚲",
     "25": "⛵", "26": "🎈", "27": "🎸", "28": "⌛", "29": "⏰",
     "30": "⚽",
+    // ハンデのおとりのシンボル
+    "31": "🍩", "32": "🦊", "33": "🌵", "34": "🎃", "35": "🐙",
+    "36": "🍄", "37": "🦋", "38": "🎁", "39": "🍉", "40": "🐢",
   };
 
   const extractSymbolNumbers = (text: string): string[] => {
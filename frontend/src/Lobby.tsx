@@ -22,6 +22,9 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
   const ws = useRef<WebSocket | null>(null);
   const [gameStatus, setGameStatus] = useState("");
   const [player, setPlayer] = useState<Player>();
+  const [resumeToken, setResumeToken] = useState("");
+  // 作成したゲームのホスト用トークン（参加時に示すとホストになる）
+  const hostTokens = useRef<Record<number, string>>({});
   const [games, setGames] = useState<Game[]>([]);
   const [gameName, setGameName] = useState("");
   const [playerName, setPlayerName] = useState(
@@ -104,7 +107,11 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
       ws.current = new WebSocket(wsUrl);
       ws.current.onopen = () => {
         ws.current?.send(
-          JSON.stringify({ game_id: player.gameId, player_id: player.id })
+          JSON.stringify({
+            game_id: player.gameId,
+            player_id: player.id,
+            resume_token: resumeToken,
+          })
         );
       };
 
@@ -245,7 +252,7 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
     lobbyWs.current.onmessage = (e: MessageEvent) => {
       try {
         const msg = JSON.parse(e.data);
-        if (msg.event === "CREATED" || msg.event === "JOINED" || msg.event === "DELETED" || msg.event === "STARTED") {
+        if (msg.event === "CREATED" || msg.event === "JOINED" || msg.event === "DELETED" || msg.event === "STARTED" || msg.event === "SPECTATORS") {
           updateGames();
         }
       } catch {}
@@ -388,10 +395,11 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
             e.preventDefault();
             if (!gameName.trim()) return;
             try {
-              await createGameClient.createGame({
+              const created = await createGameClient.createGame({
                 gameName: gameName,
                 cardCount: cardCount,
               });
+              hostTokens.current[created.gameId] = created.hostToken;
               setGameName("");
               updateGames();
             } catch (err: any) {
@@ -476,6 +484,11 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
                     <span className="ml-1 text-xs px-2 py-0.5 bg-primary/10 text-primary rounded-full font-medium">
                       {item.totalRounds}ラウンド
                     </span>
+                    {item.spectatorCount > 0 && (
+                      <span className="ml-1 text-xs px-2 py-0.5 bg-gray-100 text-text-muted rounded-full font-medium">
+                        {item.spectatorCount}人観戦中
+                      </span>
+                    )}
                   </div>
                 </div>
 
@@ -488,8 +501,10 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
                             const response = await joinGameServiceclient.joinGame({
                               gameId: String(item.id),
                               playerName: playerName.trim() || "player",
+                              hostToken: hostTokens.current[item.id] ?? "",
                             });
                             setPlayer(response.player);
+                            setResumeToken(response.resumeToken);
                             setGameStatus("JOINED");
                             setTotalRounds(0);
                             updateGames();
@@ -527,6 +542,7 @@ const Lobby: React.FC<LobbyProps> = ({}) => {
                         await startGameServiceclient.startGame({
                           gameId: String(item.id),
                           userId: String(player?.id),
+                          resumeToken: resumeToken,
                         });
                       }}
                       disabled={item.playerCount < 2}